@@ -0,0 +1,246 @@
+package cli
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/pentops/j5/gen/j5/config/v1/config_j5pb"
+	"github.com/pentops/j5/gen/j5/source/v1/source_j5pb"
+	"github.com/pentops/j5/internal/breaking"
+	"github.com/pentops/j5/internal/source"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+type BreakingConfig struct {
+	SourceConfig
+	Against string `flag:"against" description:"Image to compare against: registry:<version>, registry:<owner>/<name>@<version>, git:<ref> or an image file"`
+	Format  string `flag:"format" default:"human" description:"Report format, human or json"`
+	Output  string `flag:"output" default:"-" description:"Destination for the report. - for stdout, otherwise a file"`
+}
+
+// RunBreaking compares the bundle against a previous version, and fails when
+// the change would break existing clients.
+func RunBreaking(ctx context.Context, cfg BreakingConfig) error {
+	if cfg.Format != "human" && cfg.Format != "json" {
+		return fmt.Errorf("unknown format %q, expected human or json", cfg.Format)
+	}
+
+	current, bundleConfig, err := cfg.GetBundleImage(ctx)
+	if err != nil {
+		return err
+	}
+
+	against, err := cfg.againstImage(ctx, bundleConfig)
+	if err != nil {
+		return fmt.Errorf("loading image to compare against: %w", err)
+	}
+
+	report, err := breaking.CompareImages(against, current)
+	if err != nil {
+		return err
+	}
+
+	var bb []byte
+	switch cfg.Format {
+	case "json":
+		bb, err = json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return err
+		}
+		bb = append(bb, '\n')
+	default:
+		bb = []byte(report.HumanString())
+	}
+
+	if err := writeBytes(cfg.Output, bb); err != nil {
+		return err
+	}
+
+	if report.Breaking() {
+		return fmt.Errorf("%d breaking changes", len(report.Changes))
+	}
+	return nil
+}
+
+func (cfg BreakingConfig) againstImage(ctx context.Context, bundleConfig *config_j5pb.BundleConfigFile) (*source_j5pb.SourceImage, error) {
+	kind, ref, ok := strings.Cut(cfg.Against, ":")
+	if !ok {
+		kind, ref = "file", cfg.Against
+	}
+
+	switch kind {
+	case "registry":
+		return cfg.registryImage(ctx, bundleConfig, ref)
+	case "git":
+		return cfg.gitImage(ctx, ref)
+	case "file":
+		return readImageFile(ref)
+	default:
+		// Not a known prefix, e.g. a windows drive letter.
+		return readImageFile(cfg.Against)
+	}
+}
+
+func (cfg BreakingConfig) registryImage(ctx context.Context, bundleConfig *config_j5pb.BundleConfigFile, ref string) (*source_j5pb.SourceImage, error) {
+	var owner, name string
+	version := ref
+	if fullName, v, ok := strings.Cut(ref, "@"); ok {
+		parts := strings.Split(fullName, "/")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("registry bundle must be in the form of owner/name@version")
+		}
+		owner, name, version = parts[0], parts[1], v
+	} else {
+		if bundleConfig.Registry == nil {
+			return nil, fmt.Errorf("bundle has no registry config, use registry:<owner>/<name>@<version>")
+		}
+		owner, name = bundleConfig.Registry.Owner, bundleConfig.Registry.Name
+	}
+	if version == "" {
+		return nil, fmt.Errorf("registry version is required")
+	}
+
+	resolver, err := cfg.resolver()
+	if err != nil {
+		return nil, err
+	}
+
+	input := &config_j5pb.Input{
+		Type: &config_j5pb.Input_Registry_{
+			Registry: &config_j5pb.Input_Registry{
+				Owner:   owner,
+				Name:    name,
+				Version: &version,
+			},
+		},
+	}
+	src, err := resolver.GetRemoteDependency(ctx, input, nil)
+	if err != nil {
+		return nil, fmt.Errorf("getting remote bundle %s/%s@%s: %w", owner, name, version, err)
+	}
+
+	resolved, err := source.ResolveIncludes(ctx, resolver, src, nil)
+	if err != nil {
+		return nil, fmt.Errorf("resolving includes for remote bundle %s/%s@%s: %w", owner, name, version, err)
+	}
+	return resolved, nil
+}
+
+// gitImage builds the bundle from the source directory as of the git ref.
+func (cfg BreakingConfig) gitImage(ctx context.Context, ref string) (*source_j5pb.SourceImage, error) {
+	if ref == "" {
+		return nil, fmt.Errorf("git ref is required")
+	}
+
+	prefixOut, err := runGit(ctx, cfg.Source, "rev-parse", "--show-prefix")
+	if err != nil {
+		return nil, err
+	}
+	prefix := strings.TrimSpace(string(prefixOut))
+
+	tree := ref
+	if prefix != "" {
+		tree = ref + ":" + strings.TrimSuffix(prefix, "/")
+	}
+	archive, err := runGit(ctx, cfg.Source, "archive", "--format=tar", tree)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpDir, err := os.MkdirTemp("", "j5-breaking-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	if err := extractTar(bytes.NewReader(archive), tmpDir); err != nil {
+		return nil, fmt.Errorf("extracting %s: %w", ref, err)
+	}
+
+	against := SourceConfig{
+		Source: tmpDir,
+		Bundle: cfg.Bundle,
+	}
+	img, _, err := against.GetBundleImage(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("building %s: %w", ref, err)
+	}
+	return img, nil
+}
+
+func runGit(ctx context.Context, dir string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "git", append([]string{"-C", dir}, args...)...)
+	stderr := &bytes.Buffer{}
+	cmd.Stderr = stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git %s: %w: %s", strings.Join(args, " "), err, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
+
+func extractTar(r io.Reader, dir string) error {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(dir, filepath.FromSlash(hdr.Name))
+		if !strings.HasPrefix(target, filepath.Clean(dir)+string(os.PathSeparator)) {
+			return fmt.Errorf("invalid path in archive: %s", hdr.Name)
+		}
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			data, err := io.ReadAll(tr)
+			if err != nil {
+				return err
+			}
+			if err := os.WriteFile(target, data, 0644); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// readImageFile reads an image as proto JSON when the file is .json, otherwise
+// as binary protobuf.
+func readImageFile(filename string) (*source_j5pb.SourceImage, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	img := &source_j5pb.SourceImage{}
+	if filepath.Ext(filename) == ".json" {
+		err = protojson.Unmarshal(data, img)
+	} else {
+		err = proto.Unmarshal(data, img)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading image %s: %w", filename, err)
+	}
+	return img, nil
+}
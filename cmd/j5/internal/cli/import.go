@@ -0,0 +1,104 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/pentops/j5/internal/j5s/j5import"
+	"github.com/pentops/j5/internal/source"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type j5sImportConfig struct {
+	SourceConfig
+	Package   []string `flag:"package" default:"" description:"Packages to import, defaults to all local packages"`
+	Write     bool     `flag:"write" default:"false" desc:"Write the j5s files next to the sources, otherwise print them"`
+	Overwrite bool     `flag:"overwrite" default:"false" desc:"With --write, replace j5s files which already exist"`
+}
+
+// runJ5sImport converts the proto descriptors of the local packages back to
+// j5s source. Files which were already j5s are printed again in the standard
+// format, but are not written over without --overwrite, as the printed file
+// loses anything the descriptors don't hold, e.g. comments which are not
+// descriptions. Hand-written proto files are left in place when writing, and
+// should be removed once the j5s file replaces them.
+func runJ5sImport(ctx context.Context, cfg j5sImportConfig) error {
+	src, err := cfg.GetSource(ctx)
+	if err != nil {
+		return err
+	}
+
+	return cfg.EachBundle(ctx, func(bundle source.Bundle) error {
+		compiler, err := bundle.Compiler(ctx, src)
+		if err != nil {
+			return err
+		}
+
+		outWriter, err := cfg.FileWriterAt(ctx, bundle.DirInRepo())
+		if err != nil {
+			return err
+		}
+
+		for _, pkg := range compiler.ListLocalPackages() {
+			if len(cfg.Package) > 0 && !slices.Contains(cfg.Package, pkg) {
+				continue
+			}
+
+			out, err := compiler.CompilePackage(ctx, pkg)
+			if err != nil {
+				return fmt.Errorf("compile package %q: %w", pkg, err)
+			}
+
+			files := make([]protoreflect.FileDescriptor, 0, len(out.Proto))
+			for _, file := range out.Proto {
+				files = append(files, file.Linked)
+			}
+
+			imported, err := j5import.ImportPackage(pkg, files)
+			if err != nil {
+				return fmt.Errorf("import package %q: %w", pkg, err)
+			}
+
+			for _, file := range imported {
+				printed, err := j5import.Print(file)
+				if err != nil {
+					return fmt.Errorf("print %s: %w", file.Path, err)
+				}
+
+				if cfg.Write {
+					if !cfg.Overwrite {
+						_, err := fs.Stat(bundle.FS(), file.Path)
+						if err == nil {
+							fmt.Fprintf(os.Stderr, "%s already exists, skipped, use --overwrite to replace it\n", file.Path)
+							continue
+						} else if !errors.Is(err, fs.ErrNotExist) {
+							return err
+						}
+					}
+					if err := outWriter.PutFile(ctx, file.Path, []byte(printed)); err != nil {
+						return err
+					}
+					continue
+				}
+
+				fmt.Printf("// %s\n%s", file.Path, printed)
+				if !strings.HasSuffix(printed, "\n") {
+					fmt.Println()
+				}
+			}
+
+			for _, file := range out.Proto {
+				filename := file.Linked.Path()
+				if cfg.Write && !strings.HasSuffix(filename, ".j5s.proto") {
+					fmt.Fprintf(os.Stderr, "%s was imported, remove it before building\n", filename)
+				}
+			}
+		}
+		return nil
+	})
+}
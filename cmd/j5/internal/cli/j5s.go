@@ -26,12 +26,15 @@ func j5sSet() *commander.CommandSet {
 	genGroup.Add("fmt", commander.NewCommand(runJ5sFmt))
 	genGroup.Add("lint", commander.NewCommand(runJ5sLint))
 	genGroup.Add("genproto", commander.NewCommand(runJ5sGenProto))
+	genGroup.Add("rename", commander.NewCommand(runJ5sRename))
+	genGroup.Add("import", commander.NewCommand(runJ5sImport))
 	return genGroup
 }
 
 func runJ5sLint(ctx context.Context, cfg struct {
 	Dir  string `flag:"dir" required:"false" description:"Source / working directory containing j5.yaml"`
 	File string `flag:"file" required:"false" description:"Single file to format"`
+	Fix  bool   `flag:"fix" default:"false" desc:"Apply suggested fixes and formatting to files before linting"`
 }) error {
 
 	imageResolver, err := resolver.NewEnvResolver()
@@ -60,52 +63,158 @@ func runJ5sLint(ctx context.Context, cfg struct {
 		if err != nil {
 			return err
 		}
+		if cfg.Fix {
+			if err := fixJ5sFile(ctx, srcRoot, cfg.Dir, fileRel); err != nil {
+				return err
+			}
+		}
 		return runJ5sLintFile(ctx, srcRoot, fileRel)
 	}
 
+	if cfg.Fix {
+		if err := fixJ5sAll(ctx, srcRoot, cfg.Dir); err != nil {
+			return err
+		}
+	}
+
 	return runJ5sLintAll(ctx, srcRoot)
 }
 
-func runJ5sLintFile(ctx context.Context, srcRoot *source.RepoRoot, fileRel string) error {
+func lintJ5sFile(ctx context.Context, srcRoot *source.RepoRoot, fileRel string) (*errpos.ErrorsWithSource, error) {
 	bundle, relToBundle, err := srcRoot.BundleForFile(fileRel)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	compiler, err := bundle.Compiler(ctx, srcRoot)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	data, err := fs.ReadFile(bundle.FS(), relToBundle)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	sourceFile, err := j5parse.ParseFile(relToBundle, string(data))
 	if err != nil {
-		return err
+		if ews, ok := errpos.AsErrorsWithSource(err); ok {
+			return ews, nil
+		}
+		return nil, err
 	}
 
 	lintErr, err := compiler.LintFile(ctx, relToBundle, sourceFile)
 	if err != nil {
-		return fmt.Errorf("root err: %w", err)
+		return nil, fmt.Errorf("root err: %w", err)
 	}
 	if lintErr == nil {
+		return nil, nil
+	}
+	return lintErr.AsErrorsWithSource(relToBundle, string(data)), nil
+}
+
+func runJ5sLintFile(ctx context.Context, srcRoot *source.RepoRoot, fileRel string) error {
+	withSource, err := lintJ5sFile(ctx, srcRoot, fileRel)
+	if err != nil {
+		return err
+	}
+	if withSource == nil {
 		fmt.Fprintln(os.Stderr, "No linting errors")
 		return nil
 	}
-	withSource := lintErr.AsErrorsWithSource(relToBundle, string(data))
 	fmt.Fprintln(os.Stderr, withSource.HumanString(2))
+	if !withSource.Errors.HasErrors() {
+		// only warnings
+		return nil
+	}
 	return fmt.Errorf("linting failed")
 }
 
+// maxFixPasses limits re-linting after fixes. Fixing one error can reveal
+// others, e.g. conversion errors are only found once the imports resolve.
+const maxFixPasses = 5
+
+// fixJ5sFile applies the suggested fixes of the lint errors in the file, then
+// formats it. The remaining errors are left for linting to report.
+func fixJ5sFile(ctx context.Context, srcRoot *source.RepoRoot, dir string, fileRel string) error {
+	bundle, relToBundle, err := srcRoot.BundleForFile(fileRel)
+	if err != nil {
+		return err
+	}
+	outWriter := &fileWriter{dir: filepath.Join(dir, bundle.DirInRepo())}
+
+	for range maxFixPasses {
+		data, err := fs.ReadFile(bundle.FS(), relToBundle)
+		if err != nil {
+			return err
+		}
+
+		content := string(data)
+		withSource, err := lintJ5sFile(ctx, srcRoot, fileRel)
+		if err != nil {
+			return err
+		}
+		if withSource != nil {
+			fixes := withSource.Errors.Fixes(relToBundle)
+			if len(fixes) > 0 {
+				content, err = errpos.ApplyFixes(content, fixes)
+				if err != nil {
+					return fmt.Errorf("fix %s: %w", fileRel, err)
+				}
+				for _, fix := range fixes {
+					fmt.Fprintf(os.Stderr, "%s: %s\n", fileRel, fix.Title)
+				}
+			}
+		}
+
+		formatted, err := bcl.Fmt(relToBundle, content)
+		if err != nil {
+			// Unparsable, the errors are reported by linting
+			return nil
+		}
+
+		if formatted == string(data) {
+			return nil
+		}
+		if err := outWriter.PutFile(ctx, relToBundle, []byte(formatted)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fixJ5sAll applies fixes to every j5s file of the local bundles.
+func fixJ5sAll(ctx context.Context, srcRoot *source.RepoRoot, dir string) error {
+	bundles, _, err := srcRoot.LocalBundlesSorted(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, bundle := range bundles {
+		err := fs.WalkDir(bundle.FS(), ".", func(pathname string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() || !strings.HasSuffix(pathname, ".j5s") {
+				return nil
+			}
+			return fixJ5sFile(ctx, srcRoot, dir, path.Join(bundle.DirInRepo(), pathname))
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func runJ5sLintAll(ctx context.Context, srcRoot *source.RepoRoot) error {
 	bundles, externalDeps, err := srcRoot.LocalBundlesSorted(ctx)
 	if err != nil {
 		return err
 	}
 
+	failed := false
 	for _, bundle := range bundles {
 
 		ps, err := bundle.Compiler(ctx, srcRoot)
@@ -129,8 +238,28 @@ func runJ5sLintAll(ctx context.Context, srcRoot *source.RepoRoot) error {
 				externalDeps[file.Linked.Path()] = protoFile
 			}
 		}
+
+		ruleErrors := errpos.Errors{}
+		for _, pkgName := range allPackages {
+			errs, err := ps.CheckLintRules(ctx, pkgName)
+			if err != nil {
+				return fmt.Errorf("lint rules: %w", err)
+			}
+			ruleErrors = append(ruleErrors, errs...)
+		}
+		if len(ruleErrors) > 0 {
+			fmt.Fprintf(os.Stderr, "Lint rule violations in bundle %s\n", bundle.DebugName())
+			ews := &errpos.ErrorsWithSource{Errors: ruleErrors}
+			fmt.Fprintln(os.Stderr, ews.ShortString())
+			if ruleErrors.HasErrors() {
+				failed = true
+			}
+		}
 	}
 
+	if failed {
+		return fmt.Errorf("linting failed")
+	}
 	fmt.Fprintln(os.Stderr, "No linting errors")
 	return nil
 }
@@ -10,16 +10,20 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"github.com/pentops/log.go/log"
 
 	"github.com/pentops/j5/gen/j5/bcl/v1/bcl_j5pb"
 	"github.com/pentops/j5/gen/j5/sourcedef/v1/sourcedef_j5pb"
 	"github.com/pentops/j5/internal/bcl/genlsp"
+	"github.com/pentops/j5/internal/j5s/j5nav"
 	"github.com/pentops/j5/internal/j5s/j5parse"
+	"github.com/pentops/j5/internal/j5s/protobuild"
 	"github.com/pentops/j5/internal/source"
 	"github.com/pentops/j5/internal/source/resolver"
 	"github.com/pentops/j5/lib/id62"
+	"go.lsp.dev/protocol"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
@@ -56,6 +60,7 @@ func runLSP(ctx context.Context, cfg struct {
 	log.DefaultLogger = logger
 
 	fileTypes := []genlsp.FileTypeConfig{}
+	var workspace genlsp.Workspace
 	cc, err := newLspCompiler(ctx, cfg.Dir)
 	if err != nil {
 		if !errors.Is(err, fs.ErrNotExist) {
@@ -66,11 +71,16 @@ func runLSP(ctx context.Context, cfg struct {
 		j5s := genlsp.FileTypeConfig{
 			FileFactory: j5parse.FileStub,
 			OnChange:    cc.updateFile,
+			Navigator:   cc,
+			Completer:   cc,
+			Renamer:     cc,
+			SymbolKinds: j5sSymbolKinds,
 			Match: func(filename string) bool {
-				return strings.HasSuffix(filename, ".j5")
+				return strings.HasSuffix(filename, ".j5s")
 			},
 		}
 		fileTypes = append(fileTypes, j5s)
+		workspace = cc
 	}
 
 	// generic fallback for basic bcl syntax and format
@@ -83,12 +93,48 @@ func runLSP(ctx context.Context, cfg struct {
 	return genlsp.RunLSP(ctx, genlsp.Config{
 		ProjectRoot: cfg.Dir,
 		FileTypes:   fileTypes,
+		Workspace:   workspace,
 	})
 }
 
+// j5sSymbolKinds are the outline symbol kinds of j5s blocks, by schema name.
+var j5sSymbolKinds = map[string]protocol.SymbolKind{
+	"j5.sourcedef.v1.Package":         protocol.SymbolKindPackage,
+	"j5.sourcedef.v1.Import":          protocol.SymbolKindModule,
+	"j5.sourcedef.v1.Entity":          protocol.SymbolKindClass,
+	"j5.sourcedef.v1.EntityKey":       protocol.SymbolKindKey,
+	"j5.sourcedef.v1.EntitySummary":   protocol.SymbolKindStruct,
+	"j5.sourcedef.v1.Event":           protocol.SymbolKindEvent,
+	"j5.sourcedef.v1.Object":          protocol.SymbolKindStruct,
+	"j5.sourcedef.v1.AnonymousObject": protocol.SymbolKindStruct,
+	"j5.sourcedef.v1.Oneof":           protocol.SymbolKindStruct,
+	"j5.sourcedef.v1.Polymorph":       protocol.SymbolKindInterface,
+	"j5.sourcedef.v1.Service":         protocol.SymbolKindInterface,
+	"j5.sourcedef.v1.APIMethod":       protocol.SymbolKindMethod,
+	"j5.sourcedef.v1.Topic":           protocol.SymbolKindInterface,
+	"j5.sourcedef.v1.TopicMethod":     protocol.SymbolKindMethod,
+	"j5.schema.v1.Enum":               protocol.SymbolKindEnum,
+	"j5.schema.v1.Enum_Option":        protocol.SymbolKindEnumMember,
+	"j5.schema.v1.ObjectProperty":     protocol.SymbolKindField,
+}
+
 type lspCompiler struct {
 	srcRoot *source.RepoRoot
 	rootDir string
+
+	// overlay is the file system of srcRoot
+	overlay *overlayFS
+
+	// j5Parser parses open documents for navigation and completion.
+	j5Parser *j5parse.Parser
+
+	lock           sync.Mutex
+	parseCaches    map[string]*protobuild.ParseCache // by bundle dir
+	packageBundles map[string]source.Bundle
+
+	// navIndexes are the indexes of the local packages of each bundle, by
+	// bundle dir, cleared when the open files change.
+	navIndexes map[string]*j5nav.Index
 }
 
 func newLspCompiler(ctx context.Context, dir string) (*lspCompiler, error) {
@@ -100,15 +146,25 @@ func newLspCompiler(ctx context.Context, dir string) (*lspCompiler, error) {
 	if err != nil {
 		return nil, err
 	}
-	fsRoot := os.DirFS(fullDir)
-	srcRoot, err := source.NewFSRepoRoot(ctx, fsRoot, imageResolver)
+	overlay := newOverlayFS(os.DirFS(fullDir))
+	srcRoot, err := source.NewFSRepoRoot(ctx, overlay, imageResolver)
+	if err != nil {
+		return nil, err
+	}
+
+	j5Parser, err := j5parse.NewParser()
 	if err != nil {
 		return nil, err
 	}
 
 	cc := &lspCompiler{
-		srcRoot: srcRoot,
-		rootDir: fullDir,
+		srcRoot:        srcRoot,
+		rootDir:        fullDir,
+		overlay:        overlay,
+		j5Parser:       j5Parser,
+		parseCaches:    map[string]*protobuild.ParseCache{},
+		packageBundles: map[string]source.Bundle{},
+		navIndexes:     map[string]*j5nav.Index{},
 	}
 	return cc, nil
 }
@@ -124,7 +180,7 @@ func (cc *lspCompiler) updateFile(ctx context.Context, filename string, locs *bc
 		return err
 	}
 
-	compiler, err := bundle.Compiler(ctx, cc.srcRoot)
+	compiler, err := cc.compiler(ctx, bundle)
 	if err != nil {
 		return err
 	}
@@ -134,6 +190,9 @@ func (cc *lspCompiler) updateFile(ctx context.Context, filename string, locs *bc
 		return fmt.Errorf("file %s is not a SourceFile", filename)
 	}
 	parsed.SourceLocations = locs
+	// the stub path is the absolute filename, the compiler works relative to
+	// the bundle.
+	parsed.Path = relToBundle
 
 	lintErr, err := compiler.LintFile(ctx, relToBundle, parsed)
 	if err != nil {
@@ -144,6 +203,7 @@ func (cc *lspCompiler) updateFile(ctx context.Context, filename string, locs *bc
 		fmt.Fprintln(os.Stderr, "No linting errors")
 		return nil
 	}
+	lintErr = repoErrors(bundle, lintErr, relToBundle)
 
 	for _, err := range lintErr {
 		if parsed.SourceLocations == nil {
@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/pentops/j5/internal/bcl/errpos"
+	"github.com/pentops/j5/internal/bcl/genlsp"
+	"github.com/pentops/j5/internal/j5s/j5nav"
+	"github.com/pentops/log.go/log"
+	"go.lsp.dev/protocol"
+)
+
+var _ genlsp.Completer = &lspCompiler{}
+
+var completionItemKinds = map[j5nav.CompletionKind]protocol.CompletionItemKind{
+	j5nav.CompletionKeyword:  protocol.CompletionItemKindKeyword,
+	j5nav.CompletionProperty: protocol.CompletionItemKindProperty,
+	j5nav.CompletionValue:    protocol.CompletionItemKindValue,
+	j5nav.CompletionType:     protocol.CompletionItemKindClass,
+	j5nav.CompletionStatus:   protocol.CompletionItemKindEnumMember,
+}
+
+func (cc *lspCompiler) Completion(ctx context.Context, doc *protocol.TextDocumentItem, pos protocol.Position) (*protocol.CompletionList, error) {
+	nd, compiler, err := cc.bundleIndex(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	file, found, err := cc.j5Parser.Complete(nd.filename, doc.Text, errpos.Point{
+		Line:   int(pos.Line),
+		Column: int(pos.Character),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The open file is usually incomplete, index as much as was walked for
+	// local type names.
+	if err := nd.index.AddFile(file); err != nil {
+		log.WithError(ctx, err).Debug("indexing incomplete file")
+	}
+	if err := nd.index.LoadImports(ctx, compiler, file); err != nil {
+		log.WithError(ctx, err).Warn("loading imports for completion")
+	}
+
+	completions := nd.index.Complete(file, found)
+	items := make([]protocol.CompletionItem, 0, len(completions))
+	for _, completion := range completions {
+		item := protocol.CompletionItem{
+			Label:  completion.Label,
+			Kind:   completionItemKinds[completion.Kind],
+			Detail: completion.Detail,
+		}
+		if completion.Documentation != "" {
+			item.Documentation = protocol.MarkupContent{
+				Kind:  protocol.Markdown,
+				Value: completion.Documentation,
+			}
+		}
+		items = append(items, item)
+	}
+
+	return &protocol.CompletionList{
+		Items: items,
+	}, nil
+}
@@ -0,0 +1,195 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/pentops/j5/internal/bcl/errpos"
+	"github.com/pentops/j5/internal/bcl/genlsp"
+	"github.com/pentops/j5/internal/j5s/j5nav"
+	"github.com/pentops/j5/internal/j5s/protobuild"
+	"github.com/pentops/j5/internal/source"
+	"github.com/pentops/log.go/log"
+	"go.lsp.dev/protocol"
+)
+
+var _ genlsp.Navigator = &lspCompiler{}
+
+// navDoc is the navigation index for an open document.
+type navDoc struct {
+	index     *j5nav.Index
+	filename  string // relative to the bundle
+	bundleDir string // absolute
+	bundle    source.Bundle
+}
+
+// bundleIndex returns a copy of the index of the local packages of the bundle
+// which contains the document, the document itself is not yet added. The index
+// is built once per bundle, until the open files change.
+func (cc *lspCompiler) bundleIndex(ctx context.Context, doc *protocol.TextDocumentItem) (*navDoc, *protobuild.PackageSet, error) {
+	fileRel, err := filepath.Rel(cc.rootDir, doc.URI.Filename())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bundle, relToBundle, err := cc.srcRoot.BundleForFile(fileRel)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	compiler, err := cc.compiler(ctx, bundle)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &navDoc{
+		index:     cc.loadBundleIndex(ctx, bundle, compiler).Clone(),
+		filename:  relToBundle,
+		bundleDir: filepath.Join(cc.rootDir, bundle.DirInRepo()),
+		bundle:    bundle,
+	}, compiler, nil
+}
+
+func (cc *lspCompiler) loadBundleIndex(ctx context.Context, bundle source.Bundle, compiler *protobuild.PackageSet) *j5nav.Index {
+	cc.lock.Lock()
+	index, ok := cc.navIndexes[bundle.DirInRepo()]
+	cc.lock.Unlock()
+	if ok {
+		return index
+	}
+
+	index, err := j5nav.LoadPackages(ctx, compiler)
+	if err != nil {
+		// Other files in the bundle may not parse while being edited, which
+		// still allows navigation within the open document. The failed index
+		// is not kept, so the next request tries again.
+		log.WithError(ctx, err).Warn("indexing bundle")
+		return j5nav.NewIndex()
+	}
+
+	cc.lock.Lock()
+	cc.navIndexes[bundle.DirInRepo()] = index
+	cc.lock.Unlock()
+	return index
+}
+
+// navIndex indexes the bundle which contains the document, with the open
+// version of the document in place of the saved file.
+func (cc *lspCompiler) navIndex(ctx context.Context, doc *protocol.TextDocumentItem) (*navDoc, error) {
+	nd, _, err := cc.bundleIndex(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed, err := cc.j5Parser.ParseFile(nd.filename, doc.Text)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s: %w", nd.filename, err)
+	}
+	if err := nd.index.AddFile(parsed); err != nil {
+		return nil, err
+	}
+
+	return nd, nil
+}
+
+func (nd *navDoc) symbolAt(pos protocol.Position) (*j5nav.Symbol, bool) {
+	return nd.index.At(nd.filename, errpos.Point{
+		Line:   int(pos.Line),
+		Column: int(pos.Character),
+	})
+}
+
+func (nd *navDoc) location(span j5nav.Span) protocol.Location {
+	return protocol.Location{
+		URI:   protocol.DocumentURI("file://" + filepath.Join(nd.bundleDir, span.Filename)),
+		Range: spanRange(span),
+	}
+}
+
+// spanRange converts the inclusive end of a span to the exclusive end of an
+// LSP range.
+func spanRange(span j5nav.Span) protocol.Range {
+	return protocol.Range{
+		Start: protocol.Position{
+			Line:      uint32(span.Start.Line),
+			Character: uint32(span.Start.Column),
+		},
+		End: protocol.Position{
+			Line:      uint32(span.End.Line),
+			Character: uint32(span.End.Column + 1),
+		},
+	}
+}
+
+func (cc *lspCompiler) Definition(ctx context.Context, doc *protocol.TextDocumentItem, pos protocol.Position) ([]protocol.Location, error) {
+	nd, err := cc.navIndex(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+	sym, ok := nd.symbolAt(pos)
+	if !ok || sym.Definition == nil {
+		return nil, nil
+	}
+	return []protocol.Location{nd.location(sym.Definition.Span)}, nil
+}
+
+func (cc *lspCompiler) References(ctx context.Context, doc *protocol.TextDocumentItem, pos protocol.Position, includeDeclaration bool) ([]protocol.Location, error) {
+	nd, err := cc.navIndex(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+	sym, ok := nd.symbolAt(pos)
+	if !ok {
+		return nil, nil
+	}
+
+	var pkgName, name string
+	switch {
+	case sym.Definition != nil:
+		pkgName, name = sym.Definition.Package, sym.Definition.Name
+	case sym.Reference != nil:
+		pkgName, name = sym.Reference.Package, sym.Reference.Name
+	default:
+		return nil, nil
+	}
+
+	locations := []protocol.Location{}
+	if includeDeclaration && sym.Definition != nil {
+		locations = append(locations, nd.location(sym.Definition.Span))
+	}
+	for _, ref := range nd.index.References(pkgName, name) {
+		locations = append(locations, nd.location(ref.Span))
+	}
+	return locations, nil
+}
+
+func (cc *lspCompiler) Hover(ctx context.Context, doc *protocol.TextDocumentItem, pos protocol.Position) (*protocol.Hover, error) {
+	nd, err := cc.navIndex(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+	sym, ok := nd.symbolAt(pos)
+	if !ok {
+		return nil, nil
+	}
+
+	var span j5nav.Span
+	switch {
+	case sym.Reference != nil:
+		span = sym.Reference.Span
+	case sym.Property != nil:
+		span = sym.Property.Span
+	default:
+		span = sym.Definition.Span
+	}
+
+	hoverRange := spanRange(span)
+	return &protocol.Hover{
+		Contents: protocol.MarkupContent{
+			Kind:  protocol.Markdown,
+			Value: sym.Hover(),
+		},
+		Range: &hoverRange,
+	}, nil
+}
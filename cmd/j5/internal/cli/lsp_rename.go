@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/pentops/j5/internal/bcl/errpos"
+	"github.com/pentops/j5/internal/bcl/genlsp"
+	"go.lsp.dev/protocol"
+)
+
+var _ genlsp.Renamer = &lspCompiler{}
+
+func (cc *lspCompiler) Rename(ctx context.Context, doc *protocol.TextDocumentItem, pos protocol.Position, newName string) (*protocol.WorkspaceEdit, error) {
+	nd, err := cc.navIndex(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := nd.index.RenameTargetAt(nd.filename, errpos.Point{
+		Line:   int(pos.Line),
+		Column: int(pos.Character),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := checkRenameLocks(cc.srcRoot, nd.bundle, target); err != nil {
+		return nil, err
+	}
+
+	// the open document may not be saved
+	readSaved := bundleReader(nd.bundle)
+	read := func(filename string) (string, error) {
+		if filename == nd.filename {
+			return doc.Text, nil
+		}
+		return readSaved(filename)
+	}
+
+	edits, err := nd.index.Rename(target, newName, read)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := map[protocol.DocumentURI][]protocol.TextEdit{}
+	for _, edit := range edits {
+		loc := nd.location(edit.Span)
+		changes[loc.URI] = append(changes[loc.URI], protocol.TextEdit{
+			Range:   loc.Range,
+			NewText: edit.NewText,
+		})
+	}
+	return &protocol.WorkspaceEdit{
+		Changes: changes,
+	}, nil
+}
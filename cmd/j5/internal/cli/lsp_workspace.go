@@ -0,0 +1,188 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"maps"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pentops/j5/internal/bcl/errpos"
+	"github.com/pentops/j5/internal/bcl/genlsp"
+	"github.com/pentops/j5/internal/j5s/j5convert"
+	"github.com/pentops/j5/internal/j5s/protobuild"
+	"github.com/pentops/j5/internal/source"
+)
+
+var _ genlsp.Workspace = &lspCompiler{}
+
+// overlayFS serves the text of documents open in the editor in place of the
+// files on disk, so that lints see unsaved changes.
+type overlayFS struct {
+	base fs.FS
+
+	lock  sync.RWMutex
+	files map[string]string
+}
+
+func newOverlayFS(base fs.FS) *overlayFS {
+	return &overlayFS{
+		base:  base,
+		files: map[string]string{},
+	}
+}
+
+// setFiles replaces the open files, returning false when they are unchanged.
+func (o *overlayFS) setFiles(files map[string]string) bool {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	if maps.Equal(o.files, files) {
+		return false
+	}
+	o.files = files
+	return true
+}
+
+func (o *overlayFS) Open(name string) (fs.File, error) {
+	o.lock.RLock()
+	data, ok := o.files[name]
+	o.lock.RUnlock()
+	if !ok {
+		return o.base.Open(name)
+	}
+	return &overlayFile{
+		Reader: strings.NewReader(data),
+		name:   path.Base(name),
+		size:   int64(len(data)),
+	}, nil
+}
+
+type overlayFile struct {
+	*strings.Reader
+	name string
+	size int64
+}
+
+func (f *overlayFile) Stat() (fs.FileInfo, error) { return f, nil }
+func (f *overlayFile) Close() error               { return nil }
+
+func (f *overlayFile) Name() string       { return f.name }
+func (f *overlayFile) Size() int64        { return f.size }
+func (f *overlayFile) Mode() fs.FileMode  { return 0444 }
+func (f *overlayFile) ModTime() time.Time { return time.Time{} }
+func (f *overlayFile) IsDir() bool        { return false }
+func (f *overlayFile) Sys() any           { return nil }
+
+// compiler builds a package set for the bundle, reusing the files parsed by
+// previous builds.
+func (cc *lspCompiler) compiler(ctx context.Context, bundle source.Bundle) (*protobuild.PackageSet, error) {
+	cc.lock.Lock()
+	cache, ok := cc.parseCaches[bundle.DirInRepo()]
+	if !ok {
+		cache = protobuild.NewParseCache()
+		cc.parseCaches[bundle.DirInRepo()] = cache
+	}
+	cc.lock.Unlock()
+
+	return bundle.CachedCompiler(ctx, cc.srcRoot, cache)
+}
+
+// repoErrors positions errors from a bundle compiler relative to the project
+// root. Errors without a filename are in defaultFile, relative to the bundle.
+func repoErrors(bundle source.Bundle, errs errpos.Errors, defaultFile string) errpos.Errors {
+	for _, err := range errs {
+		if err.Pos == nil {
+			continue
+		}
+		filename := defaultFile
+		if err.Pos.Filename != nil {
+			filename = *err.Pos.Filename
+		}
+		filename = filepath.Join(bundle.DirInRepo(), filename)
+		err.Pos.Filename = &filename
+	}
+	return errs
+}
+
+func (cc *lspCompiler) SetOpenFiles(files map[string]string) {
+	if !cc.overlay.setFiles(files) {
+		return
+	}
+	// the indexes were built from the previous open files
+	cc.lock.Lock()
+	clear(cc.navIndexes)
+	cc.lock.Unlock()
+}
+
+func (cc *lspCompiler) Packages(ctx context.Context) ([]string, error) {
+	packageBundles := map[string]source.Bundle{}
+	packages := []string{}
+	for _, bundle := range cc.srcRoot.AllBundles() {
+		bundlePackages, err := bundle.ListPackages()
+		if err != nil {
+			return nil, fmt.Errorf("bundle %s: %w", bundle.DebugName(), err)
+		}
+		for _, pkg := range bundlePackages {
+			packageBundles[pkg] = bundle
+			packages = append(packages, pkg)
+		}
+	}
+
+	cc.lock.Lock()
+	cc.packageBundles = packageBundles
+	cc.lock.Unlock()
+	return packages, nil
+}
+
+func (cc *lspCompiler) FilePackage(filename string) (string, bool) {
+	ext := filepath.Ext(filename)
+	if ext != ".j5s" && ext != ".proto" {
+		return "", false
+	}
+	_, relToBundle, err := cc.srcRoot.BundleForFile(filename)
+	if err != nil {
+		return "", false
+	}
+	pkg, _, err := j5convert.SplitPackageFromFilename(relToBundle)
+	if err != nil {
+		return "", false
+	}
+	return pkg, true
+}
+
+func (cc *lspCompiler) LintPackage(ctx context.Context, pkg string) (*genlsp.PackageLint, error) {
+	cc.lock.Lock()
+	bundle, ok := cc.packageBundles[pkg]
+	cc.lock.Unlock()
+	if !ok {
+		// The package is new since the packages were listed.
+		if _, err := cc.Packages(ctx); err != nil {
+			return nil, err
+		}
+		cc.lock.Lock()
+		bundle, ok = cc.packageBundles[pkg]
+		cc.lock.Unlock()
+		if !ok {
+			return nil, fmt.Errorf("package %s not found in any bundle", pkg)
+		}
+	}
+
+	compiler, err := cc.compiler(ctx, bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	lint, err := compiler.LintPackage(ctx, pkg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &genlsp.PackageLint{
+		Imports: lint.Imports,
+		Errors:  repoErrors(bundle, lint.Errors, ""),
+	}, nil
+}
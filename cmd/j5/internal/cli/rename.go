@@ -0,0 +1,177 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/pentops/j5/gen/j5/config/v1/config_j5pb"
+	"github.com/pentops/j5/internal/j5s/j5nav"
+	"github.com/pentops/j5/internal/source"
+	"github.com/pentops/j5/internal/source/resolver"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// checkRenameLocks refuses to rename symbols of packages which the bundle
+// publishes, when this repo's lock file pins the published version, i.e.
+// another bundle or generate input of the same repo consumes the bundle from
+// the registry. Dependents in other repos lock the bundle in their own lock
+// files, which can't be seen here, so are not protected: renaming an exported
+// symbol is still a breaking change for them.
+func checkRenameLocks(srcRoot *source.RepoRoot, bundle source.Bundle, target *j5nav.RenameTarget) error {
+	cfg, err := bundle.J5Config()
+	if err != nil {
+		return err
+	}
+
+	exported := slices.ContainsFunc(cfg.Packages, func(pkg *config_j5pb.PackageConfig) bool {
+		return pkg.Name == target.Package
+	})
+	if !exported {
+		return nil
+	}
+
+	locked, err := srcRoot.LockedDependents(bundle)
+	if err != nil {
+		return err
+	}
+	if len(locked) > 0 {
+		return fmt.Errorf("%s is exported by %s, which is locked at %s by dependents", target.FullName(), locked[0].Name, locked[0].Version)
+	}
+	return nil
+}
+
+// renameFiles groups rename edits by file, and applies them to the content
+// read for each file.
+func renameFiles(edits []j5nav.Edit, read j5nav.SourceReader) (map[string]string, error) {
+	byFile := map[string][]j5nav.Edit{}
+	for _, edit := range edits {
+		byFile[edit.Span.Filename] = append(byFile[edit.Span.Filename], edit)
+	}
+
+	out := map[string]string{}
+	for filename, fileEdits := range byFile {
+		content, err := read(filename)
+		if err != nil {
+			return nil, err
+		}
+		edited, err := j5nav.ApplyEdits(content, fileEdits)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", filename, err)
+		}
+		out[filename] = edited
+	}
+	return out, nil
+}
+
+func bundleReader(bundle source.Bundle) j5nav.SourceReader {
+	return func(filename string) (string, error) {
+		data, err := fs.ReadFile(bundle.FS(), filename)
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	}
+}
+
+func runJ5sRename(ctx context.Context, cfg struct {
+	Dir    string `flag:"dir" required:"false" description:"Source / working directory containing j5.yaml"`
+	Symbol string `flag:"symbol" description:"Full name of the symbol, e.g. foo.v1.Foo, or foo.v1.FooStatus.ACTIVE for a value"`
+	To     string `flag:"to" description:"New name, replacing the last part of the symbol"`
+	Write  bool   `flag:"write" default:"false" desc:"Write the renamed files, otherwise print a diff"`
+}) error {
+	imageResolver, err := resolver.NewEnvResolver()
+	if err != nil {
+		return err
+	}
+
+	if cfg.Dir == "" {
+		cfg.Dir, err = os.Getwd()
+		if err != nil {
+			return err
+		}
+	}
+	srcRoot, err := source.NewFSRepoRoot(ctx, os.DirFS(cfg.Dir), imageResolver)
+	if err != nil {
+		return err
+	}
+
+	for _, bundle := range srcRoot.AllBundles() {
+		compiler, err := bundle.Compiler(ctx, srcRoot)
+		if err != nil {
+			return fmt.Errorf("bundle %s: %w", bundle.DebugName(), err)
+		}
+
+		local := slices.ContainsFunc(compiler.ListLocalPackages(), func(pkgName string) bool {
+			return strings.HasPrefix(cfg.Symbol, pkgName+".")
+		})
+		if !local {
+			continue
+		}
+
+		index, err := j5nav.LoadPackages(ctx, compiler)
+		if err != nil {
+			return fmt.Errorf("bundle %s: %w", bundle.DebugName(), err)
+		}
+
+		target, err := index.FindRenameTarget(cfg.Symbol)
+		if err != nil {
+			return err
+		}
+		if err := checkRenameLocks(srcRoot, bundle, target); err != nil {
+			return err
+		}
+
+		read := bundleReader(bundle)
+		edits, err := index.Rename(target, cfg.To, read)
+		if err != nil {
+			return err
+		}
+		renamed, err := renameFiles(edits, read)
+		if err != nil {
+			return err
+		}
+
+		filenames := make([]string, 0, len(renamed))
+		for filename := range renamed {
+			filenames = append(filenames, filename)
+		}
+		sort.Strings(filenames)
+
+		outWriter := &fileWriter{dir: filepath.Join(cfg.Dir, bundle.DirInRepo())}
+		for _, filename := range filenames {
+			if cfg.Write {
+				if err := outWriter.PutFile(ctx, filename, []byte(renamed[filename])); err != nil {
+					return err
+				}
+				continue
+			}
+
+			original, err := read(filename)
+			if err != nil {
+				return err
+			}
+			diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+				A:        difflib.SplitLines(original),
+				FromFile: filename,
+				B:        difflib.SplitLines(renamed[filename]),
+				ToFile:   filename,
+				Context:  3,
+			})
+			if err != nil {
+				return err
+			}
+			fmt.Println(diff)
+		}
+
+		fmt.Fprintf(os.Stderr, "Renamed %s to %s in %d files\n", target.FullName(), cfg.To, len(renamed))
+		return nil
+	}
+
+	return fmt.Errorf("symbol %s is not in a local package", cfg.Symbol)
+}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/pentops/j5/internal/j5s/j5nav"
+	"github.com/pentops/j5/internal/source"
+)
+
+func TestCheckRenameLocks(t *testing.T) {
+	repoConfig := strings.Join([]string{
+		"registry:",
+		"  owner: acme",
+		"  name: foo",
+		"packages:",
+		"  - name: foo.v1",
+	}, "\n")
+
+	newRoot := func(t *testing.T, files fstest.MapFS) (*source.RepoRoot, source.Bundle) {
+		t.Helper()
+		files["j5.yaml"] = &fstest.MapFile{Data: []byte(repoConfig)}
+		srcRoot, err := source.NewFSRepoRoot(context.Background(), files, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		bundle, _, err := srcRoot.BundleForFile("foo/v1/foo.j5s")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return srcRoot, bundle
+	}
+
+	locked := fstest.MapFS{
+		"j5-lock.yaml": &fstest.MapFile{Data: []byte(strings.Join([]string{
+			"inputs:",
+			"  - name: registry/acme/foo",
+			"    version: abc123",
+		}, "\n"))},
+	}
+
+	t.Run("Locked", func(t *testing.T) {
+		srcRoot, bundle := newRoot(t, locked)
+		err := checkRenameLocks(srcRoot, bundle, &j5nav.RenameTarget{Package: "foo.v1", Name: "Foo"})
+		if err == nil {
+			t.Fatal("expected the rename to be refused")
+		}
+		if !strings.Contains(err.Error(), "locked at abc123") {
+			t.Errorf("unexpected error: %s", err)
+		}
+	})
+
+	t.Run("Not Exported", func(t *testing.T) {
+		srcRoot, bundle := newRoot(t, locked)
+		err := checkRenameLocks(srcRoot, bundle, &j5nav.RenameTarget{Package: "bar.v1", Name: "Bar"})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("Not Locked", func(t *testing.T) {
+		srcRoot, bundle := newRoot(t, fstest.MapFS{})
+		err := checkRenameLocks(srcRoot, bundle, &j5nav.RenameTarget{Package: "foo.v1", Name: "Foo"})
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+}
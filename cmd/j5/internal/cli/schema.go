@@ -25,6 +25,7 @@ func schemaSet() *commander.CommandSet {
 	genGroup.Add("client", commander.NewCommand(RunClient))
 	genGroup.Add("swagger", commander.NewCommand(RunSwagger))
 	genGroup.Add("sources", commander.NewCommand(RunSources))
+	genGroup.Add("breaking", commander.NewCommand(RunBreaking))
 	return genGroup
 }
 
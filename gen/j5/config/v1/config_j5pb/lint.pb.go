@@ -0,0 +1,521 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: j5/config/v1/lint.proto
+
+package config_j5pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LintSeverity int32
+
+const (
+	LintSeverity_LINT_SEVERITY_UNSPECIFIED LintSeverity = 0
+	LintSeverity_LINT_SEVERITY_ERROR       LintSeverity = 1
+	LintSeverity_LINT_SEVERITY_WARNING     LintSeverity = 2
+	// Disables the rule while keeping it in the config.
+	LintSeverity_LINT_SEVERITY_OFF LintSeverity = 3
+)
+
+// Enum value maps for LintSeverity.
+var (
+	LintSeverity_name = map[int32]string{
+		0: "LINT_SEVERITY_UNSPECIFIED",
+		1: "LINT_SEVERITY_ERROR",
+		2: "LINT_SEVERITY_WARNING",
+		3: "LINT_SEVERITY_OFF",
+	}
+	LintSeverity_value = map[string]int32{
+		"LINT_SEVERITY_UNSPECIFIED": 0,
+		"LINT_SEVERITY_ERROR":       1,
+		"LINT_SEVERITY_WARNING":     2,
+		"LINT_SEVERITY_OFF":         3,
+	}
+)
+
+func (x LintSeverity) Enum() *LintSeverity {
+	p := new(LintSeverity)
+	*p = x
+	return p
+}
+
+func (x LintSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LintSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_j5_config_v1_lint_proto_enumTypes[0].Descriptor()
+}
+
+func (LintSeverity) Type() protoreflect.EnumType {
+	return &file_j5_config_v1_lint_proto_enumTypes[0]
+}
+
+func (x LintSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LintSeverity.Descriptor instead.
+func (LintSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_j5_config_v1_lint_proto_rawDescGZIP(), []int{0}
+}
+
+type LintTarget int32
+
+const (
+	LintTarget_LINT_TARGET_UNSPECIFIED LintTarget = 0
+	LintTarget_LINT_TARGET_SCHEMA      LintTarget = 1
+	LintTarget_LINT_TARGET_PROPERTY    LintTarget = 2
+	LintTarget_LINT_TARGET_SERVICE     LintTarget = 3
+	LintTarget_LINT_TARGET_METHOD      LintTarget = 4
+	LintTarget_LINT_TARGET_TOPIC       LintTarget = 5
+	LintTarget_LINT_TARGET_ENTITY      LintTarget = 6
+)
+
+// Enum value maps for LintTarget.
+var (
+	LintTarget_name = map[int32]string{
+		0: "LINT_TARGET_UNSPECIFIED",
+		1: "LINT_TARGET_SCHEMA",
+		2: "LINT_TARGET_PROPERTY",
+		3: "LINT_TARGET_SERVICE",
+		4: "LINT_TARGET_METHOD",
+		5: "LINT_TARGET_TOPIC",
+		6: "LINT_TARGET_ENTITY",
+	}
+	LintTarget_value = map[string]int32{
+		"LINT_TARGET_UNSPECIFIED": 0,
+		"LINT_TARGET_SCHEMA":      1,
+		"LINT_TARGET_PROPERTY":    2,
+		"LINT_TARGET_SERVICE":     3,
+		"LINT_TARGET_METHOD":      4,
+		"LINT_TARGET_TOPIC":       5,
+		"LINT_TARGET_ENTITY":      6,
+	}
+)
+
+func (x LintTarget) Enum() *LintTarget {
+	p := new(LintTarget)
+	*p = x
+	return p
+}
+
+func (x LintTarget) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LintTarget) Descriptor() protoreflect.EnumDescriptor {
+	return file_j5_config_v1_lint_proto_enumTypes[1].Descriptor()
+}
+
+func (LintTarget) Type() protoreflect.EnumType {
+	return &file_j5_config_v1_lint_proto_enumTypes[1]
+}
+
+func (x LintTarget) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LintTarget.Descriptor instead.
+func (LintTarget) EnumDescriptor() ([]byte, []int) {
+	return file_j5_config_v1_lint_proto_rawDescGZIP(), []int{1}
+}
+
+// LintConfig configures the rules checked by `j5 j5s lint` and the language
+// server against the API of each local package, on top of the parse and
+// conversion errors.
+type LintConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Built in rules to run. Rules which are not listed do not run.
+	Rules []*LintRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	// Custom naming rules.
+	Patterns []*LintPattern `protobuf:"bytes,2,rep,name=patterns,proto3" json:"patterns,omitempty"`
+}
+
+func (x *LintConfig) Reset() {
+	*x = LintConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_j5_config_v1_lint_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LintConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LintConfig) ProtoMessage() {}
+
+func (x *LintConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_j5_config_v1_lint_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LintConfig.ProtoReflect.Descriptor instead.
+func (*LintConfig) Descriptor() ([]byte, []int) {
+	return file_j5_config_v1_lint_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LintConfig) GetRules() []*LintRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *LintConfig) GetPatterns() []*LintPattern {
+	if x != nil {
+		return x.Patterns
+	}
+	return nil
+}
+
+// LintRule enables a built in rule.
+//
+// Rules:
+//   - field-description: properties of public schemas require a description.
+//   - topic-naming: topic names, including the Topic suffix, match the
+//     required 'pattern' option.
+//   - entity-query-auth: every method of an entity query service requires
+//     an auth, either on the method or as the default of the service.
+//   - no-any-public: public schemas do not have 'any' properties.
+//
+// Public schemas are those reachable from the request or response of a
+// method in a service with no audience, or with the 'public' audience.
+type LintRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Defaults to ERROR
+	Severity LintSeverity `protobuf:"varint,2,opt,name=severity,proto3,enum=j5.config.v1.LintSeverity" json:"severity,omitempty"`
+	// Rule specific options
+	Options map[string]string `protobuf:"bytes,3,rep,name=options,proto3" json:"options,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *LintRule) Reset() {
+	*x = LintRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_j5_config_v1_lint_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LintRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LintRule) ProtoMessage() {}
+
+func (x *LintRule) ProtoReflect() protoreflect.Message {
+	mi := &file_j5_config_v1_lint_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LintRule.ProtoReflect.Descriptor instead.
+func (*LintRule) Descriptor() ([]byte, []int) {
+	return file_j5_config_v1_lint_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LintRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LintRule) GetSeverity() LintSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return LintSeverity_LINT_SEVERITY_UNSPECIFIED
+}
+
+func (x *LintRule) GetOptions() map[string]string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// LintPattern requires the names of the target to match a regular expression.
+type LintPattern struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Target LintTarget `protobuf:"varint,2,opt,name=target,proto3,enum=j5.config.v1.LintTarget" json:"target,omitempty"`
+	// RE2 regular expression, which must match the whole name.
+	Pattern string `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	// Replaces the default message for a violation.
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// Defaults to ERROR
+	Severity LintSeverity `protobuf:"varint,5,opt,name=severity,proto3,enum=j5.config.v1.LintSeverity" json:"severity,omitempty"`
+}
+
+func (x *LintPattern) Reset() {
+	*x = LintPattern{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_j5_config_v1_lint_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LintPattern) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LintPattern) ProtoMessage() {}
+
+func (x *LintPattern) ProtoReflect() protoreflect.Message {
+	mi := &file_j5_config_v1_lint_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LintPattern.ProtoReflect.Descriptor instead.
+func (*LintPattern) Descriptor() ([]byte, []int) {
+	return file_j5_config_v1_lint_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LintPattern) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LintPattern) GetTarget() LintTarget {
+	if x != nil {
+		return x.Target
+	}
+	return LintTarget_LINT_TARGET_UNSPECIFIED
+}
+
+func (x *LintPattern) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *LintPattern) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LintPattern) GetSeverity() LintSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return LintSeverity_LINT_SEVERITY_UNSPECIFIED
+}
+
+var File_j5_config_v1_lint_proto protoreflect.FileDescriptor
+
+var file_j5_config_v1_lint_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x6a, 0x35, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x6c,
+	0x69, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x6a, 0x35, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x22, 0x71, 0x0a, 0x0a, 0x4c, 0x69, 0x6e, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2c, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6a, 0x35, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6a, 0x35, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x52, 0x08, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x73, 0x22, 0xd1, 0x01, 0x0a, 0x08, 0x4c,
+	0x69, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x73,
+	0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e,
+	0x6a, 0x35, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e,
+	0x74, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x3d, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6a, 0x35, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xbf,
+	0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6a, 0x35, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6a, 0x35, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x74, 0x53, 0x65,
+	0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79,
+	0x2a, 0x78, 0x0a, 0x0c, 0x4c, 0x69, 0x6e, 0x74, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79,
+	0x12, 0x1d, 0x0a, 0x19, 0x4c, 0x49, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54,
+	0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x17, 0x0a, 0x13, 0x4c, 0x49, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x4c, 0x49, 0x4e, 0x54,
+	0x5f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e,
+	0x47, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x49, 0x4e, 0x54, 0x5f, 0x53, 0x45, 0x56, 0x45,
+	0x52, 0x49, 0x54, 0x59, 0x5f, 0x4f, 0x46, 0x46, 0x10, 0x03, 0x2a, 0xbb, 0x01, 0x0a, 0x0a, 0x4c,
+	0x69, 0x6e, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x17, 0x4c, 0x49, 0x4e,
+	0x54, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x49, 0x4e, 0x54, 0x5f, 0x54,
+	0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x41, 0x10, 0x01, 0x12, 0x18,
+	0x0a, 0x14, 0x4c, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x50, 0x52,
+	0x4f, 0x50, 0x45, 0x52, 0x54, 0x59, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x4c, 0x49, 0x4e, 0x54,
+	0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x10,
+	0x03, 0x12, 0x16, 0x0a, 0x12, 0x4c, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54,
+	0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x4c, 0x49, 0x4e,
+	0x54, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x54, 0x4f, 0x50, 0x49, 0x43, 0x10, 0x05,
+	0x12, 0x16, 0x0a, 0x12, 0x4c, 0x49, 0x4e, 0x54, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f,
+	0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x10, 0x06, 0x42, 0x4f, 0xf2, 0x85, 0x8f, 0x02, 0x16, 0x0a,
+	0x14, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x73, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x70, 0x65, 0x6e, 0x74, 0x6f, 0x70, 0x73, 0x2f, 0x6a, 0x35, 0x2f, 0x67, 0x65, 0x6e,
+	0x2f, 0x6a, 0x35, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x5f, 0x6a, 0x35, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_j5_config_v1_lint_proto_rawDescOnce sync.Once
+	file_j5_config_v1_lint_proto_rawDescData = file_j5_config_v1_lint_proto_rawDesc
+)
+
+func file_j5_config_v1_lint_proto_rawDescGZIP() []byte {
+	file_j5_config_v1_lint_proto_rawDescOnce.Do(func() {
+		file_j5_config_v1_lint_proto_rawDescData = protoimpl.X.CompressGZIP(file_j5_config_v1_lint_proto_rawDescData)
+	})
+	return file_j5_config_v1_lint_proto_rawDescData
+}
+
+var file_j5_config_v1_lint_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_j5_config_v1_lint_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_j5_config_v1_lint_proto_goTypes = []any{
+	(LintSeverity)(0),   // 0: j5.config.v1.LintSeverity
+	(LintTarget)(0),     // 1: j5.config.v1.LintTarget
+	(*LintConfig)(nil),  // 2: j5.config.v1.LintConfig
+	(*LintRule)(nil),    // 3: j5.config.v1.LintRule
+	(*LintPattern)(nil), // 4: j5.config.v1.LintPattern
+	nil,                 // 5: j5.config.v1.LintRule.OptionsEntry
+}
+var file_j5_config_v1_lint_proto_depIdxs = []int32{
+	3, // 0: j5.config.v1.LintConfig.rules:type_name -> j5.config.v1.LintRule
+	4, // 1: j5.config.v1.LintConfig.patterns:type_name -> j5.config.v1.LintPattern
+	0, // 2: j5.config.v1.LintRule.severity:type_name -> j5.config.v1.LintSeverity
+	5, // 3: j5.config.v1.LintRule.options:type_name -> j5.config.v1.LintRule.OptionsEntry
+	1, // 4: j5.config.v1.LintPattern.target:type_name -> j5.config.v1.LintTarget
+	0, // 5: j5.config.v1.LintPattern.severity:type_name -> j5.config.v1.LintSeverity
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_j5_config_v1_lint_proto_init() }
+func file_j5_config_v1_lint_proto_init() {
+	if File_j5_config_v1_lint_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_j5_config_v1_lint_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*LintConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_j5_config_v1_lint_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*LintRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_j5_config_v1_lint_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*LintPattern); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_j5_config_v1_lint_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_j5_config_v1_lint_proto_goTypes,
+		DependencyIndexes: file_j5_config_v1_lint_proto_depIdxs,
+		EnumInfos:         file_j5_config_v1_lint_proto_enumTypes,
+		MessageInfos:      file_j5_config_v1_lint_proto_msgTypes,
+	}.Build()
+	File_j5_config_v1_lint_proto = out.File
+	file_j5_config_v1_lint_proto_rawDesc = nil
+	file_j5_config_v1_lint_proto_goTypes = nil
+	file_j5_config_v1_lint_proto_depIdxs = nil
+}
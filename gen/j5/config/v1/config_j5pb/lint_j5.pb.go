@@ -0,0 +1,168 @@
+// Code generated by protoc-gen-go-j5. DO NOT EDIT.
+
+package config_j5pb
+
+import (
+	driver "database/sql/driver"
+	fmt "fmt"
+	j5reflect "github.com/pentops/j5/lib/j5reflect"
+	proto "google.golang.org/protobuf/proto"
+)
+
+func (msg *LintConfig) Clone() any {
+	return proto.Clone(msg).(*LintConfig)
+}
+func (msg *LintConfig) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *LintConfig) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *LintRule) Clone() any {
+	return proto.Clone(msg).(*LintRule)
+}
+func (msg *LintRule) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *LintRule) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *LintPattern) Clone() any {
+	return proto.Clone(msg).(*LintPattern)
+}
+func (msg *LintPattern) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *LintPattern) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+// LintSeverity
+const (
+	LintSeverity_UNSPECIFIED LintSeverity = 0
+	LintSeverity_ERROR       LintSeverity = 1
+	LintSeverity_WARNING     LintSeverity = 2
+	LintSeverity_OFF         LintSeverity = 3
+)
+
+var (
+	LintSeverity_name_short = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "ERROR",
+		2: "WARNING",
+		3: "OFF",
+	}
+	LintSeverity_value_short = map[string]int32{
+		"UNSPECIFIED": 0,
+		"ERROR":       1,
+		"WARNING":     2,
+		"OFF":         3,
+	}
+	LintSeverity_value_either = map[string]int32{
+		"UNSPECIFIED":               0,
+		"LINT_SEVERITY_UNSPECIFIED": 0,
+		"ERROR":                     1,
+		"LINT_SEVERITY_ERROR":       1,
+		"WARNING":                   2,
+		"LINT_SEVERITY_WARNING":     2,
+		"OFF":                       3,
+		"LINT_SEVERITY_OFF":         3,
+	}
+)
+
+// ShortString returns the un-prefixed string representation of the enum value
+func (x LintSeverity) ShortString() string {
+	return LintSeverity_name_short[int32(x)]
+}
+func (x LintSeverity) Value() (driver.Value, error) {
+	return []uint8(x.ShortString()), nil
+}
+func (x *LintSeverity) Scan(value interface{}) error {
+	var strVal string
+	switch vt := value.(type) {
+	case []uint8:
+		strVal = string(vt)
+	case string:
+		strVal = vt
+	default:
+		return fmt.Errorf("invalid type %T", value)
+	}
+	val := LintSeverity_value_either[strVal]
+	*x = LintSeverity(val)
+	return nil
+}
+
+// LintTarget
+const (
+	LintTarget_UNSPECIFIED LintTarget = 0
+	LintTarget_SCHEMA      LintTarget = 1
+	LintTarget_PROPERTY    LintTarget = 2
+	LintTarget_SERVICE     LintTarget = 3
+	LintTarget_METHOD      LintTarget = 4
+	LintTarget_TOPIC       LintTarget = 5
+	LintTarget_ENTITY      LintTarget = 6
+)
+
+var (
+	LintTarget_name_short = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "SCHEMA",
+		2: "PROPERTY",
+		3: "SERVICE",
+		4: "METHOD",
+		5: "TOPIC",
+		6: "ENTITY",
+	}
+	LintTarget_value_short = map[string]int32{
+		"UNSPECIFIED": 0,
+		"SCHEMA":      1,
+		"PROPERTY":    2,
+		"SERVICE":     3,
+		"METHOD":      4,
+		"TOPIC":       5,
+		"ENTITY":      6,
+	}
+	LintTarget_value_either = map[string]int32{
+		"UNSPECIFIED":             0,
+		"LINT_TARGET_UNSPECIFIED": 0,
+		"SCHEMA":                  1,
+		"LINT_TARGET_SCHEMA":      1,
+		"PROPERTY":                2,
+		"LINT_TARGET_PROPERTY":    2,
+		"SERVICE":                 3,
+		"LINT_TARGET_SERVICE":     3,
+		"METHOD":                  4,
+		"LINT_TARGET_METHOD":      4,
+		"TOPIC":                   5,
+		"LINT_TARGET_TOPIC":       5,
+		"ENTITY":                  6,
+		"LINT_TARGET_ENTITY":      6,
+	}
+)
+
+// ShortString returns the un-prefixed string representation of the enum value
+func (x LintTarget) ShortString() string {
+	return LintTarget_name_short[int32(x)]
+}
+func (x LintTarget) Value() (driver.Value, error) {
+	return []uint8(x.ShortString()), nil
+}
+func (x *LintTarget) Scan(value interface{}) error {
+	var strVal string
+	switch vt := value.(type) {
+	case []uint8:
+		strVal = string(vt)
+	case string:
+		strVal = vt
+	default:
+		return fmt.Errorf("invalid type %T", value)
+	}
+	val := LintTarget_value_either[strVal]
+	*x = LintTarget(val)
+	return nil
+}
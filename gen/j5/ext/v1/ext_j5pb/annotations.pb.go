@@ -71,7 +71,7 @@ func (x KeyField_Format) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use KeyField_Format.Descriptor instead.
 func (KeyField_Format) EnumDescriptor() ([]byte, []int) {
-	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{30, 0}
+	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{31, 0}
 }
 
 type PackageOptions struct {
@@ -299,6 +299,8 @@ type ServiceOptions struct {
 	Type        isServiceOptions_Type     `protobuf_oneof:"type"`
 	DefaultAuth *auth_j5pb.MethodAuthType `protobuf:"bytes,10,opt,name=default_auth,json=defaultAuth,proto3" json:"default_auth,omitempty"`
 	Audience    []string                  `protobuf:"bytes,20,rep,name=audience,proto3" json:"audience,omitempty"`
+	// Applies to each method of the service which does not set its own limit.
+	DefaultRateLimit *schema_j5pb.RateLimit `protobuf:"bytes,30,opt,name=default_rate_limit,json=defaultRateLimit,proto3" json:"default_rate_limit,omitempty"`
 }
 
 func (x *ServiceOptions) Reset() {
@@ -368,6 +370,13 @@ func (x *ServiceOptions) GetAudience() []string {
 	return nil
 }
 
+func (x *ServiceOptions) GetDefaultRateLimit() *schema_j5pb.RateLimit {
+	if x != nil {
+		return x.DefaultRateLimit
+	}
+	return nil
+}
+
 type isServiceOptions_Type interface {
 	isServiceOptions_Type()
 }
@@ -493,7 +502,8 @@ type ObjectMessageOptions struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PolymorphMember []string `protobuf:"bytes,3,rep,name=polymorph_member,json=polymorphMember,proto3" json:"polymorph_member,omitempty"`
+	PolymorphMember []string                  `protobuf:"bytes,3,rep,name=polymorph_member,json=polymorphMember,proto3" json:"polymorph_member,omitempty"`
+	Rules           []*schema_j5pb.ObjectRule `protobuf:"bytes,4,rep,name=rules,proto3" json:"rules,omitempty"`
 }
 
 func (x *ObjectMessageOptions) Reset() {
@@ -535,6 +545,13 @@ func (x *ObjectMessageOptions) GetPolymorphMember() []string {
 	return nil
 }
 
+func (x *ObjectMessageOptions) GetRules() []*schema_j5pb.ObjectRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
 type OneofMessageOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -631,6 +648,10 @@ type MethodOptions struct {
 	Hidden     bool                      `protobuf:"varint,2,opt,name=hidden,proto3" json:"hidden,omitempty"`
 	StateQuery *StateQueryMethodOptions  `protobuf:"bytes,10,opt,name=state_query,json=stateQuery,proto3" json:"state_query,omitempty"`
 	Auth       *auth_j5pb.MethodAuthType `protobuf:"bytes,20,opt,name=auth,proto3" json:"auth,omitempty"`
+	// Sets the Cache-Control header on successful responses to GET methods.
+	CacheControl *CacheControl `protobuf:"bytes,30,opt,name=cache_control,json=cacheControl,proto3" json:"cache_control,omitempty"`
+	// Limits calls to the method per caller, overriding the service default.
+	RateLimit *schema_j5pb.RateLimit `protobuf:"bytes,31,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
 }
 
 func (x *MethodOptions) Reset() {
@@ -693,6 +714,104 @@ func (x *MethodOptions) GetAuth() *auth_j5pb.MethodAuthType {
 	return nil
 }
 
+func (x *MethodOptions) GetCacheControl() *CacheControl {
+	if x != nil {
+		return x.CacheControl
+	}
+	return nil
+}
+
+func (x *MethodOptions) GetRateLimit() *schema_j5pb.RateLimit {
+	if x != nil {
+		return x.RateLimit
+	}
+	return nil
+}
+
+type CacheControl struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Seconds the response may be reused without revalidation (max-age).
+	MaxAge *int32 `protobuf:"varint,1,opt,name=max_age,json=maxAge,proto3,oneof" json:"max_age,omitempty"`
+	// The response is specific to the caller, shared caches must not store it.
+	Private bool `protobuf:"varint,2,opt,name=private,proto3" json:"private,omitempty"`
+	// The response must be revalidated using the ETag before each reuse.
+	NoCache bool `protobuf:"varint,3,opt,name=no_cache,json=noCache,proto3" json:"no_cache,omitempty"`
+	// The response must not be stored by any cache.
+	NoStore bool `protobuf:"varint,4,opt,name=no_store,json=noStore,proto3" json:"no_store,omitempty"`
+	// Once stale, the response must be revalidated before reuse.
+	MustRevalidate bool `protobuf:"varint,5,opt,name=must_revalidate,json=mustRevalidate,proto3" json:"must_revalidate,omitempty"`
+}
+
+func (x *CacheControl) Reset() {
+	*x = CacheControl{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_j5_ext_v1_annotations_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CacheControl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheControl) ProtoMessage() {}
+
+func (x *CacheControl) ProtoReflect() protoreflect.Message {
+	mi := &file_j5_ext_v1_annotations_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheControl.ProtoReflect.Descriptor instead.
+func (*CacheControl) Descriptor() ([]byte, []int) {
+	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CacheControl) GetMaxAge() int32 {
+	if x != nil && x.MaxAge != nil {
+		return *x.MaxAge
+	}
+	return 0
+}
+
+func (x *CacheControl) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+func (x *CacheControl) GetNoCache() bool {
+	if x != nil {
+		return x.NoCache
+	}
+	return false
+}
+
+func (x *CacheControl) GetNoStore() bool {
+	if x != nil {
+		return x.NoStore
+	}
+	return false
+}
+
+func (x *CacheControl) GetMustRevalidate() bool {
+	if x != nil {
+		return x.MustRevalidate
+	}
+	return false
+}
+
 type StateQueryMethodOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -706,7 +825,7 @@ type StateQueryMethodOptions struct {
 func (x *StateQueryMethodOptions) Reset() {
 	*x = StateQueryMethodOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_ext_v1_annotations_proto_msgTypes[10]
+		mi := &file_j5_ext_v1_annotations_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -719,7 +838,7 @@ func (x *StateQueryMethodOptions) String() string {
 func (*StateQueryMethodOptions) ProtoMessage() {}
 
 func (x *StateQueryMethodOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_ext_v1_annotations_proto_msgTypes[10]
+	mi := &file_j5_ext_v1_annotations_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -732,7 +851,7 @@ func (x *StateQueryMethodOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StateQueryMethodOptions.ProtoReflect.Descriptor instead.
 func (*StateQueryMethodOptions) Descriptor() ([]byte, []int) {
-	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{10}
+	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *StateQueryMethodOptions) GetGet() bool {
@@ -769,7 +888,7 @@ type EnumOptions struct {
 func (x *EnumOptions) Reset() {
 	*x = EnumOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_ext_v1_annotations_proto_msgTypes[11]
+		mi := &file_j5_ext_v1_annotations_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -782,7 +901,7 @@ func (x *EnumOptions) String() string {
 func (*EnumOptions) ProtoMessage() {}
 
 func (x *EnumOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_ext_v1_annotations_proto_msgTypes[11]
+	mi := &file_j5_ext_v1_annotations_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -795,7 +914,7 @@ func (x *EnumOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnumOptions.ProtoReflect.Descriptor instead.
 func (*EnumOptions) Descriptor() ([]byte, []int) {
-	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{11}
+	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *EnumOptions) GetNoDefault() bool {
@@ -825,7 +944,7 @@ type EnumInfoField struct {
 func (x *EnumInfoField) Reset() {
 	*x = EnumInfoField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_ext_v1_annotations_proto_msgTypes[12]
+		mi := &file_j5_ext_v1_annotations_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -838,7 +957,7 @@ func (x *EnumInfoField) String() string {
 func (*EnumInfoField) ProtoMessage() {}
 
 func (x *EnumInfoField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_ext_v1_annotations_proto_msgTypes[12]
+	mi := &file_j5_ext_v1_annotations_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -851,7 +970,7 @@ func (x *EnumInfoField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnumInfoField.ProtoReflect.Descriptor instead.
 func (*EnumInfoField) Descriptor() ([]byte, []int) {
-	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{12}
+	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *EnumInfoField) GetName() string {
@@ -888,7 +1007,7 @@ type EnumValueOptions struct {
 func (x *EnumValueOptions) Reset() {
 	*x = EnumValueOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_ext_v1_annotations_proto_msgTypes[13]
+		mi := &file_j5_ext_v1_annotations_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -901,7 +1020,7 @@ func (x *EnumValueOptions) String() string {
 func (*EnumValueOptions) ProtoMessage() {}
 
 func (x *EnumValueOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_ext_v1_annotations_proto_msgTypes[13]
+	mi := &file_j5_ext_v1_annotations_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -914,7 +1033,7 @@ func (x *EnumValueOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnumValueOptions.ProtoReflect.Descriptor instead.
 func (*EnumValueOptions) Descriptor() ([]byte, []int) {
-	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{13}
+	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *EnumValueOptions) GetDescription() string {
@@ -965,7 +1084,7 @@ type FieldOptions struct {
 func (x *FieldOptions) Reset() {
 	*x = FieldOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_ext_v1_annotations_proto_msgTypes[14]
+		mi := &file_j5_ext_v1_annotations_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -978,7 +1097,7 @@ func (x *FieldOptions) String() string {
 func (*FieldOptions) ProtoMessage() {}
 
 func (x *FieldOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_ext_v1_annotations_proto_msgTypes[14]
+	mi := &file_j5_ext_v1_annotations_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -991,7 +1110,7 @@ func (x *FieldOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FieldOptions.ProtoReflect.Descriptor instead.
 func (*FieldOptions) Descriptor() ([]byte, []int) {
-	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{14}
+	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *FieldOptions) GetDescription() string {
@@ -1230,7 +1349,7 @@ type AnyField struct {
 func (x *AnyField) Reset() {
 	*x = AnyField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_ext_v1_annotations_proto_msgTypes[15]
+		mi := &file_j5_ext_v1_annotations_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1243,7 +1362,7 @@ func (x *AnyField) String() string {
 func (*AnyField) ProtoMessage() {}
 
 func (x *AnyField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_ext_v1_annotations_proto_msgTypes[15]
+	mi := &file_j5_ext_v1_annotations_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1256,7 +1375,7 @@ func (x *AnyField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AnyField.ProtoReflect.Descriptor instead.
 func (*AnyField) Descriptor() ([]byte, []int) {
-	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{15}
+	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{16}
 }
 
 type ObjectField struct {
@@ -1272,7 +1391,7 @@ type ObjectField struct {
 func (x *ObjectField) Reset() {
 	*x = ObjectField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_ext_v1_annotations_proto_msgTypes[16]
+		mi := &file_j5_ext_v1_annotations_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1285,7 +1404,7 @@ func (x *ObjectField) String() string {
 func (*ObjectField) ProtoMessage() {}
 
 func (x *ObjectField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_ext_v1_annotations_proto_msgTypes[16]
+	mi := &file_j5_ext_v1_annotations_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1298,7 +1417,7 @@ func (x *ObjectField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ObjectField.ProtoReflect.Descriptor instead.
 func (*ObjectField) Descriptor() ([]byte, []int) {
-	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{16}
+	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ObjectField) GetFlatten() bool {
@@ -1317,7 +1436,7 @@ type EnumField struct {
 func (x *EnumField) Reset() {
 	*x = EnumField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_ext_v1_annotations_proto_msgTypes[17]
+		mi := &file_j5_ext_v1_annotations_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1330,7 +1449,7 @@ func (x *EnumField) String() string {
 func (*EnumField) ProtoMessage() {}
 
 func (x *EnumField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_ext_v1_annotations_proto_msgTypes[17]
+	mi := &file_j5_ext_v1_annotations_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1343,7 +1462,7 @@ func (x *EnumField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnumField.ProtoReflect.Descriptor instead.
 func (*EnumField) Descriptor() ([]byte, []int) {
-	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{17}
+	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{18}
 }
 
 type OneofField struct {
@@ -1355,7 +1474,7 @@ type OneofField struct {
 func (x *OneofField) Reset() {
 	*x = OneofField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_ext_v1_annotations_proto_msgTypes[18]
+		mi := &file_j5_ext_v1_annotations_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1368,7 +1487,7 @@ func (x *OneofField) String() string {
 func (*OneofField) ProtoMessage() {}
 
 func (x *OneofField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_ext_v1_annotations_proto_msgTypes[18]
+	mi := &file_j5_ext_v1_annotations_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1381,7 +1500,7 @@ func (x *OneofField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OneofField.ProtoReflect.Descriptor instead.
 func (*OneofField) Descriptor() ([]byte, []int) {
-	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{18}
+	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{19}
 }
 
 type PolymorphField struct {
@@ -1393,7 +1512,7 @@ type PolymorphField struct {
 func (x *PolymorphField) Reset() {
 	*x = PolymorphField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_ext_v1_annotations_proto_msgTypes[19]
+		mi := &file_j5_ext_v1_annotations_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1406,7 +1525,7 @@ func (x *PolymorphField) String() string {
 func (*PolymorphField) ProtoMessage() {}
 
 func (x *PolymorphField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_ext_v1_annotations_proto_msgTypes[19]
+	mi := &file_j5_ext_v1_annotations_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1419,7 +1538,7 @@ func (x *PolymorphField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolymorphField.ProtoReflect.Descriptor instead.
 func (*PolymorphField) Descriptor() ([]byte, []int) {
-	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{19}
+	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{20}
 }
 
 type MapField struct {
@@ -1435,7 +1554,7 @@ type MapField struct {
 func (x *MapField) Reset() {
 	*x = MapField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_ext_v1_annotations_proto_msgTypes[20]
+		mi := &file_j5_ext_v1_annotations_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1448,7 +1567,7 @@ func (x *MapField) String() string {
 func (*MapField) ProtoMessage() {}
 
 func (x *MapField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_ext_v1_annotations_proto_msgTypes[20]
+	mi := &file_j5_ext_v1_annotations_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1461,7 +1580,7 @@ func (x *MapField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MapField.ProtoReflect.Descriptor instead.
 func (*MapField) Descriptor() ([]byte, []int) {
-	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{20}
+	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *MapField) GetSingleForm() string {
@@ -1484,7 +1603,7 @@ type ArrayField struct {
 func (x *ArrayField) Reset() {
 	*x = ArrayField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_ext_v1_annotations_proto_msgTypes[21]
+		mi := &file_j5_ext_v1_annotations_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1497,7 +1616,7 @@ func (x *ArrayField) String() string {
 func (*ArrayField) ProtoMessage() {}
 
 func (x *ArrayField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_ext_v1_annotations_proto_msgTypes[21]
+	mi := &file_j5_ext_v1_annotations_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1510,7 +1629,7 @@ func (x *ArrayField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArrayField.ProtoReflect.Descriptor instead.
 func (*ArrayField) Descriptor() ([]byte, []int) {
-	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{21}
+	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ArrayField) GetSingleForm() string {
@@ -1529,7 +1648,7 @@ type StringField struct {
 func (x *StringField) Reset() {
 	*x = StringField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_ext_v1_annotations_proto_msgTypes[22]
+		mi := &file_j5_ext_v1_annotations_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1542,7 +1661,7 @@ func (x *StringField) String() string {
 func (*StringField) ProtoMessage() {}
 
 func (x *StringField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_ext_v1_annotations_proto_msgTypes[22]
+	mi := &file_j5_ext_v1_annotations_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1555,7 +1674,7 @@ func (x *StringField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StringField.ProtoReflect.Descriptor instead.
 func (*StringField) Descriptor() ([]byte, []int) {
-	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{22}
+	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{23}
 }
 
 type IntegerField struct {
@@ -1569,7 +1688,7 @@ type IntegerField struct {
 func (x *IntegerField) Reset() {
 	*x = IntegerField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_ext_v1_annotations_proto_msgTypes[23]
+		mi := &file_j5_ext_v1_annotations_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1582,7 +1701,7 @@ func (x *IntegerField) String() string {
 func (*IntegerField) ProtoMessage() {}
 
 func (x *IntegerField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_ext_v1_annotations_proto_msgTypes[23]
+	mi := &file_j5_ext_v1_annotations_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1595,7 +1714,7 @@ func (x *IntegerField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IntegerField.ProtoReflect.Descriptor instead.
 func (*IntegerField) Descriptor() ([]byte, []int) {
-	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{23}
+	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *IntegerField) GetRules() *IntegerField_Rules {
@@ -1614,7 +1733,7 @@ type FloatField struct {
 func (x *FloatField) Reset() {
 	*x = FloatField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_ext_v1_annotations_proto_msgTypes[24]
+		mi := &file_j5_ext_v1_annotations_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1627,7 +1746,7 @@ func (x *FloatField) String() string {
 func (*FloatField) ProtoMessage() {}
 
 func (x *FloatField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_ext_v1_annotations_proto_msgTypes[24]
+	mi := &file_j5_ext_v1_annotations_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1640,7 +1759,7 @@ func (x *FloatField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FloatField.ProtoReflect.Descriptor instead.
 func (*FloatField) Descriptor() ([]byte, []int) {
-	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{24}
+	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{25}
 }
 
 type BoolField struct {
@@ -1652,7 +1771,7 @@ type BoolField struct {
 func (x *BoolField) Reset() {
 	*x = BoolField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_ext_v1_annotations_proto_msgTypes[25]
+		mi := &file_j5_ext_v1_annotations_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1665,7 +1784,7 @@ func (x *BoolField) String() string {
 func (*BoolField) ProtoMessage() {}
 
 func (x *BoolField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_ext_v1_annotations_proto_msgTypes[25]
+	mi := &file_j5_ext_v1_annotations_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1678,7 +1797,7 @@ func (x *BoolField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BoolField.ProtoReflect.Descriptor instead.
 func (*BoolField) Descriptor() ([]byte, []int) {
-	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{25}
+	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{26}
 }
 
 type BytesField struct {
@@ -1690,7 +1809,7 @@ type BytesField struct {
 func (x *BytesField) Reset() {
 	*x = BytesField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_ext_v1_annotations_proto_msgTypes[26]
+		mi := &file_j5_ext_v1_annotations_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1703,7 +1822,7 @@ func (x *BytesField) String() string {
 func (*BytesField) ProtoMessage() {}
 
 func (x *BytesField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_ext_v1_annotations_proto_msgTypes[26]
+	mi := &file_j5_ext_v1_annotations_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1716,7 +1835,7 @@ func (x *BytesField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BytesField.ProtoReflect.Descriptor instead.
 func (*BytesField) Descriptor() ([]byte, []int) {
-	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{26}
+	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{27}
 }
 
 type DecimalField struct {
@@ -1730,7 +1849,7 @@ type DecimalField struct {
 func (x *DecimalField) Reset() {
 	*x = DecimalField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_ext_v1_annotations_proto_msgTypes[27]
+		mi := &file_j5_ext_v1_annotations_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1743,7 +1862,7 @@ func (x *DecimalField) String() string {
 func (*DecimalField) ProtoMessage() {}
 
 func (x *DecimalField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_ext_v1_annotations_proto_msgTypes[27]
+	mi := &file_j5_ext_v1_annotations_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1756,7 +1875,7 @@ func (x *DecimalField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DecimalField.ProtoReflect.Descriptor instead.
 func (*DecimalField) Descriptor() ([]byte, []int) {
-	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{27}
+	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *DecimalField) GetRules() *DecimalField_Rules {
@@ -1777,7 +1896,7 @@ type DateField struct {
 func (x *DateField) Reset() {
 	*x = DateField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_ext_v1_annotations_proto_msgTypes[28]
+		mi := &file_j5_ext_v1_annotations_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1790,7 +1909,7 @@ func (x *DateField) String() string {
 func (*DateField) ProtoMessage() {}
 
 func (x *DateField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_ext_v1_annotations_proto_msgTypes[28]
+	mi := &file_j5_ext_v1_annotations_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1803,7 +1922,7 @@ func (x *DateField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DateField.ProtoReflect.Descriptor instead.
 func (*DateField) Descriptor() ([]byte, []int) {
-	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{28}
+	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *DateField) GetRules() *DateField_Rules {
@@ -1822,7 +1941,7 @@ type TimestampField struct {
 func (x *TimestampField) Reset() {
 	*x = TimestampField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_ext_v1_annotations_proto_msgTypes[29]
+		mi := &file_j5_ext_v1_annotations_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1835,7 +1954,7 @@ func (x *TimestampField) String() string {
 func (*TimestampField) ProtoMessage() {}
 
 func (x *TimestampField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_ext_v1_annotations_proto_msgTypes[29]
+	mi := &file_j5_ext_v1_annotations_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1848,7 +1967,7 @@ func (x *TimestampField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimestampField.ProtoReflect.Descriptor instead.
 func (*TimestampField) Descriptor() ([]byte, []int) {
-	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{29}
+	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{30}
 }
 
 type KeyField struct {
@@ -1869,7 +1988,7 @@ type KeyField struct {
 func (x *KeyField) Reset() {
 	*x = KeyField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_ext_v1_annotations_proto_msgTypes[30]
+		mi := &file_j5_ext_v1_annotations_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1882,7 +2001,7 @@ func (x *KeyField) String() string {
 func (*KeyField) ProtoMessage() {}
 
 func (x *KeyField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_ext_v1_annotations_proto_msgTypes[30]
+	mi := &file_j5_ext_v1_annotations_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1895,7 +2014,7 @@ func (x *KeyField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KeyField.ProtoReflect.Descriptor instead.
 func (*KeyField) Descriptor() ([]byte, []int) {
-	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{30}
+	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{31}
 }
 
 func (m *KeyField) GetType() isKeyField_Type {
@@ -1960,7 +2079,7 @@ type ServiceOptions_StateQuery struct {
 func (x *ServiceOptions_StateQuery) Reset() {
 	*x = ServiceOptions_StateQuery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_ext_v1_annotations_proto_msgTypes[31]
+		mi := &file_j5_ext_v1_annotations_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1973,7 +2092,7 @@ func (x *ServiceOptions_StateQuery) String() string {
 func (*ServiceOptions_StateQuery) ProtoMessage() {}
 
 func (x *ServiceOptions_StateQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_ext_v1_annotations_proto_msgTypes[31]
+	mi := &file_j5_ext_v1_annotations_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2007,7 +2126,7 @@ type ServiceOptions_StateCommand struct {
 func (x *ServiceOptions_StateCommand) Reset() {
 	*x = ServiceOptions_StateCommand{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_ext_v1_annotations_proto_msgTypes[32]
+		mi := &file_j5_ext_v1_annotations_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2020,7 +2139,7 @@ func (x *ServiceOptions_StateCommand) String() string {
 func (*ServiceOptions_StateCommand) ProtoMessage() {}
 
 func (x *ServiceOptions_StateCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_ext_v1_annotations_proto_msgTypes[32]
+	mi := &file_j5_ext_v1_annotations_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2057,7 +2176,7 @@ type IntegerField_Rules struct {
 func (x *IntegerField_Rules) Reset() {
 	*x = IntegerField_Rules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_ext_v1_annotations_proto_msgTypes[34]
+		mi := &file_j5_ext_v1_annotations_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2070,7 +2189,7 @@ func (x *IntegerField_Rules) String() string {
 func (*IntegerField_Rules) ProtoMessage() {}
 
 func (x *IntegerField_Rules) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_ext_v1_annotations_proto_msgTypes[34]
+	mi := &file_j5_ext_v1_annotations_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2083,7 +2202,7 @@ func (x *IntegerField_Rules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IntegerField_Rules.ProtoReflect.Descriptor instead.
 func (*IntegerField_Rules) Descriptor() ([]byte, []int) {
-	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{23, 0}
+	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{24, 0}
 }
 
 func (x *IntegerField_Rules) GetMinimum() int64 {
@@ -2128,7 +2247,7 @@ type DecimalField_Rules struct {
 func (x *DecimalField_Rules) Reset() {
 	*x = DecimalField_Rules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_ext_v1_annotations_proto_msgTypes[35]
+		mi := &file_j5_ext_v1_annotations_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2141,7 +2260,7 @@ func (x *DecimalField_Rules) String() string {
 func (*DecimalField_Rules) ProtoMessage() {}
 
 func (x *DecimalField_Rules) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_ext_v1_annotations_proto_msgTypes[35]
+	mi := &file_j5_ext_v1_annotations_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2154,7 +2273,7 @@ func (x *DecimalField_Rules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DecimalField_Rules.ProtoReflect.Descriptor instead.
 func (*DecimalField_Rules) Descriptor() ([]byte, []int) {
-	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{27, 0}
+	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{28, 0}
 }
 
 func (x *DecimalField_Rules) GetMinimum() string {
@@ -2199,7 +2318,7 @@ type DateField_Rules struct {
 func (x *DateField_Rules) Reset() {
 	*x = DateField_Rules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_ext_v1_annotations_proto_msgTypes[36]
+		mi := &file_j5_ext_v1_annotations_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2212,7 +2331,7 @@ func (x *DateField_Rules) String() string {
 func (*DateField_Rules) ProtoMessage() {}
 
 func (x *DateField_Rules) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_ext_v1_annotations_proto_msgTypes[36]
+	mi := &file_j5_ext_v1_annotations_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2225,7 +2344,7 @@ func (x *DateField_Rules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DateField_Rules.ProtoReflect.Descriptor instead.
 func (*DateField_Rules) Descriptor() ([]byte, []int) {
-	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{28, 0}
+	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{29, 0}
 }
 
 func (x *DateField_Rules) GetMinimum() string {
@@ -2270,7 +2389,7 @@ type KeyField_PatternInfo struct {
 func (x *KeyField_PatternInfo) Reset() {
 	*x = KeyField_PatternInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_ext_v1_annotations_proto_msgTypes[37]
+		mi := &file_j5_ext_v1_annotations_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2283,7 +2402,7 @@ func (x *KeyField_PatternInfo) String() string {
 func (*KeyField_PatternInfo) ProtoMessage() {}
 
 func (x *KeyField_PatternInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_ext_v1_annotations_proto_msgTypes[37]
+	mi := &file_j5_ext_v1_annotations_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2296,7 +2415,7 @@ func (x *KeyField_PatternInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KeyField_PatternInfo.ProtoReflect.Descriptor instead.
 func (*KeyField_PatternInfo) Descriptor() ([]byte, []int) {
-	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{30, 0}
+	return file_j5_ext_v1_annotations_proto_rawDescGZIP(), []int{31, 0}
 }
 
 func (x *KeyField_PatternInfo) GetName() string {
@@ -2455,335 +2574,363 @@ var file_j5_ext_v1_annotations_proto_rawDesc = []byte{
 	0x75, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x6a, 0x35, 0x2f, 0x65, 0x78, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x71,
 	0x75, 0x65, 0x72, 0x79, 0x5f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x6a, 0x35, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x14, 0x6a, 0x35, 0x2f, 0x62, 0x63, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x70, 0x65,
-	0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x50, 0x0a, 0x0e, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3e, 0x0a, 0x0e, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x0d, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x22, 0x22, 0x0a, 0x08, 0x4a, 0x35, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x5a, 0x0a,
-	0x0c, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7d, 0x0a, 0x0a, 0x50, 0x53, 0x4d,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e,
-	0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x50, 0x61, 0x72, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x22, 0xd9, 0x02, 0x0a, 0x0e, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x47, 0x0a, 0x0b, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x24, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x12, 0x4d, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6a, 0x35,
-	0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61,
-	0x75, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6a, 0x35, 0x2e, 0x61,
-	0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x41, 0x75, 0x74,
-	0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x75,
-	0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x14,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x24,
-	0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x1a, 0x26, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x06, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x22, 0xf1, 0x01, 0x0a, 0x0e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x06, 0x6f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6a, 0x35, 0x2e, 0x65,
-	0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x12, 0x36, 0x0a, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x12, 0x42, 0x0a, 0x09,
-	0x70, 0x6f, 0x6c, 0x79, 0x6d, 0x6f, 0x72, 0x70, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x22, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x79,
-	0x6d, 0x6f, 0x72, 0x70, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x09, 0x70, 0x6f, 0x6c, 0x79, 0x6d, 0x6f, 0x72, 0x70, 0x68,
-	0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x41, 0x0a, 0x14, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x29, 0x0a, 0x10, 0x70, 0x6f, 0x6c, 0x79, 0x6d, 0x6f, 0x72, 0x70, 0x68, 0x5f, 0x6d, 0x65,
-	0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x6f, 0x6c, 0x79,
-	0x6d, 0x6f, 0x72, 0x70, 0x68, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x15, 0x0a, 0x13, 0x4f,
-	0x6e, 0x65, 0x6f, 0x66, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x22, 0x33, 0x0a, 0x17, 0x50, 0x6f, 0x6c, 0x79, 0x6d, 0x6f, 0x72, 0x70, 0x68, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a,
-	0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
-	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0xb2, 0x01, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x68,
-	0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62,
-	0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12,
-	0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x43, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6a,
-	0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x2e, 0x0a, 0x04,
-	0x61, 0x75, 0x74, 0x68, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6a, 0x35, 0x2e,
-	0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x41, 0x75,
-	0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x22, 0x60, 0x0a, 0x17,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x65, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x67, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x73,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x67,
-	0x0a, 0x0b, 0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a,
-	0x0a, 0x6e, 0x6f, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x09, 0x6e, 0x6f, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x39, 0x0a, 0x0b,
-	0x69, 0x6e, 0x66, 0x6f, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x18, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e,
-	0x75, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0a, 0x69, 0x6e, 0x66,
-	0x6f, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x5b, 0x0a, 0x0d, 0x45, 0x6e, 0x75, 0x6d, 0x49,
-	0x6e, 0x66, 0x6f, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62,
-	0x65, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa8, 0x01, 0x0a, 0x10, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x04, 0x69,
-	0x6e, 0x66, 0x6f, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6a, 0x35, 0x2e, 0x65,
-	0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x1a, 0x37, 0x0a, 0x09, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0xc5, 0x06, 0x0a, 0x0c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x03, 0x61, 0x6e, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x79, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x03, 0x61, 0x6e, 0x79, 0x12, 0x30, 0x0a, 0x06, 0x6f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6a, 0x35,
-	0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x2a, 0x0a,
-	0x04, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6a, 0x35,
-	0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x48, 0x00, 0x52, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x12, 0x2d, 0x0a, 0x05, 0x6f, 0x6e, 0x65,
-	0x6f, 0x66, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x48,
-	0x00, 0x52, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x12, 0x39, 0x0a, 0x09, 0x70, 0x6f, 0x6c, 0x79,
-	0x6d, 0x6f, 0x72, 0x70, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6a, 0x35,
-	0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x79, 0x6d, 0x6f, 0x72, 0x70,
-	0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x09, 0x70, 0x6f, 0x6c, 0x79, 0x6d, 0x6f,
-	0x72, 0x70, 0x68, 0x12, 0x2e, 0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x45, 0x78, 0x74, 0x48, 0x00, 0x52, 0x03,
-	0x6d, 0x61, 0x70, 0x12, 0x34, 0x0a, 0x05, 0x61, 0x72, 0x72, 0x61, 0x79, 0x18, 0x15, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76,
-	0x31, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x45, 0x78, 0x74,
-	0x48, 0x00, 0x52, 0x05, 0x61, 0x72, 0x72, 0x61, 0x79, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6a, 0x35, 0x2e, 0x65,
-	0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x33, 0x0a, 0x07, 0x69,
-	0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a,
-	0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x07, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72,
-	0x12, 0x2d, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x6f, 0x61,
-	0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x12,
-	0x2a, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
-	0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x12, 0x2d, 0x0a, 0x05, 0x62,
-	0x79, 0x74, 0x65, 0x73, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6a, 0x35, 0x2e,
-	0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x48, 0x00, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x64, 0x65,
-	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x35,
-	0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12,
-	0x2a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x6a, 0x35, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x19, 0x6a, 0x35, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x6a,
+	0x35, 0x2f, 0x62, 0x63, 0x6c, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x70, 0x65, 0x63, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x50, 0x0a, 0x0e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3e, 0x0a, 0x0e, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x0d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x73, 0x22, 0x22, 0x0a, 0x08, 0x4a, 0x35, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x5a, 0x0a, 0x0c, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65,
+	0x67, 0x65, 0x78, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7d, 0x0a, 0x0a, 0x50, 0x53, 0x4d, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x70,
+	0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6a, 0x35, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50,
+	0x61, 0x72, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72,
+	0x74, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
+	0x70, 0x61, 0x72, 0x74, 0x22, 0xa0, 0x03, 0x0a, 0x0e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x47, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6a,
+	0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x12, 0x4d, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x48,
+	0x00, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12,
+	0x3d, 0x0a, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x61, 0x75, 0x74, 0x68, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6a, 0x35, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x75, 0x74, 0x68, 0x12, 0x1a,
+	0x0a, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x14, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x12, 0x64, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52,
+	0x10, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x1a, 0x24, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x1a, 0x26, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42,
+	0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xf1, 0x01, 0x0a, 0x0e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x06,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6a,
+	0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52,
+	0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x36, 0x0a, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x12,
+	0x42, 0x0a, 0x09, 0x70, 0x6f, 0x6c, 0x79, 0x6d, 0x6f, 0x72, 0x70, 0x68, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6f, 0x6c, 0x79, 0x6d, 0x6f, 0x72, 0x70, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x09, 0x70, 0x6f, 0x6c, 0x79, 0x6d, 0x6f,
+	0x72, 0x70, 0x68, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x71, 0x0a, 0x14, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x6f, 0x6c, 0x79, 0x6d, 0x6f, 0x72, 0x70, 0x68,
+	0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x70,
+	0x6f, 0x6c, 0x79, 0x6d, 0x6f, 0x72, 0x70, 0x68, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2e,
+	0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x15,
+	0x0a, 0x13, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x33, 0x0a, 0x17, 0x50, 0x6f, 0x6c, 0x79, 0x6d, 0x6f, 0x72,
+	0x70, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0xa8, 0x02, 0x0a, 0x0d, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x68, 0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x43, 0x0a, 0x0b, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x2e, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x6a, 0x35, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12,
+	0x3c, 0x0a, 0x0d, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52,
+	0x0c, 0x63, 0x61, 0x63, 0x68, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x36, 0x0a,
+	0x0a, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x1f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xb1, 0x01, 0x0a, 0x0c, 0x43, 0x61, 0x63, 0x68, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x1c, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x67,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x6e, 0x6f, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x6e, 0x6f, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6e, 0x6f, 0x5f,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x6e, 0x6f, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x75, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6d,
+	0x75, 0x73, 0x74, 0x52, 0x65, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x22, 0x60, 0x0a, 0x17, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x03, 0x67, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x69,
+	0x73, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0a, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x67, 0x0a, 0x0b, 0x45,
+	0x6e, 0x75, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f,
+	0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x6e, 0x6f, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x39, 0x0a, 0x0b, 0x69, 0x6e, 0x66,
+	0x6f, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x49,
+	0x6e, 0x66, 0x6f, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0a, 0x69, 0x6e, 0x66, 0x6f, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x22, 0x5b, 0x0a, 0x0d, 0x45, 0x6e, 0x75, 0x6d, 0x49, 0x6e, 0x66, 0x6f,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xa8, 0x01, 0x0a, 0x10, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f,
+	0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x69,
+	0x6e, 0x66, 0x6f, 0x1a, 0x37, 0x0a, 0x09, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc5, 0x06, 0x0a,
+	0x0c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x27, 0x0a, 0x03, 0x61, 0x6e, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6a,
+	0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x79, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x48, 0x00, 0x52, 0x03, 0x61, 0x6e, 0x79, 0x12, 0x30, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x48, 0x00, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x65, 0x6e,
+	0x75, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x48, 0x00,
+	0x52, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x12, 0x2d, 0x0a, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x05,
+	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x12, 0x39, 0x0a, 0x09, 0x70, 0x6f, 0x6c, 0x79, 0x6d, 0x6f, 0x72,
+	0x70, 0x68, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x79, 0x6d, 0x6f, 0x72, 0x70, 0x68, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x09, 0x70, 0x6f, 0x6c, 0x79, 0x6d, 0x6f, 0x72, 0x70, 0x68,
+	0x12, 0x2e, 0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x45, 0x78, 0x74, 0x48, 0x00, 0x52, 0x03, 0x6d, 0x61, 0x70,
+	0x12, 0x34, 0x0a, 0x05, 0x61, 0x72, 0x72, 0x61, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x72, 0x72, 0x61, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x45, 0x78, 0x74, 0x48, 0x00, 0x52,
+	0x05, 0x61, 0x72, 0x72, 0x61, 0x79, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x48, 0x00,
+	0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x33, 0x0a, 0x07, 0x69, 0x6e, 0x74, 0x65,
+	0x67, 0x65, 0x72, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x35, 0x2e, 0x65,
+	0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x48, 0x00, 0x52, 0x07, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x12, 0x2d, 0x0a,
+	0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6a,
+	0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x2a, 0x0a, 0x04,
+	0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6a, 0x35, 0x2e,
+	0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x48, 0x00, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x12, 0x2d, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x22, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x48, 0x00,
+	0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x64, 0x65, 0x63, 0x69, 0x6d,
+	0x61, 0x6c, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x2a, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x24, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6a, 0x35, 0x2e,
+	0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x48, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x25, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6a, 0x35,
+	0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x27, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x26, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x42, 0x06, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x0a, 0x0a, 0x08, 0x41, 0x6e, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x22, 0x27, 0x0a, 0x0b, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x66, 0x6c, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x66, 0x6c, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x22, 0x0b, 0x0a, 0x09, 0x45, 0x6e, 0x75,
+	0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x0c, 0x0a, 0x0a, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x22, 0x10, 0x0a, 0x0e, 0x50, 0x6f, 0x6c, 0x79, 0x6d, 0x6f, 0x72, 0x70,
+	0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x40, 0x0a, 0x08, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x69, 0x6e, 0x67, 0x6c,
+	0x65, 0x46, 0x6f, 0x72, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x69, 0x6e,
+	0x67, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x22, 0x42, 0x0a, 0x0a, 0x41, 0x72, 0x72, 0x61,
+	0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65,
+	0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x73,
+	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x22, 0x0d, 0x0a, 0x0b,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0xb3, 0x02, 0x0a, 0x0c,
+	0x49, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x33, 0x0a, 0x05,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6a, 0x35,
+	0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x1a, 0xed, 0x01, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x07, 0x6d,
+	0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x07,
+	0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x61,
+	0x78, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x07, 0x6d,
+	0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76,
+	0x65, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x65,
+	0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73,
+	0x69, 0x76, 0x65, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a,
+	0x08, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x61,
+	0x78, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73,
+	0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x14, 0x0a, 0x12, 0x5f,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75,
+	0x6d, 0x22, 0x0c, 0x0a, 0x0a, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22,
+	0x0b, 0x0a, 0x09, 0x42, 0x6f, 0x6f, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x0c, 0x0a, 0x0a,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0xb3, 0x02, 0x0a, 0x0c, 0x44,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x33, 0x0a, 0x05, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6a, 0x35, 0x2e,
+	0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x1a, 0xed, 0x01, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x69,
+	0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6d,
+	0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x61, 0x78,
+	0x69, 0x6d, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x6d, 0x61,
+	0x78, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65,
+	0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69,
+	0x76, 0x65, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78,
+	0x69, 0x6d, 0x75, 0x6d, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69,
+	0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x65,
+	0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d,
+	0x22, 0xad, 0x02, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x30,
+	0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
 	0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x25, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x27, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x26, 0x20,
+	0x65, 0x6c, 0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x1a, 0xed, 0x01, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x69,
+	0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6d,
+	0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x61, 0x78,
+	0x69, 0x6d, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x6d, 0x61,
+	0x78, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65,
+	0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69,
+	0x76, 0x65, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78,
+	0x69, 0x6d, 0x75, 0x6d, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69,
+	0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x65,
+	0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d,
+	0x22, 0x10, 0x0a, 0x0e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x22, 0x86, 0x03, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x34, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x48, 0x00, 0x52, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72,
+	0x6e, 0x12, 0x31, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x66, 0x52, 0x07, 0x66, 0x6f, 0x72,
+	0x65, 0x69, 0x67, 0x6e, 0x12, 0x41, 0x0a, 0x0b, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x49,
+	0x6e, 0x66, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6a, 0x35, 0x2e, 0x65,
+	0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x50,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x70, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x66, 0x0a, 0x0b, 0x50, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x42, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x4f, 0x52,
+	0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x55, 0x49, 0x44,
+	0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x49, 0x44, 0x36,
+	0x32, 0x10, 0x03, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x53, 0x0a, 0x07, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0xdd, 0xf0, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6a,
+	0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65,
+	0x3a, 0x50, 0x0a, 0x09, 0x6a, 0x35, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xde, 0xf0, 0x21, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x4b, 0x65, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x48, 0x00, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x42,
-	0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x0a, 0x0a, 0x08, 0x41, 0x6e, 0x79, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x22, 0x27, 0x0a, 0x0b, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x6c, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x66, 0x6c, 0x61, 0x74, 0x74, 0x65, 0x6e, 0x22, 0x0b, 0x0a, 0x09,
-	0x45, 0x6e, 0x75, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x0c, 0x0a, 0x0a, 0x4f, 0x6e, 0x65,
-	0x6f, 0x66, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x10, 0x0a, 0x0e, 0x50, 0x6f, 0x6c, 0x79, 0x6d,
-	0x6f, 0x72, 0x70, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x40, 0x0a, 0x08, 0x4d, 0x61, 0x70,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f,
-	0x66, 0x6f, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x69,
-	0x6e, 0x67, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
-	0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x22, 0x42, 0x0a, 0x0a, 0x41,
-	0x72, 0x72, 0x61, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x69, 0x6e,
-	0x67, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x0a, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x88, 0x01, 0x01, 0x42,
-	0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x22,
-	0x0d, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0xb3,
-	0x02, 0x0a, 0x0c, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
-	0x33, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67,
-	0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72,
-	0x75, 0x6c, 0x65, 0x73, 0x1a, 0xed, 0x01, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1d,
-	0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x48,
-	0x00, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a,
-	0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01,
-	0x52, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11,
-	0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75,
-	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75,
-	0x73, 0x69, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x30,
-	0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x69,
-	0x6d, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x10, 0x65, 0x78, 0x63,
-	0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01,
-	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x0a, 0x0a, 0x08,
-	0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x65, 0x78, 0x63,
-	0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x14,
-	0x0a, 0x12, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x61, 0x78,
-	0x69, 0x6d, 0x75, 0x6d, 0x22, 0x0c, 0x0a, 0x0a, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x22, 0x0b, 0x0a, 0x09, 0x42, 0x6f, 0x6f, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22,
-	0x0c, 0x0a, 0x0a, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0xb3, 0x02,
-	0x0a, 0x0c, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x33,
-	0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61,
-	0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72, 0x75,
-	0x6c, 0x65, 0x73, 0x1a, 0xed, 0x01, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a,
-	0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07,
-	0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
-	0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x65,
-	0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73,
-	0x69, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a,
-	0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d,
-	0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c,
-	0x75, 0x73, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x42,
-	0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
-	0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x65, 0x78, 0x63, 0x6c,
-	0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x14, 0x0a,
-	0x12, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x69,
-	0x6d, 0x75, 0x6d, 0x22, 0xad, 0x02, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x12, 0x30, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74,
-	0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72, 0x75,
-	0x6c, 0x65, 0x73, 0x1a, 0xed, 0x01, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a,
-	0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07,
-	0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
-	0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x65,
-	0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73,
-	0x69, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a,
-	0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d,
-	0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c,
-	0x75, 0x73, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x42,
-	0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
-	0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x65, 0x78, 0x63, 0x6c,
-	0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x14, 0x0a,
-	0x12, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x69,
-	0x6d, 0x75, 0x6d, 0x22, 0x10, 0x0a, 0x0e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x86, 0x03, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4b,
-	0x65, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x48, 0x00,
-	0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74,
-	0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x70, 0x61, 0x74,
-	0x74, 0x65, 0x72, 0x6e, 0x12, 0x31, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x66, 0x52, 0x07,
-	0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x12, 0x41, 0x0a, 0x0b, 0x70, 0x61, 0x74, 0x74, 0x65,
-	0x72, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6a,
-	0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x2e, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x70,
-	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x66, 0x0a, 0x0b, 0x50, 0x61,
-	0x74, 0x74, 0x65, 0x72, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x42, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x12,
-	0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55,
-	0x55, 0x49, 0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
-	0x49, 0x44, 0x36, 0x32, 0x10, 0x03, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x53,
-	0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xdd, 0xf0, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x3a, 0x50, 0x0a, 0x09, 0x6a, 0x35, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xde,
-	0xf0, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x4a, 0x35, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x6a, 0x35, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x3a, 0x4a, 0x0a, 0x03, 0x70, 0x73, 0x6d, 0x12, 0x1f, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xdd, 0xf0,
-	0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76,
-	0x31, 0x2e, 0x50, 0x53, 0x4d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x03, 0x70, 0x73,
-	0x6d, 0x3a, 0x4a, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xdd, 0xf0, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x3a, 0x56, 0x0a,
-	0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xdd, 0xf0, 0x21, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x3a, 0x56, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0xf8, 0xef, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6a, 0x35, 0x2e, 0x65,
-	0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x3a, 0x52, 0x0a,
-	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf8, 0xef, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x68,
-	0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x3a, 0x4a, 0x0a, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf8, 0xef, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x3a, 0x5f, 0x0a,
-	0x0a, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e,
-	0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf8,
-	0xef, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e,
-	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x09, 0x65, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x4e,
-	0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf8, 0xef, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x42, 0xad,
-	0x01, 0xea, 0x85, 0x8f, 0x02, 0x61, 0x0a, 0x5f, 0x0a, 0x0a, 0x6b, 0x65, 0x62, 0x61, 0x62, 0x2d,
-	0x63, 0x61, 0x73, 0x65, 0x12, 0x18, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x2b,
-	0x28, 0x2d, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x29, 0x2a, 0x24, 0x1a, 0x37,
-	0x4c, 0x6f, 0x77, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x20, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x20,
-	0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x68, 0x79, 0x70,
-	0x68, 0x65, 0x6e, 0x73, 0x2c, 0x20, 0x65, 0x2e, 0x67, 0x2e, 0x20, 0x27, 0x6d, 0x79, 0x2d, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x27, 0x2e, 0xf2, 0x85, 0x8f, 0x02, 0x14, 0x0a, 0x12, 0x6c, 0x6f,
-	0x63, 0x61, 0x6c, 0x2f, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x72, 0x65,
-	0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x65, 0x6e,
-	0x74, 0x6f, 0x70, 0x73, 0x2f, 0x6a, 0x35, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x6a, 0x35, 0x2f, 0x65,
-	0x78, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x74, 0x5f, 0x6a, 0x35, 0x70, 0x62, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x4a, 0x35, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x08, 0x6a, 0x35, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x3a, 0x4a, 0x0a, 0x03, 0x70, 0x73, 0x6d, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xdd, 0xf0, 0x21, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x53, 0x4d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x03, 0x70, 0x73, 0x6d, 0x3a, 0x4a,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0xdd, 0xf0, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a,
+	0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x4b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x3a, 0x56, 0x0a, 0x07, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xdd, 0xf0, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x3a, 0x56, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf8,
+	0xef, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x3a, 0x52, 0x0a, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf8, 0xef, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6a,
+	0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x3a, 0x4a,
+	0x0a, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf8, 0xef, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6a,
+	0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x3a, 0x5f, 0x0a, 0x0a, 0x65, 0x6e,
+	0x75, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf8, 0xef, 0x21, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6a, 0x35, 0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x09, 0x65, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x4e, 0x0a, 0x05, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0xf8, 0xef, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x35,
+	0x2e, 0x65, 0x78, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x42, 0xad, 0x01, 0xea, 0x85,
+	0x8f, 0x02, 0x61, 0x0a, 0x5f, 0x0a, 0x0a, 0x6b, 0x65, 0x62, 0x61, 0x62, 0x2d, 0x63, 0x61, 0x73,
+	0x65, 0x12, 0x18, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x28, 0x2d, 0x5b,
+	0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x29, 0x2a, 0x24, 0x1a, 0x37, 0x4c, 0x6f, 0x77,
+	0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x20, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x20, 0x73, 0x65, 0x70,
+	0x61, 0x72, 0x61, 0x74, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x68, 0x79, 0x70, 0x68, 0x65, 0x6e,
+	0x73, 0x2c, 0x20, 0x65, 0x2e, 0x67, 0x2e, 0x20, 0x27, 0x6d, 0x79, 0x2d, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x27, 0x2e, 0xf2, 0x85, 0x8f, 0x02, 0x14, 0x0a, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x2f, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x5a, 0x2c, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x65, 0x6e, 0x74, 0x6f, 0x70,
+	0x73, 0x2f, 0x6a, 0x35, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x6a, 0x35, 0x2f, 0x65, 0x78, 0x74, 0x2f,
+	0x76, 0x31, 0x2f, 0x65, 0x78, 0x74, 0x5f, 0x6a, 0x35, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2799,7 +2946,7 @@ func file_j5_ext_v1_annotations_proto_rawDescGZIP() []byte {
 }
 
 var file_j5_ext_v1_annotations_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_j5_ext_v1_annotations_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
+var file_j5_ext_v1_annotations_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
 var file_j5_ext_v1_annotations_proto_goTypes = []any{
 	(KeyField_Format)(0),                  // 0: j5.ext.v1.KeyField.Format
 	(*PackageOptions)(nil),                // 1: j5.ext.v1.PackageOptions
@@ -2812,108 +2959,115 @@ var file_j5_ext_v1_annotations_proto_goTypes = []any{
 	(*OneofMessageOptions)(nil),           // 8: j5.ext.v1.OneofMessageOptions
 	(*PolymorphMessageOptions)(nil),       // 9: j5.ext.v1.PolymorphMessageOptions
 	(*MethodOptions)(nil),                 // 10: j5.ext.v1.MethodOptions
-	(*StateQueryMethodOptions)(nil),       // 11: j5.ext.v1.StateQueryMethodOptions
-	(*EnumOptions)(nil),                   // 12: j5.ext.v1.EnumOptions
-	(*EnumInfoField)(nil),                 // 13: j5.ext.v1.EnumInfoField
-	(*EnumValueOptions)(nil),              // 14: j5.ext.v1.EnumValueOptions
-	(*FieldOptions)(nil),                  // 15: j5.ext.v1.FieldOptions
-	(*AnyField)(nil),                      // 16: j5.ext.v1.AnyField
-	(*ObjectField)(nil),                   // 17: j5.ext.v1.ObjectField
-	(*EnumField)(nil),                     // 18: j5.ext.v1.EnumField
-	(*OneofField)(nil),                    // 19: j5.ext.v1.OneofField
-	(*PolymorphField)(nil),                // 20: j5.ext.v1.PolymorphField
-	(*MapField)(nil),                      // 21: j5.ext.v1.MapField
-	(*ArrayField)(nil),                    // 22: j5.ext.v1.ArrayField
-	(*StringField)(nil),                   // 23: j5.ext.v1.StringField
-	(*IntegerField)(nil),                  // 24: j5.ext.v1.IntegerField
-	(*FloatField)(nil),                    // 25: j5.ext.v1.FloatField
-	(*BoolField)(nil),                     // 26: j5.ext.v1.BoolField
-	(*BytesField)(nil),                    // 27: j5.ext.v1.BytesField
-	(*DecimalField)(nil),                  // 28: j5.ext.v1.DecimalField
-	(*DateField)(nil),                     // 29: j5.ext.v1.DateField
-	(*TimestampField)(nil),                // 30: j5.ext.v1.TimestampField
-	(*KeyField)(nil),                      // 31: j5.ext.v1.KeyField
-	(*ServiceOptions_StateQuery)(nil),     // 32: j5.ext.v1.ServiceOptions.StateQuery
-	(*ServiceOptions_StateCommand)(nil),   // 33: j5.ext.v1.ServiceOptions.StateCommand
-	nil,                                   // 34: j5.ext.v1.EnumValueOptions.InfoEntry
-	(*IntegerField_Rules)(nil),            // 35: j5.ext.v1.IntegerField.Rules
-	(*DecimalField_Rules)(nil),            // 36: j5.ext.v1.DecimalField.Rules
-	(*DateField_Rules)(nil),               // 37: j5.ext.v1.DateField.Rules
-	(*KeyField_PatternInfo)(nil),          // 38: j5.ext.v1.KeyField.PatternInfo
-	(schema_j5pb.EntityPart)(0),           // 39: j5.schema.v1.EntityPart
-	(*auth_j5pb.MethodAuthType)(nil),      // 40: j5.auth.v1.MethodAuthType
-	(*schema_j5pb.MapField_Ext)(nil),      // 41: j5.schema.v1.MapField.Ext
-	(*schema_j5pb.ArrayField_Ext)(nil),    // 42: j5.schema.v1.ArrayField.Ext
-	(*schema_j5pb.EntityRef)(nil),         // 43: j5.schema.v1.EntityRef
-	(*descriptorpb.FileOptions)(nil),      // 44: google.protobuf.FileOptions
-	(*descriptorpb.MessageOptions)(nil),   // 45: google.protobuf.MessageOptions
-	(*descriptorpb.FieldOptions)(nil),     // 46: google.protobuf.FieldOptions
-	(*descriptorpb.ServiceOptions)(nil),   // 47: google.protobuf.ServiceOptions
-	(*descriptorpb.MethodOptions)(nil),    // 48: google.protobuf.MethodOptions
-	(*descriptorpb.EnumOptions)(nil),      // 49: google.protobuf.EnumOptions
-	(*descriptorpb.EnumValueOptions)(nil), // 50: google.protobuf.EnumValueOptions
-	(*schema_j5pb.EntityKey)(nil),         // 51: j5.schema.v1.EntityKey
+	(*CacheControl)(nil),                  // 11: j5.ext.v1.CacheControl
+	(*StateQueryMethodOptions)(nil),       // 12: j5.ext.v1.StateQueryMethodOptions
+	(*EnumOptions)(nil),                   // 13: j5.ext.v1.EnumOptions
+	(*EnumInfoField)(nil),                 // 14: j5.ext.v1.EnumInfoField
+	(*EnumValueOptions)(nil),              // 15: j5.ext.v1.EnumValueOptions
+	(*FieldOptions)(nil),                  // 16: j5.ext.v1.FieldOptions
+	(*AnyField)(nil),                      // 17: j5.ext.v1.AnyField
+	(*ObjectField)(nil),                   // 18: j5.ext.v1.ObjectField
+	(*EnumField)(nil),                     // 19: j5.ext.v1.EnumField
+	(*OneofField)(nil),                    // 20: j5.ext.v1.OneofField
+	(*PolymorphField)(nil),                // 21: j5.ext.v1.PolymorphField
+	(*MapField)(nil),                      // 22: j5.ext.v1.MapField
+	(*ArrayField)(nil),                    // 23: j5.ext.v1.ArrayField
+	(*StringField)(nil),                   // 24: j5.ext.v1.StringField
+	(*IntegerField)(nil),                  // 25: j5.ext.v1.IntegerField
+	(*FloatField)(nil),                    // 26: j5.ext.v1.FloatField
+	(*BoolField)(nil),                     // 27: j5.ext.v1.BoolField
+	(*BytesField)(nil),                    // 28: j5.ext.v1.BytesField
+	(*DecimalField)(nil),                  // 29: j5.ext.v1.DecimalField
+	(*DateField)(nil),                     // 30: j5.ext.v1.DateField
+	(*TimestampField)(nil),                // 31: j5.ext.v1.TimestampField
+	(*KeyField)(nil),                      // 32: j5.ext.v1.KeyField
+	(*ServiceOptions_StateQuery)(nil),     // 33: j5.ext.v1.ServiceOptions.StateQuery
+	(*ServiceOptions_StateCommand)(nil),   // 34: j5.ext.v1.ServiceOptions.StateCommand
+	nil,                                   // 35: j5.ext.v1.EnumValueOptions.InfoEntry
+	(*IntegerField_Rules)(nil),            // 36: j5.ext.v1.IntegerField.Rules
+	(*DecimalField_Rules)(nil),            // 37: j5.ext.v1.DecimalField.Rules
+	(*DateField_Rules)(nil),               // 38: j5.ext.v1.DateField.Rules
+	(*KeyField_PatternInfo)(nil),          // 39: j5.ext.v1.KeyField.PatternInfo
+	(schema_j5pb.EntityPart)(0),           // 40: j5.schema.v1.EntityPart
+	(*auth_j5pb.MethodAuthType)(nil),      // 41: j5.auth.v1.MethodAuthType
+	(*schema_j5pb.RateLimit)(nil),         // 42: j5.schema.v1.RateLimit
+	(*schema_j5pb.ObjectRule)(nil),        // 43: j5.schema.v1.ObjectRule
+	(*schema_j5pb.MapField_Ext)(nil),      // 44: j5.schema.v1.MapField.Ext
+	(*schema_j5pb.ArrayField_Ext)(nil),    // 45: j5.schema.v1.ArrayField.Ext
+	(*schema_j5pb.EntityRef)(nil),         // 46: j5.schema.v1.EntityRef
+	(*descriptorpb.FileOptions)(nil),      // 47: google.protobuf.FileOptions
+	(*descriptorpb.MessageOptions)(nil),   // 48: google.protobuf.MessageOptions
+	(*descriptorpb.FieldOptions)(nil),     // 49: google.protobuf.FieldOptions
+	(*descriptorpb.ServiceOptions)(nil),   // 50: google.protobuf.ServiceOptions
+	(*descriptorpb.MethodOptions)(nil),    // 51: google.protobuf.MethodOptions
+	(*descriptorpb.EnumOptions)(nil),      // 52: google.protobuf.EnumOptions
+	(*descriptorpb.EnumValueOptions)(nil), // 53: google.protobuf.EnumValueOptions
+	(*schema_j5pb.EntityKey)(nil),         // 54: j5.schema.v1.EntityKey
 }
 var file_j5_ext_v1_annotations_proto_depIdxs = []int32{
 	3,  // 0: j5.ext.v1.PackageOptions.string_formats:type_name -> j5.ext.v1.StringFormat
-	39, // 1: j5.ext.v1.PSMOptions.entity_part:type_name -> j5.schema.v1.EntityPart
-	32, // 2: j5.ext.v1.ServiceOptions.state_query:type_name -> j5.ext.v1.ServiceOptions.StateQuery
-	33, // 3: j5.ext.v1.ServiceOptions.state_command:type_name -> j5.ext.v1.ServiceOptions.StateCommand
-	40, // 4: j5.ext.v1.ServiceOptions.default_auth:type_name -> j5.auth.v1.MethodAuthType
-	7,  // 5: j5.ext.v1.MessageOptions.object:type_name -> j5.ext.v1.ObjectMessageOptions
-	8,  // 6: j5.ext.v1.MessageOptions.oneof:type_name -> j5.ext.v1.OneofMessageOptions
-	9,  // 7: j5.ext.v1.MessageOptions.polymorph:type_name -> j5.ext.v1.PolymorphMessageOptions
-	11, // 8: j5.ext.v1.MethodOptions.state_query:type_name -> j5.ext.v1.StateQueryMethodOptions
-	40, // 9: j5.ext.v1.MethodOptions.auth:type_name -> j5.auth.v1.MethodAuthType
-	13, // 10: j5.ext.v1.EnumOptions.info_fields:type_name -> j5.ext.v1.EnumInfoField
-	34, // 11: j5.ext.v1.EnumValueOptions.info:type_name -> j5.ext.v1.EnumValueOptions.InfoEntry
-	16, // 12: j5.ext.v1.FieldOptions.any:type_name -> j5.ext.v1.AnyField
-	17, // 13: j5.ext.v1.FieldOptions.object:type_name -> j5.ext.v1.ObjectField
-	18, // 14: j5.ext.v1.FieldOptions.enum:type_name -> j5.ext.v1.EnumField
-	19, // 15: j5.ext.v1.FieldOptions.oneof:type_name -> j5.ext.v1.OneofField
-	20, // 16: j5.ext.v1.FieldOptions.polymorph:type_name -> j5.ext.v1.PolymorphField
-	41, // 17: j5.ext.v1.FieldOptions.map:type_name -> j5.schema.v1.MapField.Ext
-	42, // 18: j5.ext.v1.FieldOptions.array:type_name -> j5.schema.v1.ArrayField.Ext
-	23, // 19: j5.ext.v1.FieldOptions.string:type_name -> j5.ext.v1.StringField
-	24, // 20: j5.ext.v1.FieldOptions.integer:type_name -> j5.ext.v1.IntegerField
-	25, // 21: j5.ext.v1.FieldOptions.float:type_name -> j5.ext.v1.FloatField
-	26, // 22: j5.ext.v1.FieldOptions.bool:type_name -> j5.ext.v1.BoolField
-	27, // 23: j5.ext.v1.FieldOptions.bytes:type_name -> j5.ext.v1.BytesField
-	28, // 24: j5.ext.v1.FieldOptions.decimal:type_name -> j5.ext.v1.DecimalField
-	29, // 25: j5.ext.v1.FieldOptions.date:type_name -> j5.ext.v1.DateField
-	30, // 26: j5.ext.v1.FieldOptions.timestamp:type_name -> j5.ext.v1.TimestampField
-	31, // 27: j5.ext.v1.FieldOptions.key:type_name -> j5.ext.v1.KeyField
-	35, // 28: j5.ext.v1.IntegerField.rules:type_name -> j5.ext.v1.IntegerField.Rules
-	36, // 29: j5.ext.v1.DecimalField.rules:type_name -> j5.ext.v1.DecimalField.Rules
-	37, // 30: j5.ext.v1.DateField.rules:type_name -> j5.ext.v1.DateField.Rules
-	0,  // 31: j5.ext.v1.KeyField.format:type_name -> j5.ext.v1.KeyField.Format
-	43, // 32: j5.ext.v1.KeyField.foreign:type_name -> j5.schema.v1.EntityRef
-	38, // 33: j5.ext.v1.KeyField.patternInfo:type_name -> j5.ext.v1.KeyField.PatternInfo
-	44, // 34: j5.ext.v1.package:extendee -> google.protobuf.FileOptions
-	44, // 35: j5.ext.v1.j5_source:extendee -> google.protobuf.FileOptions
-	45, // 36: j5.ext.v1.psm:extendee -> google.protobuf.MessageOptions
-	46, // 37: j5.ext.v1.key:extendee -> google.protobuf.FieldOptions
-	47, // 38: j5.ext.v1.service:extendee -> google.protobuf.ServiceOptions
-	45, // 39: j5.ext.v1.message:extendee -> google.protobuf.MessageOptions
-	48, // 40: j5.ext.v1.method:extendee -> google.protobuf.MethodOptions
-	49, // 41: j5.ext.v1.enum:extendee -> google.protobuf.EnumOptions
-	50, // 42: j5.ext.v1.enum_value:extendee -> google.protobuf.EnumValueOptions
-	46, // 43: j5.ext.v1.field:extendee -> google.protobuf.FieldOptions
-	1,  // 44: j5.ext.v1.package:type_name -> j5.ext.v1.PackageOptions
-	2,  // 45: j5.ext.v1.j5_source:type_name -> j5.ext.v1.J5Source
-	4,  // 46: j5.ext.v1.psm:type_name -> j5.ext.v1.PSMOptions
-	51, // 47: j5.ext.v1.key:type_name -> j5.schema.v1.EntityKey
-	5,  // 48: j5.ext.v1.service:type_name -> j5.ext.v1.ServiceOptions
-	6,  // 49: j5.ext.v1.message:type_name -> j5.ext.v1.MessageOptions
-	10, // 50: j5.ext.v1.method:type_name -> j5.ext.v1.MethodOptions
-	12, // 51: j5.ext.v1.enum:type_name -> j5.ext.v1.EnumOptions
-	14, // 52: j5.ext.v1.enum_value:type_name -> j5.ext.v1.EnumValueOptions
-	15, // 53: j5.ext.v1.field:type_name -> j5.ext.v1.FieldOptions
-	54, // [54:54] is the sub-list for method output_type
-	54, // [54:54] is the sub-list for method input_type
-	44, // [44:54] is the sub-list for extension type_name
-	34, // [34:44] is the sub-list for extension extendee
-	0,  // [0:34] is the sub-list for field type_name
+	40, // 1: j5.ext.v1.PSMOptions.entity_part:type_name -> j5.schema.v1.EntityPart
+	33, // 2: j5.ext.v1.ServiceOptions.state_query:type_name -> j5.ext.v1.ServiceOptions.StateQuery
+	34, // 3: j5.ext.v1.ServiceOptions.state_command:type_name -> j5.ext.v1.ServiceOptions.StateCommand
+	41, // 4: j5.ext.v1.ServiceOptions.default_auth:type_name -> j5.auth.v1.MethodAuthType
+	42, // 5: j5.ext.v1.ServiceOptions.default_rate_limit:type_name -> j5.schema.v1.RateLimit
+	7,  // 6: j5.ext.v1.MessageOptions.object:type_name -> j5.ext.v1.ObjectMessageOptions
+	8,  // 7: j5.ext.v1.MessageOptions.oneof:type_name -> j5.ext.v1.OneofMessageOptions
+	9,  // 8: j5.ext.v1.MessageOptions.polymorph:type_name -> j5.ext.v1.PolymorphMessageOptions
+	43, // 9: j5.ext.v1.ObjectMessageOptions.rules:type_name -> j5.schema.v1.ObjectRule
+	12, // 10: j5.ext.v1.MethodOptions.state_query:type_name -> j5.ext.v1.StateQueryMethodOptions
+	41, // 11: j5.ext.v1.MethodOptions.auth:type_name -> j5.auth.v1.MethodAuthType
+	11, // 12: j5.ext.v1.MethodOptions.cache_control:type_name -> j5.ext.v1.CacheControl
+	42, // 13: j5.ext.v1.MethodOptions.rate_limit:type_name -> j5.schema.v1.RateLimit
+	14, // 14: j5.ext.v1.EnumOptions.info_fields:type_name -> j5.ext.v1.EnumInfoField
+	35, // 15: j5.ext.v1.EnumValueOptions.info:type_name -> j5.ext.v1.EnumValueOptions.InfoEntry
+	17, // 16: j5.ext.v1.FieldOptions.any:type_name -> j5.ext.v1.AnyField
+	18, // 17: j5.ext.v1.FieldOptions.object:type_name -> j5.ext.v1.ObjectField
+	19, // 18: j5.ext.v1.FieldOptions.enum:type_name -> j5.ext.v1.EnumField
+	20, // 19: j5.ext.v1.FieldOptions.oneof:type_name -> j5.ext.v1.OneofField
+	21, // 20: j5.ext.v1.FieldOptions.polymorph:type_name -> j5.ext.v1.PolymorphField
+	44, // 21: j5.ext.v1.FieldOptions.map:type_name -> j5.schema.v1.MapField.Ext
+	45, // 22: j5.ext.v1.FieldOptions.array:type_name -> j5.schema.v1.ArrayField.Ext
+	24, // 23: j5.ext.v1.FieldOptions.string:type_name -> j5.ext.v1.StringField
+	25, // 24: j5.ext.v1.FieldOptions.integer:type_name -> j5.ext.v1.IntegerField
+	26, // 25: j5.ext.v1.FieldOptions.float:type_name -> j5.ext.v1.FloatField
+	27, // 26: j5.ext.v1.FieldOptions.bool:type_name -> j5.ext.v1.BoolField
+	28, // 27: j5.ext.v1.FieldOptions.bytes:type_name -> j5.ext.v1.BytesField
+	29, // 28: j5.ext.v1.FieldOptions.decimal:type_name -> j5.ext.v1.DecimalField
+	30, // 29: j5.ext.v1.FieldOptions.date:type_name -> j5.ext.v1.DateField
+	31, // 30: j5.ext.v1.FieldOptions.timestamp:type_name -> j5.ext.v1.TimestampField
+	32, // 31: j5.ext.v1.FieldOptions.key:type_name -> j5.ext.v1.KeyField
+	36, // 32: j5.ext.v1.IntegerField.rules:type_name -> j5.ext.v1.IntegerField.Rules
+	37, // 33: j5.ext.v1.DecimalField.rules:type_name -> j5.ext.v1.DecimalField.Rules
+	38, // 34: j5.ext.v1.DateField.rules:type_name -> j5.ext.v1.DateField.Rules
+	0,  // 35: j5.ext.v1.KeyField.format:type_name -> j5.ext.v1.KeyField.Format
+	46, // 36: j5.ext.v1.KeyField.foreign:type_name -> j5.schema.v1.EntityRef
+	39, // 37: j5.ext.v1.KeyField.patternInfo:type_name -> j5.ext.v1.KeyField.PatternInfo
+	47, // 38: j5.ext.v1.package:extendee -> google.protobuf.FileOptions
+	47, // 39: j5.ext.v1.j5_source:extendee -> google.protobuf.FileOptions
+	48, // 40: j5.ext.v1.psm:extendee -> google.protobuf.MessageOptions
+	49, // 41: j5.ext.v1.key:extendee -> google.protobuf.FieldOptions
+	50, // 42: j5.ext.v1.service:extendee -> google.protobuf.ServiceOptions
+	48, // 43: j5.ext.v1.message:extendee -> google.protobuf.MessageOptions
+	51, // 44: j5.ext.v1.method:extendee -> google.protobuf.MethodOptions
+	52, // 45: j5.ext.v1.enum:extendee -> google.protobuf.EnumOptions
+	53, // 46: j5.ext.v1.enum_value:extendee -> google.protobuf.EnumValueOptions
+	49, // 47: j5.ext.v1.field:extendee -> google.protobuf.FieldOptions
+	1,  // 48: j5.ext.v1.package:type_name -> j5.ext.v1.PackageOptions
+	2,  // 49: j5.ext.v1.j5_source:type_name -> j5.ext.v1.J5Source
+	4,  // 50: j5.ext.v1.psm:type_name -> j5.ext.v1.PSMOptions
+	54, // 51: j5.ext.v1.key:type_name -> j5.schema.v1.EntityKey
+	5,  // 52: j5.ext.v1.service:type_name -> j5.ext.v1.ServiceOptions
+	6,  // 53: j5.ext.v1.message:type_name -> j5.ext.v1.MessageOptions
+	10, // 54: j5.ext.v1.method:type_name -> j5.ext.v1.MethodOptions
+	13, // 55: j5.ext.v1.enum:type_name -> j5.ext.v1.EnumOptions
+	15, // 56: j5.ext.v1.enum_value:type_name -> j5.ext.v1.EnumValueOptions
+	16, // 57: j5.ext.v1.field:type_name -> j5.ext.v1.FieldOptions
+	58, // [58:58] is the sub-list for method output_type
+	58, // [58:58] is the sub-list for method input_type
+	48, // [48:58] is the sub-list for extension type_name
+	38, // [38:48] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_j5_ext_v1_annotations_proto_init() }
@@ -3043,7 +3197,7 @@ func file_j5_ext_v1_annotations_proto_init() {
 			}
 		}
 		file_j5_ext_v1_annotations_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*StateQueryMethodOptions); i {
+			switch v := v.(*CacheControl); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3055,7 +3209,7 @@ func file_j5_ext_v1_annotations_proto_init() {
 			}
 		}
 		file_j5_ext_v1_annotations_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*EnumOptions); i {
+			switch v := v.(*StateQueryMethodOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3067,7 +3221,7 @@ func file_j5_ext_v1_annotations_proto_init() {
 			}
 		}
 		file_j5_ext_v1_annotations_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*EnumInfoField); i {
+			switch v := v.(*EnumOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3079,7 +3233,7 @@ func file_j5_ext_v1_annotations_proto_init() {
 			}
 		}
 		file_j5_ext_v1_annotations_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*EnumValueOptions); i {
+			switch v := v.(*EnumInfoField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3091,7 +3245,7 @@ func file_j5_ext_v1_annotations_proto_init() {
 			}
 		}
 		file_j5_ext_v1_annotations_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*FieldOptions); i {
+			switch v := v.(*EnumValueOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3103,7 +3257,7 @@ func file_j5_ext_v1_annotations_proto_init() {
 			}
 		}
 		file_j5_ext_v1_annotations_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*AnyField); i {
+			switch v := v.(*FieldOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3115,7 +3269,7 @@ func file_j5_ext_v1_annotations_proto_init() {
 			}
 		}
 		file_j5_ext_v1_annotations_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*ObjectField); i {
+			switch v := v.(*AnyField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3127,7 +3281,7 @@ func file_j5_ext_v1_annotations_proto_init() {
 			}
 		}
 		file_j5_ext_v1_annotations_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*EnumField); i {
+			switch v := v.(*ObjectField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3139,7 +3293,7 @@ func file_j5_ext_v1_annotations_proto_init() {
 			}
 		}
 		file_j5_ext_v1_annotations_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*OneofField); i {
+			switch v := v.(*EnumField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3151,7 +3305,7 @@ func file_j5_ext_v1_annotations_proto_init() {
 			}
 		}
 		file_j5_ext_v1_annotations_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*PolymorphField); i {
+			switch v := v.(*OneofField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3163,7 +3317,7 @@ func file_j5_ext_v1_annotations_proto_init() {
 			}
 		}
 		file_j5_ext_v1_annotations_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*MapField); i {
+			switch v := v.(*PolymorphField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3175,7 +3329,7 @@ func file_j5_ext_v1_annotations_proto_init() {
 			}
 		}
 		file_j5_ext_v1_annotations_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*ArrayField); i {
+			switch v := v.(*MapField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3187,7 +3341,7 @@ func file_j5_ext_v1_annotations_proto_init() {
 			}
 		}
 		file_j5_ext_v1_annotations_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*StringField); i {
+			switch v := v.(*ArrayField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3199,7 +3353,7 @@ func file_j5_ext_v1_annotations_proto_init() {
 			}
 		}
 		file_j5_ext_v1_annotations_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*IntegerField); i {
+			switch v := v.(*StringField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3211,7 +3365,7 @@ func file_j5_ext_v1_annotations_proto_init() {
 			}
 		}
 		file_j5_ext_v1_annotations_proto_msgTypes[24].Exporter = func(v any, i int) any {
-			switch v := v.(*FloatField); i {
+			switch v := v.(*IntegerField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3223,7 +3377,7 @@ func file_j5_ext_v1_annotations_proto_init() {
 			}
 		}
 		file_j5_ext_v1_annotations_proto_msgTypes[25].Exporter = func(v any, i int) any {
-			switch v := v.(*BoolField); i {
+			switch v := v.(*FloatField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3235,7 +3389,7 @@ func file_j5_ext_v1_annotations_proto_init() {
 			}
 		}
 		file_j5_ext_v1_annotations_proto_msgTypes[26].Exporter = func(v any, i int) any {
-			switch v := v.(*BytesField); i {
+			switch v := v.(*BoolField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3247,7 +3401,7 @@ func file_j5_ext_v1_annotations_proto_init() {
 			}
 		}
 		file_j5_ext_v1_annotations_proto_msgTypes[27].Exporter = func(v any, i int) any {
-			switch v := v.(*DecimalField); i {
+			switch v := v.(*BytesField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3259,7 +3413,7 @@ func file_j5_ext_v1_annotations_proto_init() {
 			}
 		}
 		file_j5_ext_v1_annotations_proto_msgTypes[28].Exporter = func(v any, i int) any {
-			switch v := v.(*DateField); i {
+			switch v := v.(*DecimalField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3271,7 +3425,7 @@ func file_j5_ext_v1_annotations_proto_init() {
 			}
 		}
 		file_j5_ext_v1_annotations_proto_msgTypes[29].Exporter = func(v any, i int) any {
-			switch v := v.(*TimestampField); i {
+			switch v := v.(*DateField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3283,7 +3437,7 @@ func file_j5_ext_v1_annotations_proto_init() {
 			}
 		}
 		file_j5_ext_v1_annotations_proto_msgTypes[30].Exporter = func(v any, i int) any {
-			switch v := v.(*KeyField); i {
+			switch v := v.(*TimestampField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3295,7 +3449,7 @@ func file_j5_ext_v1_annotations_proto_init() {
 			}
 		}
 		file_j5_ext_v1_annotations_proto_msgTypes[31].Exporter = func(v any, i int) any {
-			switch v := v.(*ServiceOptions_StateQuery); i {
+			switch v := v.(*KeyField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3307,6 +3461,18 @@ func file_j5_ext_v1_annotations_proto_init() {
 			}
 		}
 		file_j5_ext_v1_annotations_proto_msgTypes[32].Exporter = func(v any, i int) any {
+			switch v := v.(*ServiceOptions_StateQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_j5_ext_v1_annotations_proto_msgTypes[33].Exporter = func(v any, i int) any {
 			switch v := v.(*ServiceOptions_StateCommand); i {
 			case 0:
 				return &v.state
@@ -3318,7 +3484,7 @@ func file_j5_ext_v1_annotations_proto_init() {
 				return nil
 			}
 		}
-		file_j5_ext_v1_annotations_proto_msgTypes[34].Exporter = func(v any, i int) any {
+		file_j5_ext_v1_annotations_proto_msgTypes[35].Exporter = func(v any, i int) any {
 			switch v := v.(*IntegerField_Rules); i {
 			case 0:
 				return &v.state
@@ -3330,7 +3496,7 @@ func file_j5_ext_v1_annotations_proto_init() {
 				return nil
 			}
 		}
-		file_j5_ext_v1_annotations_proto_msgTypes[35].Exporter = func(v any, i int) any {
+		file_j5_ext_v1_annotations_proto_msgTypes[36].Exporter = func(v any, i int) any {
 			switch v := v.(*DecimalField_Rules); i {
 			case 0:
 				return &v.state
@@ -3342,7 +3508,7 @@ func file_j5_ext_v1_annotations_proto_init() {
 				return nil
 			}
 		}
-		file_j5_ext_v1_annotations_proto_msgTypes[36].Exporter = func(v any, i int) any {
+		file_j5_ext_v1_annotations_proto_msgTypes[37].Exporter = func(v any, i int) any {
 			switch v := v.(*DateField_Rules); i {
 			case 0:
 				return &v.state
@@ -3354,7 +3520,7 @@ func file_j5_ext_v1_annotations_proto_init() {
 				return nil
 			}
 		}
-		file_j5_ext_v1_annotations_proto_msgTypes[37].Exporter = func(v any, i int) any {
+		file_j5_ext_v1_annotations_proto_msgTypes[38].Exporter = func(v any, i int) any {
 			switch v := v.(*KeyField_PatternInfo); i {
 			case 0:
 				return &v.state
@@ -3377,7 +3543,8 @@ func file_j5_ext_v1_annotations_proto_init() {
 		(*MessageOptions_Oneof)(nil),
 		(*MessageOptions_Polymorph)(nil),
 	}
-	file_j5_ext_v1_annotations_proto_msgTypes[14].OneofWrappers = []any{
+	file_j5_ext_v1_annotations_proto_msgTypes[10].OneofWrappers = []any{}
+	file_j5_ext_v1_annotations_proto_msgTypes[15].OneofWrappers = []any{
 		(*FieldOptions_Any)(nil),
 		(*FieldOptions_Object)(nil),
 		(*FieldOptions_Enum)(nil),
@@ -3395,23 +3562,23 @@ func file_j5_ext_v1_annotations_proto_init() {
 		(*FieldOptions_Timestamp)(nil),
 		(*FieldOptions_Key)(nil),
 	}
-	file_j5_ext_v1_annotations_proto_msgTypes[20].OneofWrappers = []any{}
 	file_j5_ext_v1_annotations_proto_msgTypes[21].OneofWrappers = []any{}
-	file_j5_ext_v1_annotations_proto_msgTypes[30].OneofWrappers = []any{
+	file_j5_ext_v1_annotations_proto_msgTypes[22].OneofWrappers = []any{}
+	file_j5_ext_v1_annotations_proto_msgTypes[31].OneofWrappers = []any{
 		(*KeyField_Format_)(nil),
 		(*KeyField_Pattern)(nil),
 	}
-	file_j5_ext_v1_annotations_proto_msgTypes[34].OneofWrappers = []any{}
 	file_j5_ext_v1_annotations_proto_msgTypes[35].OneofWrappers = []any{}
 	file_j5_ext_v1_annotations_proto_msgTypes[36].OneofWrappers = []any{}
 	file_j5_ext_v1_annotations_proto_msgTypes[37].OneofWrappers = []any{}
+	file_j5_ext_v1_annotations_proto_msgTypes[38].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_j5_ext_v1_annotations_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   38,
+			NumMessages:   39,
 			NumExtensions: 10,
 			NumServices:   0,
 		},
@@ -51,6 +51,9 @@ func (msg *PolymorphMessageOptions) Clone() any {
 func (msg *MethodOptions) Clone() any {
 	return proto.Clone(msg).(*MethodOptions)
 }
+func (msg *CacheControl) Clone() any {
+	return proto.Clone(msg).(*CacheControl)
+}
 func (msg *StateQueryMethodOptions) Clone() any {
 	return proto.Clone(msg).(*StateQueryMethodOptions)
 }
@@ -356,11 +356,12 @@ type Service struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Methods     []*Method                 `protobuf:"bytes,3,rep,name=methods,proto3" json:"methods,omitempty"`
-	Type        *ServiceType              `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
-	DefaultAuth *auth_j5pb.MethodAuthType `protobuf:"bytes,5,opt,name=default_auth,json=defaultAuth,proto3" json:"default_auth,omitempty"`
-	Audience    []string                  `protobuf:"bytes,6,rep,name=audience,proto3" json:"audience,omitempty"`
+	Name             string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Methods          []*Method                 `protobuf:"bytes,3,rep,name=methods,proto3" json:"methods,omitempty"`
+	Type             *ServiceType              `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	DefaultAuth      *auth_j5pb.MethodAuthType `protobuf:"bytes,5,opt,name=default_auth,json=defaultAuth,proto3" json:"default_auth,omitempty"`
+	Audience         []string                  `protobuf:"bytes,6,rep,name=audience,proto3" json:"audience,omitempty"`
+	DefaultRateLimit *RateLimit                `protobuf:"bytes,7,opt,name=default_rate_limit,json=defaultRateLimit,proto3" json:"default_rate_limit,omitempty"`
 }
 
 func (x *Service) Reset() {
@@ -430,6 +431,13 @@ func (x *Service) GetAudience() []string {
 	return nil
 }
 
+func (x *Service) GetDefaultRateLimit() *RateLimit {
+	if x != nil {
+		return x.DefaultRateLimit
+	}
+	return nil
+}
+
 type ServiceType struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -524,6 +532,7 @@ type Method struct {
 	ResponseSchema string                    `protobuf:"bytes,7,opt,name=response_schema,json=responseSchema,proto3" json:"response_schema,omitempty"`
 	Auth           *auth_j5pb.MethodAuthType `protobuf:"bytes,8,opt,name=auth,proto3" json:"auth,omitempty"`
 	MethodType     *MethodType               `protobuf:"bytes,9,opt,name=method_type,json=methodType,proto3" json:"method_type,omitempty"`
+	RateLimit      *RateLimit                `protobuf:"bytes,10,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
 }
 
 func (x *Method) Reset() {
@@ -614,6 +623,82 @@ func (x *Method) GetMethodType() *MethodType {
 	return nil
 }
 
+func (x *Method) GetRateLimit() *RateLimit {
+	if x != nil {
+		return x.RateLimit
+	}
+	return nil
+}
+
+// RateLimit limits the rate of calls to a method, counted per caller.
+type RateLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Sustained rate of requests allowed per caller. Zero is unlimited.
+	RequestsPerSecond float64 `protobuf:"fixed64,1,opt,name=requests_per_second,json=requestsPerSecond,proto3" json:"requests_per_second,omitempty"`
+	// Number of requests a caller may make at once before the rate applies.
+	// Defaults to the ceiling of requests_per_second.
+	Burst int32 `protobuf:"varint,2,opt,name=burst,proto3" json:"burst,omitempty"`
+	// Number of calls per caller which may be in progress at once. Zero is
+	// unlimited.
+	MaxConcurrent int32 `protobuf:"varint,3,opt,name=max_concurrent,json=maxConcurrent,proto3" json:"max_concurrent,omitempty"`
+}
+
+func (x *RateLimit) Reset() {
+	*x = RateLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_j5_schema_v1_package_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateLimit) ProtoMessage() {}
+
+func (x *RateLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_j5_schema_v1_package_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateLimit.ProtoReflect.Descriptor instead.
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return file_j5_schema_v1_package_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RateLimit) GetRequestsPerSecond() float64 {
+	if x != nil {
+		return x.RequestsPerSecond
+	}
+	return 0
+}
+
+func (x *RateLimit) GetBurst() int32 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+func (x *RateLimit) GetMaxConcurrent() int32 {
+	if x != nil {
+		return x.MaxConcurrent
+	}
+	return 0
+}
+
 type MethodType struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -628,7 +713,7 @@ type MethodType struct {
 func (x *MethodType) Reset() {
 	*x = MethodType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_package_proto_msgTypes[6]
+		mi := &file_j5_schema_v1_package_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -641,7 +726,7 @@ func (x *MethodType) String() string {
 func (*MethodType) ProtoMessage() {}
 
 func (x *MethodType) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_package_proto_msgTypes[6]
+	mi := &file_j5_schema_v1_package_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -654,7 +739,7 @@ func (x *MethodType) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MethodType.ProtoReflect.Descriptor instead.
 func (*MethodType) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_package_proto_rawDescGZIP(), []int{6}
+	return file_j5_schema_v1_package_proto_rawDescGZIP(), []int{7}
 }
 
 func (m *MethodType) GetType() isMethodType_Type {
@@ -694,7 +779,7 @@ type Topic struct {
 func (x *Topic) Reset() {
 	*x = Topic{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_package_proto_msgTypes[7]
+		mi := &file_j5_schema_v1_package_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -707,7 +792,7 @@ func (x *Topic) String() string {
 func (*Topic) ProtoMessage() {}
 
 func (x *Topic) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_package_proto_msgTypes[7]
+	mi := &file_j5_schema_v1_package_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -720,7 +805,7 @@ func (x *Topic) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Topic.ProtoReflect.Descriptor instead.
 func (*Topic) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_package_proto_rawDescGZIP(), []int{7}
+	return file_j5_schema_v1_package_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Topic) GetName() string {
@@ -750,7 +835,7 @@ type TopicMessage struct {
 func (x *TopicMessage) Reset() {
 	*x = TopicMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_package_proto_msgTypes[8]
+		mi := &file_j5_schema_v1_package_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -763,7 +848,7 @@ func (x *TopicMessage) String() string {
 func (*TopicMessage) ProtoMessage() {}
 
 func (x *TopicMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_package_proto_msgTypes[8]
+	mi := &file_j5_schema_v1_package_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -776,7 +861,7 @@ func (x *TopicMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TopicMessage.ProtoReflect.Descriptor instead.
 func (*TopicMessage) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_package_proto_rawDescGZIP(), []int{8}
+	return file_j5_schema_v1_package_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *TopicMessage) GetName() string {
@@ -811,7 +896,7 @@ type ServiceType_StateEntityQuery struct {
 func (x *ServiceType_StateEntityQuery) Reset() {
 	*x = ServiceType_StateEntityQuery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_package_proto_msgTypes[11]
+		mi := &file_j5_schema_v1_package_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -824,7 +909,7 @@ func (x *ServiceType_StateEntityQuery) String() string {
 func (*ServiceType_StateEntityQuery) ProtoMessage() {}
 
 func (x *ServiceType_StateEntityQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_package_proto_msgTypes[11]
+	mi := &file_j5_schema_v1_package_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -858,7 +943,7 @@ type ServiceType_StateEntityCommand struct {
 func (x *ServiceType_StateEntityCommand) Reset() {
 	*x = ServiceType_StateEntityCommand{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_package_proto_msgTypes[12]
+		mi := &file_j5_schema_v1_package_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -871,7 +956,7 @@ func (x *ServiceType_StateEntityCommand) String() string {
 func (*ServiceType_StateEntityCommand) ProtoMessage() {}
 
 func (x *ServiceType_StateEntityCommand) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_package_proto_msgTypes[12]
+	mi := &file_j5_schema_v1_package_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -906,7 +991,7 @@ type MethodType_StateQuery struct {
 func (x *MethodType_StateQuery) Reset() {
 	*x = MethodType_StateQuery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_package_proto_msgTypes[13]
+		mi := &file_j5_schema_v1_package_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -919,7 +1004,7 @@ func (x *MethodType_StateQuery) String() string {
 func (*MethodType_StateQuery) ProtoMessage() {}
 
 func (x *MethodType_StateQuery) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_package_proto_msgTypes[13]
+	mi := &file_j5_schema_v1_package_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -932,7 +1017,7 @@ func (x *MethodType_StateQuery) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MethodType_StateQuery.ProtoReflect.Descriptor instead.
 func (*MethodType_StateQuery) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_package_proto_rawDescGZIP(), []int{6, 0}
+	return file_j5_schema_v1_package_proto_rawDescGZIP(), []int{7, 0}
 }
 
 func (x *MethodType_StateQuery) GetEntityName() string {
@@ -1006,7 +1091,7 @@ var file_j5_schema_v1_package_proto_rawDesc = []byte{
 	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65,
 	0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f, 0x6f, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf3, 0x01, 0x0a, 0x07,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xba, 0x02, 0x0a, 0x07,
 	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xba, 0x48, 0x17, 0x72, 0x15, 0x32, 0x13, 0x5e, 0x5b,
 	0x41, 0x2d, 0x5a, 0x5d, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x2a,
@@ -1022,100 +1107,118 @@ var file_j5_schema_v1_package_proto_rawDesc = []byte{
 	0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
 	0x74, 0x41, 0x75, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63,
 	0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63,
-	0x65, 0x22, 0xad, 0x02, 0x0a, 0x0b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x5a, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
-	0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x00, 0x52, 0x10, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x60, 0x0a,
-	0x14, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x6f,
-	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6a, 0x35,
-	0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x12, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x1a,
-	0x2a, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x1a, 0x2c, 0x0a, 0x12, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
-	0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x22, 0xa9, 0x03, 0x0a, 0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x2e, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xba, 0x48, 0x17, 0x72,
-	0x15, 0x32, 0x13, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x5d, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a,
-	0x30, 0x2d, 0x39, 0x5d, 0x2a, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e,
-	0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x75, 0x6c, 0x6c, 0x47, 0x72, 0x70, 0x63, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x45, 0x0a, 0x0b, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x4d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x0a, 0x68,
-	0x74, 0x74, 0x70, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x68, 0x74, 0x74,
-	0x70, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xba, 0x48,
-	0x17, 0x72, 0x15, 0x32, 0x13, 0x5e, 0x28, 0x5c, 0x2f, 0x3a, 0x3f, 0x5b, 0x61, 0x2d, 0x7a, 0x30,
-	0x2d, 0x39, 0x5f, 0x5d, 0x2b, 0x29, 0x2b, 0x24, 0x52, 0x08, 0x68, 0x74, 0x74, 0x70, 0x50, 0x61,
-	0x74, 0x68, 0x12, 0x2d, 0x0a, 0x0e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8,
-	0x01, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x12, 0x2f, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x73, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8,
-	0x01, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x12, 0x2e, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x6a, 0x35, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65,
-	0x74, 0x68, 0x6f, 0x64, 0x41, 0x75, 0x74, 0x68, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x61, 0x75,
-	0x74, 0x68, 0x12, 0x39, 0x0a, 0x0b, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x5f, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x54, 0x79, 0x70,
-	0x65, 0x52, 0x0a, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x54, 0x79, 0x70, 0x65, 0x22, 0xc8, 0x01,
-	0x0a, 0x0a, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x46, 0x0a, 0x0b,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x23, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x1a, 0x6a, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4e,
-	0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x50, 0x61, 0x72, 0x74, 0x52, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74,
-	0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x53, 0x0a, 0x05, 0x54, 0x6f, 0x70, 0x69,
-	0x63, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x60, 0x0a,
-	0x0c, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a,
+	0x65, 0x12, 0x45, 0x0a, 0x12, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x10, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x52,
+	0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xad, 0x02, 0x0a, 0x0b, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x5a, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x5f, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x48, 0x00, 0x52, 0x10, 0x73, 0x74, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x12, 0x60, 0x0a, 0x14, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x48, 0x00, 0x52, 0x12, 0x73, 0x74, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x43,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x1a, 0x2a, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x1a, 0x2c, 0x0a, 0x12, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xe1, 0x03, 0x0a, 0x06, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x1a, 0xba, 0x48, 0x17, 0x72, 0x15, 0x32, 0x13, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x5d,
+	0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x2a, 0x24, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x67, 0x72, 0x70, 0x63,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x75, 0x6c,
+	0x6c, 0x47, 0x72, 0x70, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x0b, 0x68, 0x74, 0x74,
+	0x70, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18,
+	0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x54,
+	0x54, 0x50, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x82, 0x01, 0x04,
+	0x10, 0x01, 0x20, 0x00, 0x52, 0x0a, 0x68, 0x74, 0x74, 0x70, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x12, 0x37, 0x0a, 0x09, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1a, 0xba, 0x48, 0x17, 0x72, 0x15, 0x32, 0x13, 0x5e, 0x28, 0x5c, 0x2f,
+	0x3a, 0x3f, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5f, 0x5d, 0x2b, 0x29, 0x2b, 0x24, 0x52,
+	0x08, 0x68, 0x74, 0x74, 0x70, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2d, 0x0a, 0x0e, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x2f, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x2e, 0x0a, 0x04, 0x61, 0x75, 0x74,
+	0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6a, 0x35, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x41, 0x75, 0x74, 0x68, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x39, 0x0a, 0x0b, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x9a, 0x01, 0x0a,
+	0x09, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3e, 0x0a, 0x13, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0e, 0xba, 0x48, 0x0b, 0x12, 0x09, 0x29, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x11, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x05, 0x62, 0x75,
+	0x72, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02,
+	0x28, 0x00, 0x52, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x0e, 0x6d, 0x61, 0x78,
+	0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x43,
+	0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x22, 0xc8, 0x01, 0x0a, 0x0a, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x5f, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x1a, 0x6a, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1f,
+	0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x3b, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72,
+	0x74, 0x52, 0x09, 0x71, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74, 0x42, 0x06, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x22, 0x53, 0x0a, 0x05, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x12, 0x0a,
 	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x75, 0x6c, 0x6c, 0x47,
-	0x72, 0x70, 0x63, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2a,
-	0x98, 0x01, 0x0a, 0x0a, 0x48, 0x54, 0x54, 0x50, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1b,
-	0x0a, 0x17, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x48,
-	0x54, 0x54, 0x50, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x47, 0x45, 0x54, 0x10, 0x01,
-	0x12, 0x14, 0x0a, 0x10, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f,
-	0x50, 0x4f, 0x53, 0x54, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x4d,
-	0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x50, 0x55, 0x54, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x48,
-	0x54, 0x54, 0x50, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54,
-	0x45, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x4d, 0x45, 0x54, 0x48,
-	0x4f, 0x44, 0x5f, 0x50, 0x41, 0x54, 0x43, 0x48, 0x10, 0x05, 0x2a, 0x89, 0x01, 0x0a, 0x0e, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74, 0x12, 0x20, 0x0a,
-	0x1c, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x50, 0x41, 0x52,
-	0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
-	0x18, 0x0a, 0x14, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x50,
-	0x41, 0x52, 0x54, 0x5f, 0x47, 0x45, 0x54, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x41,
-	0x54, 0x45, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x4c, 0x49,
-	0x53, 0x54, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x51, 0x55,
-	0x45, 0x52, 0x59, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x53, 0x10, 0x03, 0x42, 0x4d, 0xf2, 0x85, 0x8f, 0x02, 0x14, 0x0a, 0x12, 0x6c,
-	0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x72,
-	0x65, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x65,
-	0x6e, 0x74, 0x6f, 0x70, 0x73, 0x2f, 0x6a, 0x35, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x6a, 0x35, 0x2f,
-	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x5f, 0x6a, 0x35, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x12, 0x36, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x60, 0x0a, 0x0c, 0x54, 0x6f, 0x70,
+	0x69, 0x63, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a,
+	0x0e, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x75, 0x6c, 0x6c, 0x47, 0x72, 0x70, 0x63, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2a, 0x98, 0x01, 0x0a, 0x0a,
+	0x48, 0x54, 0x54, 0x50, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1b, 0x0a, 0x17, 0x48, 0x54,
+	0x54, 0x50, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x48, 0x54, 0x54, 0x50, 0x5f,
+	0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x47, 0x45, 0x54, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10,
+	0x48, 0x54, 0x54, 0x50, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x50, 0x4f, 0x53, 0x54,
+	0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f,
+	0x44, 0x5f, 0x50, 0x55, 0x54, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x48, 0x54, 0x54, 0x50, 0x5f,
+	0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x10, 0x04, 0x12,
+	0x15, 0x0a, 0x11, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x50,
+	0x41, 0x54, 0x43, 0x48, 0x10, 0x05, 0x2a, 0x89, 0x01, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f,
+	0x47, 0x45, 0x54, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x51,
+	0x55, 0x45, 0x52, 0x59, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x10, 0x02,
+	0x12, 0x20, 0x0a, 0x1c, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x51, 0x55, 0x45, 0x52, 0x59, 0x5f,
+	0x50, 0x41, 0x52, 0x54, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x53,
+	0x10, 0x03, 0x42, 0x4d, 0xf2, 0x85, 0x8f, 0x02, 0x14, 0x0a, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x2f, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x5a, 0x32, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x65, 0x6e, 0x74, 0x6f, 0x70,
+	0x73, 0x2f, 0x6a, 0x35, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x6a, 0x35, 0x2f, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6a, 0x35, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1131,7 +1234,7 @@ func file_j5_schema_v1_package_proto_rawDescGZIP() []byte {
 }
 
 var file_j5_schema_v1_package_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_j5_schema_v1_package_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_j5_schema_v1_package_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_j5_schema_v1_package_proto_goTypes = []any{
 	(HTTPMethod)(0),                        // 0: j5.schema.v1.HTTPMethod
 	(StateQueryPart)(0),                    // 1: j5.schema.v1.StateQueryPart
@@ -1141,44 +1244,47 @@ var file_j5_schema_v1_package_proto_goTypes = []any{
 	(*Service)(nil),                        // 5: j5.schema.v1.Service
 	(*ServiceType)(nil),                    // 6: j5.schema.v1.ServiceType
 	(*Method)(nil),                         // 7: j5.schema.v1.Method
-	(*MethodType)(nil),                     // 8: j5.schema.v1.MethodType
-	(*Topic)(nil),                          // 9: j5.schema.v1.Topic
-	(*TopicMessage)(nil),                   // 10: j5.schema.v1.TopicMessage
-	nil,                                    // 11: j5.schema.v1.Package.SchemasEntry
-	nil,                                    // 12: j5.schema.v1.SubPackage.SchemasEntry
-	(*ServiceType_StateEntityQuery)(nil),   // 13: j5.schema.v1.ServiceType.StateEntityQuery
-	(*ServiceType_StateEntityCommand)(nil), // 14: j5.schema.v1.ServiceType.StateEntityCommand
-	(*MethodType_StateQuery)(nil),          // 15: j5.schema.v1.MethodType.StateQuery
-	(*StringFormat)(nil),                   // 16: j5.schema.v1.StringFormat
-	(*auth_j5pb.MethodAuthType)(nil),       // 17: j5.auth.v1.MethodAuthType
-	(*RootSchema)(nil),                     // 18: j5.schema.v1.RootSchema
+	(*RateLimit)(nil),                      // 8: j5.schema.v1.RateLimit
+	(*MethodType)(nil),                     // 9: j5.schema.v1.MethodType
+	(*Topic)(nil),                          // 10: j5.schema.v1.Topic
+	(*TopicMessage)(nil),                   // 11: j5.schema.v1.TopicMessage
+	nil,                                    // 12: j5.schema.v1.Package.SchemasEntry
+	nil,                                    // 13: j5.schema.v1.SubPackage.SchemasEntry
+	(*ServiceType_StateEntityQuery)(nil),   // 14: j5.schema.v1.ServiceType.StateEntityQuery
+	(*ServiceType_StateEntityCommand)(nil), // 15: j5.schema.v1.ServiceType.StateEntityCommand
+	(*MethodType_StateQuery)(nil),          // 16: j5.schema.v1.MethodType.StateQuery
+	(*StringFormat)(nil),                   // 17: j5.schema.v1.StringFormat
+	(*auth_j5pb.MethodAuthType)(nil),       // 18: j5.auth.v1.MethodAuthType
+	(*RootSchema)(nil),                     // 19: j5.schema.v1.RootSchema
 }
 var file_j5_schema_v1_package_proto_depIdxs = []int32{
 	3,  // 0: j5.schema.v1.API.packages:type_name -> j5.schema.v1.Package
 	4,  // 1: j5.schema.v1.Package.sub_packages:type_name -> j5.schema.v1.SubPackage
-	11, // 2: j5.schema.v1.Package.schemas:type_name -> j5.schema.v1.Package.SchemasEntry
-	16, // 3: j5.schema.v1.Package.string_formats:type_name -> j5.schema.v1.StringFormat
+	12, // 2: j5.schema.v1.Package.schemas:type_name -> j5.schema.v1.Package.SchemasEntry
+	17, // 3: j5.schema.v1.Package.string_formats:type_name -> j5.schema.v1.StringFormat
 	5,  // 4: j5.schema.v1.SubPackage.services:type_name -> j5.schema.v1.Service
-	9,  // 5: j5.schema.v1.SubPackage.topics:type_name -> j5.schema.v1.Topic
-	12, // 6: j5.schema.v1.SubPackage.schemas:type_name -> j5.schema.v1.SubPackage.SchemasEntry
+	10, // 5: j5.schema.v1.SubPackage.topics:type_name -> j5.schema.v1.Topic
+	13, // 6: j5.schema.v1.SubPackage.schemas:type_name -> j5.schema.v1.SubPackage.SchemasEntry
 	7,  // 7: j5.schema.v1.Service.methods:type_name -> j5.schema.v1.Method
 	6,  // 8: j5.schema.v1.Service.type:type_name -> j5.schema.v1.ServiceType
-	17, // 9: j5.schema.v1.Service.default_auth:type_name -> j5.auth.v1.MethodAuthType
-	13, // 10: j5.schema.v1.ServiceType.state_entity_query:type_name -> j5.schema.v1.ServiceType.StateEntityQuery
-	14, // 11: j5.schema.v1.ServiceType.state_entity_command:type_name -> j5.schema.v1.ServiceType.StateEntityCommand
-	0,  // 12: j5.schema.v1.Method.http_method:type_name -> j5.schema.v1.HTTPMethod
-	17, // 13: j5.schema.v1.Method.auth:type_name -> j5.auth.v1.MethodAuthType
-	8,  // 14: j5.schema.v1.Method.method_type:type_name -> j5.schema.v1.MethodType
-	15, // 15: j5.schema.v1.MethodType.state_query:type_name -> j5.schema.v1.MethodType.StateQuery
-	10, // 16: j5.schema.v1.Topic.messages:type_name -> j5.schema.v1.TopicMessage
-	18, // 17: j5.schema.v1.Package.SchemasEntry.value:type_name -> j5.schema.v1.RootSchema
-	18, // 18: j5.schema.v1.SubPackage.SchemasEntry.value:type_name -> j5.schema.v1.RootSchema
-	1,  // 19: j5.schema.v1.MethodType.StateQuery.query_part:type_name -> j5.schema.v1.StateQueryPart
-	20, // [20:20] is the sub-list for method output_type
-	20, // [20:20] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	18, // 9: j5.schema.v1.Service.default_auth:type_name -> j5.auth.v1.MethodAuthType
+	8,  // 10: j5.schema.v1.Service.default_rate_limit:type_name -> j5.schema.v1.RateLimit
+	14, // 11: j5.schema.v1.ServiceType.state_entity_query:type_name -> j5.schema.v1.ServiceType.StateEntityQuery
+	15, // 12: j5.schema.v1.ServiceType.state_entity_command:type_name -> j5.schema.v1.ServiceType.StateEntityCommand
+	0,  // 13: j5.schema.v1.Method.http_method:type_name -> j5.schema.v1.HTTPMethod
+	18, // 14: j5.schema.v1.Method.auth:type_name -> j5.auth.v1.MethodAuthType
+	9,  // 15: j5.schema.v1.Method.method_type:type_name -> j5.schema.v1.MethodType
+	8,  // 16: j5.schema.v1.Method.rate_limit:type_name -> j5.schema.v1.RateLimit
+	16, // 17: j5.schema.v1.MethodType.state_query:type_name -> j5.schema.v1.MethodType.StateQuery
+	11, // 18: j5.schema.v1.Topic.messages:type_name -> j5.schema.v1.TopicMessage
+	19, // 19: j5.schema.v1.Package.SchemasEntry.value:type_name -> j5.schema.v1.RootSchema
+	19, // 20: j5.schema.v1.SubPackage.SchemasEntry.value:type_name -> j5.schema.v1.RootSchema
+	1,  // 21: j5.schema.v1.MethodType.StateQuery.query_part:type_name -> j5.schema.v1.StateQueryPart
+	22, // [22:22] is the sub-list for method output_type
+	22, // [22:22] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_j5_schema_v1_package_proto_init() }
@@ -1261,7 +1367,7 @@ func file_j5_schema_v1_package_proto_init() {
 			}
 		}
 		file_j5_schema_v1_package_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*MethodType); i {
+			switch v := v.(*RateLimit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1273,7 +1379,7 @@ func file_j5_schema_v1_package_proto_init() {
 			}
 		}
 		file_j5_schema_v1_package_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*Topic); i {
+			switch v := v.(*MethodType); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1285,6 +1391,18 @@ func file_j5_schema_v1_package_proto_init() {
 			}
 		}
 		file_j5_schema_v1_package_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*Topic); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_j5_schema_v1_package_proto_msgTypes[9].Exporter = func(v any, i int) any {
 			switch v := v.(*TopicMessage); i {
 			case 0:
 				return &v.state
@@ -1296,7 +1414,7 @@ func file_j5_schema_v1_package_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_package_proto_msgTypes[11].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_package_proto_msgTypes[12].Exporter = func(v any, i int) any {
 			switch v := v.(*ServiceType_StateEntityQuery); i {
 			case 0:
 				return &v.state
@@ -1308,7 +1426,7 @@ func file_j5_schema_v1_package_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_package_proto_msgTypes[12].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_package_proto_msgTypes[13].Exporter = func(v any, i int) any {
 			switch v := v.(*ServiceType_StateEntityCommand); i {
 			case 0:
 				return &v.state
@@ -1320,7 +1438,7 @@ func file_j5_schema_v1_package_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_package_proto_msgTypes[13].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_package_proto_msgTypes[14].Exporter = func(v any, i int) any {
 			switch v := v.(*MethodType_StateQuery); i {
 			case 0:
 				return &v.state
@@ -1337,7 +1455,7 @@ func file_j5_schema_v1_package_proto_init() {
 		(*ServiceType_StateEntityQuery_)(nil),
 		(*ServiceType_StateEntityCommand_)(nil),
 	}
-	file_j5_schema_v1_package_proto_msgTypes[6].OneofWrappers = []any{
+	file_j5_schema_v1_package_proto_msgTypes[7].OneofWrappers = []any{
 		(*MethodType_StateQuery_)(nil),
 	}
 	type x struct{}
@@ -1346,7 +1464,7 @@ func file_j5_schema_v1_package_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_j5_schema_v1_package_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   14,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -85,6 +85,9 @@ func (msg *ServiceType_StateEntityCommand) Clone() any {
 func (msg *Method) Clone() any {
 	return proto.Clone(msg).(*Method)
 }
+func (msg *RateLimit) Clone() any {
+	return proto.Clone(msg).(*RateLimit)
+}
 
 // MethodType is a oneof wrapper
 type MethodTypeKey string
@@ -131,7 +131,7 @@ func (x FloatField_Format) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FloatField_Format.Descriptor instead.
 func (FloatField_Format) EnumDescriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{21, 0}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{22, 0}
 }
 
 type IntegerField_Format int32
@@ -186,7 +186,7 @@ func (x IntegerField_Format) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use IntegerField_Format.Descriptor instead.
 func (IntegerField_Format) EnumDescriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{22, 0}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{23, 0}
 }
 
 type RootSchema struct {
@@ -922,6 +922,8 @@ type Object struct {
 	// The names of any Polymorph types this object is a member of.
 	PolymorphMember []string        `protobuf:"bytes,5,rep,name=polymorph_member,json=polymorphMember,proto3" json:"polymorph_member,omitempty"`
 	Bcl             *bcl_j5pb.Block `protobuf:"bytes,6,opt,name=bcl,proto3" json:"bcl,omitempty"`
+	// Cross-field rules, checked once the properties of the object are valid.
+	Rules []*ObjectRule `protobuf:"bytes,7,rep,name=rules,proto3" json:"rules,omitempty"`
 }
 
 func (x *Object) Reset() {
@@ -998,6 +1000,82 @@ func (x *Object) GetBcl() *bcl_j5pb.Block {
 	return nil
 }
 
+func (x *Object) GetRules() []*ObjectRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// ObjectRule is a CEL expression which must evaluate to true for the object to
+// be valid. The object is available as 'this', a map of the set properties by
+// JSON name, e.g. `!has(this.endDate) || this.endDate > this.startDate`.
+type ObjectRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Expression string `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+	// Returned as the validation error when the expression is false.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// The dot separated JSON path, relative to the object, which the error is
+	// reported at. Empty reports the error against the object itself.
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+}
+
+func (x *ObjectRule) Reset() {
+	*x = ObjectRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObjectRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectRule) ProtoMessage() {}
+
+func (x *ObjectRule) ProtoReflect() protoreflect.Message {
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectRule.ProtoReflect.Descriptor instead.
+func (*ObjectRule) Descriptor() ([]byte, []int) {
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ObjectRule) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *ObjectRule) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ObjectRule) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
 type EntityObject struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1010,7 +1088,7 @@ type EntityObject struct {
 func (x *EntityObject) Reset() {
 	*x = EntityObject{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[8]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1023,7 +1101,7 @@ func (x *EntityObject) String() string {
 func (*EntityObject) ProtoMessage() {}
 
 func (x *EntityObject) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[8]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1036,7 +1114,7 @@ func (x *EntityObject) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EntityObject.ProtoReflect.Descriptor instead.
 func (*EntityObject) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{8}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *EntityObject) GetEntity() string {
@@ -1071,7 +1149,7 @@ type PolymorphField struct {
 func (x *PolymorphField) Reset() {
 	*x = PolymorphField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[9]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1084,7 +1162,7 @@ func (x *PolymorphField) String() string {
 func (*PolymorphField) ProtoMessage() {}
 
 func (x *PolymorphField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[9]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1097,7 +1175,7 @@ func (x *PolymorphField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolymorphField.ProtoReflect.Descriptor instead.
 func (*PolymorphField) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{9}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{10}
 }
 
 func (m *PolymorphField) GetSchema() isPolymorphField_Schema {
@@ -1169,7 +1247,7 @@ type Polymorph struct {
 func (x *Polymorph) Reset() {
 	*x = Polymorph{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[10]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1182,7 +1260,7 @@ func (x *Polymorph) String() string {
 func (*Polymorph) ProtoMessage() {}
 
 func (x *Polymorph) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[10]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1195,7 +1273,7 @@ func (x *Polymorph) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Polymorph.ProtoReflect.Descriptor instead.
 func (*Polymorph) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{10}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *Polymorph) GetName() string {
@@ -1237,7 +1315,7 @@ type OneofField struct {
 func (x *OneofField) Reset() {
 	*x = OneofField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[11]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1250,7 +1328,7 @@ func (x *OneofField) String() string {
 func (*OneofField) ProtoMessage() {}
 
 func (x *OneofField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[11]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1263,7 +1341,7 @@ func (x *OneofField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OneofField.ProtoReflect.Descriptor instead.
 func (*OneofField) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{11}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{12}
 }
 
 func (m *OneofField) GetSchema() isOneofField_Schema {
@@ -1340,7 +1418,7 @@ type Oneof struct {
 func (x *Oneof) Reset() {
 	*x = Oneof{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[12]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1353,7 +1431,7 @@ func (x *Oneof) String() string {
 func (*Oneof) ProtoMessage() {}
 
 func (x *Oneof) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[12]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1366,7 +1444,7 @@ func (x *Oneof) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Oneof.ProtoReflect.Descriptor instead.
 func (*Oneof) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{12}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Oneof) GetName() string {
@@ -1415,7 +1493,7 @@ type EnumField struct {
 func (x *EnumField) Reset() {
 	*x = EnumField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[13]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1428,7 +1506,7 @@ func (x *EnumField) String() string {
 func (*EnumField) ProtoMessage() {}
 
 func (x *EnumField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[13]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1441,7 +1519,7 @@ func (x *EnumField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnumField.ProtoReflect.Descriptor instead.
 func (*EnumField) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{13}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{14}
 }
 
 func (m *EnumField) GetSchema() isEnumField_Schema {
@@ -1517,7 +1595,7 @@ type Enum struct {
 func (x *Enum) Reset() {
 	*x = Enum{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[14]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1530,7 +1608,7 @@ func (x *Enum) String() string {
 func (*Enum) ProtoMessage() {}
 
 func (x *Enum) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[14]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1543,7 +1621,7 @@ func (x *Enum) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Enum.ProtoReflect.Descriptor instead.
 func (*Enum) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{14}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Enum) GetName() string {
@@ -1594,7 +1672,7 @@ type ArrayField struct {
 func (x *ArrayField) Reset() {
 	*x = ArrayField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[15]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1607,7 +1685,7 @@ func (x *ArrayField) String() string {
 func (*ArrayField) ProtoMessage() {}
 
 func (x *ArrayField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[15]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1620,7 +1698,7 @@ func (x *ArrayField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArrayField.ProtoReflect.Descriptor instead.
 func (*ArrayField) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{15}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ArrayField) GetRules() *ArrayField_Rules {
@@ -1658,7 +1736,7 @@ type MapField struct {
 func (x *MapField) Reset() {
 	*x = MapField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[16]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1671,7 +1749,7 @@ func (x *MapField) String() string {
 func (*MapField) ProtoMessage() {}
 
 func (x *MapField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[16]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1684,7 +1762,7 @@ func (x *MapField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MapField.ProtoReflect.Descriptor instead.
 func (*MapField) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{16}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *MapField) GetItemSchema() *Field {
@@ -1728,7 +1806,7 @@ type StringFormat struct {
 func (x *StringFormat) Reset() {
 	*x = StringFormat{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[17]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1741,7 +1819,7 @@ func (x *StringFormat) String() string {
 func (*StringFormat) ProtoMessage() {}
 
 func (x *StringFormat) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[17]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1754,7 +1832,7 @@ func (x *StringFormat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StringFormat.ProtoReflect.Descriptor instead.
 func (*StringFormat) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{17}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *StringFormat) GetRegex() string {
@@ -1792,7 +1870,7 @@ type StringField struct {
 func (x *StringField) Reset() {
 	*x = StringField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[18]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1805,7 +1883,7 @@ func (x *StringField) String() string {
 func (*StringField) ProtoMessage() {}
 
 func (x *StringField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[18]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1818,7 +1896,7 @@ func (x *StringField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StringField.ProtoReflect.Descriptor instead.
 func (*StringField) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{18}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *StringField) GetFormat() string {
@@ -1864,7 +1942,7 @@ type KeyField struct {
 func (x *KeyField) Reset() {
 	*x = KeyField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[19]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1877,7 +1955,7 @@ func (x *KeyField) String() string {
 func (*KeyField) ProtoMessage() {}
 
 func (x *KeyField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[19]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1890,7 +1968,7 @@ func (x *KeyField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KeyField.ProtoReflect.Descriptor instead.
 func (*KeyField) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{19}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *KeyField) GetRules() *KeyField_Rules {
@@ -1946,7 +2024,7 @@ type KeyFormat struct {
 func (x *KeyFormat) Reset() {
 	*x = KeyFormat{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[20]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1959,7 +2037,7 @@ func (x *KeyFormat) String() string {
 func (*KeyFormat) ProtoMessage() {}
 
 func (x *KeyFormat) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[20]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1972,7 +2050,7 @@ func (x *KeyFormat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KeyFormat.ProtoReflect.Descriptor instead.
 func (*KeyFormat) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{20}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{21}
 }
 
 func (m *KeyFormat) GetType() isKeyFormat_Type {
@@ -2065,7 +2143,7 @@ type FloatField struct {
 func (x *FloatField) Reset() {
 	*x = FloatField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[21]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2078,7 +2156,7 @@ func (x *FloatField) String() string {
 func (*FloatField) ProtoMessage() {}
 
 func (x *FloatField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[21]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2091,7 +2169,7 @@ func (x *FloatField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FloatField.ProtoReflect.Descriptor instead.
 func (*FloatField) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{21}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *FloatField) GetFormat() FloatField_Format {
@@ -2136,7 +2214,7 @@ type IntegerField struct {
 func (x *IntegerField) Reset() {
 	*x = IntegerField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[22]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2149,7 +2227,7 @@ func (x *IntegerField) String() string {
 func (*IntegerField) ProtoMessage() {}
 
 func (x *IntegerField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[22]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2162,7 +2240,7 @@ func (x *IntegerField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IntegerField.ProtoReflect.Descriptor instead.
 func (*IntegerField) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{22}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *IntegerField) GetFormat() IntegerField_Format {
@@ -2206,7 +2284,7 @@ type BoolField struct {
 func (x *BoolField) Reset() {
 	*x = BoolField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[23]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2219,7 +2297,7 @@ func (x *BoolField) String() string {
 func (*BoolField) ProtoMessage() {}
 
 func (x *BoolField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[23]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2232,7 +2310,7 @@ func (x *BoolField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BoolField.ProtoReflect.Descriptor instead.
 func (*BoolField) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{23}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *BoolField) GetRules() *BoolField_Rules {
@@ -2268,7 +2346,7 @@ type BytesField struct {
 func (x *BytesField) Reset() {
 	*x = BytesField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[24]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2281,7 +2359,7 @@ func (x *BytesField) String() string {
 func (*BytesField) ProtoMessage() {}
 
 func (x *BytesField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[24]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2294,7 +2372,7 @@ func (x *BytesField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BytesField.ProtoReflect.Descriptor instead.
 func (*BytesField) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{24}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *BytesField) GetRules() *BytesField_Rules {
@@ -2324,7 +2402,7 @@ type DecimalField struct {
 func (x *DecimalField) Reset() {
 	*x = DecimalField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[25]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2337,7 +2415,7 @@ func (x *DecimalField) String() string {
 func (*DecimalField) ProtoMessage() {}
 
 func (x *DecimalField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[25]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2350,7 +2428,7 @@ func (x *DecimalField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DecimalField.ProtoReflect.Descriptor instead.
 func (*DecimalField) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{25}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *DecimalField) GetRules() *DecimalField_Rules {
@@ -2387,7 +2465,7 @@ type DateField struct {
 func (x *DateField) Reset() {
 	*x = DateField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[26]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2400,7 +2478,7 @@ func (x *DateField) String() string {
 func (*DateField) ProtoMessage() {}
 
 func (x *DateField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[26]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2413,7 +2491,7 @@ func (x *DateField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DateField.ProtoReflect.Descriptor instead.
 func (*DateField) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{26}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *DateField) GetRules() *DateField_Rules {
@@ -2450,7 +2528,7 @@ type TimestampField struct {
 func (x *TimestampField) Reset() {
 	*x = TimestampField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[27]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2463,7 +2541,7 @@ func (x *TimestampField) String() string {
 func (*TimestampField) ProtoMessage() {}
 
 func (x *TimestampField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[27]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2476,7 +2554,7 @@ func (x *TimestampField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimestampField.ProtoReflect.Descriptor instead.
 func (*TimestampField) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{27}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *TimestampField) GetRules() *TimestampField_Rules {
@@ -2518,7 +2596,7 @@ type ObjectProperty struct {
 func (x *ObjectProperty) Reset() {
 	*x = ObjectProperty{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[28]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2531,7 +2609,7 @@ func (x *ObjectProperty) String() string {
 func (*ObjectProperty) ProtoMessage() {}
 
 func (x *ObjectProperty) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[28]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2544,7 +2622,7 @@ func (x *ObjectProperty) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ObjectProperty.ProtoReflect.Descriptor instead.
 func (*ObjectProperty) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{28}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *ObjectProperty) GetSchema() *Field {
@@ -2614,7 +2692,7 @@ type EntityKey struct {
 func (x *EntityKey) Reset() {
 	*x = EntityKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[29]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2627,7 +2705,7 @@ func (x *EntityKey) String() string {
 func (*EntityKey) ProtoMessage() {}
 
 func (x *EntityKey) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[29]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2640,7 +2718,7 @@ func (x *EntityKey) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EntityKey.ProtoReflect.Descriptor instead.
 func (*EntityKey) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{29}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *EntityKey) GetPrimary() bool {
@@ -2676,7 +2754,7 @@ type ObjectField_Rules struct {
 func (x *ObjectField_Rules) Reset() {
 	*x = ObjectField_Rules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[30]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2689,7 +2767,7 @@ func (x *ObjectField_Rules) String() string {
 func (*ObjectField_Rules) ProtoMessage() {}
 
 func (x *ObjectField_Rules) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[30]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2728,7 +2806,7 @@ type ObjectField_Ext struct {
 func (x *ObjectField_Ext) Reset() {
 	*x = ObjectField_Ext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[31]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2741,7 +2819,7 @@ func (x *ObjectField_Ext) String() string {
 func (*ObjectField_Ext) ProtoMessage() {}
 
 func (x *ObjectField_Ext) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[31]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2776,7 +2854,7 @@ type ObjectField_EntityJoin struct {
 func (x *ObjectField_EntityJoin) Reset() {
 	*x = ObjectField_EntityJoin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[32]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2789,7 +2867,7 @@ func (x *ObjectField_EntityJoin) String() string {
 func (*ObjectField_EntityJoin) ProtoMessage() {}
 
 func (x *ObjectField_EntityJoin) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[32]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2828,7 +2906,7 @@ type PolymorphField_Rules struct {
 func (x *PolymorphField_Rules) Reset() {
 	*x = PolymorphField_Rules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[33]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2841,7 +2919,7 @@ func (x *PolymorphField_Rules) String() string {
 func (*PolymorphField_Rules) ProtoMessage() {}
 
 func (x *PolymorphField_Rules) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[33]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2854,7 +2932,7 @@ func (x *PolymorphField_Rules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolymorphField_Rules.ProtoReflect.Descriptor instead.
 func (*PolymorphField_Rules) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{9, 0}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{10, 0}
 }
 
 type PolymorphField_Ext struct {
@@ -2866,7 +2944,7 @@ type PolymorphField_Ext struct {
 func (x *PolymorphField_Ext) Reset() {
 	*x = PolymorphField_Ext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[34]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2879,7 +2957,7 @@ func (x *PolymorphField_Ext) String() string {
 func (*PolymorphField_Ext) ProtoMessage() {}
 
 func (x *PolymorphField_Ext) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[34]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2892,7 +2970,7 @@ func (x *PolymorphField_Ext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolymorphField_Ext.ProtoReflect.Descriptor instead.
 func (*PolymorphField_Ext) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{9, 1}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{10, 1}
 }
 
 type OneofField_Rules struct {
@@ -2904,7 +2982,7 @@ type OneofField_Rules struct {
 func (x *OneofField_Rules) Reset() {
 	*x = OneofField_Rules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[35]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2917,7 +2995,7 @@ func (x *OneofField_Rules) String() string {
 func (*OneofField_Rules) ProtoMessage() {}
 
 func (x *OneofField_Rules) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[35]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2930,7 +3008,7 @@ func (x *OneofField_Rules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OneofField_Rules.ProtoReflect.Descriptor instead.
 func (*OneofField_Rules) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{11, 0}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{12, 0}
 }
 
 type OneofField_Ext struct {
@@ -2942,7 +3020,7 @@ type OneofField_Ext struct {
 func (x *OneofField_Ext) Reset() {
 	*x = OneofField_Ext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[36]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2955,7 +3033,7 @@ func (x *OneofField_Ext) String() string {
 func (*OneofField_Ext) ProtoMessage() {}
 
 func (x *OneofField_Ext) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[36]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2968,7 +3046,7 @@ func (x *OneofField_Ext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OneofField_Ext.ProtoReflect.Descriptor instead.
 func (*OneofField_Ext) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{11, 1}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{12, 1}
 }
 
 type EnumField_Rules struct {
@@ -2983,7 +3061,7 @@ type EnumField_Rules struct {
 func (x *EnumField_Rules) Reset() {
 	*x = EnumField_Rules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[37]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2996,7 +3074,7 @@ func (x *EnumField_Rules) String() string {
 func (*EnumField_Rules) ProtoMessage() {}
 
 func (x *EnumField_Rules) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[37]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3009,7 +3087,7 @@ func (x *EnumField_Rules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnumField_Rules.ProtoReflect.Descriptor instead.
 func (*EnumField_Rules) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{13, 0}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{14, 0}
 }
 
 func (x *EnumField_Rules) GetIn() []string {
@@ -3035,7 +3113,7 @@ type EnumField_Ext struct {
 func (x *EnumField_Ext) Reset() {
 	*x = EnumField_Ext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[38]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3048,7 +3126,7 @@ func (x *EnumField_Ext) String() string {
 func (*EnumField_Ext) ProtoMessage() {}
 
 func (x *EnumField_Ext) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[38]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3061,7 +3139,7 @@ func (x *EnumField_Ext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnumField_Ext.ProtoReflect.Descriptor instead.
 func (*EnumField_Ext) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{13, 1}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{14, 1}
 }
 
 type Enum_Option struct {
@@ -3078,7 +3156,7 @@ type Enum_Option struct {
 func (x *Enum_Option) Reset() {
 	*x = Enum_Option{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[39]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3091,7 +3169,7 @@ func (x *Enum_Option) String() string {
 func (*Enum_Option) ProtoMessage() {}
 
 func (x *Enum_Option) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[39]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3104,7 +3182,7 @@ func (x *Enum_Option) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Enum_Option.ProtoReflect.Descriptor instead.
 func (*Enum_Option) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{14, 0}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{15, 0}
 }
 
 func (x *Enum_Option) GetName() string {
@@ -3148,7 +3226,7 @@ type Enum_OptionInfoField struct {
 func (x *Enum_OptionInfoField) Reset() {
 	*x = Enum_OptionInfoField{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[40]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3161,7 +3239,7 @@ func (x *Enum_OptionInfoField) String() string {
 func (*Enum_OptionInfoField) ProtoMessage() {}
 
 func (x *Enum_OptionInfoField) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[40]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3174,7 +3252,7 @@ func (x *Enum_OptionInfoField) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Enum_OptionInfoField.ProtoReflect.Descriptor instead.
 func (*Enum_OptionInfoField) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{14, 1}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{15, 1}
 }
 
 func (x *Enum_OptionInfoField) GetName() string {
@@ -3211,7 +3289,7 @@ type ArrayField_Ext struct {
 func (x *ArrayField_Ext) Reset() {
 	*x = ArrayField_Ext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[42]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3224,7 +3302,7 @@ func (x *ArrayField_Ext) String() string {
 func (*ArrayField_Ext) ProtoMessage() {}
 
 func (x *ArrayField_Ext) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[42]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3237,7 +3315,7 @@ func (x *ArrayField_Ext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArrayField_Ext.ProtoReflect.Descriptor instead.
 func (*ArrayField_Ext) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{15, 0}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{16, 0}
 }
 
 func (x *ArrayField_Ext) GetSingleForm() string {
@@ -3260,7 +3338,7 @@ type ArrayField_Rules struct {
 func (x *ArrayField_Rules) Reset() {
 	*x = ArrayField_Rules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[43]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3273,7 +3351,7 @@ func (x *ArrayField_Rules) String() string {
 func (*ArrayField_Rules) ProtoMessage() {}
 
 func (x *ArrayField_Rules) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[43]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3286,7 +3364,7 @@ func (x *ArrayField_Rules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArrayField_Rules.ProtoReflect.Descriptor instead.
 func (*ArrayField_Rules) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{15, 1}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{16, 1}
 }
 
 func (x *ArrayField_Rules) GetMinItems() uint64 {
@@ -3322,7 +3400,7 @@ type MapField_Rules struct {
 func (x *MapField_Rules) Reset() {
 	*x = MapField_Rules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[44]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3335,7 +3413,7 @@ func (x *MapField_Rules) String() string {
 func (*MapField_Rules) ProtoMessage() {}
 
 func (x *MapField_Rules) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[44]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3348,7 +3426,7 @@ func (x *MapField_Rules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MapField_Rules.ProtoReflect.Descriptor instead.
 func (*MapField_Rules) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{16, 0}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{17, 0}
 }
 
 func (x *MapField_Rules) GetMinPairs() uint64 {
@@ -3378,7 +3456,7 @@ type MapField_Ext struct {
 func (x *MapField_Ext) Reset() {
 	*x = MapField_Ext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[45]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3391,7 +3469,7 @@ func (x *MapField_Ext) String() string {
 func (*MapField_Ext) ProtoMessage() {}
 
 func (x *MapField_Ext) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[45]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3404,7 +3482,7 @@ func (x *MapField_Ext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MapField_Ext.ProtoReflect.Descriptor instead.
 func (*MapField_Ext) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{16, 1}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{17, 1}
 }
 
 func (x *MapField_Ext) GetSingleForm() string {
@@ -3427,7 +3505,7 @@ type StringField_Rules struct {
 func (x *StringField_Rules) Reset() {
 	*x = StringField_Rules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[46]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3440,7 +3518,7 @@ func (x *StringField_Rules) String() string {
 func (*StringField_Rules) ProtoMessage() {}
 
 func (x *StringField_Rules) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[46]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3453,7 +3531,7 @@ func (x *StringField_Rules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StringField_Rules.ProtoReflect.Descriptor instead.
 func (*StringField_Rules) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{18, 0}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{19, 0}
 }
 
 func (x *StringField_Rules) GetPattern() string {
@@ -3486,7 +3564,7 @@ type StringField_Ext struct {
 func (x *StringField_Ext) Reset() {
 	*x = StringField_Ext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[47]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3499,7 +3577,7 @@ func (x *StringField_Ext) String() string {
 func (*StringField_Ext) ProtoMessage() {}
 
 func (x *StringField_Ext) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[47]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3512,7 +3590,7 @@ func (x *StringField_Ext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StringField_Ext.ProtoReflect.Descriptor instead.
 func (*StringField_Ext) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{18, 1}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{19, 1}
 }
 
 type KeyField_Rules struct {
@@ -3524,7 +3602,7 @@ type KeyField_Rules struct {
 func (x *KeyField_Rules) Reset() {
 	*x = KeyField_Rules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[48]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3537,7 +3615,7 @@ func (x *KeyField_Rules) String() string {
 func (*KeyField_Rules) ProtoMessage() {}
 
 func (x *KeyField_Rules) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[48]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3550,7 +3628,7 @@ func (x *KeyField_Rules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KeyField_Rules.ProtoReflect.Descriptor instead.
 func (*KeyField_Rules) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{19, 0}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{20, 0}
 }
 
 type KeyField_Ext struct {
@@ -3565,7 +3643,7 @@ type KeyField_Ext struct {
 func (x *KeyField_Ext) Reset() {
 	*x = KeyField_Ext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[49]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3578,7 +3656,7 @@ func (x *KeyField_Ext) String() string {
 func (*KeyField_Ext) ProtoMessage() {}
 
 func (x *KeyField_Ext) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[49]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3591,7 +3669,7 @@ func (x *KeyField_Ext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KeyField_Ext.ProtoReflect.Descriptor instead.
 func (*KeyField_Ext) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{19, 1}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{20, 1}
 }
 
 func (x *KeyField_Ext) GetForeign() *EntityRef {
@@ -3616,7 +3694,7 @@ type KeyField_DeprecatedEntityKey struct {
 func (x *KeyField_DeprecatedEntityKey) Reset() {
 	*x = KeyField_DeprecatedEntityKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[50]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3629,7 +3707,7 @@ func (x *KeyField_DeprecatedEntityKey) String() string {
 func (*KeyField_DeprecatedEntityKey) ProtoMessage() {}
 
 func (x *KeyField_DeprecatedEntityKey) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[50]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3642,7 +3720,7 @@ func (x *KeyField_DeprecatedEntityKey) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KeyField_DeprecatedEntityKey.ProtoReflect.Descriptor instead.
 func (*KeyField_DeprecatedEntityKey) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{19, 2}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{20, 2}
 }
 
 func (m *KeyField_DeprecatedEntityKey) GetType() isKeyField_DeprecatedEntityKey_Type {
@@ -3693,7 +3771,7 @@ type KeyFormat_Informal struct {
 func (x *KeyFormat_Informal) Reset() {
 	*x = KeyFormat_Informal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[51]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3706,7 +3784,7 @@ func (x *KeyFormat_Informal) String() string {
 func (*KeyFormat_Informal) ProtoMessage() {}
 
 func (x *KeyFormat_Informal) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[51]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3719,7 +3797,7 @@ func (x *KeyFormat_Informal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KeyFormat_Informal.ProtoReflect.Descriptor instead.
 func (*KeyFormat_Informal) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{20, 0}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{21, 0}
 }
 
 type KeyFormat_Custom struct {
@@ -3734,7 +3812,7 @@ type KeyFormat_Custom struct {
 func (x *KeyFormat_Custom) Reset() {
 	*x = KeyFormat_Custom{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[52]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3747,7 +3825,7 @@ func (x *KeyFormat_Custom) String() string {
 func (*KeyFormat_Custom) ProtoMessage() {}
 
 func (x *KeyFormat_Custom) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[52]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3760,7 +3838,7 @@ func (x *KeyFormat_Custom) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KeyFormat_Custom.ProtoReflect.Descriptor instead.
 func (*KeyFormat_Custom) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{20, 1}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{21, 1}
 }
 
 func (x *KeyFormat_Custom) GetPattern() string {
@@ -3786,7 +3864,7 @@ type KeyFormat_UUID struct {
 func (x *KeyFormat_UUID) Reset() {
 	*x = KeyFormat_UUID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[53]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3799,7 +3877,7 @@ func (x *KeyFormat_UUID) String() string {
 func (*KeyFormat_UUID) ProtoMessage() {}
 
 func (x *KeyFormat_UUID) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[53]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3812,7 +3890,7 @@ func (x *KeyFormat_UUID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KeyFormat_UUID.ProtoReflect.Descriptor instead.
 func (*KeyFormat_UUID) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{20, 2}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{21, 2}
 }
 
 type KeyFormat_ID62 struct {
@@ -3824,7 +3902,7 @@ type KeyFormat_ID62 struct {
 func (x *KeyFormat_ID62) Reset() {
 	*x = KeyFormat_ID62{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[54]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3837,7 +3915,7 @@ func (x *KeyFormat_ID62) String() string {
 func (*KeyFormat_ID62) ProtoMessage() {}
 
 func (x *KeyFormat_ID62) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[54]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3850,7 +3928,7 @@ func (x *KeyFormat_ID62) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KeyFormat_ID62.ProtoReflect.Descriptor instead.
 func (*KeyFormat_ID62) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{20, 3}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{21, 3}
 }
 
 type KeyFormat_Named struct {
@@ -3864,7 +3942,7 @@ type KeyFormat_Named struct {
 func (x *KeyFormat_Named) Reset() {
 	*x = KeyFormat_Named{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[55]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3877,7 +3955,7 @@ func (x *KeyFormat_Named) String() string {
 func (*KeyFormat_Named) ProtoMessage() {}
 
 func (x *KeyFormat_Named) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[55]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3890,7 +3968,7 @@ func (x *KeyFormat_Named) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KeyFormat_Named.ProtoReflect.Descriptor instead.
 func (*KeyFormat_Named) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{20, 4}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{21, 4}
 }
 
 func (x *KeyFormat_Named) GetRef() *Ref {
@@ -3915,7 +3993,7 @@ type FloatField_Rules struct {
 func (x *FloatField_Rules) Reset() {
 	*x = FloatField_Rules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[56]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3928,7 +4006,7 @@ func (x *FloatField_Rules) String() string {
 func (*FloatField_Rules) ProtoMessage() {}
 
 func (x *FloatField_Rules) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[56]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3941,7 +4019,7 @@ func (x *FloatField_Rules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FloatField_Rules.ProtoReflect.Descriptor instead.
 func (*FloatField_Rules) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{21, 0}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{22, 0}
 }
 
 func (x *FloatField_Rules) GetExclusiveMaximum() bool {
@@ -3988,7 +4066,7 @@ type FloatField_Ext struct {
 func (x *FloatField_Ext) Reset() {
 	*x = FloatField_Ext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[57]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4001,7 +4079,7 @@ func (x *FloatField_Ext) String() string {
 func (*FloatField_Ext) ProtoMessage() {}
 
 func (x *FloatField_Ext) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[57]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4014,7 +4092,7 @@ func (x *FloatField_Ext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FloatField_Ext.ProtoReflect.Descriptor instead.
 func (*FloatField_Ext) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{21, 1}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{22, 1}
 }
 
 type IntegerField_Rules struct {
@@ -4032,7 +4110,7 @@ type IntegerField_Rules struct {
 func (x *IntegerField_Rules) Reset() {
 	*x = IntegerField_Rules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[58]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4045,7 +4123,7 @@ func (x *IntegerField_Rules) String() string {
 func (*IntegerField_Rules) ProtoMessage() {}
 
 func (x *IntegerField_Rules) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[58]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4058,7 +4136,7 @@ func (x *IntegerField_Rules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IntegerField_Rules.ProtoReflect.Descriptor instead.
 func (*IntegerField_Rules) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{22, 0}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{23, 0}
 }
 
 func (x *IntegerField_Rules) GetExclusiveMaximum() bool {
@@ -4105,7 +4183,7 @@ type IntegerField_Ext struct {
 func (x *IntegerField_Ext) Reset() {
 	*x = IntegerField_Ext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[59]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4118,7 +4196,7 @@ func (x *IntegerField_Ext) String() string {
 func (*IntegerField_Ext) ProtoMessage() {}
 
 func (x *IntegerField_Ext) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[59]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4131,7 +4209,7 @@ func (x *IntegerField_Ext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IntegerField_Ext.ProtoReflect.Descriptor instead.
 func (*IntegerField_Ext) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{22, 1}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{23, 1}
 }
 
 type BoolField_Rules struct {
@@ -4145,7 +4223,7 @@ type BoolField_Rules struct {
 func (x *BoolField_Rules) Reset() {
 	*x = BoolField_Rules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[60]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4158,7 +4236,7 @@ func (x *BoolField_Rules) String() string {
 func (*BoolField_Rules) ProtoMessage() {}
 
 func (x *BoolField_Rules) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[60]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4171,7 +4249,7 @@ func (x *BoolField_Rules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BoolField_Rules.ProtoReflect.Descriptor instead.
 func (*BoolField_Rules) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{23, 0}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{24, 0}
 }
 
 func (x *BoolField_Rules) GetConst() bool {
@@ -4190,7 +4268,7 @@ type BoolField_Ext struct {
 func (x *BoolField_Ext) Reset() {
 	*x = BoolField_Ext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[61]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4203,7 +4281,7 @@ func (x *BoolField_Ext) String() string {
 func (*BoolField_Ext) ProtoMessage() {}
 
 func (x *BoolField_Ext) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[61]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4216,7 +4294,7 @@ func (x *BoolField_Ext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BoolField_Ext.ProtoReflect.Descriptor instead.
 func (*BoolField_Ext) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{23, 1}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{24, 1}
 }
 
 type BytesField_Rules struct {
@@ -4231,7 +4309,7 @@ type BytesField_Rules struct {
 func (x *BytesField_Rules) Reset() {
 	*x = BytesField_Rules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[62]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4244,7 +4322,7 @@ func (x *BytesField_Rules) String() string {
 func (*BytesField_Rules) ProtoMessage() {}
 
 func (x *BytesField_Rules) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[62]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4257,7 +4335,7 @@ func (x *BytesField_Rules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BytesField_Rules.ProtoReflect.Descriptor instead.
 func (*BytesField_Rules) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{24, 0}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{25, 0}
 }
 
 func (x *BytesField_Rules) GetMinLength() uint64 {
@@ -4283,7 +4361,7 @@ type BytesField_Ext struct {
 func (x *BytesField_Ext) Reset() {
 	*x = BytesField_Ext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[63]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4296,7 +4374,7 @@ func (x *BytesField_Ext) String() string {
 func (*BytesField_Ext) ProtoMessage() {}
 
 func (x *BytesField_Ext) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[63]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4309,7 +4387,7 @@ func (x *BytesField_Ext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BytesField_Ext.ProtoReflect.Descriptor instead.
 func (*BytesField_Ext) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{24, 1}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{25, 1}
 }
 
 type DecimalField_Rules struct {
@@ -4326,7 +4404,7 @@ type DecimalField_Rules struct {
 func (x *DecimalField_Rules) Reset() {
 	*x = DecimalField_Rules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[64]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4339,7 +4417,7 @@ func (x *DecimalField_Rules) String() string {
 func (*DecimalField_Rules) ProtoMessage() {}
 
 func (x *DecimalField_Rules) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[64]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4352,7 +4430,7 @@ func (x *DecimalField_Rules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DecimalField_Rules.ProtoReflect.Descriptor instead.
 func (*DecimalField_Rules) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{25, 0}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{26, 0}
 }
 
 func (x *DecimalField_Rules) GetMinimum() string {
@@ -4392,7 +4470,7 @@ type DecimalField_Ext struct {
 func (x *DecimalField_Ext) Reset() {
 	*x = DecimalField_Ext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[65]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4405,7 +4483,7 @@ func (x *DecimalField_Ext) String() string {
 func (*DecimalField_Ext) ProtoMessage() {}
 
 func (x *DecimalField_Ext) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[65]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4418,7 +4496,7 @@ func (x *DecimalField_Ext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DecimalField_Ext.ProtoReflect.Descriptor instead.
 func (*DecimalField_Ext) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{25, 1}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{26, 1}
 }
 
 type DateField_Rules struct {
@@ -4435,7 +4513,7 @@ type DateField_Rules struct {
 func (x *DateField_Rules) Reset() {
 	*x = DateField_Rules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[66]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4448,7 +4526,7 @@ func (x *DateField_Rules) String() string {
 func (*DateField_Rules) ProtoMessage() {}
 
 func (x *DateField_Rules) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[66]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4461,7 +4539,7 @@ func (x *DateField_Rules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DateField_Rules.ProtoReflect.Descriptor instead.
 func (*DateField_Rules) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{26, 0}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{27, 0}
 }
 
 func (x *DateField_Rules) GetMinimum() string {
@@ -4501,7 +4579,7 @@ type DateField_Ext struct {
 func (x *DateField_Ext) Reset() {
 	*x = DateField_Ext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[67]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4514,7 +4592,7 @@ func (x *DateField_Ext) String() string {
 func (*DateField_Ext) ProtoMessage() {}
 
 func (x *DateField_Ext) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[67]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4527,7 +4605,7 @@ func (x *DateField_Ext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DateField_Ext.ProtoReflect.Descriptor instead.
 func (*DateField_Ext) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{26, 1}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{27, 1}
 }
 
 type TimestampField_Rules struct {
@@ -4544,7 +4622,7 @@ type TimestampField_Rules struct {
 func (x *TimestampField_Rules) Reset() {
 	*x = TimestampField_Rules{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[68]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4557,7 +4635,7 @@ func (x *TimestampField_Rules) String() string {
 func (*TimestampField_Rules) ProtoMessage() {}
 
 func (x *TimestampField_Rules) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[68]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4570,7 +4648,7 @@ func (x *TimestampField_Rules) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimestampField_Rules.ProtoReflect.Descriptor instead.
 func (*TimestampField_Rules) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{27, 0}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{28, 0}
 }
 
 func (x *TimestampField_Rules) GetMinimum() *timestamppb.Timestamp {
@@ -4610,7 +4688,7 @@ type TimestampField_Ext struct {
 func (x *TimestampField_Ext) Reset() {
 	*x = TimestampField_Ext{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_schema_v1_schema_proto_msgTypes[69]
+		mi := &file_j5_schema_v1_schema_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4623,7 +4701,7 @@ func (x *TimestampField_Ext) String() string {
 func (*TimestampField_Ext) ProtoMessage() {}
 
 func (x *TimestampField_Ext) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_schema_v1_schema_proto_msgTypes[69]
+	mi := &file_j5_schema_v1_schema_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4636,7 +4714,7 @@ func (x *TimestampField_Ext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TimestampField_Ext.ProtoReflect.Descriptor instead.
 func (*TimestampField_Ext) Descriptor() ([]byte, []int) {
-	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{27, 1}
+	return file_j5_schema_v1_schema_proto_rawDescGZIP(), []int{28, 1}
 }
 
 var File_j5_schema_v1_schema_proto protoreflect.FileDescriptor
@@ -4736,7 +4814,7 @@ var file_j5_schema_v1_schema_proto_rawDesc = []byte{
 	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6a, 0x35, 0x2e, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e,
 	0x41, 0x6e, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x75,
 	0x6c, 0x65, 0x73, 0x3a, 0x14, 0x82, 0xbe, 0x8f, 0x02, 0x0f, 0x52, 0x0d, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0xf6, 0x04, 0x0a, 0x0b, 0x4f, 0x62,
+	0x70, 0x65, 0x12, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x22, 0x8d, 0x05, 0x0a, 0x0b, 0x4f, 0x62,
 	0x6a, 0x65, 0x63, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x25, 0x0a, 0x03, 0x72, 0x65, 0x66,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65,
 	0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x48, 0x00, 0x52, 0x03, 0x72, 0x65, 0x66,
@@ -4771,488 +4849,469 @@ var file_j5_schema_v1_schema_proto_rawDesc = []byte{
 	0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20,
 	0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e,
 	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x74, 0x52, 0x0a, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x74, 0x3a, 0x3d, 0x82, 0xbe, 0x8f, 0x02, 0x38,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x74, 0x3a, 0x54, 0x82, 0xbe, 0x8f, 0x02, 0x4f,
 	0x2a, 0x05, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x52, 0x1b, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
 	0x12, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72,
 	0x74, 0x69, 0x65, 0x73, 0x52, 0x12, 0x0a, 0x03, 0x62, 0x63, 0x6c, 0x12, 0x06, 0x6f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x12, 0x03, 0x62, 0x63, 0x6c, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x22, 0x4c, 0x0a, 0x0c, 0x49, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x12, 0x3c, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x70,
-	0x65, 0x72, 0x74, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
-	0x22, 0xcf, 0x02, 0x0a, 0x06, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x31, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xba, 0x48, 0x1a, 0x72, 0x18,
-	0x32, 0x16, 0x5e, 0x28, 0x7c, 0x5b, 0x41, 0x2d, 0x5a, 0x5d, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d,
-	0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x2a, 0x29, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x32, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e,
-	0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x06, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x12, 0x3c, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x72,
-	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x6f, 0x6c, 0x79, 0x6d, 0x6f, 0x72, 0x70, 0x68, 0x5f,
-	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x6f,
-	0x6c, 0x79, 0x6d, 0x6f, 0x72, 0x70, 0x68, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x22, 0x0a,
-	0x03, 0x62, 0x63, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6a, 0x35, 0x2e,
-	0x62, 0x63, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x03, 0x62, 0x63,
-	0x6c, 0x3a, 0x2f, 0x82, 0xbe, 0x8f, 0x02, 0x2a, 0x1a, 0x06, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x32, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x0a,
-	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69,
-	0x65, 0x73, 0x22, 0x70, 0x0a, 0x0c, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4f, 0x62, 0x6a, 0x65,
-	0x63, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x1a, 0xba, 0x48, 0x17, 0x72, 0x15, 0x32, 0x13, 0x5e, 0x5b, 0x41, 0x2d, 0x5a,
-	0x5d, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x2a, 0x24, 0x52, 0x06,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x04, 0x70, 0x61, 0x72, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x74, 0x52, 0x04,
-	0x70, 0x61, 0x72, 0x74, 0x22, 0x86, 0x02, 0x0a, 0x0e, 0x50, 0x6f, 0x6c, 0x79, 0x6d, 0x6f, 0x72,
-	0x70, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x25, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x48, 0x00, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x37,
-	0x0a, 0x09, 0x70, 0x6f, 0x6c, 0x79, 0x6d, 0x6f, 0x72, 0x70, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31,
-	0x2e, 0x50, 0x6f, 0x6c, 0x79, 0x6d, 0x6f, 0x72, 0x70, 0x68, 0x48, 0x00, 0x52, 0x09, 0x70, 0x6f,
-	0x6c, 0x79, 0x6d, 0x6f, 0x72, 0x70, 0x68, 0x12, 0x38, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x79, 0x6d, 0x6f, 0x72, 0x70, 0x68, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65,
-	0x73, 0x12, 0x32, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20,
-	0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f,
-	0x6c, 0x79, 0x6d, 0x6f, 0x72, 0x70, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x45, 0x78, 0x74,
-	0x52, 0x03, 0x65, 0x78, 0x74, 0x1a, 0x07, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x05,
-	0x0a, 0x03, 0x45, 0x78, 0x74, 0x3a, 0x0c, 0x82, 0xbe, 0x8f, 0x02, 0x07, 0x2a, 0x05, 0x0a, 0x03,
-	0x72, 0x65, 0x66, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0x5b, 0x0a,
-	0x09, 0x50, 0x6f, 0x6c, 0x79, 0x6d, 0x6f, 0x72, 0x70, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0xd5, 0x02, 0x0a, 0x0a, 0x4f,
-	0x6e, 0x65, 0x6f, 0x66, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x25, 0x0a, 0x03, 0x72, 0x65, 0x66,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x48, 0x00, 0x52, 0x03, 0x72, 0x65, 0x66,
-	0x12, 0x2b, 0x0a, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
-	0x6e, 0x65, 0x6f, 0x66, 0x48, 0x00, 0x52, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x12, 0x34, 0x0a,
-	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6a,
-	0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x6e, 0x65, 0x6f,
-	0x66, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72, 0x75,
-	0x6c, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65,
-	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6a, 0x35, 0x2e, 0x6c, 0x69, 0x73,
-	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52,
-	0x09, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x03, 0x65, 0x78,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x2e, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x1a, 0x07, 0x0a, 0x05, 0x52, 0x75,
-	0x6c, 0x65, 0x73, 0x1a, 0x05, 0x0a, 0x03, 0x45, 0x78, 0x74, 0x3a, 0x3c, 0x82, 0xbe, 0x8f, 0x02,
-	0x37, 0x2a, 0x05, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x52, 0x1b, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x12, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x11, 0x0a, 0x03, 0x62, 0x63, 0x6c, 0x12, 0x05, 0x6f, 0x6e,
-	0x65, 0x6f, 0x66, 0x12, 0x03, 0x62, 0x63, 0x6c, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x22, 0xf0, 0x01, 0x0a, 0x05, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x12, 0x31, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xba, 0x48, 0x1a, 0x72,
-	0x18, 0x32, 0x16, 0x5e, 0x28, 0x7c, 0x5b, 0x41, 0x2d, 0x5a, 0x5d, 0x5b, 0x61, 0x2d, 0x7a, 0x41,
-	0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x2a, 0x29, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x22, 0x0a, 0x03, 0x62, 0x63, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x6a, 0x35, 0x2e, 0x62, 0x63, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x03, 0x62, 0x63, 0x6c, 0x12, 0x3c, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x35, 0x2e, 0x73,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x50,
-	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74,
-	0x69, 0x65, 0x73, 0x3a, 0x30, 0x82, 0xbe, 0x8f, 0x02, 0x2b, 0x1a, 0x06, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x32, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x14, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0xde, 0x02, 0x0a, 0x09, 0x45, 0x6e, 0x75, 0x6d, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x12, 0x25, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x66, 0x48, 0x00, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x28, 0x0a, 0x04, 0x65, 0x6e,
-	0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x48, 0x00, 0x52, 0x04,
-	0x65, 0x6e, 0x75, 0x6d, 0x12, 0x33, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e,
-	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x52, 0x75, 0x6c,
-	0x65, 0x73, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x6c, 0x69, 0x73,
-	0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x6a, 0x35, 0x2e, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x52,
-	0x75, 0x6c, 0x65, 0x73, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12,
-	0x2d, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6a,
-	0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x1a, 0x2e,
-	0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x5f, 0x69,
-	0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x1a, 0x05,
-	0x0a, 0x03, 0x45, 0x78, 0x74, 0x3a, 0x25, 0x82, 0xbe, 0x8f, 0x02, 0x20, 0x2a, 0x05, 0x0a, 0x03,
-	0x72, 0x65, 0x66, 0x52, 0x17, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x04, 0x65,
-	0x6e, 0x75, 0x6d, 0x12, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x08, 0x0a, 0x06,
-	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0xb6, 0x04, 0x0a, 0x04, 0x45, 0x6e, 0x75, 0x6d, 0x12,
-	0x2e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xba,
-	0x48, 0x17, 0x72, 0x15, 0x32, 0x13, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x5d, 0x5b, 0x61, 0x2d, 0x7a,
-	0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x2a, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x33, 0x0a, 0x07, 0x6f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6a, 0x35, 0x2e,
-	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36,
-	0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6a,
-	0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d,
-	0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x1a, 0xc8, 0x01, 0x0a, 0x06, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x20, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x37, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
-	0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75,
-	0x6d, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x1a, 0x37, 0x0a, 0x09, 0x49, 0x6e, 0x66, 0x6f,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x1a, 0x5d, 0x0a, 0x0f, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x20,
-	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x3a, 0x2d, 0x82, 0xbe, 0x8f, 0x02, 0x28, 0x1a, 0x06, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x0a, 0x06,
-	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
-	0x8f, 0x03, 0x0a, 0x0a, 0x41, 0x72, 0x72, 0x61, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x34,
-	0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
-	0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x72,
-	0x61, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72,
-	0x75, 0x6c, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e,
-	0x76, 0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12,
-	0x2e, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a,
-	0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x72, 0x61,
-	0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x1a,
-	0x3b, 0x0a, 0x03, 0x45, 0x78, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65,
-	0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x73,
-	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c,
-	0x5f, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x1a, 0xa0, 0x01, 0x0a,
-	0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x74,
-	0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x69, 0x6e,
-	0x49, 0x74, 0x65, 0x6d, 0x73, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x08, 0x6d,
-	0x61, 0x78, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x75, 0x6e,
-	0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x48, 0x02, 0x52, 0x0b, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x88,
-	0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73,
-	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x42, 0x0f,
-	0x0a, 0x0d, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x3a,
-	0x10, 0x82, 0xbe, 0x8f, 0x02, 0x0b, 0x2a, 0x09, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x10,
-	0x01, 0x22, 0x93, 0x03, 0x0a, 0x08, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x34,
-	0x0a, 0x0b, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e,
-	0x76, 0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x0a, 0x69, 0x74, 0x65, 0x6d, 0x53, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x12, 0x32, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x09, 0x6b,
-	0x65, 0x79, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x32, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e,
-	0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x03,
-	0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6a, 0x35, 0x2e, 0x73,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x2e, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x1a, 0x67, 0x0a, 0x05, 0x52, 0x75,
-	0x6c, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x50, 0x61, 0x69,
-	0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x69,
-	0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50,
-	0x61, 0x69, 0x72, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x69, 0x6e, 0x5f,
-	0x70, 0x61, 0x69, 0x72, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61,
-	0x69, 0x72, 0x73, 0x1a, 0x3b, 0x0a, 0x03, 0x45, 0x78, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x69,
-	0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x00, 0x52, 0x0a, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x88, 0x01, 0x01,
-	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6d,
-	0x3a, 0x15, 0x82, 0xbe, 0x8f, 0x02, 0x10, 0x2a, 0x0e, 0x0a, 0x0a, 0x69, 0x74, 0x65, 0x6d, 0x53,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x10, 0x01, 0x22, 0x5a, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x8a, 0x03, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69,
-	0x65, 0x6c, 0x64, 0x12, 0x1b, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x88, 0x01, 0x01,
-	0x12, 0x35, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73,
-	0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f,
-	0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6a, 0x35,
-	0x2e, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x54, 0x65, 0x78,
-	0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65,
-	0x73, 0x12, 0x2f, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65,
-	0x78, 0x74, 0x1a, 0x98, 0x01, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x07,
-	0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
-	0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6d,
-	0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48,
-	0x01, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12,
-	0x22, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x48, 0x02, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
-	0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x42,
-	0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x42, 0x0d,
-	0x0a, 0x0b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x1a, 0x05, 0x0a,
-	0x03, 0x45, 0x78, 0x74, 0x3a, 0x0f, 0x82, 0xbe, 0x8f, 0x02, 0x0a, 0x2a, 0x08, 0x0a, 0x06, 0x66,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
-	0x22, 0x83, 0x04, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x32, 0x0a,
-	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a,
-	0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65,
-	0x73, 0x12, 0x2f, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31,
-	0x2e, 0x4b, 0x65, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d,
-	0x61, 0x74, 0x12, 0x33, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6a, 0x35, 0x2e, 0x6c, 0x69, 0x73, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x09, 0x6c, 0x69,
-	0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x45, 0x78, 0x74,
-	0x52, 0x03, 0x65, 0x78, 0x74, 0x12, 0x42, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x44, 0x65,
-	0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x65,
-	0x79, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x1a, 0x07, 0x0a, 0x05, 0x52, 0x75, 0x6c,
-	0x65, 0x73, 0x1a, 0x38, 0x0a, 0x03, 0x45, 0x78, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x66, 0x6f, 0x72,
-	0x65, 0x69, 0x67, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x35, 0x2e,
-	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x52, 0x65, 0x66, 0x52, 0x07, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x1a, 0x7c, 0x0a, 0x13,
-	0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d,
-	0x61, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x3a, 0x0a, 0x0b, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67,
-	0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x35,
-	0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x52, 0x65, 0x66, 0x48, 0x00, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b,
-	0x65, 0x79, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x2a, 0x82, 0xbe, 0x8f, 0x02,
-	0x25, 0x2a, 0x0a, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x10, 0x01, 0x52, 0x17, 0x0a,
-	0x07, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x12, 0x03, 0x65, 0x78, 0x74, 0x12, 0x07, 0x66,
-	0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x22, 0xf7, 0x03, 0x0a, 0x09, 0x4b, 0x65, 0x79, 0x46, 0x6f,
-	0x72, 0x6d, 0x61, 0x74, 0x12, 0x3e, 0x0a, 0x08, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x2e,
-	0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x66, 0x6f,
-	0x72, 0x6d, 0x61, 0x6c, 0x12, 0x38, 0x0a, 0x06, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x2e, 0x43, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x48, 0x00, 0x52, 0x06, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x12, 0x32,
-	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a,
-	0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x46,
-	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x2e, 0x55, 0x55, 0x49, 0x44, 0x48, 0x00, 0x52, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x12, 0x32, 0x0a, 0x04, 0x69, 0x64, 0x36, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e,
-	0x4b, 0x65, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x2e, 0x49, 0x44, 0x36, 0x32, 0x48, 0x00,
-	0x52, 0x04, 0x69, 0x64, 0x36, 0x32, 0x12, 0x35, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x2e, 0x4e,
-	0x61, 0x6d, 0x65, 0x64, 0x48, 0x00, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x1a, 0x0a, 0x0a,
-	0x08, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x1a, 0x4c, 0x0a, 0x06, 0x43, 0x75, 0x73,
-	0x74, 0x6f, 0x6d, 0x12, 0x20, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x07, 0x70, 0x61,
-	0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x65, 0x63, 0x74, 0x12, 0x03, 0x62, 0x63, 0x6c, 0x52, 0x15, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65,
+	0x12, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x42,
+	0x08, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0x4c, 0x0a, 0x0c, 0x49, 0x6e, 0x6c,
+	0x69, 0x6e, 0x65, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x3c, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x22, 0x8e, 0x03, 0x0a, 0x06, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x12, 0x31, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x1d, 0xba, 0x48, 0x1a, 0x72, 0x18, 0x32, 0x16, 0x5e, 0x28, 0x7c, 0x5b, 0x41, 0x2d, 0x5a,
+	0x5d, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x2a, 0x29, 0x24, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
 	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x06, 0x0a, 0x04, 0x55, 0x55, 0x49, 0x44, 0x1a,
-	0x06, 0x0a, 0x04, 0x49, 0x44, 0x36, 0x32, 0x1a, 0x53, 0x0a, 0x05, 0x4e, 0x61, 0x6d, 0x65, 0x64,
-	0x12, 0x23, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66,
-	0x52, 0x03, 0x72, 0x65, 0x66, 0x3a, 0x25, 0x82, 0xbe, 0x8f, 0x02, 0x20, 0x2a, 0x05, 0x0a, 0x03,
-	0x72, 0x65, 0x66, 0x42, 0x17, 0x10, 0x01, 0x1a, 0x08, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x2a, 0x09, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x3a, 0x0a, 0x82, 0xbe,
-	0x8f, 0x02, 0x05, 0x22, 0x03, 0x0a, 0x01, 0x2e, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x22, 0xea, 0x04, 0x0a, 0x0a, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
-	0x37, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x1f, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x46,
-	0x6c, 0x6f, 0x61, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
-	0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x34, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x46, 0x69, 0x65, 0x6c,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x3c, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x52, 0x0a, 0x70,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x6f, 0x6c,
+	0x79, 0x6d, 0x6f, 0x72, 0x70, 0x68, 0x5f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x6f, 0x6c, 0x79, 0x6d, 0x6f, 0x72, 0x70, 0x68, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x03, 0x62, 0x63, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x6a, 0x35, 0x2e, 0x62, 0x63, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x03, 0x62, 0x63, 0x6c, 0x12, 0x2e, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x3a, 0x3e, 0x82, 0xbe, 0x8f, 0x02, 0x39, 0x1a,
+	0x06, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x0a, 0x70,
+	0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0d, 0x0a, 0x04, 0x72, 0x75, 0x6c,
+	0x65, 0x12, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x63, 0x0a, 0x0a, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x70, 0x0a,
+	0x0c, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x32, 0x0a,
+	0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xba,
+	0x48, 0x17, 0x72, 0x15, 0x32, 0x13, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x5d, 0x5b, 0x61, 0x2d, 0x7a,
+	0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x2a, 0x24, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x12, 0x2c, 0x0a, 0x04, 0x70, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x74, 0x52, 0x04, 0x70, 0x61, 0x72, 0x74, 0x22,
+	0x86, 0x02, 0x0a, 0x0e, 0x50, 0x6f, 0x6c, 0x79, 0x6d, 0x6f, 0x72, 0x70, 0x68, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x12, 0x25, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x66, 0x48, 0x00, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x37, 0x0a, 0x09, 0x70, 0x6f, 0x6c,
+	0x79, 0x6d, 0x6f, 0x72, 0x70, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a,
+	0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x79,
+	0x6d, 0x6f, 0x72, 0x70, 0x68, 0x48, 0x00, 0x52, 0x09, 0x70, 0x6f, 0x6c, 0x79, 0x6d, 0x6f, 0x72,
+	0x70, 0x68, 0x12, 0x38, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x6f, 0x6c, 0x79, 0x6d, 0x6f, 0x72, 0x70, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x03,
+	0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6a, 0x35, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x6c, 0x79, 0x6d, 0x6f, 0x72,
+	0x70, 0x68, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74,
+	0x1a, 0x07, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x05, 0x0a, 0x03, 0x45, 0x78, 0x74,
+	0x3a, 0x0c, 0x82, 0xbe, 0x8f, 0x02, 0x07, 0x2a, 0x05, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x42, 0x08,
+	0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0x5b, 0x0a, 0x09, 0x50, 0x6f, 0x6c, 0x79,
+	0x6d, 0x6f, 0x72, 0x70, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0xd5, 0x02, 0x0a, 0x0a, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x25, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x66, 0x48, 0x00, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x2b, 0x0a, 0x05, 0x6f,
+	0x6e, 0x65, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6a, 0x35, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x48,
+	0x00, 0x52, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x12, 0x34, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x46, 0x69, 0x65, 0x6c,
 	0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x35,
-	0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6a, 0x35, 0x2e, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x46, 0x6c, 0x6f, 0x61, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74,
-	0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x06, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76,
-	0x31, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x45, 0x78, 0x74,
-	0x52, 0x03, 0x65, 0x78, 0x74, 0x1a, 0xa3, 0x02, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12,
-	0x30, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x61, 0x78,
-	0x69, 0x6d, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x10, 0x65, 0x78,
-	0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01,
-	0x01, 0x12, 0x30, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d,
-	0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x10,
-	0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d,
-	0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x88,
-	0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01,
-	0x01, 0x12, 0x24, 0x0a, 0x0b, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x5f, 0x6f, 0x66,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70,
-	0x6c, 0x65, 0x4f, 0x66, 0x88, 0x01, 0x01, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x65, 0x78, 0x63, 0x6c,
-	0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x14, 0x0a,
-	0x12, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69,
-	0x6d, 0x75, 0x6d, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x42,
-	0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
-	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x5f, 0x6f, 0x66, 0x1a, 0x05, 0x0a, 0x03, 0x45,
-	0x78, 0x74, 0x22, 0x48, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x12,
-	0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x46,
-	0x4c, 0x4f, 0x41, 0x54, 0x33, 0x32, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x4f, 0x52, 0x4d,
-	0x41, 0x54, 0x5f, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x36, 0x34, 0x10, 0x02, 0x3a, 0x0f, 0x82, 0xbe,
-	0x8f, 0x02, 0x0a, 0x2a, 0x08, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0xa3, 0x05,
-	0x0a, 0x0c, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x46,
-	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21,
-	0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
-	0x74, 0x65, 0x67, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61,
-	0x74, 0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x82, 0x01, 0x02, 0x20, 0x00, 0x52, 0x06,
-	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x36, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x37,
-	0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6a, 0x35, 0x2e, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e,
-	0x49, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x09, 0x6c, 0x69,
-	0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x2e, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x1a, 0xa3, 0x02, 0x0a, 0x05, 0x52, 0x75,
-	0x6c, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65,
-	0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
-	0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x78, 0x69, 0x6d,
-	0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69,
-	0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x48, 0x01, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x4d, 0x69, 0x6e,
-	0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d,
-	0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69,
-	0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75,
-	0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d,
-	0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c,
-	0x65, 0x5f, 0x6f, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x0a, 0x6d, 0x75,
-	0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x4f, 0x66, 0x88, 0x01, 0x01, 0x42, 0x14, 0x0a, 0x12, 0x5f,
-	0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75,
-	0x6d, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f,
-	0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x69, 0x6e, 0x69,
-	0x6d, 0x75, 0x6d, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x42,
-	0x0e, 0x0a, 0x0c, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x5f, 0x6f, 0x66, 0x1a,
-	0x05, 0x0a, 0x03, 0x45, 0x78, 0x74, 0x22, 0x6a, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
-	0x12, 0x16, 0x0a, 0x12, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
-	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x4f, 0x52, 0x4d,
-	0x41, 0x54, 0x5f, 0x49, 0x4e, 0x54, 0x33, 0x32, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x4f,
-	0x52, 0x4d, 0x41, 0x54, 0x5f, 0x49, 0x4e, 0x54, 0x36, 0x34, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d,
-	0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x49, 0x4e, 0x54, 0x33, 0x32, 0x10, 0x03, 0x12,
-	0x11, 0x0a, 0x0d, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x49, 0x4e, 0x54, 0x36, 0x34,
-	0x10, 0x04, 0x3a, 0x0f, 0x82, 0xbe, 0x8f, 0x02, 0x0a, 0x2a, 0x08, 0x0a, 0x06, 0x66, 0x6f, 0x72,
-	0x6d, 0x61, 0x74, 0x22, 0xda, 0x01, 0x0a, 0x09, 0x42, 0x6f, 0x6f, 0x6c, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x12, 0x33, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e,
-	0x42, 0x6f, 0x6f, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52,
-	0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72,
-	0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6a, 0x35, 0x2e,
-	0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x75, 0x6c, 0x65,
-	0x73, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x03,
-	0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6a, 0x35, 0x2e, 0x73,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x2e, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x1a, 0x2c, 0x0a, 0x05, 0x52,
-	0x75, 0x6c, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x88, 0x01, 0x01, 0x42,
-	0x08, 0x0a, 0x06, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x1a, 0x05, 0x0a, 0x03, 0x45, 0x78, 0x74,
-	0x22, 0xe8, 0x01, 0x0a, 0x0a, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
-	0x34, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
-	0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x79,
-	0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05,
-	0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x31, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x45, 0x78, 0x74,
+	0x52, 0x03, 0x65, 0x78, 0x74, 0x1a, 0x07, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x05,
+	0x0a, 0x03, 0x45, 0x78, 0x74, 0x3a, 0x3c, 0x82, 0xbe, 0x8f, 0x02, 0x37, 0x2a, 0x05, 0x0a, 0x03,
+	0x72, 0x65, 0x66, 0x52, 0x1b, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x05, 0x6f,
+	0x6e, 0x65, 0x6f, 0x66, 0x12, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
+	0x52, 0x11, 0x0a, 0x03, 0x62, 0x63, 0x6c, 0x12, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x12, 0x03,
+	0x62, 0x63, 0x6c, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0xf0, 0x01,
+	0x0a, 0x05, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x12, 0x31, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xba, 0x48, 0x1a, 0x72, 0x18, 0x32, 0x16, 0x5e, 0x28,
+	0x7c, 0x5b, 0x41, 0x2d, 0x5a, 0x5d, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39,
+	0x5d, 0x2a, 0x29, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x03,
+	0x62, 0x63, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6a, 0x35, 0x2e, 0x62,
+	0x63, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x03, 0x62, 0x63, 0x6c,
+	0x12, 0x3c, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72,
+	0x74, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x3a, 0x30,
+	0x82, 0xbe, 0x8f, 0x02, 0x2b, 0x1a, 0x06, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14, 0x0a, 0x06, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73,
+	0x22, 0xde, 0x02, 0x0a, 0x09, 0x45, 0x6e, 0x75, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x25,
+	0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6a, 0x35,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x48, 0x00,
+	0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x28, 0x0a, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x48, 0x00, 0x52, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x12,
+	0x33, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e,
+	0x75, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6a, 0x35, 0x2e, 0x6c, 0x69,
+	0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52,
+	0x09, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x03, 0x65, 0x78,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x2e, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x1a, 0x2e, 0x0a, 0x05, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x1a, 0x05, 0x0a, 0x03, 0x45, 0x78, 0x74,
+	0x3a, 0x25, 0x82, 0xbe, 0x8f, 0x02, 0x20, 0x2a, 0x05, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x52, 0x17,
+	0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x12, 0x07,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x22, 0xb6, 0x04, 0x0a, 0x04, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xba, 0x48, 0x17, 0x72, 0x15, 0x32,
+	0x13, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x5d, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d,
+	0x39, 0x5d, 0x2a, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x12, 0x33, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x04, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x04, 0x69, 0x6e, 0x66,
+	0x6f, 0x1a, 0xc8, 0x01, 0x0a, 0x06, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x04, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x2e, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x69,
+	0x6e, 0x66, 0x6f, 0x1a, 0x37, 0x0a, 0x09, 0x49, 0x6e, 0x66, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5d, 0x0a, 0x0f,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x2d, 0x82, 0xbe, 0x8f,
+	0x02, 0x28, 0x1a, 0x06, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8f, 0x03, 0x0a, 0x0a, 0x41,
+	0x72, 0x72, 0x61, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x05, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12,
+	0x29, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x03, 0x65, 0x78,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x2e, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x1a, 0x3b, 0x0a, 0x03, 0x45, 0x78,
+	0x74, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6d,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65,
+	0x46, 0x6f, 0x72, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x73, 0x69, 0x6e, 0x67,
+	0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x1a, 0xa0, 0x01, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x12, 0x20, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x73,
+	0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x49, 0x74, 0x65,
+	0x6d, 0x73, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x0b, 0x75,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x6d, 0x61, 0x78, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x75, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x3a, 0x10, 0x82, 0xbe, 0x8f, 0x02,
+	0x0b, 0x2a, 0x09, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x10, 0x01, 0x22, 0x93, 0x03, 0x0a,
+	0x08, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x0b, 0x69, 0x74, 0x65,
+	0x6d, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x52, 0x0a, 0x69, 0x74, 0x65, 0x6d, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12,
+	0x32, 0x0a, 0x0a, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x53, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x12, 0x32, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76,
-	0x31, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x45, 0x78, 0x74,
-	0x52, 0x03, 0x65, 0x78, 0x74, 0x1a, 0x6d, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x22,
-	0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x48, 0x00, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x88,
-	0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e,
-	0x67, 0x74, 0x68, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x6c,
-	0x65, 0x6e, 0x67, 0x74, 0x68, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65,
-	0x6e, 0x67, 0x74, 0x68, 0x1a, 0x05, 0x0a, 0x03, 0x45, 0x78, 0x74, 0x22, 0xa8, 0x03, 0x0a, 0x0c,
-	0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x36, 0x0a, 0x05,
-	0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6a, 0x35,
-	0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d,
-	0x61, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72,
-	0x75, 0x6c, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6c,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6a, 0x35, 0x2e, 0x6c, 0x69,
-	0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x75, 0x6c,
-	0x65, 0x73, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x30, 0x0a,
-	0x03, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6a, 0x35, 0x2e,
-	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61,
-	0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x1a,
-	0xed, 0x01, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x69, 0x6e,
-	0x69, 0x6d, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x69,
-	0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x69,
-	0x6d, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x6d, 0x61, 0x78,
-	0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75,
-	0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x48, 0x02, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x4d,
-	0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x65, 0x78, 0x63,
-	0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76,
-	0x65, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
-	0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78, 0x69,
-	0x6d, 0x75, 0x6d, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76,
-	0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x65, 0x78,
-	0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x1a,
-	0x05, 0x0a, 0x03, 0x45, 0x78, 0x74, 0x22, 0x9c, 0x03, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x65, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x12, 0x33, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e,
-	0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x52, 0x75, 0x6c,
-	0x65, 0x73, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x6c, 0x69, 0x73,
-	0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
-	0x6a, 0x35, 0x2e, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52,
-	0x75, 0x6c, 0x65, 0x73, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12,
-	0x2d, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6a,
-	0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x1a, 0xed,
-	0x01, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x69,
-	0x6d, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x69, 0x6e,
-	0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d,
-	0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x69,
-	0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73,
-	0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x48, 0x02, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x4d, 0x69,
-	0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c,
-	0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65,
-	0x4d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d,
-	0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d,
-	0x75, 0x6d, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65,
-	0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x65, 0x78, 0x63,
-	0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x1a, 0x05,
-	0x0a, 0x03, 0x45, 0x78, 0x74, 0x22, 0xe8, 0x03, 0x0a, 0x0e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x38, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x31, 0x2e, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x45, 0x78, 0x74,
+	0x52, 0x03, 0x65, 0x78, 0x74, 0x1a, 0x67, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x20,
+	0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x73, 0x88, 0x01, 0x01,
+	0x12, 0x20, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x61, 0x69, 0x72, 0x73, 0x88,
+	0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x1a, 0x3b,
+	0x0a, 0x03, 0x45, 0x78, 0x74, 0x12, 0x24, 0x0a, 0x0b, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f,
+	0x66, 0x6f, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x69,
+	0x6e, 0x67, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x3a, 0x15, 0x82, 0xbe, 0x8f,
+	0x02, 0x10, 0x2a, 0x0e, 0x0a, 0x0a, 0x69, 0x74, 0x65, 0x6d, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x10, 0x01, 0x22, 0x5a, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8a,
+	0x03, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1b,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x05, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6a, 0x35, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
 	0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72, 0x75, 0x6c,
-	0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6a, 0x35, 0x2e, 0x6c, 0x69, 0x73, 0x74,
-	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x75, 0x6c,
-	0x65, 0x73, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x32, 0x0a,
-	0x03, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6a, 0x35, 0x2e,
-	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78,
-	0x74, 0x1a, 0xa5, 0x02, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x07, 0x6d,
-	0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69,
-	0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75,
-	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01,
+	0x65, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6a, 0x35, 0x2e, 0x6c, 0x69, 0x73, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x54, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x03,
+	0x65, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6a, 0x35, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x1a, 0x98, 0x01,
+	0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65,
+	0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01, 0x52, 0x09, 0x6d, 0x69,
+	0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x61,
+	0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x02,
+	0x52, 0x09, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x88, 0x01, 0x01, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d,
+	0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x1a, 0x05, 0x0a, 0x03, 0x45, 0x78, 0x74, 0x3a,
+	0x0f, 0x82, 0xbe, 0x8f, 0x02, 0x0a, 0x2a, 0x08, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x83, 0x04, 0x0a, 0x08,
+	0x4b, 0x65, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x32, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a,
+	0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x33, 0x0a,
+	0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x6a, 0x35, 0x2e, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4b,
+	0x65, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4b,
+	0x65, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74,
+	0x12, 0x42, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x4b, 0x65, 0x79, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x44, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x52, 0x06, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x1a, 0x07, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x38, 0x0a,
+	0x03, 0x45, 0x78, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x66, 0x52, 0x07,
+	0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x1a, 0x7c, 0x0a, 0x13, 0x44, 0x65, 0x70, 0x72, 0x65,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x21,
+	0x0a, 0x0b, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x4b, 0x65,
+	0x79, 0x12, 0x3a, 0x0a, 0x0b, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x66, 0x48,
+	0x00, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4b, 0x65, 0x79, 0x42, 0x06, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x2a, 0x82, 0xbe, 0x8f, 0x02, 0x25, 0x2a, 0x0a, 0x0a, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x10, 0x01, 0x52, 0x17, 0x0a, 0x07, 0x66, 0x6f, 0x72, 0x65,
+	0x69, 0x67, 0x6e, 0x12, 0x03, 0x65, 0x78, 0x74, 0x12, 0x07, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67,
+	0x6e, 0x22, 0xf7, 0x03, 0x0a, 0x09, 0x4b, 0x65, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x3e, 0x0a, 0x08, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31,
+	0x2e, 0x4b, 0x65, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x2e, 0x49, 0x6e, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x12,
+	0x38, 0x0a, 0x06, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4b,
+	0x65, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x48,
+	0x00, 0x52, 0x06, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x12, 0x32, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x2e, 0x55, 0x55, 0x49, 0x44, 0x48, 0x00, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x32, 0x0a,
+	0x04, 0x69, 0x64, 0x36, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x35,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x2e, 0x49, 0x44, 0x36, 0x32, 0x48, 0x00, 0x52, 0x04, 0x69, 0x64, 0x36,
+	0x32, 0x12, 0x35, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x4b, 0x65, 0x79, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x48,
+	0x00, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x64, 0x1a, 0x0a, 0x0a, 0x08, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x6c, 0x1a, 0x4c, 0x0a, 0x06, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x12, 0x20,
+	0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x1a, 0x06, 0x0a, 0x04, 0x55, 0x55, 0x49, 0x44, 0x1a, 0x06, 0x0a, 0x04, 0x49, 0x44,
+	0x36, 0x32, 0x1a, 0x53, 0x0a, 0x05, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x03, 0x72,
+	0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x03, 0x72, 0x65, 0x66,
+	0x3a, 0x25, 0x82, 0xbe, 0x8f, 0x02, 0x20, 0x2a, 0x05, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x42, 0x17,
+	0x10, 0x01, 0x1a, 0x08, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2a, 0x09, 0x0a, 0x07,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x3a, 0x0a, 0x82, 0xbe, 0x8f, 0x02, 0x05, 0x22, 0x03,
+	0x0a, 0x01, 0x2e, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xea, 0x04, 0x0a, 0x0a,
+	0x46, 0x6c, 0x6f, 0x61, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x37, 0x0a, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6a, 0x35, 0x2e,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x34, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0a, 0x6c, 0x69, 0x73,
+	0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x6a, 0x35, 0x2e, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x12, 0x2e, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x6f,
+	0x61, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74,
+	0x1a, 0xa3, 0x02, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x11, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69,
+	0x76, 0x65, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75,
+	0x73, 0x69, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1d,
+	0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x02, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a,
+	0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03,
+	0x52, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x5f, 0x6f, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x48, 0x04, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x4f, 0x66, 0x88,
+	0x01, 0x01, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65,
+	0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d,
+	0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x70, 0x6c, 0x65, 0x5f, 0x6f, 0x66, 0x1a, 0x05, 0x0a, 0x03, 0x45, 0x78, 0x74, 0x22, 0x48, 0x0a,
+	0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x12, 0x0a, 0x0e, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x46, 0x4c, 0x4f, 0x41, 0x54, 0x33,
+	0x32, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x46, 0x4c,
+	0x4f, 0x41, 0x54, 0x36, 0x34, 0x10, 0x02, 0x3a, 0x0f, 0x82, 0xbe, 0x8f, 0x02, 0x0a, 0x2a, 0x08,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0xa3, 0x05, 0x0a, 0x0c, 0x49, 0x6e, 0x74,
+	0x65, 0x67, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x46, 0x0a, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6a, 0x35, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x42, 0x0b, 0xba, 0x48,
+	0x08, 0xc8, 0x01, 0x01, 0x82, 0x01, 0x02, 0x20, 0x00, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x12, 0x36, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x6c, 0x69, 0x73,
+	0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6a, 0x35, 0x2e, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x67,
+	0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x12, 0x30, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6e, 0x74, 0x65, 0x67, 0x65, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x45, 0x78, 0x74, 0x52,
+	0x03, 0x65, 0x78, 0x74, 0x1a, 0xa3, 0x02, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x30,
+	0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x69,
+	0x6d, 0x75, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x10, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01,
+	0x12, 0x30, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69,
+	0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x10, 0x65,
+	0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x88,
+	0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01,
+	0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x03, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01,
+	0x12, 0x24, 0x0a, 0x0b, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x5f, 0x6f, 0x66, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c,
+	0x65, 0x4f, 0x66, 0x88, 0x01, 0x01, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75,
+	0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x14, 0x0a, 0x12,
+	0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d,
+	0x75, 0x6d, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6d,
+	0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x5f, 0x6f, 0x66, 0x1a, 0x05, 0x0a, 0x03, 0x45, 0x78,
+	0x74, 0x22, 0x6a, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x46,
+	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x49, 0x4e,
+	0x54, 0x33, 0x32, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
+	0x49, 0x4e, 0x54, 0x36, 0x34, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x5f, 0x55, 0x49, 0x4e, 0x54, 0x33, 0x32, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x46, 0x4f,
+	0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x49, 0x4e, 0x54, 0x36, 0x34, 0x10, 0x04, 0x3a, 0x0f, 0x82,
+	0xbe, 0x8f, 0x02, 0x0a, 0x2a, 0x08, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0xda,
+	0x01, 0x0a, 0x09, 0x42, 0x6f, 0x6f, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x33, 0x0a, 0x05,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6a, 0x35,
+	0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x12, 0x34, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6a, 0x35, 0x2e, 0x6c, 0x69, 0x73, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x09, 0x6c, 0x69,
+	0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x45, 0x78,
+	0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x1a, 0x2c, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12,
+	0x19, 0x0a, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
+	0x52, 0x05, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x88, 0x01, 0x01, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x63,
+	0x6f, 0x6e, 0x73, 0x74, 0x1a, 0x05, 0x0a, 0x03, 0x45, 0x78, 0x74, 0x22, 0xe8, 0x01, 0x0a, 0x0a,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x34, 0x0a, 0x05, 0x72, 0x75,
+	0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6a, 0x35, 0x2e, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x12, 0x2e, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74,
+	0x1a, 0x6d, 0x0a, 0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0a, 0x6d, 0x69, 0x6e,
+	0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52,
+	0x09, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a,
+	0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x48, 0x01, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x88, 0x01,
+	0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x1a,
+	0x05, 0x0a, 0x03, 0x45, 0x78, 0x74, 0x22, 0xa8, 0x03, 0x0a, 0x0c, 0x44, 0x65, 0x63, 0x69, 0x6d,
+	0x61, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x36, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12,
+	0x37, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6a, 0x35, 0x2e, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x09, 0x6c,
+	0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x2e, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x1a, 0xed, 0x01, 0x0a, 0x05, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d,
+	0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x88,
+	0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f,
+	0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52,
+	0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75,
+	0x6d, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76,
+	0x65, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x03, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x78, 0x69,
+	0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d,
+	0x75, 0x6d, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x14,
+	0x0a, 0x12, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e,
+	0x69, 0x6d, 0x75, 0x6d, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69,
+	0x76, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x1a, 0x05, 0x0a, 0x03, 0x45, 0x78,
+	0x74, 0x22, 0x9c, 0x03, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x33, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61,
+	0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x6a, 0x35, 0x2e, 0x6c, 0x69,
+	0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52,
+	0x09, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x2d, 0x0a, 0x03, 0x65, 0x78,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x2e, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x1a, 0xed, 0x01, 0x0a, 0x05, 0x52, 0x75,
+	0x6c, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x88,
+	0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01,
 	0x01, 0x12, 0x30, 0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d,
 	0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x10,
 	0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d,
@@ -5264,53 +5323,83 @@ var file_j5_schema_v1_schema_proto_rawDesc = []byte{
 	0x12, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69,
 	0x6d, 0x75, 0x6d, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76,
 	0x65, 0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x1a, 0x05, 0x0a, 0x03, 0x45, 0x78, 0x74,
-	0x22, 0xe0, 0x02, 0x0a, 0x0e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65,
-	0x72, 0x74, 0x79, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e,
-	0x76, 0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a,
-	0xba, 0x48, 0x17, 0x72, 0x15, 0x32, 0x13, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x5d, 0x5b, 0x61, 0x2d,
-	0x7a, 0x41, 0x2d, 0x5a, 0x30, 0x2d, 0x39, 0x5d, 0x2a, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x13,
-	0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x6c, 0x79, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x65, 0x78, 0x70, 0x6c, 0x69,
-	0x63, 0x69, 0x74, 0x6c, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x20, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x35, 0x0a, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76,
-	0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x3a, 0x4b, 0x82, 0xbe, 0x8f, 0x02, 0x46, 0x1a, 0x06, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x1c, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22,
-	0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x2a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x61, 0x6c, 0x52, 0x1e, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12,
-	0x12, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x6c, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x61, 0x6c, 0x22, 0x6a, 0x0a, 0x09, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x65, 0x79,
-	0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68,
-	0x61, 0x72, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73,
-	0x68, 0x61, 0x72, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x1b, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e,
-	0x74, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2a,
-	0xb8, 0x01, 0x0a, 0x0a, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x74, 0x12, 0x1b,
-	0x0a, 0x17, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x55, 0x4e,
-	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x45,
-	0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x53, 0x10,
-	0x01, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x41, 0x52, 0x54,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x4e, 0x54, 0x49,
-	0x54, 0x59, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x03, 0x12,
-	0x14, 0x0a, 0x10, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x44,
-	0x41, 0x54, 0x41, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f,
-	0x50, 0x41, 0x52, 0x54, 0x5f, 0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x53, 0x10,
-	0x05, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x41, 0x52, 0x54,
-	0x5f, 0x44, 0x45, 0x52, 0x49, 0x56, 0x45, 0x44, 0x10, 0x06, 0x42, 0x4d, 0xf2, 0x85, 0x8f, 0x02,
-	0x14, 0x0a, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x63, 0x6f, 0x72, 0x65, 0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x70, 0x65, 0x6e, 0x74, 0x6f, 0x70, 0x73, 0x2f, 0x6a, 0x35, 0x2f, 0x67, 0x65, 0x6e,
-	0x2f, 0x6a, 0x35, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x5f, 0x6a, 0x35, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x22, 0xe8, 0x03, 0x0a, 0x0e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x38, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x39, 0x0a,
+	0x0a, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x6a, 0x35, 0x2e, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x09, 0x6c,
+	0x69, 0x73, 0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x03, 0x65, 0x78, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x2e, 0x45, 0x78, 0x74, 0x52, 0x03, 0x65, 0x78, 0x74, 0x1a, 0xa5, 0x02, 0x0a,
+	0x05, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01,
+	0x01, 0x12, 0x39, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01,
+	0x52, 0x07, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x11,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75,
+	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75,
+	0x73, 0x69, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01, 0x12, 0x30,
+	0x0a, 0x11, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x69,
+	0x6d, 0x75, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x10, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x88, 0x01, 0x01,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x6d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x42, 0x14,
+	0x0a, 0x12, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x61, 0x78,
+	0x69, 0x6d, 0x75, 0x6d, 0x1a, 0x05, 0x0a, 0x03, 0x45, 0x78, 0x74, 0x22, 0xe0, 0x02, 0x0a, 0x0e,
+	0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x12, 0x2b,
+	0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x2e, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xba, 0x48, 0x17, 0x72, 0x15,
+	0x32, 0x13, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x5d, 0x5b, 0x61, 0x2d, 0x7a, 0x41, 0x2d, 0x5a, 0x30,
+	0x2d, 0x39, 0x5d, 0x2a, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x13, 0x65, 0x78, 0x70, 0x6c, 0x69,
+	0x63, 0x69, 0x74, 0x6c, 0x79, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x6c, 0x79,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x09, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x52, 0x09, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x65,
+	0x79, 0x3a, 0x4b, 0x82, 0xbe, 0x8f, 0x02, 0x46, 0x1a, 0x06, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x22, 0x1c, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x2a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x1e,
+	0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x12, 0x65, 0x78, 0x70, 0x6c,
+	0x69, 0x63, 0x69, 0x74, 0x6c, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x6a,
+	0x0a, 0x09, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72,
+	0x69, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x73, 0x68, 0x61, 0x72, 0x64, 0x4b,
+	0x65, 0x79, 0x12, 0x1b, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x2a, 0xb8, 0x01, 0x0a, 0x0a, 0x45,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x61, 0x72, 0x74, 0x12, 0x1b, 0x0a, 0x17, 0x45, 0x4e, 0x54,
+	0x49, 0x54, 0x59, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59,
+	0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x4b, 0x45, 0x59, 0x53, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11,
+	0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x45, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x41,
+	0x52, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x4e,
+	0x54, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x44, 0x41, 0x54, 0x41, 0x10, 0x04,
+	0x12, 0x1a, 0x0a, 0x16, 0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f,
+	0x52, 0x45, 0x46, 0x45, 0x52, 0x45, 0x4e, 0x43, 0x45, 0x53, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13,
+	0x45, 0x4e, 0x54, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x5f, 0x44, 0x45, 0x52, 0x49,
+	0x56, 0x45, 0x44, 0x10, 0x06, 0x42, 0x4d, 0xf2, 0x85, 0x8f, 0x02, 0x14, 0x0a, 0x12, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x2f, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x72, 0x65,
+	0x5a, 0x32, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x65, 0x6e,
+	0x74, 0x6f, 0x70, 0x73, 0x2f, 0x6a, 0x35, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x6a, 0x35, 0x2f, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f,
+	0x6a, 0x35, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -5326,7 +5415,7 @@ func file_j5_schema_v1_schema_proto_rawDescGZIP() []byte {
 }
 
 var file_j5_schema_v1_schema_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_j5_schema_v1_schema_proto_msgTypes = make([]protoimpl.MessageInfo, 70)
+var file_j5_schema_v1_schema_proto_msgTypes = make([]protoimpl.MessageInfo, 71)
 var file_j5_schema_v1_schema_proto_goTypes = []any{
 	(EntityPart)(0),                      // 0: j5.schema.v1.EntityPart
 	(FloatField_Format)(0),               // 1: j5.schema.v1.FloatField.Format
@@ -5339,189 +5428,191 @@ var file_j5_schema_v1_schema_proto_goTypes = []any{
 	(*ObjectField)(nil),                  // 8: j5.schema.v1.ObjectField
 	(*InlineObject)(nil),                 // 9: j5.schema.v1.InlineObject
 	(*Object)(nil),                       // 10: j5.schema.v1.Object
-	(*EntityObject)(nil),                 // 11: j5.schema.v1.EntityObject
-	(*PolymorphField)(nil),               // 12: j5.schema.v1.PolymorphField
-	(*Polymorph)(nil),                    // 13: j5.schema.v1.Polymorph
-	(*OneofField)(nil),                   // 14: j5.schema.v1.OneofField
-	(*Oneof)(nil),                        // 15: j5.schema.v1.Oneof
-	(*EnumField)(nil),                    // 16: j5.schema.v1.EnumField
-	(*Enum)(nil),                         // 17: j5.schema.v1.Enum
-	(*ArrayField)(nil),                   // 18: j5.schema.v1.ArrayField
-	(*MapField)(nil),                     // 19: j5.schema.v1.MapField
-	(*StringFormat)(nil),                 // 20: j5.schema.v1.StringFormat
-	(*StringField)(nil),                  // 21: j5.schema.v1.StringField
-	(*KeyField)(nil),                     // 22: j5.schema.v1.KeyField
-	(*KeyFormat)(nil),                    // 23: j5.schema.v1.KeyFormat
-	(*FloatField)(nil),                   // 24: j5.schema.v1.FloatField
-	(*IntegerField)(nil),                 // 25: j5.schema.v1.IntegerField
-	(*BoolField)(nil),                    // 26: j5.schema.v1.BoolField
-	(*BytesField)(nil),                   // 27: j5.schema.v1.BytesField
-	(*DecimalField)(nil),                 // 28: j5.schema.v1.DecimalField
-	(*DateField)(nil),                    // 29: j5.schema.v1.DateField
-	(*TimestampField)(nil),               // 30: j5.schema.v1.TimestampField
-	(*ObjectProperty)(nil),               // 31: j5.schema.v1.ObjectProperty
-	(*EntityKey)(nil),                    // 32: j5.schema.v1.EntityKey
-	(*ObjectField_Rules)(nil),            // 33: j5.schema.v1.ObjectField.Rules
-	(*ObjectField_Ext)(nil),              // 34: j5.schema.v1.ObjectField.Ext
-	(*ObjectField_EntityJoin)(nil),       // 35: j5.schema.v1.ObjectField.EntityJoin
-	(*PolymorphField_Rules)(nil),         // 36: j5.schema.v1.PolymorphField.Rules
-	(*PolymorphField_Ext)(nil),           // 37: j5.schema.v1.PolymorphField.Ext
-	(*OneofField_Rules)(nil),             // 38: j5.schema.v1.OneofField.Rules
-	(*OneofField_Ext)(nil),               // 39: j5.schema.v1.OneofField.Ext
-	(*EnumField_Rules)(nil),              // 40: j5.schema.v1.EnumField.Rules
-	(*EnumField_Ext)(nil),                // 41: j5.schema.v1.EnumField.Ext
-	(*Enum_Option)(nil),                  // 42: j5.schema.v1.Enum.Option
-	(*Enum_OptionInfoField)(nil),         // 43: j5.schema.v1.Enum.OptionInfoField
-	nil,                                  // 44: j5.schema.v1.Enum.Option.InfoEntry
-	(*ArrayField_Ext)(nil),               // 45: j5.schema.v1.ArrayField.Ext
-	(*ArrayField_Rules)(nil),             // 46: j5.schema.v1.ArrayField.Rules
-	(*MapField_Rules)(nil),               // 47: j5.schema.v1.MapField.Rules
-	(*MapField_Ext)(nil),                 // 48: j5.schema.v1.MapField.Ext
-	(*StringField_Rules)(nil),            // 49: j5.schema.v1.StringField.Rules
-	(*StringField_Ext)(nil),              // 50: j5.schema.v1.StringField.Ext
-	(*KeyField_Rules)(nil),               // 51: j5.schema.v1.KeyField.Rules
-	(*KeyField_Ext)(nil),                 // 52: j5.schema.v1.KeyField.Ext
-	(*KeyField_DeprecatedEntityKey)(nil), // 53: j5.schema.v1.KeyField.DeprecatedEntityKey
-	(*KeyFormat_Informal)(nil),           // 54: j5.schema.v1.KeyFormat.Informal
-	(*KeyFormat_Custom)(nil),             // 55: j5.schema.v1.KeyFormat.Custom
-	(*KeyFormat_UUID)(nil),               // 56: j5.schema.v1.KeyFormat.UUID
-	(*KeyFormat_ID62)(nil),               // 57: j5.schema.v1.KeyFormat.ID62
-	(*KeyFormat_Named)(nil),              // 58: j5.schema.v1.KeyFormat.Named
-	(*FloatField_Rules)(nil),             // 59: j5.schema.v1.FloatField.Rules
-	(*FloatField_Ext)(nil),               // 60: j5.schema.v1.FloatField.Ext
-	(*IntegerField_Rules)(nil),           // 61: j5.schema.v1.IntegerField.Rules
-	(*IntegerField_Ext)(nil),             // 62: j5.schema.v1.IntegerField.Ext
-	(*BoolField_Rules)(nil),              // 63: j5.schema.v1.BoolField.Rules
-	(*BoolField_Ext)(nil),                // 64: j5.schema.v1.BoolField.Ext
-	(*BytesField_Rules)(nil),             // 65: j5.schema.v1.BytesField.Rules
-	(*BytesField_Ext)(nil),               // 66: j5.schema.v1.BytesField.Ext
-	(*DecimalField_Rules)(nil),           // 67: j5.schema.v1.DecimalField.Rules
-	(*DecimalField_Ext)(nil),             // 68: j5.schema.v1.DecimalField.Ext
-	(*DateField_Rules)(nil),              // 69: j5.schema.v1.DateField.Rules
-	(*DateField_Ext)(nil),                // 70: j5.schema.v1.DateField.Ext
-	(*TimestampField_Rules)(nil),         // 71: j5.schema.v1.TimestampField.Rules
-	(*TimestampField_Ext)(nil),           // 72: j5.schema.v1.TimestampField.Ext
-	(*list_j5pb.AnyRules)(nil),           // 73: j5.list.v1.AnyRules
-	(*bcl_j5pb.Block)(nil),               // 74: j5.bcl.v1.Block
-	(*list_j5pb.OneofRules)(nil),         // 75: j5.list.v1.OneofRules
-	(*list_j5pb.EnumRules)(nil),          // 76: j5.list.v1.EnumRules
-	(*list_j5pb.OpenTextRules)(nil),      // 77: j5.list.v1.OpenTextRules
-	(*list_j5pb.KeyRules)(nil),           // 78: j5.list.v1.KeyRules
-	(*list_j5pb.FloatRules)(nil),         // 79: j5.list.v1.FloatRules
-	(*list_j5pb.IntegerRules)(nil),       // 80: j5.list.v1.IntegerRules
-	(*list_j5pb.BoolRules)(nil),          // 81: j5.list.v1.BoolRules
-	(*list_j5pb.DecimalRules)(nil),       // 82: j5.list.v1.DecimalRules
-	(*list_j5pb.DateRules)(nil),          // 83: j5.list.v1.DateRules
-	(*list_j5pb.TimestampRules)(nil),     // 84: j5.list.v1.TimestampRules
-	(*timestamppb.Timestamp)(nil),        // 85: google.protobuf.Timestamp
+	(*ObjectRule)(nil),                   // 11: j5.schema.v1.ObjectRule
+	(*EntityObject)(nil),                 // 12: j5.schema.v1.EntityObject
+	(*PolymorphField)(nil),               // 13: j5.schema.v1.PolymorphField
+	(*Polymorph)(nil),                    // 14: j5.schema.v1.Polymorph
+	(*OneofField)(nil),                   // 15: j5.schema.v1.OneofField
+	(*Oneof)(nil),                        // 16: j5.schema.v1.Oneof
+	(*EnumField)(nil),                    // 17: j5.schema.v1.EnumField
+	(*Enum)(nil),                         // 18: j5.schema.v1.Enum
+	(*ArrayField)(nil),                   // 19: j5.schema.v1.ArrayField
+	(*MapField)(nil),                     // 20: j5.schema.v1.MapField
+	(*StringFormat)(nil),                 // 21: j5.schema.v1.StringFormat
+	(*StringField)(nil),                  // 22: j5.schema.v1.StringField
+	(*KeyField)(nil),                     // 23: j5.schema.v1.KeyField
+	(*KeyFormat)(nil),                    // 24: j5.schema.v1.KeyFormat
+	(*FloatField)(nil),                   // 25: j5.schema.v1.FloatField
+	(*IntegerField)(nil),                 // 26: j5.schema.v1.IntegerField
+	(*BoolField)(nil),                    // 27: j5.schema.v1.BoolField
+	(*BytesField)(nil),                   // 28: j5.schema.v1.BytesField
+	(*DecimalField)(nil),                 // 29: j5.schema.v1.DecimalField
+	(*DateField)(nil),                    // 30: j5.schema.v1.DateField
+	(*TimestampField)(nil),               // 31: j5.schema.v1.TimestampField
+	(*ObjectProperty)(nil),               // 32: j5.schema.v1.ObjectProperty
+	(*EntityKey)(nil),                    // 33: j5.schema.v1.EntityKey
+	(*ObjectField_Rules)(nil),            // 34: j5.schema.v1.ObjectField.Rules
+	(*ObjectField_Ext)(nil),              // 35: j5.schema.v1.ObjectField.Ext
+	(*ObjectField_EntityJoin)(nil),       // 36: j5.schema.v1.ObjectField.EntityJoin
+	(*PolymorphField_Rules)(nil),         // 37: j5.schema.v1.PolymorphField.Rules
+	(*PolymorphField_Ext)(nil),           // 38: j5.schema.v1.PolymorphField.Ext
+	(*OneofField_Rules)(nil),             // 39: j5.schema.v1.OneofField.Rules
+	(*OneofField_Ext)(nil),               // 40: j5.schema.v1.OneofField.Ext
+	(*EnumField_Rules)(nil),              // 41: j5.schema.v1.EnumField.Rules
+	(*EnumField_Ext)(nil),                // 42: j5.schema.v1.EnumField.Ext
+	(*Enum_Option)(nil),                  // 43: j5.schema.v1.Enum.Option
+	(*Enum_OptionInfoField)(nil),         // 44: j5.schema.v1.Enum.OptionInfoField
+	nil,                                  // 45: j5.schema.v1.Enum.Option.InfoEntry
+	(*ArrayField_Ext)(nil),               // 46: j5.schema.v1.ArrayField.Ext
+	(*ArrayField_Rules)(nil),             // 47: j5.schema.v1.ArrayField.Rules
+	(*MapField_Rules)(nil),               // 48: j5.schema.v1.MapField.Rules
+	(*MapField_Ext)(nil),                 // 49: j5.schema.v1.MapField.Ext
+	(*StringField_Rules)(nil),            // 50: j5.schema.v1.StringField.Rules
+	(*StringField_Ext)(nil),              // 51: j5.schema.v1.StringField.Ext
+	(*KeyField_Rules)(nil),               // 52: j5.schema.v1.KeyField.Rules
+	(*KeyField_Ext)(nil),                 // 53: j5.schema.v1.KeyField.Ext
+	(*KeyField_DeprecatedEntityKey)(nil), // 54: j5.schema.v1.KeyField.DeprecatedEntityKey
+	(*KeyFormat_Informal)(nil),           // 55: j5.schema.v1.KeyFormat.Informal
+	(*KeyFormat_Custom)(nil),             // 56: j5.schema.v1.KeyFormat.Custom
+	(*KeyFormat_UUID)(nil),               // 57: j5.schema.v1.KeyFormat.UUID
+	(*KeyFormat_ID62)(nil),               // 58: j5.schema.v1.KeyFormat.ID62
+	(*KeyFormat_Named)(nil),              // 59: j5.schema.v1.KeyFormat.Named
+	(*FloatField_Rules)(nil),             // 60: j5.schema.v1.FloatField.Rules
+	(*FloatField_Ext)(nil),               // 61: j5.schema.v1.FloatField.Ext
+	(*IntegerField_Rules)(nil),           // 62: j5.schema.v1.IntegerField.Rules
+	(*IntegerField_Ext)(nil),             // 63: j5.schema.v1.IntegerField.Ext
+	(*BoolField_Rules)(nil),              // 64: j5.schema.v1.BoolField.Rules
+	(*BoolField_Ext)(nil),                // 65: j5.schema.v1.BoolField.Ext
+	(*BytesField_Rules)(nil),             // 66: j5.schema.v1.BytesField.Rules
+	(*BytesField_Ext)(nil),               // 67: j5.schema.v1.BytesField.Ext
+	(*DecimalField_Rules)(nil),           // 68: j5.schema.v1.DecimalField.Rules
+	(*DecimalField_Ext)(nil),             // 69: j5.schema.v1.DecimalField.Ext
+	(*DateField_Rules)(nil),              // 70: j5.schema.v1.DateField.Rules
+	(*DateField_Ext)(nil),                // 71: j5.schema.v1.DateField.Ext
+	(*TimestampField_Rules)(nil),         // 72: j5.schema.v1.TimestampField.Rules
+	(*TimestampField_Ext)(nil),           // 73: j5.schema.v1.TimestampField.Ext
+	(*list_j5pb.AnyRules)(nil),           // 74: j5.list.v1.AnyRules
+	(*bcl_j5pb.Block)(nil),               // 75: j5.bcl.v1.Block
+	(*list_j5pb.OneofRules)(nil),         // 76: j5.list.v1.OneofRules
+	(*list_j5pb.EnumRules)(nil),          // 77: j5.list.v1.EnumRules
+	(*list_j5pb.OpenTextRules)(nil),      // 78: j5.list.v1.OpenTextRules
+	(*list_j5pb.KeyRules)(nil),           // 79: j5.list.v1.KeyRules
+	(*list_j5pb.FloatRules)(nil),         // 80: j5.list.v1.FloatRules
+	(*list_j5pb.IntegerRules)(nil),       // 81: j5.list.v1.IntegerRules
+	(*list_j5pb.BoolRules)(nil),          // 82: j5.list.v1.BoolRules
+	(*list_j5pb.DecimalRules)(nil),       // 83: j5.list.v1.DecimalRules
+	(*list_j5pb.DateRules)(nil),          // 84: j5.list.v1.DateRules
+	(*list_j5pb.TimestampRules)(nil),     // 85: j5.list.v1.TimestampRules
+	(*timestamppb.Timestamp)(nil),        // 86: google.protobuf.Timestamp
 }
 var file_j5_schema_v1_schema_proto_depIdxs = []int32{
-	15,  // 0: j5.schema.v1.RootSchema.oneof:type_name -> j5.schema.v1.Oneof
+	16,  // 0: j5.schema.v1.RootSchema.oneof:type_name -> j5.schema.v1.Oneof
 	10,  // 1: j5.schema.v1.RootSchema.object:type_name -> j5.schema.v1.Object
-	17,  // 2: j5.schema.v1.RootSchema.enum:type_name -> j5.schema.v1.Enum
-	13,  // 3: j5.schema.v1.RootSchema.polymorph:type_name -> j5.schema.v1.Polymorph
+	18,  // 2: j5.schema.v1.RootSchema.enum:type_name -> j5.schema.v1.Enum
+	14,  // 3: j5.schema.v1.RootSchema.polymorph:type_name -> j5.schema.v1.Polymorph
 	7,   // 4: j5.schema.v1.Field.any:type_name -> j5.schema.v1.AnyField
-	14,  // 5: j5.schema.v1.Field.oneof:type_name -> j5.schema.v1.OneofField
+	15,  // 5: j5.schema.v1.Field.oneof:type_name -> j5.schema.v1.OneofField
 	8,   // 6: j5.schema.v1.Field.object:type_name -> j5.schema.v1.ObjectField
-	12,  // 7: j5.schema.v1.Field.polymorph:type_name -> j5.schema.v1.PolymorphField
-	16,  // 8: j5.schema.v1.Field.enum:type_name -> j5.schema.v1.EnumField
-	18,  // 9: j5.schema.v1.Field.array:type_name -> j5.schema.v1.ArrayField
-	19,  // 10: j5.schema.v1.Field.map:type_name -> j5.schema.v1.MapField
-	21,  // 11: j5.schema.v1.Field.string:type_name -> j5.schema.v1.StringField
-	25,  // 12: j5.schema.v1.Field.integer:type_name -> j5.schema.v1.IntegerField
-	24,  // 13: j5.schema.v1.Field.float:type_name -> j5.schema.v1.FloatField
-	26,  // 14: j5.schema.v1.Field.bool:type_name -> j5.schema.v1.BoolField
-	27,  // 15: j5.schema.v1.Field.bytes:type_name -> j5.schema.v1.BytesField
-	28,  // 16: j5.schema.v1.Field.decimal:type_name -> j5.schema.v1.DecimalField
-	29,  // 17: j5.schema.v1.Field.date:type_name -> j5.schema.v1.DateField
-	30,  // 18: j5.schema.v1.Field.timestamp:type_name -> j5.schema.v1.TimestampField
-	22,  // 19: j5.schema.v1.Field.key:type_name -> j5.schema.v1.KeyField
-	73,  // 20: j5.schema.v1.AnyField.list_rules:type_name -> j5.list.v1.AnyRules
+	13,  // 7: j5.schema.v1.Field.polymorph:type_name -> j5.schema.v1.PolymorphField
+	17,  // 8: j5.schema.v1.Field.enum:type_name -> j5.schema.v1.EnumField
+	19,  // 9: j5.schema.v1.Field.array:type_name -> j5.schema.v1.ArrayField
+	20,  // 10: j5.schema.v1.Field.map:type_name -> j5.schema.v1.MapField
+	22,  // 11: j5.schema.v1.Field.string:type_name -> j5.schema.v1.StringField
+	26,  // 12: j5.schema.v1.Field.integer:type_name -> j5.schema.v1.IntegerField
+	25,  // 13: j5.schema.v1.Field.float:type_name -> j5.schema.v1.FloatField
+	27,  // 14: j5.schema.v1.Field.bool:type_name -> j5.schema.v1.BoolField
+	28,  // 15: j5.schema.v1.Field.bytes:type_name -> j5.schema.v1.BytesField
+	29,  // 16: j5.schema.v1.Field.decimal:type_name -> j5.schema.v1.DecimalField
+	30,  // 17: j5.schema.v1.Field.date:type_name -> j5.schema.v1.DateField
+	31,  // 18: j5.schema.v1.Field.timestamp:type_name -> j5.schema.v1.TimestampField
+	23,  // 19: j5.schema.v1.Field.key:type_name -> j5.schema.v1.KeyField
+	74,  // 20: j5.schema.v1.AnyField.list_rules:type_name -> j5.list.v1.AnyRules
 	5,   // 21: j5.schema.v1.ObjectField.ref:type_name -> j5.schema.v1.Ref
 	10,  // 22: j5.schema.v1.ObjectField.object:type_name -> j5.schema.v1.Object
-	33,  // 23: j5.schema.v1.ObjectField.rules:type_name -> j5.schema.v1.ObjectField.Rules
-	34,  // 24: j5.schema.v1.ObjectField.ext:type_name -> j5.schema.v1.ObjectField.Ext
-	35,  // 25: j5.schema.v1.ObjectField.entity:type_name -> j5.schema.v1.ObjectField.EntityJoin
-	31,  // 26: j5.schema.v1.InlineObject.properties:type_name -> j5.schema.v1.ObjectProperty
-	11,  // 27: j5.schema.v1.Object.entity:type_name -> j5.schema.v1.EntityObject
-	31,  // 28: j5.schema.v1.Object.properties:type_name -> j5.schema.v1.ObjectProperty
-	74,  // 29: j5.schema.v1.Object.bcl:type_name -> j5.bcl.v1.Block
-	0,   // 30: j5.schema.v1.EntityObject.part:type_name -> j5.schema.v1.EntityPart
-	5,   // 31: j5.schema.v1.PolymorphField.ref:type_name -> j5.schema.v1.Ref
-	13,  // 32: j5.schema.v1.PolymorphField.polymorph:type_name -> j5.schema.v1.Polymorph
-	36,  // 33: j5.schema.v1.PolymorphField.rules:type_name -> j5.schema.v1.PolymorphField.Rules
-	37,  // 34: j5.schema.v1.PolymorphField.ext:type_name -> j5.schema.v1.PolymorphField.Ext
-	5,   // 35: j5.schema.v1.OneofField.ref:type_name -> j5.schema.v1.Ref
-	15,  // 36: j5.schema.v1.OneofField.oneof:type_name -> j5.schema.v1.Oneof
-	38,  // 37: j5.schema.v1.OneofField.rules:type_name -> j5.schema.v1.OneofField.Rules
-	75,  // 38: j5.schema.v1.OneofField.list_rules:type_name -> j5.list.v1.OneofRules
-	39,  // 39: j5.schema.v1.OneofField.ext:type_name -> j5.schema.v1.OneofField.Ext
-	74,  // 40: j5.schema.v1.Oneof.bcl:type_name -> j5.bcl.v1.Block
-	31,  // 41: j5.schema.v1.Oneof.properties:type_name -> j5.schema.v1.ObjectProperty
-	5,   // 42: j5.schema.v1.EnumField.ref:type_name -> j5.schema.v1.Ref
-	17,  // 43: j5.schema.v1.EnumField.enum:type_name -> j5.schema.v1.Enum
-	40,  // 44: j5.schema.v1.EnumField.rules:type_name -> j5.schema.v1.EnumField.Rules
-	76,  // 45: j5.schema.v1.EnumField.list_rules:type_name -> j5.list.v1.EnumRules
-	41,  // 46: j5.schema.v1.EnumField.ext:type_name -> j5.schema.v1.EnumField.Ext
-	42,  // 47: j5.schema.v1.Enum.options:type_name -> j5.schema.v1.Enum.Option
-	43,  // 48: j5.schema.v1.Enum.info:type_name -> j5.schema.v1.Enum.OptionInfoField
-	46,  // 49: j5.schema.v1.ArrayField.rules:type_name -> j5.schema.v1.ArrayField.Rules
-	4,   // 50: j5.schema.v1.ArrayField.items:type_name -> j5.schema.v1.Field
-	45,  // 51: j5.schema.v1.ArrayField.ext:type_name -> j5.schema.v1.ArrayField.Ext
-	4,   // 52: j5.schema.v1.MapField.item_schema:type_name -> j5.schema.v1.Field
-	4,   // 53: j5.schema.v1.MapField.key_schema:type_name -> j5.schema.v1.Field
-	47,  // 54: j5.schema.v1.MapField.rules:type_name -> j5.schema.v1.MapField.Rules
-	48,  // 55: j5.schema.v1.MapField.ext:type_name -> j5.schema.v1.MapField.Ext
-	49,  // 56: j5.schema.v1.StringField.rules:type_name -> j5.schema.v1.StringField.Rules
-	77,  // 57: j5.schema.v1.StringField.list_rules:type_name -> j5.list.v1.OpenTextRules
-	50,  // 58: j5.schema.v1.StringField.ext:type_name -> j5.schema.v1.StringField.Ext
-	51,  // 59: j5.schema.v1.KeyField.rules:type_name -> j5.schema.v1.KeyField.Rules
-	23,  // 60: j5.schema.v1.KeyField.format:type_name -> j5.schema.v1.KeyFormat
-	78,  // 61: j5.schema.v1.KeyField.list_rules:type_name -> j5.list.v1.KeyRules
-	52,  // 62: j5.schema.v1.KeyField.ext:type_name -> j5.schema.v1.KeyField.Ext
-	53,  // 63: j5.schema.v1.KeyField.entity:type_name -> j5.schema.v1.KeyField.DeprecatedEntityKey
-	54,  // 64: j5.schema.v1.KeyFormat.informal:type_name -> j5.schema.v1.KeyFormat.Informal
-	55,  // 65: j5.schema.v1.KeyFormat.custom:type_name -> j5.schema.v1.KeyFormat.Custom
-	56,  // 66: j5.schema.v1.KeyFormat.uuid:type_name -> j5.schema.v1.KeyFormat.UUID
-	57,  // 67: j5.schema.v1.KeyFormat.id62:type_name -> j5.schema.v1.KeyFormat.ID62
-	58,  // 68: j5.schema.v1.KeyFormat.named:type_name -> j5.schema.v1.KeyFormat.Named
-	1,   // 69: j5.schema.v1.FloatField.format:type_name -> j5.schema.v1.FloatField.Format
-	59,  // 70: j5.schema.v1.FloatField.rules:type_name -> j5.schema.v1.FloatField.Rules
-	79,  // 71: j5.schema.v1.FloatField.list_rules:type_name -> j5.list.v1.FloatRules
-	60,  // 72: j5.schema.v1.FloatField.ext:type_name -> j5.schema.v1.FloatField.Ext
-	2,   // 73: j5.schema.v1.IntegerField.format:type_name -> j5.schema.v1.IntegerField.Format
-	61,  // 74: j5.schema.v1.IntegerField.rules:type_name -> j5.schema.v1.IntegerField.Rules
-	80,  // 75: j5.schema.v1.IntegerField.list_rules:type_name -> j5.list.v1.IntegerRules
-	62,  // 76: j5.schema.v1.IntegerField.ext:type_name -> j5.schema.v1.IntegerField.Ext
-	63,  // 77: j5.schema.v1.BoolField.rules:type_name -> j5.schema.v1.BoolField.Rules
-	81,  // 78: j5.schema.v1.BoolField.list_rules:type_name -> j5.list.v1.BoolRules
-	64,  // 79: j5.schema.v1.BoolField.ext:type_name -> j5.schema.v1.BoolField.Ext
-	65,  // 80: j5.schema.v1.BytesField.rules:type_name -> j5.schema.v1.BytesField.Rules
-	66,  // 81: j5.schema.v1.BytesField.ext:type_name -> j5.schema.v1.BytesField.Ext
-	67,  // 82: j5.schema.v1.DecimalField.rules:type_name -> j5.schema.v1.DecimalField.Rules
-	82,  // 83: j5.schema.v1.DecimalField.list_rules:type_name -> j5.list.v1.DecimalRules
-	68,  // 84: j5.schema.v1.DecimalField.ext:type_name -> j5.schema.v1.DecimalField.Ext
-	69,  // 85: j5.schema.v1.DateField.rules:type_name -> j5.schema.v1.DateField.Rules
-	83,  // 86: j5.schema.v1.DateField.list_rules:type_name -> j5.list.v1.DateRules
-	70,  // 87: j5.schema.v1.DateField.ext:type_name -> j5.schema.v1.DateField.Ext
-	71,  // 88: j5.schema.v1.TimestampField.rules:type_name -> j5.schema.v1.TimestampField.Rules
-	84,  // 89: j5.schema.v1.TimestampField.list_rules:type_name -> j5.list.v1.TimestampRules
-	72,  // 90: j5.schema.v1.TimestampField.ext:type_name -> j5.schema.v1.TimestampField.Ext
-	4,   // 91: j5.schema.v1.ObjectProperty.schema:type_name -> j5.schema.v1.Field
-	32,  // 92: j5.schema.v1.ObjectProperty.entityKey:type_name -> j5.schema.v1.EntityKey
-	6,   // 93: j5.schema.v1.ObjectField.EntityJoin.entity:type_name -> j5.schema.v1.EntityRef
-	0,   // 94: j5.schema.v1.ObjectField.EntityJoin.entity_part:type_name -> j5.schema.v1.EntityPart
-	44,  // 95: j5.schema.v1.Enum.Option.info:type_name -> j5.schema.v1.Enum.Option.InfoEntry
-	6,   // 96: j5.schema.v1.KeyField.Ext.foreign:type_name -> j5.schema.v1.EntityRef
-	6,   // 97: j5.schema.v1.KeyField.DeprecatedEntityKey.foreign_key:type_name -> j5.schema.v1.EntityRef
-	5,   // 98: j5.schema.v1.KeyFormat.Named.ref:type_name -> j5.schema.v1.Ref
-	85,  // 99: j5.schema.v1.TimestampField.Rules.minimum:type_name -> google.protobuf.Timestamp
-	85,  // 100: j5.schema.v1.TimestampField.Rules.maximum:type_name -> google.protobuf.Timestamp
-	101, // [101:101] is the sub-list for method output_type
-	101, // [101:101] is the sub-list for method input_type
-	101, // [101:101] is the sub-list for extension type_name
-	101, // [101:101] is the sub-list for extension extendee
-	0,   // [0:101] is the sub-list for field type_name
+	34,  // 23: j5.schema.v1.ObjectField.rules:type_name -> j5.schema.v1.ObjectField.Rules
+	35,  // 24: j5.schema.v1.ObjectField.ext:type_name -> j5.schema.v1.ObjectField.Ext
+	36,  // 25: j5.schema.v1.ObjectField.entity:type_name -> j5.schema.v1.ObjectField.EntityJoin
+	32,  // 26: j5.schema.v1.InlineObject.properties:type_name -> j5.schema.v1.ObjectProperty
+	12,  // 27: j5.schema.v1.Object.entity:type_name -> j5.schema.v1.EntityObject
+	32,  // 28: j5.schema.v1.Object.properties:type_name -> j5.schema.v1.ObjectProperty
+	75,  // 29: j5.schema.v1.Object.bcl:type_name -> j5.bcl.v1.Block
+	11,  // 30: j5.schema.v1.Object.rules:type_name -> j5.schema.v1.ObjectRule
+	0,   // 31: j5.schema.v1.EntityObject.part:type_name -> j5.schema.v1.EntityPart
+	5,   // 32: j5.schema.v1.PolymorphField.ref:type_name -> j5.schema.v1.Ref
+	14,  // 33: j5.schema.v1.PolymorphField.polymorph:type_name -> j5.schema.v1.Polymorph
+	37,  // 34: j5.schema.v1.PolymorphField.rules:type_name -> j5.schema.v1.PolymorphField.Rules
+	38,  // 35: j5.schema.v1.PolymorphField.ext:type_name -> j5.schema.v1.PolymorphField.Ext
+	5,   // 36: j5.schema.v1.OneofField.ref:type_name -> j5.schema.v1.Ref
+	16,  // 37: j5.schema.v1.OneofField.oneof:type_name -> j5.schema.v1.Oneof
+	39,  // 38: j5.schema.v1.OneofField.rules:type_name -> j5.schema.v1.OneofField.Rules
+	76,  // 39: j5.schema.v1.OneofField.list_rules:type_name -> j5.list.v1.OneofRules
+	40,  // 40: j5.schema.v1.OneofField.ext:type_name -> j5.schema.v1.OneofField.Ext
+	75,  // 41: j5.schema.v1.Oneof.bcl:type_name -> j5.bcl.v1.Block
+	32,  // 42: j5.schema.v1.Oneof.properties:type_name -> j5.schema.v1.ObjectProperty
+	5,   // 43: j5.schema.v1.EnumField.ref:type_name -> j5.schema.v1.Ref
+	18,  // 44: j5.schema.v1.EnumField.enum:type_name -> j5.schema.v1.Enum
+	41,  // 45: j5.schema.v1.EnumField.rules:type_name -> j5.schema.v1.EnumField.Rules
+	77,  // 46: j5.schema.v1.EnumField.list_rules:type_name -> j5.list.v1.EnumRules
+	42,  // 47: j5.schema.v1.EnumField.ext:type_name -> j5.schema.v1.EnumField.Ext
+	43,  // 48: j5.schema.v1.Enum.options:type_name -> j5.schema.v1.Enum.Option
+	44,  // 49: j5.schema.v1.Enum.info:type_name -> j5.schema.v1.Enum.OptionInfoField
+	47,  // 50: j5.schema.v1.ArrayField.rules:type_name -> j5.schema.v1.ArrayField.Rules
+	4,   // 51: j5.schema.v1.ArrayField.items:type_name -> j5.schema.v1.Field
+	46,  // 52: j5.schema.v1.ArrayField.ext:type_name -> j5.schema.v1.ArrayField.Ext
+	4,   // 53: j5.schema.v1.MapField.item_schema:type_name -> j5.schema.v1.Field
+	4,   // 54: j5.schema.v1.MapField.key_schema:type_name -> j5.schema.v1.Field
+	48,  // 55: j5.schema.v1.MapField.rules:type_name -> j5.schema.v1.MapField.Rules
+	49,  // 56: j5.schema.v1.MapField.ext:type_name -> j5.schema.v1.MapField.Ext
+	50,  // 57: j5.schema.v1.StringField.rules:type_name -> j5.schema.v1.StringField.Rules
+	78,  // 58: j5.schema.v1.StringField.list_rules:type_name -> j5.list.v1.OpenTextRules
+	51,  // 59: j5.schema.v1.StringField.ext:type_name -> j5.schema.v1.StringField.Ext
+	52,  // 60: j5.schema.v1.KeyField.rules:type_name -> j5.schema.v1.KeyField.Rules
+	24,  // 61: j5.schema.v1.KeyField.format:type_name -> j5.schema.v1.KeyFormat
+	79,  // 62: j5.schema.v1.KeyField.list_rules:type_name -> j5.list.v1.KeyRules
+	53,  // 63: j5.schema.v1.KeyField.ext:type_name -> j5.schema.v1.KeyField.Ext
+	54,  // 64: j5.schema.v1.KeyField.entity:type_name -> j5.schema.v1.KeyField.DeprecatedEntityKey
+	55,  // 65: j5.schema.v1.KeyFormat.informal:type_name -> j5.schema.v1.KeyFormat.Informal
+	56,  // 66: j5.schema.v1.KeyFormat.custom:type_name -> j5.schema.v1.KeyFormat.Custom
+	57,  // 67: j5.schema.v1.KeyFormat.uuid:type_name -> j5.schema.v1.KeyFormat.UUID
+	58,  // 68: j5.schema.v1.KeyFormat.id62:type_name -> j5.schema.v1.KeyFormat.ID62
+	59,  // 69: j5.schema.v1.KeyFormat.named:type_name -> j5.schema.v1.KeyFormat.Named
+	1,   // 70: j5.schema.v1.FloatField.format:type_name -> j5.schema.v1.FloatField.Format
+	60,  // 71: j5.schema.v1.FloatField.rules:type_name -> j5.schema.v1.FloatField.Rules
+	80,  // 72: j5.schema.v1.FloatField.list_rules:type_name -> j5.list.v1.FloatRules
+	61,  // 73: j5.schema.v1.FloatField.ext:type_name -> j5.schema.v1.FloatField.Ext
+	2,   // 74: j5.schema.v1.IntegerField.format:type_name -> j5.schema.v1.IntegerField.Format
+	62,  // 75: j5.schema.v1.IntegerField.rules:type_name -> j5.schema.v1.IntegerField.Rules
+	81,  // 76: j5.schema.v1.IntegerField.list_rules:type_name -> j5.list.v1.IntegerRules
+	63,  // 77: j5.schema.v1.IntegerField.ext:type_name -> j5.schema.v1.IntegerField.Ext
+	64,  // 78: j5.schema.v1.BoolField.rules:type_name -> j5.schema.v1.BoolField.Rules
+	82,  // 79: j5.schema.v1.BoolField.list_rules:type_name -> j5.list.v1.BoolRules
+	65,  // 80: j5.schema.v1.BoolField.ext:type_name -> j5.schema.v1.BoolField.Ext
+	66,  // 81: j5.schema.v1.BytesField.rules:type_name -> j5.schema.v1.BytesField.Rules
+	67,  // 82: j5.schema.v1.BytesField.ext:type_name -> j5.schema.v1.BytesField.Ext
+	68,  // 83: j5.schema.v1.DecimalField.rules:type_name -> j5.schema.v1.DecimalField.Rules
+	83,  // 84: j5.schema.v1.DecimalField.list_rules:type_name -> j5.list.v1.DecimalRules
+	69,  // 85: j5.schema.v1.DecimalField.ext:type_name -> j5.schema.v1.DecimalField.Ext
+	70,  // 86: j5.schema.v1.DateField.rules:type_name -> j5.schema.v1.DateField.Rules
+	84,  // 87: j5.schema.v1.DateField.list_rules:type_name -> j5.list.v1.DateRules
+	71,  // 88: j5.schema.v1.DateField.ext:type_name -> j5.schema.v1.DateField.Ext
+	72,  // 89: j5.schema.v1.TimestampField.rules:type_name -> j5.schema.v1.TimestampField.Rules
+	85,  // 90: j5.schema.v1.TimestampField.list_rules:type_name -> j5.list.v1.TimestampRules
+	73,  // 91: j5.schema.v1.TimestampField.ext:type_name -> j5.schema.v1.TimestampField.Ext
+	4,   // 92: j5.schema.v1.ObjectProperty.schema:type_name -> j5.schema.v1.Field
+	33,  // 93: j5.schema.v1.ObjectProperty.entityKey:type_name -> j5.schema.v1.EntityKey
+	6,   // 94: j5.schema.v1.ObjectField.EntityJoin.entity:type_name -> j5.schema.v1.EntityRef
+	0,   // 95: j5.schema.v1.ObjectField.EntityJoin.entity_part:type_name -> j5.schema.v1.EntityPart
+	45,  // 96: j5.schema.v1.Enum.Option.info:type_name -> j5.schema.v1.Enum.Option.InfoEntry
+	6,   // 97: j5.schema.v1.KeyField.Ext.foreign:type_name -> j5.schema.v1.EntityRef
+	6,   // 98: j5.schema.v1.KeyField.DeprecatedEntityKey.foreign_key:type_name -> j5.schema.v1.EntityRef
+	5,   // 99: j5.schema.v1.KeyFormat.Named.ref:type_name -> j5.schema.v1.Ref
+	86,  // 100: j5.schema.v1.TimestampField.Rules.minimum:type_name -> google.protobuf.Timestamp
+	86,  // 101: j5.schema.v1.TimestampField.Rules.maximum:type_name -> google.protobuf.Timestamp
+	102, // [102:102] is the sub-list for method output_type
+	102, // [102:102] is the sub-list for method input_type
+	102, // [102:102] is the sub-list for extension type_name
+	102, // [102:102] is the sub-list for extension extendee
+	0,   // [0:102] is the sub-list for field type_name
 }
 
 func init() { file_j5_schema_v1_schema_proto_init() }
@@ -5627,7 +5718,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*EntityObject); i {
+			switch v := v.(*ObjectRule); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5639,7 +5730,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[9].Exporter = func(v any, i int) any {
-			switch v := v.(*PolymorphField); i {
+			switch v := v.(*EntityObject); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5651,7 +5742,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[10].Exporter = func(v any, i int) any {
-			switch v := v.(*Polymorph); i {
+			switch v := v.(*PolymorphField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5663,7 +5754,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[11].Exporter = func(v any, i int) any {
-			switch v := v.(*OneofField); i {
+			switch v := v.(*Polymorph); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5675,7 +5766,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[12].Exporter = func(v any, i int) any {
-			switch v := v.(*Oneof); i {
+			switch v := v.(*OneofField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5687,7 +5778,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[13].Exporter = func(v any, i int) any {
-			switch v := v.(*EnumField); i {
+			switch v := v.(*Oneof); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5699,7 +5790,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[14].Exporter = func(v any, i int) any {
-			switch v := v.(*Enum); i {
+			switch v := v.(*EnumField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5711,7 +5802,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[15].Exporter = func(v any, i int) any {
-			switch v := v.(*ArrayField); i {
+			switch v := v.(*Enum); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5723,7 +5814,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[16].Exporter = func(v any, i int) any {
-			switch v := v.(*MapField); i {
+			switch v := v.(*ArrayField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5735,7 +5826,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[17].Exporter = func(v any, i int) any {
-			switch v := v.(*StringFormat); i {
+			switch v := v.(*MapField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5747,7 +5838,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[18].Exporter = func(v any, i int) any {
-			switch v := v.(*StringField); i {
+			switch v := v.(*StringFormat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5759,7 +5850,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[19].Exporter = func(v any, i int) any {
-			switch v := v.(*KeyField); i {
+			switch v := v.(*StringField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5771,7 +5862,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[20].Exporter = func(v any, i int) any {
-			switch v := v.(*KeyFormat); i {
+			switch v := v.(*KeyField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5783,7 +5874,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*FloatField); i {
+			switch v := v.(*KeyFormat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5795,7 +5886,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*IntegerField); i {
+			switch v := v.(*FloatField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5807,7 +5898,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*BoolField); i {
+			switch v := v.(*IntegerField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5819,7 +5910,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[24].Exporter = func(v any, i int) any {
-			switch v := v.(*BytesField); i {
+			switch v := v.(*BoolField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5831,7 +5922,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[25].Exporter = func(v any, i int) any {
-			switch v := v.(*DecimalField); i {
+			switch v := v.(*BytesField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5843,7 +5934,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[26].Exporter = func(v any, i int) any {
-			switch v := v.(*DateField); i {
+			switch v := v.(*DecimalField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5855,7 +5946,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[27].Exporter = func(v any, i int) any {
-			switch v := v.(*TimestampField); i {
+			switch v := v.(*DateField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5867,7 +5958,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[28].Exporter = func(v any, i int) any {
-			switch v := v.(*ObjectProperty); i {
+			switch v := v.(*TimestampField); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5879,7 +5970,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[29].Exporter = func(v any, i int) any {
-			switch v := v.(*EntityKey); i {
+			switch v := v.(*ObjectProperty); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5891,7 +5982,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[30].Exporter = func(v any, i int) any {
-			switch v := v.(*ObjectField_Rules); i {
+			switch v := v.(*EntityKey); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5903,7 +5994,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[31].Exporter = func(v any, i int) any {
-			switch v := v.(*ObjectField_Ext); i {
+			switch v := v.(*ObjectField_Rules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5915,7 +6006,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[32].Exporter = func(v any, i int) any {
-			switch v := v.(*ObjectField_EntityJoin); i {
+			switch v := v.(*ObjectField_Ext); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5927,7 +6018,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[33].Exporter = func(v any, i int) any {
-			switch v := v.(*PolymorphField_Rules); i {
+			switch v := v.(*ObjectField_EntityJoin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5939,7 +6030,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[34].Exporter = func(v any, i int) any {
-			switch v := v.(*PolymorphField_Ext); i {
+			switch v := v.(*PolymorphField_Rules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5951,7 +6042,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[35].Exporter = func(v any, i int) any {
-			switch v := v.(*OneofField_Rules); i {
+			switch v := v.(*PolymorphField_Ext); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5963,7 +6054,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[36].Exporter = func(v any, i int) any {
-			switch v := v.(*OneofField_Ext); i {
+			switch v := v.(*OneofField_Rules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5975,7 +6066,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[37].Exporter = func(v any, i int) any {
-			switch v := v.(*EnumField_Rules); i {
+			switch v := v.(*OneofField_Ext); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5987,7 +6078,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[38].Exporter = func(v any, i int) any {
-			switch v := v.(*EnumField_Ext); i {
+			switch v := v.(*EnumField_Rules); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5999,7 +6090,7 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[39].Exporter = func(v any, i int) any {
-			switch v := v.(*Enum_Option); i {
+			switch v := v.(*EnumField_Ext); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6011,6 +6102,18 @@ func file_j5_schema_v1_schema_proto_init() {
 			}
 		}
 		file_j5_schema_v1_schema_proto_msgTypes[40].Exporter = func(v any, i int) any {
+			switch v := v.(*Enum_Option); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_j5_schema_v1_schema_proto_msgTypes[41].Exporter = func(v any, i int) any {
 			switch v := v.(*Enum_OptionInfoField); i {
 			case 0:
 				return &v.state
@@ -6022,7 +6125,7 @@ func file_j5_schema_v1_schema_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_schema_proto_msgTypes[42].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_schema_proto_msgTypes[43].Exporter = func(v any, i int) any {
 			switch v := v.(*ArrayField_Ext); i {
 			case 0:
 				return &v.state
@@ -6034,7 +6137,7 @@ func file_j5_schema_v1_schema_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_schema_proto_msgTypes[43].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_schema_proto_msgTypes[44].Exporter = func(v any, i int) any {
 			switch v := v.(*ArrayField_Rules); i {
 			case 0:
 				return &v.state
@@ -6046,7 +6149,7 @@ func file_j5_schema_v1_schema_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_schema_proto_msgTypes[44].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_schema_proto_msgTypes[45].Exporter = func(v any, i int) any {
 			switch v := v.(*MapField_Rules); i {
 			case 0:
 				return &v.state
@@ -6058,7 +6161,7 @@ func file_j5_schema_v1_schema_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_schema_proto_msgTypes[45].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_schema_proto_msgTypes[46].Exporter = func(v any, i int) any {
 			switch v := v.(*MapField_Ext); i {
 			case 0:
 				return &v.state
@@ -6070,7 +6173,7 @@ func file_j5_schema_v1_schema_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_schema_proto_msgTypes[46].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_schema_proto_msgTypes[47].Exporter = func(v any, i int) any {
 			switch v := v.(*StringField_Rules); i {
 			case 0:
 				return &v.state
@@ -6082,7 +6185,7 @@ func file_j5_schema_v1_schema_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_schema_proto_msgTypes[47].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_schema_proto_msgTypes[48].Exporter = func(v any, i int) any {
 			switch v := v.(*StringField_Ext); i {
 			case 0:
 				return &v.state
@@ -6094,7 +6197,7 @@ func file_j5_schema_v1_schema_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_schema_proto_msgTypes[48].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_schema_proto_msgTypes[49].Exporter = func(v any, i int) any {
 			switch v := v.(*KeyField_Rules); i {
 			case 0:
 				return &v.state
@@ -6106,7 +6209,7 @@ func file_j5_schema_v1_schema_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_schema_proto_msgTypes[49].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_schema_proto_msgTypes[50].Exporter = func(v any, i int) any {
 			switch v := v.(*KeyField_Ext); i {
 			case 0:
 				return &v.state
@@ -6118,7 +6221,7 @@ func file_j5_schema_v1_schema_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_schema_proto_msgTypes[50].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_schema_proto_msgTypes[51].Exporter = func(v any, i int) any {
 			switch v := v.(*KeyField_DeprecatedEntityKey); i {
 			case 0:
 				return &v.state
@@ -6130,7 +6233,7 @@ func file_j5_schema_v1_schema_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_schema_proto_msgTypes[51].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_schema_proto_msgTypes[52].Exporter = func(v any, i int) any {
 			switch v := v.(*KeyFormat_Informal); i {
 			case 0:
 				return &v.state
@@ -6142,7 +6245,7 @@ func file_j5_schema_v1_schema_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_schema_proto_msgTypes[52].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_schema_proto_msgTypes[53].Exporter = func(v any, i int) any {
 			switch v := v.(*KeyFormat_Custom); i {
 			case 0:
 				return &v.state
@@ -6154,7 +6257,7 @@ func file_j5_schema_v1_schema_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_schema_proto_msgTypes[53].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_schema_proto_msgTypes[54].Exporter = func(v any, i int) any {
 			switch v := v.(*KeyFormat_UUID); i {
 			case 0:
 				return &v.state
@@ -6166,7 +6269,7 @@ func file_j5_schema_v1_schema_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_schema_proto_msgTypes[54].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_schema_proto_msgTypes[55].Exporter = func(v any, i int) any {
 			switch v := v.(*KeyFormat_ID62); i {
 			case 0:
 				return &v.state
@@ -6178,7 +6281,7 @@ func file_j5_schema_v1_schema_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_schema_proto_msgTypes[55].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_schema_proto_msgTypes[56].Exporter = func(v any, i int) any {
 			switch v := v.(*KeyFormat_Named); i {
 			case 0:
 				return &v.state
@@ -6190,7 +6293,7 @@ func file_j5_schema_v1_schema_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_schema_proto_msgTypes[56].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_schema_proto_msgTypes[57].Exporter = func(v any, i int) any {
 			switch v := v.(*FloatField_Rules); i {
 			case 0:
 				return &v.state
@@ -6202,7 +6305,7 @@ func file_j5_schema_v1_schema_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_schema_proto_msgTypes[57].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_schema_proto_msgTypes[58].Exporter = func(v any, i int) any {
 			switch v := v.(*FloatField_Ext); i {
 			case 0:
 				return &v.state
@@ -6214,7 +6317,7 @@ func file_j5_schema_v1_schema_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_schema_proto_msgTypes[58].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_schema_proto_msgTypes[59].Exporter = func(v any, i int) any {
 			switch v := v.(*IntegerField_Rules); i {
 			case 0:
 				return &v.state
@@ -6226,7 +6329,7 @@ func file_j5_schema_v1_schema_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_schema_proto_msgTypes[59].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_schema_proto_msgTypes[60].Exporter = func(v any, i int) any {
 			switch v := v.(*IntegerField_Ext); i {
 			case 0:
 				return &v.state
@@ -6238,7 +6341,7 @@ func file_j5_schema_v1_schema_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_schema_proto_msgTypes[60].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_schema_proto_msgTypes[61].Exporter = func(v any, i int) any {
 			switch v := v.(*BoolField_Rules); i {
 			case 0:
 				return &v.state
@@ -6250,7 +6353,7 @@ func file_j5_schema_v1_schema_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_schema_proto_msgTypes[61].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_schema_proto_msgTypes[62].Exporter = func(v any, i int) any {
 			switch v := v.(*BoolField_Ext); i {
 			case 0:
 				return &v.state
@@ -6262,7 +6365,7 @@ func file_j5_schema_v1_schema_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_schema_proto_msgTypes[62].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_schema_proto_msgTypes[63].Exporter = func(v any, i int) any {
 			switch v := v.(*BytesField_Rules); i {
 			case 0:
 				return &v.state
@@ -6274,7 +6377,7 @@ func file_j5_schema_v1_schema_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_schema_proto_msgTypes[63].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_schema_proto_msgTypes[64].Exporter = func(v any, i int) any {
 			switch v := v.(*BytesField_Ext); i {
 			case 0:
 				return &v.state
@@ -6286,7 +6389,7 @@ func file_j5_schema_v1_schema_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_schema_proto_msgTypes[64].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_schema_proto_msgTypes[65].Exporter = func(v any, i int) any {
 			switch v := v.(*DecimalField_Rules); i {
 			case 0:
 				return &v.state
@@ -6298,7 +6401,7 @@ func file_j5_schema_v1_schema_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_schema_proto_msgTypes[65].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_schema_proto_msgTypes[66].Exporter = func(v any, i int) any {
 			switch v := v.(*DecimalField_Ext); i {
 			case 0:
 				return &v.state
@@ -6310,7 +6413,7 @@ func file_j5_schema_v1_schema_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_schema_proto_msgTypes[66].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_schema_proto_msgTypes[67].Exporter = func(v any, i int) any {
 			switch v := v.(*DateField_Rules); i {
 			case 0:
 				return &v.state
@@ -6322,7 +6425,7 @@ func file_j5_schema_v1_schema_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_schema_proto_msgTypes[67].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_schema_proto_msgTypes[68].Exporter = func(v any, i int) any {
 			switch v := v.(*DateField_Ext); i {
 			case 0:
 				return &v.state
@@ -6334,7 +6437,7 @@ func file_j5_schema_v1_schema_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_schema_proto_msgTypes[68].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_schema_proto_msgTypes[69].Exporter = func(v any, i int) any {
 			switch v := v.(*TimestampField_Rules); i {
 			case 0:
 				return &v.state
@@ -6346,7 +6449,7 @@ func file_j5_schema_v1_schema_proto_init() {
 				return nil
 			}
 		}
-		file_j5_schema_v1_schema_proto_msgTypes[69].Exporter = func(v any, i int) any {
+		file_j5_schema_v1_schema_proto_msgTypes[70].Exporter = func(v any, i int) any {
 			switch v := v.(*TimestampField_Ext); i {
 			case 0:
 				return &v.state
@@ -6387,51 +6490,51 @@ func file_j5_schema_v1_schema_proto_init() {
 		(*ObjectField_Ref)(nil),
 		(*ObjectField_Object)(nil),
 	}
-	file_j5_schema_v1_schema_proto_msgTypes[9].OneofWrappers = []any{
+	file_j5_schema_v1_schema_proto_msgTypes[10].OneofWrappers = []any{
 		(*PolymorphField_Ref)(nil),
 		(*PolymorphField_Polymorph)(nil),
 	}
-	file_j5_schema_v1_schema_proto_msgTypes[11].OneofWrappers = []any{
+	file_j5_schema_v1_schema_proto_msgTypes[12].OneofWrappers = []any{
 		(*OneofField_Ref)(nil),
 		(*OneofField_Oneof)(nil),
 	}
-	file_j5_schema_v1_schema_proto_msgTypes[13].OneofWrappers = []any{
+	file_j5_schema_v1_schema_proto_msgTypes[14].OneofWrappers = []any{
 		(*EnumField_Ref)(nil),
 		(*EnumField_Enum)(nil),
 	}
-	file_j5_schema_v1_schema_proto_msgTypes[18].OneofWrappers = []any{}
-	file_j5_schema_v1_schema_proto_msgTypes[20].OneofWrappers = []any{
+	file_j5_schema_v1_schema_proto_msgTypes[19].OneofWrappers = []any{}
+	file_j5_schema_v1_schema_proto_msgTypes[21].OneofWrappers = []any{
 		(*KeyFormat_Informal_)(nil),
 		(*KeyFormat_Custom_)(nil),
 		(*KeyFormat_Uuid)(nil),
 		(*KeyFormat_Id62)(nil),
 		(*KeyFormat_Named_)(nil),
 	}
-	file_j5_schema_v1_schema_proto_msgTypes[29].OneofWrappers = []any{}
 	file_j5_schema_v1_schema_proto_msgTypes[30].OneofWrappers = []any{}
-	file_j5_schema_v1_schema_proto_msgTypes[42].OneofWrappers = []any{}
+	file_j5_schema_v1_schema_proto_msgTypes[31].OneofWrappers = []any{}
 	file_j5_schema_v1_schema_proto_msgTypes[43].OneofWrappers = []any{}
 	file_j5_schema_v1_schema_proto_msgTypes[44].OneofWrappers = []any{}
 	file_j5_schema_v1_schema_proto_msgTypes[45].OneofWrappers = []any{}
 	file_j5_schema_v1_schema_proto_msgTypes[46].OneofWrappers = []any{}
-	file_j5_schema_v1_schema_proto_msgTypes[50].OneofWrappers = []any{
+	file_j5_schema_v1_schema_proto_msgTypes[47].OneofWrappers = []any{}
+	file_j5_schema_v1_schema_proto_msgTypes[51].OneofWrappers = []any{
 		(*KeyField_DeprecatedEntityKey_PrimaryKey)(nil),
 		(*KeyField_DeprecatedEntityKey_ForeignKey)(nil),
 	}
-	file_j5_schema_v1_schema_proto_msgTypes[56].OneofWrappers = []any{}
-	file_j5_schema_v1_schema_proto_msgTypes[58].OneofWrappers = []any{}
-	file_j5_schema_v1_schema_proto_msgTypes[60].OneofWrappers = []any{}
-	file_j5_schema_v1_schema_proto_msgTypes[62].OneofWrappers = []any{}
-	file_j5_schema_v1_schema_proto_msgTypes[64].OneofWrappers = []any{}
-	file_j5_schema_v1_schema_proto_msgTypes[66].OneofWrappers = []any{}
-	file_j5_schema_v1_schema_proto_msgTypes[68].OneofWrappers = []any{}
+	file_j5_schema_v1_schema_proto_msgTypes[57].OneofWrappers = []any{}
+	file_j5_schema_v1_schema_proto_msgTypes[59].OneofWrappers = []any{}
+	file_j5_schema_v1_schema_proto_msgTypes[61].OneofWrappers = []any{}
+	file_j5_schema_v1_schema_proto_msgTypes[63].OneofWrappers = []any{}
+	file_j5_schema_v1_schema_proto_msgTypes[65].OneofWrappers = []any{}
+	file_j5_schema_v1_schema_proto_msgTypes[67].OneofWrappers = []any{}
+	file_j5_schema_v1_schema_proto_msgTypes[69].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_j5_schema_v1_schema_proto_rawDesc,
 			NumEnums:      3,
-			NumMessages:   70,
+			NumMessages:   71,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
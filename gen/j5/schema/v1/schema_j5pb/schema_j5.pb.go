@@ -307,6 +307,9 @@ func (msg *InlineObject) Clone() any {
 func (msg *Object) Clone() any {
 	return proto.Clone(msg).(*Object)
 }
+func (msg *ObjectRule) Clone() any {
+	return proto.Clone(msg).(*ObjectRule)
+}
 func (msg *EntityObject) Clone() any {
 	return proto.Clone(msg).(*EntityObject)
 }
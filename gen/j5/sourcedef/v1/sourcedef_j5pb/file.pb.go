@@ -322,6 +322,20 @@ func (x *RootElement) GetStringFormat() *schema_j5pb.StringFormat {
 	return nil
 }
 
+func (x *RootElement) GetTemplate() *ObjectTemplate {
+	if x, ok := x.GetType().(*RootElement_Template); ok {
+		return x.Template
+	}
+	return nil
+}
+
+func (x *RootElement) GetInstance() *TemplateInstance {
+	if x, ok := x.GetType().(*RootElement_Instance); ok {
+		return x.Instance
+	}
+	return nil
+}
+
 type isRootElement_Type interface {
 	isRootElement_Type()
 }
@@ -358,6 +372,14 @@ type RootElement_StringFormat struct {
 	StringFormat *schema_j5pb.StringFormat `protobuf:"bytes,8,opt,name=string_format,json=stringFormat,proto3,oneof"`
 }
 
+type RootElement_Template struct {
+	Template *ObjectTemplate `protobuf:"bytes,9,opt,name=template,proto3,oneof"`
+}
+
+type RootElement_Instance struct {
+	Instance *TemplateInstance `protobuf:"bytes,10,opt,name=instance,proto3,oneof"`
+}
+
 func (*RootElement_Entity) isRootElement_Type() {}
 
 func (*RootElement_Oneof) isRootElement_Type() {}
@@ -374,6 +396,10 @@ func (*RootElement_Service) isRootElement_Type() {}
 
 func (*RootElement_StringFormat) isRootElement_Type() {}
 
+func (*RootElement_Template) isRootElement_Type() {}
+
+func (*RootElement_Instance) isRootElement_Type() {}
+
 type Entity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1636,6 +1662,190 @@ func (x *TopicMethod) GetFields() []*schema_j5pb.ObjectProperty {
 	return nil
 }
 
+// ObjectTemplate is an object with type parameters. The template is not a
+// schema itself, each TemplateInstance expands it into a concrete object.
+type ObjectTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Def *schema_j5pb.Object `protobuf:"bytes,1,opt,name=def,proto3" json:"def,omitempty"`
+	// Parameters stand in for schema names in the properties of the template,
+	// e.g. `field items array:object:T`.
+	Params []*TemplateParam `protobuf:"bytes,2,rep,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *ObjectTemplate) Reset() {
+	*x = ObjectTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_j5_sourcedef_v1_file_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObjectTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectTemplate) ProtoMessage() {}
+
+func (x *ObjectTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_j5_sourcedef_v1_file_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectTemplate.ProtoReflect.Descriptor instead.
+func (*ObjectTemplate) Descriptor() ([]byte, []int) {
+	return file_j5_sourcedef_v1_file_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ObjectTemplate) GetDef() *schema_j5pb.Object {
+	if x != nil {
+		return x.Def
+	}
+	return nil
+}
+
+func (x *ObjectTemplate) GetParams() []*TemplateParam {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type TemplateParam struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *TemplateParam) Reset() {
+	*x = TemplateParam{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_j5_sourcedef_v1_file_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateParam) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateParam) ProtoMessage() {}
+
+func (x *TemplateParam) ProtoReflect() protoreflect.Message {
+	mi := &file_j5_sourcedef_v1_file_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateParam.ProtoReflect.Descriptor instead.
+func (*TemplateParam) Descriptor() ([]byte, []int) {
+	return file_j5_sourcedef_v1_file_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TemplateParam) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TemplateParam) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// TemplateInstance expands a template with a schema for each parameter, in
+// order. The object is named for the template followed by the schema names of
+// the arguments, e.g. `instance Paged { args = ["Foo"] }` defines `PagedFoo`.
+type TemplateInstance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The template, in this package or an imported package, e.g. `Paged` or
+	// `common.v1.Paged`.
+	Template string `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	// Schemas for the parameters, e.g. `Foo` or `bar.v1.Bar`.
+	Args []string `protobuf:"bytes,2,rep,name=args,proto3" json:"args,omitempty"`
+	// Replaces the description of the template.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *TemplateInstance) Reset() {
+	*x = TemplateInstance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_j5_sourcedef_v1_file_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TemplateInstance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateInstance) ProtoMessage() {}
+
+func (x *TemplateInstance) ProtoReflect() protoreflect.Message {
+	mi := &file_j5_sourcedef_v1_file_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateInstance.ProtoReflect.Descriptor instead.
+func (*TemplateInstance) Descriptor() ([]byte, []int) {
+	return file_j5_sourcedef_v1_file_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TemplateInstance) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *TemplateInstance) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *TemplateInstance) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
 type TopicType_Publish struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1647,7 +1857,7 @@ type TopicType_Publish struct {
 func (x *TopicType_Publish) Reset() {
 	*x = TopicType_Publish{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_sourcedef_v1_file_proto_msgTypes[21]
+		mi := &file_j5_sourcedef_v1_file_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1660,7 +1870,7 @@ func (x *TopicType_Publish) String() string {
 func (*TopicType_Publish) ProtoMessage() {}
 
 func (x *TopicType_Publish) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_sourcedef_v1_file_proto_msgTypes[21]
+	mi := &file_j5_sourcedef_v1_file_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1695,7 +1905,7 @@ type TopicType_ReqRes struct {
 func (x *TopicType_ReqRes) Reset() {
 	*x = TopicType_ReqRes{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_sourcedef_v1_file_proto_msgTypes[22]
+		mi := &file_j5_sourcedef_v1_file_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1708,7 +1918,7 @@ func (x *TopicType_ReqRes) String() string {
 func (*TopicType_ReqRes) ProtoMessage() {}
 
 func (x *TopicType_ReqRes) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_sourcedef_v1_file_proto_msgTypes[22]
+	mi := &file_j5_sourcedef_v1_file_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1750,7 +1960,7 @@ type TopicType_Upsert struct {
 func (x *TopicType_Upsert) Reset() {
 	*x = TopicType_Upsert{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_sourcedef_v1_file_proto_msgTypes[23]
+		mi := &file_j5_sourcedef_v1_file_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1763,7 +1973,7 @@ func (x *TopicType_Upsert) String() string {
 func (*TopicType_Upsert) ProtoMessage() {}
 
 func (x *TopicType_Upsert) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_sourcedef_v1_file_proto_msgTypes[23]
+	mi := &file_j5_sourcedef_v1_file_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1805,7 +2015,7 @@ type TopicType_Event struct {
 func (x *TopicType_Event) Reset() {
 	*x = TopicType_Event{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_j5_sourcedef_v1_file_proto_msgTypes[24]
+		mi := &file_j5_sourcedef_v1_file_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1818,7 +2028,7 @@ func (x *TopicType_Event) String() string {
 func (*TopicType_Event) ProtoMessage() {}
 
 func (x *TopicType_Event) ProtoReflect() protoreflect.Message {
-	mi := &file_j5_sourcedef_v1_file_proto_msgTypes[24]
+	mi := &file_j5_sourcedef_v1_file_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1870,7 +2080,7 @@ var file_j5_sourcedef_v1_file_proto_rawDesc = []byte{
 	0x65, 0x6d, 0x61, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x6a, 0x35, 0x2f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2f, 0x76,
 	0x31, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x8e, 0x04, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12,
+	0xce, 0x04, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x12,
 	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
 	0x74, 0x68, 0x12, 0x32, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x64,
@@ -1887,8 +2097,8 @@ var file_j5_sourcedef_v1_file_proto_rawDesc = []byte{
 	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x19, 0x2e, 0x6a, 0x35, 0x2e, 0x62, 0x63, 0x6c, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6f, 0x75, 0x72,
 	0x63, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0xf2, 0x01, 0x82, 0xbe,
-	0x8f, 0x02, 0xec, 0x01, 0x52, 0x1a, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x08,
+	0x63, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0xb2, 0x02, 0x82, 0xbe,
+	0x8f, 0x02, 0xac, 0x02, 0x52, 0x1a, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x08,
 	0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
 	0x52, 0x16, 0x0a, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x12, 0x08, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
 	0x74, 0x73, 0x12, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x52, 0x18, 0x0a, 0x05, 0x6f, 0x6e, 0x65, 0x6f,
@@ -1903,13 +2113,17 @@ var file_j5_sourcedef_v1_file_proto_rawDesc = []byte{
 	0x73, 0x12, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x52, 0x26, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x69,
 	0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x08, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e,
 	0x74, 0x73, 0x12, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x52, 0x1e, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x08, 0x65, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x52, 0x1e, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x08, 0x65, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
 	0x22, 0x1d, 0x0a, 0x07, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
 	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22,
 	0x4a, 0x0a, 0x06, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
 	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a,
 	0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c,
 	0x69, 0x61, 0x73, 0x3a, 0x16, 0x82, 0xbe, 0x8f, 0x02, 0x11, 0x1a, 0x06, 0x0a, 0x04, 0x70, 0x61,
-	0x74, 0x68, 0x2a, 0x07, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x22, 0xba, 0x03, 0x0a, 0x0b,
+	0x74, 0x68, 0x2a, 0x07, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x22, 0xba, 0x04, 0x0a, 0x0b,
 	0x52, 0x6f, 0x6f, 0x74, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x65,
 	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x35,
 	0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x64, 0x65, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e,
@@ -1937,6 +2151,14 @@ var file_j5_sourcedef_v1_file_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
 	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
 	0x48, 0x00, 0x52, 0x0c, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x3d, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x64, 0x65,
+	0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12,
+	0x3f, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x64, 0x65, 0x66,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
 	0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xfb, 0x05, 0x0a, 0x06, 0x45, 0x6e, 0x74,
 	0x69, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x42, 0x1a, 0xba, 0x48, 0x17, 0x72, 0x15, 0x32, 0x13, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x5d,
@@ -2134,104 +2356,135 @@ var file_j5_sourcedef_v1_file_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x52, 0x14, 0x0a, 0x06, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0a, 0x70, 0x72,
 	0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x19, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65,
 	0x63, 0x74, 0x12, 0x07, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x12, 0x06, 0x6f, 0x62, 0x6a,
-	0x65, 0x63, 0x74, 0x22, 0xc0, 0x01, 0x0a, 0x06, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x31,
+	0x65, 0x63, 0x74, 0x22, 0xcf, 0x01, 0x0a, 0x06, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x31,
 	0x0a, 0x03, 0x64, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6a, 0x35,
 	0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63,
 	0x74, 0x42, 0x09, 0xc2, 0xff, 0x8e, 0x02, 0x04, 0x52, 0x02, 0x08, 0x01, 0x52, 0x03, 0x64, 0x65,
 	0x66, 0x12, 0x37, 0x0a, 0x07, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x18, 0x03, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x64, 0x65,
 	0x66, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x53, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x52, 0x07, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x3a, 0x4a, 0x82, 0xbe, 0x8f, 0x02,
-	0x45, 0x1a, 0x06, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x61, 0x52, 0x07, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x3a, 0x59, 0x82, 0xbe, 0x8f, 0x02,
+	0x54, 0x1a, 0x06, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
 	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12,
 	0x0a, 0x70, 0x72, 0x6f, 0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x19, 0x0a, 0x06, 0x6f,
 	0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x07, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x12, 0x06,
-	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x77, 0x0a, 0x09, 0x50, 0x6f, 0x6c, 0x79, 0x6d, 0x6f,
-	0x72, 0x70, 0x68, 0x12, 0x34, 0x0a, 0x03, 0x64, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e,
-	0x50, 0x6f, 0x6c, 0x79, 0x6d, 0x6f, 0x72, 0x70, 0x68, 0x42, 0x09, 0xc2, 0xff, 0x8e, 0x02, 0x04,
-	0x52, 0x02, 0x08, 0x01, 0x52, 0x03, 0x64, 0x65, 0x66, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x73, 0x3a, 0x18, 0x82, 0xbe, 0x8f, 0x02, 0x13, 0x52, 0x11, 0x0a, 0x06,
-	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22,
-	0x4b, 0x0a, 0x0d, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x3a, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x64, 0x65, 0x66, 0x2e,
-	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x09, 0xc2, 0xff, 0x8e, 0x02, 0x04,
-	0x52, 0x02, 0x08, 0x01, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0xa0, 0x01, 0x0a,
-	0x05, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xba, 0x48, 0x17, 0x72, 0x15, 0x32, 0x13, 0x5e, 0x5b, 0x41,
-	0x2d, 0x5a, 0x5d, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x24,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x64, 0x65, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x15, 0x82, 0xbe, 0x8f, 0x02, 0x10, 0x1a,
-	0x06, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22,
-	0xcf, 0x05, 0x0a, 0x09, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3e, 0x0a,
-	0x07, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
-	0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x64, 0x65, 0x66, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69,
-	0x73, 0x68, 0x48, 0x00, 0x52, 0x07, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x3b, 0x0a,
-	0x06, 0x72, 0x65, 0x71, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x0d, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x05,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x77, 0x0a, 0x09, 0x50, 0x6f, 0x6c, 0x79, 0x6d, 0x6f, 0x72,
+	0x70, 0x68, 0x12, 0x34, 0x0a, 0x03, 0x64, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6f, 0x6c, 0x79, 0x6d, 0x6f, 0x72, 0x70, 0x68, 0x42, 0x09, 0xc2, 0xff, 0x8e, 0x02, 0x04, 0x52,
+	0x02, 0x08, 0x01, 0x52, 0x03, 0x64, 0x65, 0x66, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x73, 0x3a, 0x18, 0x82, 0xbe, 0x8f, 0x02, 0x13, 0x52, 0x11, 0x0a, 0x06, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x4b,
+	0x0a, 0x0d, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x3a, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x64, 0x65, 0x66, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x09, 0xc2, 0xff, 0x8e, 0x02, 0x04, 0x52,
+	0x02, 0x08, 0x01, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0xa0, 0x01, 0x0a, 0x05,
+	0x54, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1a, 0xba, 0x48, 0x17, 0x72, 0x15, 0x32, 0x13, 0x5e, 0x5b, 0x41, 0x2d,
+	0x5a, 0x5d, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x24, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x64, 0x65, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x3a, 0x15, 0x82, 0xbe, 0x8f, 0x02, 0x10, 0x1a, 0x06,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0xcf,
+	0x05, 0x0a, 0x09, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3e, 0x0a, 0x07,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
 	0x6a, 0x35, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x64, 0x65, 0x66, 0x2e, 0x76, 0x31, 0x2e,
-	0x54, 0x6f, 0x70, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x52, 0x65, 0x73,
-	0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x71, 0x72, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x06, 0x75, 0x70,
-	0x73, 0x65, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6a, 0x35, 0x2e,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x64, 0x65, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70,
-	0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x48, 0x00, 0x52,
-	0x06, 0x75, 0x70, 0x73, 0x65, 0x72, 0x74, 0x12, 0x38, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x64, 0x65, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x54, 0x79,
-	0x70, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x1a, 0x56, 0x0a, 0x07, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x4b, 0x0a, 0x08,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x64, 0x65, 0x66, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x42, 0x11, 0xc2, 0xff,
-	0x8e, 0x02, 0x0c, 0xaa, 0x01, 0x09, 0x1a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
-	0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x1a, 0x98, 0x01, 0x0a, 0x06, 0x52, 0x65,
-	0x71, 0x52, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x64, 0x65, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x65, 0x74,
-	0x68, 0x6f, 0x64, 0x42, 0x11, 0xc2, 0xff, 0x8e, 0x02, 0x0c, 0xaa, 0x01, 0x09, 0x1a, 0x07, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x43, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x64, 0x65, 0x66, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x42, 0x0f, 0xc2, 0xff,
-	0x8e, 0x02, 0x0a, 0xaa, 0x01, 0x07, 0x1a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x05, 0x72,
-	0x65, 0x70, 0x6c, 0x79, 0x1a, 0x69, 0x0a, 0x06, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x12, 0x1f,
-	0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x3e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x64, 0x65, 0x66, 0x2e,
-	0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x42, 0x06,
-	0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a,
-	0x68, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x35, 0x2e,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x64, 0x65, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70,
-	0x69, 0x63, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x22, 0xb4, 0x01, 0x0a, 0x0b, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x12, 0x33, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x1a, 0xba, 0x48, 0x17, 0x72, 0x15, 0x32, 0x13, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x5d, 0x5b, 0x41,
-	0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x24, 0x48, 0x00, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x72,
-	0x6f, 0x70, 0x65, 0x72, 0x74, 0x79, 0x42, 0x0f, 0xc2, 0xff, 0x8e, 0x02, 0x0a, 0xaa, 0x01, 0x07,
-	0x1a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x42,
-	0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x55, 0xf2, 0x85, 0x8f, 0x02, 0x16, 0x0a,
-	0x14, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x2e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62,
-	0x75, 0x69, 0x6c, 0x64, 0x73, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x70, 0x65, 0x6e, 0x74, 0x6f, 0x70, 0x73, 0x2f, 0x6a, 0x35, 0x2f, 0x67, 0x65, 0x6e,
-	0x2f, 0x6a, 0x35, 0x2f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x64, 0x65, 0x66, 0x2f, 0x76, 0x31,
-	0x2f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x64, 0x65, 0x66, 0x5f, 0x6a, 0x35, 0x70, 0x62, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x54, 0x6f, 0x70, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x48, 0x00, 0x52, 0x07, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x3b, 0x0a, 0x06,
+	0x72, 0x65, 0x71, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6a,
+	0x35, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x64, 0x65, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x6f, 0x70, 0x69, 0x63, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x52, 0x65, 0x71, 0x52, 0x65, 0x73, 0x48,
+	0x00, 0x52, 0x06, 0x72, 0x65, 0x71, 0x72, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x06, 0x75, 0x70, 0x73,
+	0x65, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6a, 0x35, 0x2e, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x64, 0x65, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x48, 0x00, 0x52, 0x06,
+	0x75, 0x70, 0x73, 0x65, 0x72, 0x74, 0x12, 0x38, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x64, 0x65, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x54, 0x79, 0x70,
+	0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x1a, 0x56, 0x0a, 0x07, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x12, 0x4b, 0x0a, 0x08, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6a, 0x35, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x64, 0x65, 0x66, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x42, 0x11, 0xc2, 0xff, 0x8e,
+	0x02, 0x0c, 0xaa, 0x01, 0x09, 0x1a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x1a, 0x98, 0x01, 0x0a, 0x06, 0x52, 0x65, 0x71,
+	0x52, 0x65, 0x73, 0x12, 0x49, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x64, 0x65, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x42, 0x11, 0xc2, 0xff, 0x8e, 0x02, 0x0c, 0xaa, 0x01, 0x09, 0x1a, 0x07, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43,
+	0x0a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6a, 0x35, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x64, 0x65, 0x66, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x42, 0x0f, 0xc2, 0xff, 0x8e,
+	0x02, 0x0a, 0xaa, 0x01, 0x07, 0x1a, 0x05, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x05, 0x72, 0x65,
+	0x70, 0x6c, 0x79, 0x1a, 0x69, 0x0a, 0x06, 0x55, 0x70, 0x73, 0x65, 0x72, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3e,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x64, 0x65, 0x66, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x42, 0x06, 0xba,
+	0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x68,
+	0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x35, 0x2e, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x64, 0x65, 0x66, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x70, 0x69,
+	0x63, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0xb4, 0x01, 0x0a, 0x0b, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x12, 0x33, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a,
+	0xba, 0x48, 0x17, 0x72, 0x15, 0x32, 0x13, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x5d, 0x5b, 0x41, 0x2d,
+	0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x24, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x79, 0x42, 0x0f, 0xc2, 0xff, 0x8e, 0x02, 0x0a, 0xaa, 0x01, 0x07, 0x1a,
+	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xdd, 0x01, 0x0a, 0x0e, 0x4f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x03, 0x64, 0x65,
+	0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6a, 0x35, 0x2e, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x09, 0xc2,
+	0xff, 0x8e, 0x02, 0x04, 0x52, 0x02, 0x08, 0x01, 0x52, 0x03, 0x64, 0x65, 0x66, 0x12, 0x47, 0x0a,
+	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x6a, 0x35, 0x2e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x64, 0x65, 0x66, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x42, 0x0f, 0xc2,
+	0xff, 0x8e, 0x02, 0x0a, 0xaa, 0x01, 0x07, 0x1a, 0x05, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x52, 0x06,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x4f, 0x82, 0xbe, 0x8f, 0x02, 0x4a, 0x1a, 0x06, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x13, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x0a, 0x70, 0x72, 0x6f,
+	0x70, 0x65, 0x72, 0x74, 0x69, 0x65, 0x73, 0x52, 0x0f, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x12, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x0d, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65,
+	0x12, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x7d, 0x0a, 0x0d, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1a, 0xba, 0x48, 0x17, 0x72, 0x15, 0x32, 0x13, 0x5e,
+	0x5b, 0x41, 0x2d, 0x5a, 0x5d, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d,
+	0x2a, 0x24, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x1a, 0x82, 0xbe, 0x8f, 0x02,
+	0x15, 0x1a, 0x06, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x32, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x84, 0x01, 0x0a, 0x10, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x1e, 0x82,
+	0xbe, 0x8f, 0x02, 0x19, 0x1a, 0x0a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x32, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x55, 0xf2,
+	0x85, 0x8f, 0x02, 0x16, 0x0a, 0x14, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x2f, 0x2e, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x73, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x65, 0x6e, 0x74, 0x6f, 0x70, 0x73, 0x2f, 0x6a,
+	0x35, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x6a, 0x35, 0x2f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x64,
+	0x65, 0x66, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x64, 0x65, 0x66, 0x5f,
+	0x6a, 0x35, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2246,7 +2499,7 @@ func file_j5_sourcedef_v1_file_proto_rawDescGZIP() []byte {
 	return file_j5_sourcedef_v1_file_proto_rawDescData
 }
 
-var file_j5_sourcedef_v1_file_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_j5_sourcedef_v1_file_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_j5_sourcedef_v1_file_proto_goTypes = []any{
 	(*SourceFile)(nil),                   // 0: j5.sourcedef.v1.SourceFile
 	(*Package)(nil),                      // 1: j5.sourcedef.v1.Package
@@ -2269,89 +2522,96 @@ var file_j5_sourcedef_v1_file_proto_goTypes = []any{
 	(*Topic)(nil),                        // 18: j5.sourcedef.v1.Topic
 	(*TopicType)(nil),                    // 19: j5.sourcedef.v1.TopicType
 	(*TopicMethod)(nil),                  // 20: j5.sourcedef.v1.TopicMethod
-	(*TopicType_Publish)(nil),            // 21: j5.sourcedef.v1.TopicType.Publish
-	(*TopicType_ReqRes)(nil),             // 22: j5.sourcedef.v1.TopicType.ReqRes
-	(*TopicType_Upsert)(nil),             // 23: j5.sourcedef.v1.TopicType.Upsert
-	(*TopicType_Event)(nil),              // 24: j5.sourcedef.v1.TopicType.Event
-	(*bcl_j5pb.SourceLocation)(nil),      // 25: j5.bcl.v1.SourceLocation
-	(*schema_j5pb.Enum)(nil),             // 26: j5.schema.v1.Enum
-	(*schema_j5pb.StringFormat)(nil),     // 27: j5.schema.v1.StringFormat
-	(*schema_j5pb.Enum_Option)(nil),      // 28: j5.schema.v1.Enum.Option
-	(*schema_j5pb.ObjectProperty)(nil),   // 29: j5.schema.v1.ObjectProperty
-	(*schema_j5pb.Object)(nil),           // 30: j5.schema.v1.Object
-	(*list_j5pb.ListRequestMessage)(nil), // 31: j5.list.v1.ListRequestMessage
-	(*auth_j5pb.MethodAuthType)(nil),     // 32: j5.auth.v1.MethodAuthType
-	(schema_j5pb.HTTPMethod)(0),          // 33: j5.schema.v1.HTTPMethod
-	(*ext_j5pb.MethodOptions)(nil),       // 34: j5.ext.v1.MethodOptions
-	(*ext_j5pb.ServiceOptions)(nil),      // 35: j5.ext.v1.ServiceOptions
-	(*schema_j5pb.EntityKey)(nil),        // 36: j5.schema.v1.EntityKey
-	(*schema_j5pb.Oneof)(nil),            // 37: j5.schema.v1.Oneof
-	(*schema_j5pb.Polymorph)(nil),        // 38: j5.schema.v1.Polymorph
+	(*ObjectTemplate)(nil),               // 21: j5.sourcedef.v1.ObjectTemplate
+	(*TemplateParam)(nil),                // 22: j5.sourcedef.v1.TemplateParam
+	(*TemplateInstance)(nil),             // 23: j5.sourcedef.v1.TemplateInstance
+	(*TopicType_Publish)(nil),            // 24: j5.sourcedef.v1.TopicType.Publish
+	(*TopicType_ReqRes)(nil),             // 25: j5.sourcedef.v1.TopicType.ReqRes
+	(*TopicType_Upsert)(nil),             // 26: j5.sourcedef.v1.TopicType.Upsert
+	(*TopicType_Event)(nil),              // 27: j5.sourcedef.v1.TopicType.Event
+	(*bcl_j5pb.SourceLocation)(nil),      // 28: j5.bcl.v1.SourceLocation
+	(*schema_j5pb.Enum)(nil),             // 29: j5.schema.v1.Enum
+	(*schema_j5pb.StringFormat)(nil),     // 30: j5.schema.v1.StringFormat
+	(*schema_j5pb.Enum_Option)(nil),      // 31: j5.schema.v1.Enum.Option
+	(*schema_j5pb.ObjectProperty)(nil),   // 32: j5.schema.v1.ObjectProperty
+	(*schema_j5pb.Object)(nil),           // 33: j5.schema.v1.Object
+	(*list_j5pb.ListRequestMessage)(nil), // 34: j5.list.v1.ListRequestMessage
+	(*auth_j5pb.MethodAuthType)(nil),     // 35: j5.auth.v1.MethodAuthType
+	(schema_j5pb.HTTPMethod)(0),          // 36: j5.schema.v1.HTTPMethod
+	(*ext_j5pb.MethodOptions)(nil),       // 37: j5.ext.v1.MethodOptions
+	(*ext_j5pb.ServiceOptions)(nil),      // 38: j5.ext.v1.ServiceOptions
+	(*schema_j5pb.EntityKey)(nil),        // 39: j5.schema.v1.EntityKey
+	(*schema_j5pb.Oneof)(nil),            // 40: j5.schema.v1.Oneof
+	(*schema_j5pb.Polymorph)(nil),        // 41: j5.schema.v1.Polymorph
 }
 var file_j5_sourcedef_v1_file_proto_depIdxs = []int32{
 	1,  // 0: j5.sourcedef.v1.SourceFile.package:type_name -> j5.sourcedef.v1.Package
 	2,  // 1: j5.sourcedef.v1.SourceFile.imports:type_name -> j5.sourcedef.v1.Import
 	3,  // 2: j5.sourcedef.v1.SourceFile.elements:type_name -> j5.sourcedef.v1.RootElement
-	25, // 3: j5.sourcedef.v1.SourceFile.source_locations:type_name -> j5.bcl.v1.SourceLocation
+	28, // 3: j5.sourcedef.v1.SourceFile.source_locations:type_name -> j5.bcl.v1.SourceLocation
 	4,  // 4: j5.sourcedef.v1.RootElement.entity:type_name -> j5.sourcedef.v1.Entity
 	14, // 5: j5.sourcedef.v1.RootElement.oneof:type_name -> j5.sourcedef.v1.Oneof
 	15, // 6: j5.sourcedef.v1.RootElement.object:type_name -> j5.sourcedef.v1.Object
 	16, // 7: j5.sourcedef.v1.RootElement.polymorph:type_name -> j5.sourcedef.v1.Polymorph
-	26, // 8: j5.sourcedef.v1.RootElement.enum:type_name -> j5.schema.v1.Enum
+	29, // 8: j5.sourcedef.v1.RootElement.enum:type_name -> j5.schema.v1.Enum
 	18, // 9: j5.sourcedef.v1.RootElement.topic:type_name -> j5.sourcedef.v1.Topic
 	10, // 10: j5.sourcedef.v1.RootElement.service:type_name -> j5.sourcedef.v1.Service
-	27, // 11: j5.sourcedef.v1.RootElement.string_format:type_name -> j5.schema.v1.StringFormat
-	7,  // 12: j5.sourcedef.v1.Entity.query:type_name -> j5.sourcedef.v1.EntityQuery
-	28, // 13: j5.sourcedef.v1.Entity.status:type_name -> j5.schema.v1.Enum.Option
-	13, // 14: j5.sourcedef.v1.Entity.keys:type_name -> j5.sourcedef.v1.EntityKey
-	29, // 15: j5.sourcedef.v1.Entity.data:type_name -> j5.schema.v1.ObjectProperty
-	5,  // 16: j5.sourcedef.v1.Entity.events:type_name -> j5.sourcedef.v1.Event
-	12, // 17: j5.sourcedef.v1.Entity.schemas:type_name -> j5.sourcedef.v1.NestedSchema
-	10, // 18: j5.sourcedef.v1.Entity.commands:type_name -> j5.sourcedef.v1.Service
-	11, // 19: j5.sourcedef.v1.Entity.summaries:type_name -> j5.sourcedef.v1.EntitySummary
-	30, // 20: j5.sourcedef.v1.Event.def:type_name -> j5.schema.v1.Object
-	6,  // 21: j5.sourcedef.v1.Event.transitions:type_name -> j5.sourcedef.v1.Transition
-	12, // 22: j5.sourcedef.v1.Event.schemas:type_name -> j5.sourcedef.v1.NestedSchema
-	31, // 23: j5.sourcedef.v1.EntityQuery.list_request:type_name -> j5.list.v1.ListRequestMessage
-	31, // 24: j5.sourcedef.v1.EntityQuery.events_list_request:type_name -> j5.list.v1.ListRequestMessage
-	32, // 25: j5.sourcedef.v1.EntityQuery.auth:type_name -> j5.auth.v1.MethodAuthType
-	33, // 26: j5.sourcedef.v1.APIMethod.http_method:type_name -> j5.schema.v1.HTTPMethod
-	9,  // 27: j5.sourcedef.v1.APIMethod.request:type_name -> j5.sourcedef.v1.AnonymousObject
-	9,  // 28: j5.sourcedef.v1.APIMethod.response:type_name -> j5.sourcedef.v1.AnonymousObject
-	32, // 29: j5.sourcedef.v1.APIMethod.auth:type_name -> j5.auth.v1.MethodAuthType
-	34, // 30: j5.sourcedef.v1.APIMethod.options:type_name -> j5.ext.v1.MethodOptions
-	31, // 31: j5.sourcedef.v1.APIMethod.list_request:type_name -> j5.list.v1.ListRequestMessage
-	29, // 32: j5.sourcedef.v1.AnonymousObject.properties:type_name -> j5.schema.v1.ObjectProperty
-	8,  // 33: j5.sourcedef.v1.Service.methods:type_name -> j5.sourcedef.v1.APIMethod
-	35, // 34: j5.sourcedef.v1.Service.options:type_name -> j5.ext.v1.ServiceOptions
-	29, // 35: j5.sourcedef.v1.EntitySummary.fields:type_name -> j5.schema.v1.ObjectProperty
-	14, // 36: j5.sourcedef.v1.NestedSchema.oneof:type_name -> j5.sourcedef.v1.Oneof
-	15, // 37: j5.sourcedef.v1.NestedSchema.object:type_name -> j5.sourcedef.v1.Object
-	26, // 38: j5.sourcedef.v1.NestedSchema.enum:type_name -> j5.schema.v1.Enum
-	29, // 39: j5.sourcedef.v1.EntityKey.def:type_name -> j5.schema.v1.ObjectProperty
-	36, // 40: j5.sourcedef.v1.EntityKey.key:type_name -> j5.schema.v1.EntityKey
-	37, // 41: j5.sourcedef.v1.Oneof.def:type_name -> j5.schema.v1.Oneof
-	12, // 42: j5.sourcedef.v1.Oneof.schemas:type_name -> j5.sourcedef.v1.NestedSchema
-	30, // 43: j5.sourcedef.v1.Object.def:type_name -> j5.schema.v1.Object
-	12, // 44: j5.sourcedef.v1.Object.schemas:type_name -> j5.sourcedef.v1.NestedSchema
-	38, // 45: j5.sourcedef.v1.Polymorph.def:type_name -> j5.schema.v1.Polymorph
-	4,  // 46: j5.sourcedef.v1.EntityElement.entity:type_name -> j5.sourcedef.v1.Entity
-	19, // 47: j5.sourcedef.v1.Topic.type:type_name -> j5.sourcedef.v1.TopicType
-	21, // 48: j5.sourcedef.v1.TopicType.publish:type_name -> j5.sourcedef.v1.TopicType.Publish
-	22, // 49: j5.sourcedef.v1.TopicType.reqres:type_name -> j5.sourcedef.v1.TopicType.ReqRes
-	23, // 50: j5.sourcedef.v1.TopicType.upsert:type_name -> j5.sourcedef.v1.TopicType.Upsert
-	24, // 51: j5.sourcedef.v1.TopicType.event:type_name -> j5.sourcedef.v1.TopicType.Event
-	29, // 52: j5.sourcedef.v1.TopicMethod.fields:type_name -> j5.schema.v1.ObjectProperty
-	20, // 53: j5.sourcedef.v1.TopicType.Publish.messages:type_name -> j5.sourcedef.v1.TopicMethod
-	20, // 54: j5.sourcedef.v1.TopicType.ReqRes.request:type_name -> j5.sourcedef.v1.TopicMethod
-	20, // 55: j5.sourcedef.v1.TopicType.ReqRes.reply:type_name -> j5.sourcedef.v1.TopicMethod
-	20, // 56: j5.sourcedef.v1.TopicType.Upsert.message:type_name -> j5.sourcedef.v1.TopicMethod
-	20, // 57: j5.sourcedef.v1.TopicType.Event.message:type_name -> j5.sourcedef.v1.TopicMethod
-	58, // [58:58] is the sub-list for method output_type
-	58, // [58:58] is the sub-list for method input_type
-	58, // [58:58] is the sub-list for extension type_name
-	58, // [58:58] is the sub-list for extension extendee
-	0,  // [0:58] is the sub-list for field type_name
+	30, // 11: j5.sourcedef.v1.RootElement.string_format:type_name -> j5.schema.v1.StringFormat
+	21, // 12: j5.sourcedef.v1.RootElement.template:type_name -> j5.sourcedef.v1.ObjectTemplate
+	23, // 13: j5.sourcedef.v1.RootElement.instance:type_name -> j5.sourcedef.v1.TemplateInstance
+	7,  // 14: j5.sourcedef.v1.Entity.query:type_name -> j5.sourcedef.v1.EntityQuery
+	31, // 15: j5.sourcedef.v1.Entity.status:type_name -> j5.schema.v1.Enum.Option
+	13, // 16: j5.sourcedef.v1.Entity.keys:type_name -> j5.sourcedef.v1.EntityKey
+	32, // 17: j5.sourcedef.v1.Entity.data:type_name -> j5.schema.v1.ObjectProperty
+	5,  // 18: j5.sourcedef.v1.Entity.events:type_name -> j5.sourcedef.v1.Event
+	12, // 19: j5.sourcedef.v1.Entity.schemas:type_name -> j5.sourcedef.v1.NestedSchema
+	10, // 20: j5.sourcedef.v1.Entity.commands:type_name -> j5.sourcedef.v1.Service
+	11, // 21: j5.sourcedef.v1.Entity.summaries:type_name -> j5.sourcedef.v1.EntitySummary
+	33, // 22: j5.sourcedef.v1.Event.def:type_name -> j5.schema.v1.Object
+	6,  // 23: j5.sourcedef.v1.Event.transitions:type_name -> j5.sourcedef.v1.Transition
+	12, // 24: j5.sourcedef.v1.Event.schemas:type_name -> j5.sourcedef.v1.NestedSchema
+	34, // 25: j5.sourcedef.v1.EntityQuery.list_request:type_name -> j5.list.v1.ListRequestMessage
+	34, // 26: j5.sourcedef.v1.EntityQuery.events_list_request:type_name -> j5.list.v1.ListRequestMessage
+	35, // 27: j5.sourcedef.v1.EntityQuery.auth:type_name -> j5.auth.v1.MethodAuthType
+	36, // 28: j5.sourcedef.v1.APIMethod.http_method:type_name -> j5.schema.v1.HTTPMethod
+	9,  // 29: j5.sourcedef.v1.APIMethod.request:type_name -> j5.sourcedef.v1.AnonymousObject
+	9,  // 30: j5.sourcedef.v1.APIMethod.response:type_name -> j5.sourcedef.v1.AnonymousObject
+	35, // 31: j5.sourcedef.v1.APIMethod.auth:type_name -> j5.auth.v1.MethodAuthType
+	37, // 32: j5.sourcedef.v1.APIMethod.options:type_name -> j5.ext.v1.MethodOptions
+	34, // 33: j5.sourcedef.v1.APIMethod.list_request:type_name -> j5.list.v1.ListRequestMessage
+	32, // 34: j5.sourcedef.v1.AnonymousObject.properties:type_name -> j5.schema.v1.ObjectProperty
+	8,  // 35: j5.sourcedef.v1.Service.methods:type_name -> j5.sourcedef.v1.APIMethod
+	38, // 36: j5.sourcedef.v1.Service.options:type_name -> j5.ext.v1.ServiceOptions
+	32, // 37: j5.sourcedef.v1.EntitySummary.fields:type_name -> j5.schema.v1.ObjectProperty
+	14, // 38: j5.sourcedef.v1.NestedSchema.oneof:type_name -> j5.sourcedef.v1.Oneof
+	15, // 39: j5.sourcedef.v1.NestedSchema.object:type_name -> j5.sourcedef.v1.Object
+	29, // 40: j5.sourcedef.v1.NestedSchema.enum:type_name -> j5.schema.v1.Enum
+	32, // 41: j5.sourcedef.v1.EntityKey.def:type_name -> j5.schema.v1.ObjectProperty
+	39, // 42: j5.sourcedef.v1.EntityKey.key:type_name -> j5.schema.v1.EntityKey
+	40, // 43: j5.sourcedef.v1.Oneof.def:type_name -> j5.schema.v1.Oneof
+	12, // 44: j5.sourcedef.v1.Oneof.schemas:type_name -> j5.sourcedef.v1.NestedSchema
+	33, // 45: j5.sourcedef.v1.Object.def:type_name -> j5.schema.v1.Object
+	12, // 46: j5.sourcedef.v1.Object.schemas:type_name -> j5.sourcedef.v1.NestedSchema
+	41, // 47: j5.sourcedef.v1.Polymorph.def:type_name -> j5.schema.v1.Polymorph
+	4,  // 48: j5.sourcedef.v1.EntityElement.entity:type_name -> j5.sourcedef.v1.Entity
+	19, // 49: j5.sourcedef.v1.Topic.type:type_name -> j5.sourcedef.v1.TopicType
+	24, // 50: j5.sourcedef.v1.TopicType.publish:type_name -> j5.sourcedef.v1.TopicType.Publish
+	25, // 51: j5.sourcedef.v1.TopicType.reqres:type_name -> j5.sourcedef.v1.TopicType.ReqRes
+	26, // 52: j5.sourcedef.v1.TopicType.upsert:type_name -> j5.sourcedef.v1.TopicType.Upsert
+	27, // 53: j5.sourcedef.v1.TopicType.event:type_name -> j5.sourcedef.v1.TopicType.Event
+	32, // 54: j5.sourcedef.v1.TopicMethod.fields:type_name -> j5.schema.v1.ObjectProperty
+	33, // 55: j5.sourcedef.v1.ObjectTemplate.def:type_name -> j5.schema.v1.Object
+	22, // 56: j5.sourcedef.v1.ObjectTemplate.params:type_name -> j5.sourcedef.v1.TemplateParam
+	20, // 57: j5.sourcedef.v1.TopicType.Publish.messages:type_name -> j5.sourcedef.v1.TopicMethod
+	20, // 58: j5.sourcedef.v1.TopicType.ReqRes.request:type_name -> j5.sourcedef.v1.TopicMethod
+	20, // 59: j5.sourcedef.v1.TopicType.ReqRes.reply:type_name -> j5.sourcedef.v1.TopicMethod
+	20, // 60: j5.sourcedef.v1.TopicType.Upsert.message:type_name -> j5.sourcedef.v1.TopicMethod
+	20, // 61: j5.sourcedef.v1.TopicType.Event.message:type_name -> j5.sourcedef.v1.TopicMethod
+	62, // [62:62] is the sub-list for method output_type
+	62, // [62:62] is the sub-list for method input_type
+	62, // [62:62] is the sub-list for extension type_name
+	62, // [62:62] is the sub-list for extension extendee
+	0,  // [0:62] is the sub-list for field type_name
 }
 
 func init() { file_j5_sourcedef_v1_file_proto_init() }
@@ -2613,7 +2873,7 @@ func file_j5_sourcedef_v1_file_proto_init() {
 			}
 		}
 		file_j5_sourcedef_v1_file_proto_msgTypes[21].Exporter = func(v any, i int) any {
-			switch v := v.(*TopicType_Publish); i {
+			switch v := v.(*ObjectTemplate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2625,7 +2885,7 @@ func file_j5_sourcedef_v1_file_proto_init() {
 			}
 		}
 		file_j5_sourcedef_v1_file_proto_msgTypes[22].Exporter = func(v any, i int) any {
-			switch v := v.(*TopicType_ReqRes); i {
+			switch v := v.(*TemplateParam); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2637,7 +2897,7 @@ func file_j5_sourcedef_v1_file_proto_init() {
 			}
 		}
 		file_j5_sourcedef_v1_file_proto_msgTypes[23].Exporter = func(v any, i int) any {
-			switch v := v.(*TopicType_Upsert); i {
+			switch v := v.(*TemplateInstance); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2649,6 +2909,42 @@ func file_j5_sourcedef_v1_file_proto_init() {
 			}
 		}
 		file_j5_sourcedef_v1_file_proto_msgTypes[24].Exporter = func(v any, i int) any {
+			switch v := v.(*TopicType_Publish); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_j5_sourcedef_v1_file_proto_msgTypes[25].Exporter = func(v any, i int) any {
+			switch v := v.(*TopicType_ReqRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_j5_sourcedef_v1_file_proto_msgTypes[26].Exporter = func(v any, i int) any {
+			switch v := v.(*TopicType_Upsert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_j5_sourcedef_v1_file_proto_msgTypes[27].Exporter = func(v any, i int) any {
 			switch v := v.(*TopicType_Event); i {
 			case 0:
 				return &v.state
@@ -2670,6 +2966,8 @@ func file_j5_sourcedef_v1_file_proto_init() {
 		(*RootElement_Topic)(nil),
 		(*RootElement_Service)(nil),
 		(*RootElement_StringFormat)(nil),
+		(*RootElement_Template)(nil),
+		(*RootElement_Instance)(nil),
 	}
 	file_j5_sourcedef_v1_file_proto_msgTypes[10].OneofWrappers = []any{}
 	file_j5_sourcedef_v1_file_proto_msgTypes[12].OneofWrappers = []any{
@@ -2690,7 +2988,7 @@ func file_j5_sourcedef_v1_file_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_j5_sourcedef_v1_file_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   25,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -52,6 +52,8 @@ const (
 	RootElement_Type_Topic        RootElementTypeKey = "topic"
 	RootElement_Type_Service      RootElementTypeKey = "service"
 	RootElement_Type_StringFormat RootElementTypeKey = "stringFormat"
+	RootElement_Type_Template     RootElementTypeKey = "template"
+	RootElement_Type_Instance     RootElementTypeKey = "instance"
 )
 
 func (x *RootElement) TypeKey() (RootElementTypeKey, bool) {
@@ -72,6 +74,10 @@ func (x *RootElement) TypeKey() (RootElementTypeKey, bool) {
 		return RootElement_Type_Service, true
 	case *RootElement_StringFormat:
 		return RootElement_Type_StringFormat, true
+	case *RootElement_Template:
+		return RootElement_Type_Template, true
+	case *RootElement_Instance:
+		return RootElement_Type_Instance, true
 	default:
 		return "", false
 	}
@@ -403,3 +409,36 @@ func (msg *TopicMethod) J5Reflect() j5reflect.Root {
 func (msg *TopicMethod) J5Object() j5reflect.Object {
 	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
 }
+
+func (msg *ObjectTemplate) Clone() any {
+	return proto.Clone(msg).(*ObjectTemplate)
+}
+func (msg *ObjectTemplate) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *ObjectTemplate) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *TemplateParam) Clone() any {
+	return proto.Clone(msg).(*TemplateParam)
+}
+func (msg *TemplateParam) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *TemplateParam) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
+
+func (msg *TemplateInstance) Clone() any {
+	return proto.Clone(msg).(*TemplateInstance)
+}
+func (msg *TemplateInstance) J5Reflect() j5reflect.Root {
+	return j5reflect.MustReflect(msg.ProtoReflect())
+}
+
+func (msg *TemplateInstance) J5Object() j5reflect.Object {
+	return j5reflect.MustReflect(msg.ProtoReflect()).(j5reflect.Object)
+}
@@ -0,0 +1,145 @@
+// Package apilint checks a J5 API against the lint rules configured for a repo,
+// for conventions which are valid J5 but not the house style, e.g. missing
+// descriptions or topic names.
+package apilint
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pentops/j5/gen/j5/config/v1/config_j5pb"
+	"github.com/pentops/j5/gen/j5/schema/v1/schema_j5pb"
+	"github.com/pentops/j5/internal/bcl/errpos"
+)
+
+// Location of an element in the API. Package is the package of the element
+// including the sub-package, e.g. `foo.v1.service`. Only the names for the
+// kind of element are set, e.g. Schema and Property for a property.
+type Location struct {
+	Package  string
+	Schema   string
+	Property string
+	Service  string
+	Method   string
+	Topic    string
+}
+
+func (loc Location) String() string {
+	parts := []string{loc.Package}
+	for _, part := range []string{loc.Schema, loc.Property, loc.Service, loc.Method, loc.Topic} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ".")
+}
+
+// Violation is an element of the API which breaks a rule.
+type Violation struct {
+	Rule     string
+	Severity errpos.Severity
+	Location Location
+	Message  string
+}
+
+func (v *Violation) Error() string {
+	return fmt.Sprintf("%s [%s]", v.Message, v.Rule)
+}
+
+type reportFunc func(loc Location, format string, args ...any)
+
+type checkFunc func(api *apiIndex, report reportFunc)
+
+type rule struct {
+	name     string
+	severity errpos.Severity
+	check    checkFunc
+}
+
+// RuleSet is the enabled rules of a lint config.
+type RuleSet struct {
+	rules []*rule
+}
+
+// NewRuleSet builds the rules of the config. Rules with severity OFF are
+// skipped, unknown rules and invalid options are errors.
+func NewRuleSet(config *config_j5pb.LintConfig) (*RuleSet, error) {
+	rs := &RuleSet{}
+	seen := map[string]bool{}
+
+	add := func(name string, configSeverity config_j5pb.LintSeverity, check checkFunc) error {
+		if seen[name] {
+			return fmt.Errorf("duplicate lint rule %q", name)
+		}
+		seen[name] = true
+
+		severity, enabled := ruleSeverity(configSeverity)
+		if !enabled {
+			return nil
+		}
+		rs.rules = append(rs.rules, &rule{
+			name:     name,
+			severity: severity,
+			check:    check,
+		})
+		return nil
+	}
+
+	for _, ruleConfig := range config.Rules {
+		build, ok := builtinRules[ruleConfig.Name]
+		if !ok {
+			return nil, fmt.Errorf("unknown lint rule %q, expected one of %s", ruleConfig.Name, strings.Join(ruleNames(), ", "))
+		}
+		check, err := build(ruleConfig.Options)
+		if err != nil {
+			return nil, fmt.Errorf("lint rule %s: %w", ruleConfig.Name, err)
+		}
+		if err := add(ruleConfig.Name, ruleConfig.Severity, check); err != nil {
+			return nil, err
+		}
+	}
+
+	for idx, pattern := range config.Patterns {
+		if pattern.Name == "" {
+			return nil, fmt.Errorf("lint pattern %d has no name", idx)
+		}
+		check, err := patternRule(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("lint pattern %s: %w", pattern.Name, err)
+		}
+		if err := add(pattern.Name, pattern.Severity, check); err != nil {
+			return nil, err
+		}
+	}
+
+	return rs, nil
+}
+
+func ruleSeverity(severity config_j5pb.LintSeverity) (errpos.Severity, bool) {
+	switch severity {
+	case config_j5pb.LintSeverity_LINT_SEVERITY_OFF:
+		return errpos.SeverityError, false
+	case config_j5pb.LintSeverity_LINT_SEVERITY_WARNING:
+		return errpos.SeverityWarning, true
+	default:
+		return errpos.SeverityError, true
+	}
+}
+
+// Run checks the API against each rule. Indirect packages are not checked,
+// but are followed to find the schemas used by public services.
+func (rs *RuleSet) Run(api *schema_j5pb.API) []*Violation {
+	index := newAPIIndex(api)
+	violations := make([]*Violation, 0)
+	for _, rule := range rs.rules {
+		rule.check(index, func(loc Location, format string, args ...any) {
+			violations = append(violations, &Violation{
+				Rule:     rule.name,
+				Severity: rule.severity,
+				Location: loc,
+				Message:  fmt.Sprintf(format, args...),
+			})
+		})
+	}
+	return violations
+}
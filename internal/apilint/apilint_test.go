@@ -0,0 +1,263 @@
+package apilint
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/pentops/j5/gen/j5/config/v1/config_j5pb"
+	"github.com/pentops/j5/gen/j5/schema/v1/schema_j5pb"
+)
+
+func stringField() *schema_j5pb.Field {
+	return &schema_j5pb.Field{
+		Type: &schema_j5pb.Field_String_{
+			String_: &schema_j5pb.StringField{},
+		},
+	}
+}
+
+func refField(pkg, name string) *schema_j5pb.Field {
+	return &schema_j5pb.Field{
+		Type: &schema_j5pb.Field_Object{
+			Object: &schema_j5pb.ObjectField{
+				Schema: &schema_j5pb.ObjectField_Ref{
+					Ref: &schema_j5pb.Ref{Package: pkg, Schema: name},
+				},
+			},
+		},
+	}
+}
+
+func objectSchema(obj *schema_j5pb.Object) *schema_j5pb.RootSchema {
+	return &schema_j5pb.RootSchema{
+		Type: &schema_j5pb.RootSchema_Object{Object: obj},
+	}
+}
+
+func testAPI() *schema_j5pb.API {
+	return &schema_j5pb.API{
+		Packages: []*schema_j5pb.Package{{
+			Name: "foo.v1",
+			Schemas: map[string]*schema_j5pb.RootSchema{
+				"Foo": objectSchema(&schema_j5pb.Object{
+					Name: "Foo",
+					Properties: []*schema_j5pb.ObjectProperty{{
+						Name:        "fooId",
+						Description: "The ID",
+						Schema:      stringField(),
+					}, {
+						Name:   "extra",
+						Schema: &schema_j5pb.Field{Type: &schema_j5pb.Field_Any{Any: &schema_j5pb.AnyField{}}},
+					}, {
+						Name:   "shared",
+						Schema: refField("shared.v1", "Shared"),
+					}},
+				}),
+				"Internal": objectSchema(&schema_j5pb.Object{
+					Name: "Internal",
+					Properties: []*schema_j5pb.ObjectProperty{{
+						Name:   "secret",
+						Schema: stringField(),
+					}},
+				}),
+				"FooKeys": objectSchema(&schema_j5pb.Object{
+					Name:   "FooKeys",
+					Entity: &schema_j5pb.EntityObject{Entity: "foo", Part: schema_j5pb.EntityPart_KEYS},
+					Properties: []*schema_j5pb.ObjectProperty{{
+						Name:        "fooId",
+						Description: "The ID",
+						Schema:      stringField(),
+					}},
+				}),
+			},
+			SubPackages: []*schema_j5pb.SubPackage{{
+				Name: "service",
+				Schemas: map[string]*schema_j5pb.RootSchema{
+					"GetFooRequest": objectSchema(&schema_j5pb.Object{
+						Name: "GetFooRequest",
+					}),
+					"GetFooResponse": objectSchema(&schema_j5pb.Object{
+						Name: "GetFooResponse",
+						Properties: []*schema_j5pb.ObjectProperty{{
+							Name:        "foo",
+							Description: "The Foo",
+							Schema:      refField("foo.v1", "Foo"),
+						}},
+					}),
+					"GetInternalRequest": objectSchema(&schema_j5pb.Object{
+						Name: "GetInternalRequest",
+					}),
+					"GetInternalResponse": objectSchema(&schema_j5pb.Object{
+						Name: "GetInternalResponse",
+						Properties: []*schema_j5pb.ObjectProperty{{
+							Name:   "internal",
+							Schema: refField("foo.v1", "Internal"),
+						}},
+					}),
+				},
+				Services: []*schema_j5pb.Service{{
+					Name: "FooService",
+					Methods: []*schema_j5pb.Method{{
+						Name:           "GetFoo",
+						RequestSchema:  "GetFooRequest",
+						ResponseSchema: "GetFooResponse",
+					}},
+				}, {
+					Name:     "InternalService",
+					Audience: []string{"admin"},
+					Methods: []*schema_j5pb.Method{{
+						Name:           "getInternal",
+						RequestSchema:  "GetInternalRequest",
+						ResponseSchema: "GetInternalResponse",
+					}},
+				}},
+			}},
+		}, {
+			Name:     "shared.v1",
+			Indirect: true,
+			Schemas: map[string]*schema_j5pb.RootSchema{
+				"Shared": objectSchema(&schema_j5pb.Object{
+					Name: "Shared",
+					Properties: []*schema_j5pb.ObjectProperty{{
+						Name:   "undocumented",
+						Schema: stringField(),
+					}},
+				}),
+			},
+		}},
+	}
+}
+
+func runRules(t *testing.T, config *config_j5pb.LintConfig) []string {
+	t.Helper()
+	rules, err := NewRuleSet(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make([]string, 0)
+	for _, violation := range rules.Run(testAPI()) {
+		got = append(got, fmt.Sprintf("%s: %s (%s)", violation.Location, violation.Error(), violation.Severity))
+	}
+	return got
+}
+
+func assertViolations(t *testing.T, got []string, want ...string) {
+	t.Helper()
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("got violations:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestBuiltinRules(t *testing.T) {
+	got := runRules(t, &config_j5pb.LintConfig{
+		Rules: []*config_j5pb.LintRule{{
+			Name: "field-description",
+		}, {
+			Name:     "no-any-public",
+			Severity: config_j5pb.LintSeverity_LINT_SEVERITY_WARNING,
+		}},
+	})
+
+	// Internal is only used by a service with another audience, Shared is
+	// public but in an indirect package.
+	assertViolations(t, got,
+		"foo.v1.Foo.extra: property extra of public schema Foo has no description [field-description] (error)",
+		"foo.v1.Foo.shared: property shared of public schema Foo has no description [field-description] (error)",
+		"foo.v1.Foo.extra: property extra of public schema Foo is 'any' [no-any-public] (warning)",
+	)
+}
+
+func TestPatternRules(t *testing.T) {
+	got := runRules(t, &config_j5pb.LintConfig{
+		Patterns: []*config_j5pb.LintPattern{{
+			Name:    "method-case",
+			Target:  config_j5pb.LintTarget_LINT_TARGET_METHOD,
+			Pattern: "[A-Z][A-Za-z]+",
+			Message: "methods are PascalCase",
+		}, {
+			Name:    "entity-name",
+			Target:  config_j5pb.LintTarget_LINT_TARGET_ENTITY,
+			Pattern: "[A-Z][a-z]{3,}",
+		}, {
+			Name:     "no-id-suffix",
+			Target:   config_j5pb.LintTarget_LINT_TARGET_PROPERTY,
+			Pattern:  ".*Id",
+			Severity: config_j5pb.LintSeverity_LINT_SEVERITY_OFF,
+		}},
+	})
+
+	assertViolations(t, got,
+		"foo.v1.service.InternalService.getInternal: method getInternal: methods are PascalCase [method-case] (error)",
+		"foo.v1.FooKeys: entity Foo does not match [A-Z][a-z]{3,} [entity-name] (error)",
+	)
+}
+
+func TestRuleConfigErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		config    *config_j5pb.LintConfig
+		wantError string
+	}{{
+		name: "unknown rule",
+		config: &config_j5pb.LintConfig{
+			Rules: []*config_j5pb.LintRule{{Name: "no-such-rule"}},
+		},
+		wantError: `unknown lint rule "no-such-rule"`,
+	}, {
+		name: "unknown option",
+		config: &config_j5pb.LintConfig{
+			Rules: []*config_j5pb.LintRule{{
+				Name:    "field-description",
+				Options: map[string]string{"pattern": "x"},
+			}},
+		},
+		wantError: `unknown option "pattern"`,
+	}, {
+		name: "missing option",
+		config: &config_j5pb.LintConfig{
+			Rules: []*config_j5pb.LintRule{{Name: "topic-naming"}},
+		},
+		wantError: "option 'pattern' is required",
+	}, {
+		name: "invalid pattern",
+		config: &config_j5pb.LintConfig{
+			Patterns: []*config_j5pb.LintPattern{{
+				Name:    "bad",
+				Target:  config_j5pb.LintTarget_LINT_TARGET_SCHEMA,
+				Pattern: "[",
+			}},
+		},
+		wantError: "invalid pattern",
+	}, {
+		name: "no target",
+		config: &config_j5pb.LintConfig{
+			Patterns: []*config_j5pb.LintPattern{{
+				Name:    "bad",
+				Pattern: ".*",
+			}},
+		},
+		wantError: "unsupported target",
+	}, {
+		name: "duplicate",
+		config: &config_j5pb.LintConfig{
+			Rules: []*config_j5pb.LintRule{{Name: "field-description"}},
+			Patterns: []*config_j5pb.LintPattern{{
+				Name:    "field-description",
+				Target:  config_j5pb.LintTarget_LINT_TARGET_SCHEMA,
+				Pattern: ".*",
+			}},
+		},
+		wantError: `duplicate lint rule "field-description"`,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewRuleSet(tc.config)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if !strings.Contains(err.Error(), tc.wantError) {
+				t.Errorf("got error %q, want %q", err.Error(), tc.wantError)
+			}
+		})
+	}
+}
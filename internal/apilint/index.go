@@ -0,0 +1,249 @@
+package apilint
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/pentops/j5/gen/j5/schema/v1/schema_j5pb"
+)
+
+// apiIndex is the API flattened for the rules, with the schemas of every
+// package by the full name used in refs.
+type apiIndex struct {
+	// packages are the checked packages and sub-packages, in order.
+	packages []*indexPackage
+
+	schemas map[string]*schema_j5pb.RootSchema
+
+	// public is built on the first call to isPublic
+	public map[string]bool
+}
+
+type indexPackage struct {
+	name     string
+	schemas  map[string]*schema_j5pb.RootSchema
+	services []*schema_j5pb.Service
+	topics   []*schema_j5pb.Topic
+}
+
+func newAPIIndex(api *schema_j5pb.API) *apiIndex {
+	index := &apiIndex{
+		schemas: map[string]*schema_j5pb.RootSchema{},
+	}
+
+	addSchemas := func(pkgName string, schemas map[string]*schema_j5pb.RootSchema) {
+		for name, schema := range schemas {
+			index.schemas[pkgName+"."+name] = schema
+		}
+	}
+
+	for _, pkg := range api.Packages {
+		addSchemas(pkg.Name, pkg.Schemas)
+		if !pkg.Indirect {
+			index.packages = append(index.packages, &indexPackage{
+				name:    pkg.Name,
+				schemas: pkg.Schemas,
+			})
+		}
+
+		for _, subPkg := range pkg.SubPackages {
+			subName := pkg.Name + "." + subPkg.Name
+			addSchemas(subName, subPkg.Schemas)
+			if !pkg.Indirect {
+				index.packages = append(index.packages, &indexPackage{
+					name:     subName,
+					schemas:  subPkg.Schemas,
+					services: subPkg.Services,
+					topics:   subPkg.Topics,
+				})
+			}
+		}
+	}
+
+	return index
+}
+
+// rangeSchemas calls the callback for each schema of the checked packages,
+// sorted by name within each package.
+func (index *apiIndex) rangeSchemas(callback func(loc Location, schema *schema_j5pb.RootSchema)) {
+	for _, pkg := range index.packages {
+		names := make([]string, 0, len(pkg.schemas))
+		for name := range pkg.schemas {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			callback(Location{
+				Package: pkg.name,
+				Schema:  name,
+			}, pkg.schemas[name])
+		}
+	}
+}
+
+// rangeProperties calls the callback for each property of the object and
+// oneof schemas of the checked packages.
+func (index *apiIndex) rangeProperties(callback func(loc Location, prop *schema_j5pb.ObjectProperty)) {
+	index.rangeSchemas(func(loc Location, schema *schema_j5pb.RootSchema) {
+		for _, prop := range schemaProperties(schema) {
+			propLoc := loc
+			propLoc.Property = prop.Name
+			callback(propLoc, prop)
+		}
+	})
+}
+
+func (index *apiIndex) rangeServices(callback func(loc Location, service *schema_j5pb.Service)) {
+	for _, pkg := range index.packages {
+		for _, service := range pkg.services {
+			callback(Location{
+				Package: pkg.name,
+				Service: service.Name,
+			}, service)
+		}
+	}
+}
+
+func (index *apiIndex) rangeTopics(callback func(loc Location, topic *schema_j5pb.Topic)) {
+	for _, pkg := range index.packages {
+		for _, topic := range pkg.topics {
+			callback(Location{
+				Package: pkg.name,
+				Topic:   topic.Name,
+			}, topic)
+		}
+	}
+}
+
+// isPublic is true for schemas used, directly or through other schemas, by a
+// method of a public service.
+func (index *apiIndex) isPublic(loc Location) bool {
+	if index.public == nil {
+		index.public = index.publicSchemas()
+	}
+	return index.public[loc.Package+"."+loc.Schema]
+}
+
+// isPublicService is true when the service has no audience, or the 'public'
+// audience.
+func isPublicService(service *schema_j5pb.Service) bool {
+	return len(service.Audience) == 0 || slices.Contains(service.Audience, "public")
+}
+
+func (index *apiIndex) publicSchemas() map[string]bool {
+	public := map[string]bool{}
+
+	var walk func(fullName string)
+	walk = func(fullName string) {
+		if public[fullName] {
+			return
+		}
+		schema, ok := index.schemas[fullName]
+		if !ok {
+			return
+		}
+		public[fullName] = true
+
+		for _, prop := range schemaProperties(schema) {
+			for _, ref := range fieldRefs(prop.Schema) {
+				walk(ref)
+			}
+		}
+		if polymorph := schema.GetPolymorph(); polymorph != nil {
+			for _, member := range polymorph.Members {
+				walk(member)
+			}
+		}
+	}
+
+	for _, pkg := range index.packages {
+		for _, service := range pkg.services {
+			if !isPublicService(service) {
+				continue
+			}
+			for _, method := range service.Methods {
+				walk(pkg.name + "." + method.RequestSchema)
+				walk(pkg.name + "." + method.ResponseSchema)
+			}
+		}
+	}
+
+	return public
+}
+
+func schemaProperties(schema *schema_j5pb.RootSchema) []*schema_j5pb.ObjectProperty {
+	switch st := schema.Type.(type) {
+	case *schema_j5pb.RootSchema_Object:
+		return st.Object.Properties
+	case *schema_j5pb.RootSchema_Oneof:
+		return st.Oneof.Properties
+	default:
+		return nil
+	}
+}
+
+// fieldRefs returns the full names of the schemas referenced by the field,
+// including those in inline schemas.
+func fieldRefs(field *schema_j5pb.Field) []string {
+	refs := make([]string, 0)
+	var walkProperties func(props []*schema_j5pb.ObjectProperty)
+	var walk func(field *schema_j5pb.Field)
+
+	addRef := func(ref *schema_j5pb.Ref) {
+		refs = append(refs, ref.Package+"."+ref.Schema)
+	}
+
+	walkProperties = func(props []*schema_j5pb.ObjectProperty) {
+		for _, prop := range props {
+			walk(prop.Schema)
+		}
+	}
+
+	walk = func(field *schema_j5pb.Field) {
+		switch ft := field.GetType().(type) {
+		case *schema_j5pb.Field_Object:
+			switch st := ft.Object.Schema.(type) {
+			case *schema_j5pb.ObjectField_Ref:
+				addRef(st.Ref)
+			case *schema_j5pb.ObjectField_Object:
+				walkProperties(st.Object.Properties)
+			}
+		case *schema_j5pb.Field_Oneof:
+			switch st := ft.Oneof.Schema.(type) {
+			case *schema_j5pb.OneofField_Ref:
+				addRef(st.Ref)
+			case *schema_j5pb.OneofField_Oneof:
+				walkProperties(st.Oneof.Properties)
+			}
+		case *schema_j5pb.Field_Enum:
+			if ref := ft.Enum.GetRef(); ref != nil {
+				addRef(ref)
+			}
+		case *schema_j5pb.Field_Polymorph:
+			if ref := ft.Polymorph.GetRef(); ref != nil {
+				addRef(ref)
+			}
+		case *schema_j5pb.Field_Array:
+			walk(ft.Array.Items)
+		case *schema_j5pb.Field_Map:
+			walk(ft.Map.ItemSchema)
+		}
+	}
+
+	walk(field)
+	return refs
+}
+
+// isAny is true for an any field, or an array or map of any.
+func isAny(field *schema_j5pb.Field) bool {
+	switch ft := field.GetType().(type) {
+	case *schema_j5pb.Field_Any:
+		return true
+	case *schema_j5pb.Field_Array:
+		return isAny(ft.Array.Items)
+	case *schema_j5pb.Field_Map:
+		return isAny(ft.Map.ItemSchema)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,191 @@
+package apilint
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/pentops/j5/gen/j5/config/v1/config_j5pb"
+	"github.com/pentops/j5/gen/j5/schema/v1/schema_j5pb"
+)
+
+type ruleBuilder func(options map[string]string) (checkFunc, error)
+
+var builtinRules = map[string]ruleBuilder{
+	"field-description": noOptions(fieldDescription),
+	"topic-naming":      topicNaming,
+	"entity-query-auth": noOptions(entityQueryAuth),
+	"no-any-public":     noOptions(noAnyPublic),
+}
+
+func noOptions(check checkFunc) ruleBuilder {
+	return func(options map[string]string) (checkFunc, error) {
+		if err := checkOptions(options); err != nil {
+			return nil, err
+		}
+		return check, nil
+	}
+}
+
+func checkOptions(options map[string]string, allowed ...string) error {
+	for key := range options {
+		if !slices.Contains(allowed, key) {
+			return fmt.Errorf("unknown option %q", key)
+		}
+	}
+	return nil
+}
+
+// compilePattern requires the pattern to match the whole name.
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	re, err := regexp.Compile("^(?:" + pattern + ")$")
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern: %w", err)
+	}
+	return re, nil
+}
+
+func fieldDescription(api *apiIndex, report reportFunc) {
+	api.rangeProperties(func(loc Location, prop *schema_j5pb.ObjectProperty) {
+		if !api.isPublic(loc) || prop.Description != "" {
+			return
+		}
+		report(loc, "property %s of public schema %s has no description", prop.Name, loc.Schema)
+	})
+}
+
+func noAnyPublic(api *apiIndex, report reportFunc) {
+	api.rangeProperties(func(loc Location, prop *schema_j5pb.ObjectProperty) {
+		if !api.isPublic(loc) || !isAny(prop.Schema) {
+			return
+		}
+		report(loc, "property %s of public schema %s is 'any'", prop.Name, loc.Schema)
+	})
+}
+
+func topicNaming(options map[string]string) (checkFunc, error) {
+	if err := checkOptions(options, "pattern"); err != nil {
+		return nil, err
+	}
+	pattern, ok := options["pattern"]
+	if !ok {
+		return nil, fmt.Errorf("option 'pattern' is required")
+	}
+	re, err := compilePattern(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(api *apiIndex, report reportFunc) {
+		api.rangeTopics(func(loc Location, topic *schema_j5pb.Topic) {
+			if !re.MatchString(topic.Name) {
+				report(loc, "topic %s does not match %s", topic.Name, pattern)
+			}
+		})
+	}, nil
+}
+
+func entityQueryAuth(api *apiIndex, report reportFunc) {
+	api.rangeServices(func(loc Location, service *schema_j5pb.Service) {
+		query := service.GetType().GetStateEntityQuery()
+		if query == nil || service.DefaultAuth != nil {
+			return
+		}
+		missing := make([]string, 0)
+		for _, method := range service.Methods {
+			if method.Auth == nil {
+				missing = append(missing, method.Name)
+			}
+		}
+		if len(missing) > 0 {
+			report(loc, "entity %s has no query auth, required for %s", query.Entity, strings.Join(missing, ", "))
+		}
+	})
+}
+
+// patternRule checks the names of the target elements against the pattern.
+func patternRule(config *config_j5pb.LintPattern) (checkFunc, error) {
+	re, err := compilePattern(config.Pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	check := func(report reportFunc, loc Location, kind, name string) {
+		if re.MatchString(name) {
+			return
+		}
+		if config.Message != "" {
+			report(loc, "%s %s: %s", kind, name, config.Message)
+		} else {
+			report(loc, "%s %s does not match %s", kind, name, config.Pattern)
+		}
+	}
+
+	switch config.Target {
+	case config_j5pb.LintTarget_LINT_TARGET_SCHEMA:
+		return func(api *apiIndex, report reportFunc) {
+			api.rangeSchemas(func(loc Location, _ *schema_j5pb.RootSchema) {
+				check(report, loc, "schema", loc.Schema)
+			})
+		}, nil
+
+	case config_j5pb.LintTarget_LINT_TARGET_PROPERTY:
+		return func(api *apiIndex, report reportFunc) {
+			api.rangeProperties(func(loc Location, _ *schema_j5pb.ObjectProperty) {
+				check(report, loc, "property", loc.Property)
+			})
+		}, nil
+
+	case config_j5pb.LintTarget_LINT_TARGET_SERVICE:
+		return func(api *apiIndex, report reportFunc) {
+			api.rangeServices(func(loc Location, _ *schema_j5pb.Service) {
+				check(report, loc, "service", loc.Service)
+			})
+		}, nil
+
+	case config_j5pb.LintTarget_LINT_TARGET_METHOD:
+		return func(api *apiIndex, report reportFunc) {
+			api.rangeServices(func(loc Location, service *schema_j5pb.Service) {
+				for _, method := range service.Methods {
+					methodLoc := loc
+					methodLoc.Method = method.Name
+					check(report, methodLoc, "method", method.Name)
+				}
+			})
+		}, nil
+
+	case config_j5pb.LintTarget_LINT_TARGET_TOPIC:
+		return func(api *apiIndex, report reportFunc) {
+			api.rangeTopics(func(loc Location, _ *schema_j5pb.Topic) {
+				check(report, loc, "topic", loc.Topic)
+			})
+		}, nil
+
+	case config_j5pb.LintTarget_LINT_TARGET_ENTITY:
+		// Entities are found by their keys schema, named for the entity as
+		// written in the source, e.g. FooKeys for entity Foo.
+		return func(api *apiIndex, report reportFunc) {
+			api.rangeSchemas(func(loc Location, schema *schema_j5pb.RootSchema) {
+				entity := schema.GetObject().GetEntity()
+				if entity == nil || entity.Part != schema_j5pb.EntityPart_KEYS || !strings.HasSuffix(loc.Schema, "Keys") {
+					return
+				}
+				check(report, loc, "entity", strings.TrimSuffix(loc.Schema, "Keys"))
+			})
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported target %s", config.Target)
+	}
+}
+
+func ruleNames() []string {
+	names := make([]string, 0, len(builtinRules))
+	for name := range builtinRules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
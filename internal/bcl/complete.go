@@ -0,0 +1,103 @@
+package bcl
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/pentops/j5/gen/j5/bcl/v1/bcl_j5pb"
+	"github.com/pentops/j5/internal/bcl/errpos"
+	"github.com/pentops/j5/internal/bcl/internal/parser"
+	"github.com/pentops/j5/internal/bcl/internal/walker"
+	"github.com/pentops/j5/internal/bcl/internal/walker/schema"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type Completion = schema.Completion
+type CompletionKind = schema.CompletionKind
+
+const (
+	CompletionBlock     = schema.CompletionBlock
+	CompletionAttribute = schema.CompletionAttribute
+	CompletionValue     = schema.CompletionValue
+)
+
+// Completions describes the names which are valid at a point in a file.
+type Completions struct {
+	Point   errpos.Point
+	Options []Completion
+
+	// Block is the schema name of the innermost block at the point.
+	Block string
+
+	// Attribute is the field set by the tag or value at the point, empty when
+	// the point is in the body of a block.
+	Attribute string
+
+	// Schema is the type of the Attribute, e.g. `object(j5.schema.v1.Ref)`,
+	// for values which the schema can't list.
+	Schema string
+
+	// SourceLocations of the message, which holds as much of the file as
+	// could be parsed.
+	SourceLocations *bcl_j5pb.SourceLocation
+}
+
+// completionPlaceholder is inserted at the point when it is not already on a
+// token, so that an empty tag or value parses, e.g. `field foo key:`
+const completionPlaceholder = "x"
+
+// Complete walks the file to the point and returns the names which are valid
+// there. The file is usually incomplete while being edited, so errors away
+// from the point are ignored, and msg is populated as far as possible.
+func (p *Parser) Complete(filename string, data string, msg protoreflect.Message, pt errpos.Point) (*Completions, error) {
+	data = withPlaceholder(data, pt)
+
+	tree, err := parser.ParseFile(filename, data, false)
+	if tree == nil {
+		return nil, err
+	}
+
+	obj, err := p.refl.NewObject(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	source := &bcl_j5pb.SourceLocation{}
+	scope, err := schema.NewRootSchemaWalker(p.schema, obj, source)
+	if err != nil {
+		return nil, err
+	}
+
+	// errors are expected, the completions are as good as the walk allows.
+	walked, _ := walker.CompleteSchema(scope, tree.Body, pt, p.Verbose)
+
+	return &Completions{
+		Point:           pt,
+		Options:         walked.Options,
+		Block:           walked.Block,
+		Attribute:       walked.Attribute,
+		Schema:          walked.Schema,
+		SourceLocations: source,
+	}, nil
+}
+
+// withPlaceholder inserts the placeholder at the point, unless the point is
+// just after a word.
+func withPlaceholder(data string, pt errpos.Point) string {
+	lines := strings.Split(data, "\n")
+	if pt.Line < 0 || pt.Line >= len(lines) {
+		return data
+	}
+
+	line := []rune(lines[pt.Line])
+	col := min(max(pt.Column, 0), len(line))
+	if col > 0 {
+		prev := line[col-1]
+		if unicode.IsLetter(prev) || unicode.IsDigit(prev) || prev == '_' {
+			return data
+		}
+	}
+
+	lines[pt.Line] = string(line[:col]) + completionPlaceholder + string(line[col:])
+	return strings.Join(lines, "\n")
+}
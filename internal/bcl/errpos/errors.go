@@ -223,6 +223,11 @@ type Err struct {
 	Pos *Position
 	Ctx Context
 	Err error
+
+	// Fix is an optional suggestion which resolves the error.
+	Fix *Fix
+
+	Severity Severity
 }
 
 var _ HasPosition = &Err{}
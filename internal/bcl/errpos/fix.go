@@ -0,0 +1,175 @@
+package errpos
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Fix is a machine-readable suggestion which resolves an error. The edits
+// apply to the file of the error.
+type Fix struct {
+	// Title describes the fix to a human, e.g. `import foo.v1`
+	Title string
+
+	Edits []Edit
+}
+
+// Edit replaces the text from Start up to, but not including, End. An
+// insertion has an End equal to Start.
+type Edit struct {
+	Start   Point
+	End     Point
+	NewText string
+}
+
+// ReplaceEdit replaces the text of a position, where the End column is the
+// last character, as in parsed source locations.
+func ReplaceEdit(pos Position, newText string) Edit {
+	return Edit{
+		Start: pos.Start,
+		End: Point{
+			Line:   pos.End.Line,
+			Column: pos.End.Column + 1,
+		},
+		NewText: newText,
+	}
+}
+
+// InsertEdit inserts text at a point.
+func InsertEdit(at Point, text string) Edit {
+	return Edit{
+		Start:   at,
+		End:     at,
+		NewText: text,
+	}
+}
+
+// AddFix adds a fix to an error.
+// If the error is nil, returns nil.
+// If the error already has a fix it is returned unmodified.
+func AddFix(err error, fix *Fix) error {
+	if err == nil {
+		return nil
+	}
+
+	existing := &Err{}
+	if !errors.As(err, &existing) {
+		return &Err{
+			Pos: GetErrorPosition(err),
+			Err: err,
+			Fix: fix,
+		}
+	}
+
+	if existing.Fix != nil {
+		return existing
+	}
+
+	existing.mergeErr(err, "Fix")
+	existing.Fix = fix
+	return existing
+}
+
+// Fixes returns the fixes of the errors in the file, skipping fixes which
+// overlap an earlier fix, so that all of the returned fixes can be applied
+// together.
+func (e Errors) Fixes(filename string) []*Fix {
+	fixes := make([]*Fix, 0)
+	var applied []Edit
+	for _, err := range e {
+		if err.Fix == nil || err.Pos == nil || err.Pos.Filename == nil || *err.Pos.Filename != filename {
+			continue
+		}
+		if editsOverlap(applied, err.Fix.Edits) {
+			continue
+		}
+		applied = append(applied, err.Fix.Edits...)
+		fixes = append(fixes, err.Fix)
+	}
+	return fixes
+}
+
+func pointBefore(a, b Point) bool {
+	return a.Line < b.Line || (a.Line == b.Line && a.Column < b.Column)
+}
+
+func editsOverlap(existing []Edit, edits []Edit) bool {
+	for _, a := range existing {
+		for _, b := range edits {
+			if a == b {
+				// the same fix from two errors, e.g. two refs to one
+				// missing import
+				return true
+			}
+			if pointBefore(a.Start, b.End) && pointBefore(b.Start, a.End) {
+				return true
+			}
+			if (a.Start == a.End || b.Start == b.End) && a.Start == b.Start {
+				// insertions at the same point would be applied in an
+				// arbitrary order
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ApplyFixes applies the edits of the fixes to the content of their file. The
+// fixes must not overlap.
+func ApplyFixes(content string, fixes []*Fix) (string, error) {
+	edits := make([]Edit, 0)
+	for _, fix := range fixes {
+		edits = append(edits, fix.Edits...)
+	}
+	return ApplyEdits(content, edits)
+}
+
+// ApplyEdits applies non-overlapping edits to the content.
+func ApplyEdits(content string, edits []Edit) (string, error) {
+	lineStarts := []int{0}
+	for idx, char := range content {
+		if char == '\n' {
+			lineStarts = append(lineStarts, idx+1)
+		}
+	}
+	offset := func(pt Point) (int, error) {
+		if pt.Line < 0 || pt.Line >= len(lineStarts) {
+			return 0, fmt.Errorf("line %d is outside of the content", pt.Line+1)
+		}
+		lineEnd := len(content)
+		if pt.Line+1 < len(lineStarts) {
+			lineEnd = lineStarts[pt.Line+1] - 1
+		}
+		at := lineStarts[pt.Line] + pt.Column
+		if pt.Column < 0 || at > lineEnd {
+			return 0, fmt.Errorf("column %d is outside of line %d", pt.Column+1, pt.Line+1)
+		}
+		return at, nil
+	}
+
+	sorted := make([]Edit, len(edits))
+	copy(sorted, edits)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return pointBefore(sorted[j].Start, sorted[i].Start)
+	})
+
+	out := content
+	end := len(content) + 1
+	for _, edit := range sorted {
+		start, err := offset(edit.Start)
+		if err != nil {
+			return "", err
+		}
+		stop, err := offset(edit.End)
+		if err != nil {
+			return "", err
+		}
+		if stop < start || stop > end {
+			return "", fmt.Errorf("edit at %s overlaps another edit", edit.Start)
+		}
+		out = out[:start] + edit.NewText + out[stop:]
+		end = start
+	}
+	return out, nil
+}
@@ -0,0 +1,108 @@
+package errpos
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestApplyEdits(t *testing.T) {
+	content := "package foo.v1\n\nobject Foo {\n}\n"
+
+	for _, tc := range []struct {
+		name  string
+		edits []Edit
+		want  string
+	}{{
+		name: "replace",
+		edits: []Edit{
+			ReplaceEdit(Position{
+				Start: Point{Line: 0, Column: 8},
+				End:   Point{Line: 0, Column: 13},
+			}, "bar.v1"),
+		},
+		want: "package bar.v1\n\nobject Foo {\n}\n",
+	}, {
+		name: "insert and replace",
+		edits: []Edit{
+			InsertEdit(Point{Line: 1}, "\nimport baz.v1\n"),
+			ReplaceEdit(Position{
+				Start: Point{Line: 2, Column: 7},
+				End:   Point{Line: 2, Column: 9},
+			}, "Bar"),
+		},
+		want: "package foo.v1\n\nimport baz.v1\n\nobject Bar {\n}\n",
+	}, {
+		name: "end of file",
+		edits: []Edit{
+			InsertEdit(Point{Line: 4}, "\nobject Bar {\n}\n"),
+		},
+		want: content + "\nobject Bar {\n}\n",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ApplyEdits(content, tc.edits)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Errorf("GOT:\n%s\nWANT:\n%s", got, tc.want)
+			}
+		})
+	}
+
+	t.Run("overlap", func(t *testing.T) {
+		_, err := ApplyEdits(content, []Edit{
+			{Start: Point{Line: 0, Column: 0}, End: Point{Line: 0, Column: 10}},
+			{Start: Point{Line: 0, Column: 5}, End: Point{Line: 0, Column: 12}},
+		})
+		if err == nil {
+			t.Fatal("expected an error for overlapping edits")
+		}
+	})
+
+	t.Run("out of range", func(t *testing.T) {
+		_, err := ApplyEdits(content, []Edit{
+			InsertEdit(Point{Line: 0, Column: 20}, "x"),
+		})
+		if err == nil {
+			t.Fatal("expected an error for a column past the end of the line")
+		}
+	})
+}
+
+func TestErrorsFixes(t *testing.T) {
+	filename := "foo.j5s"
+	other := "other.j5s"
+	importFix := &Fix{
+		Title: "Import baz.v1",
+		Edits: []Edit{InsertEdit(Point{Line: 1}, "import baz.v1\n")},
+	}
+
+	errs := Errors{{
+		Pos: &Position{Filename: &filename},
+		Err: fmt.Errorf("package not imported"),
+		Fix: importFix,
+	}, {
+		// The same missing import from a second reference
+		Pos: &Position{Filename: &filename},
+		Err: fmt.Errorf("package not imported"),
+		Fix: &Fix{
+			Title: "Import baz.v1",
+			Edits: []Edit{InsertEdit(Point{Line: 1}, "import baz.v1\n")},
+		},
+	}, {
+		Pos: &Position{Filename: &other},
+		Err: fmt.Errorf("other file"),
+		Fix: &Fix{Title: "Other"},
+	}, {
+		Pos: &Position{Filename: &filename},
+		Err: fmt.Errorf("no fix"),
+	}}
+
+	fixes := errs.Fixes(filename)
+	if len(fixes) != 1 {
+		t.Fatalf("expected 1 fix, got %d", len(fixes))
+	}
+	if fixes[0] != importFix {
+		t.Errorf("expected the first import fix, got %q", fixes[0].Title)
+	}
+}
@@ -87,10 +87,14 @@ func AsErrorsWithSource(err error) (*ErrorsWithSource, bool) {
 }
 
 func shortString(err *Err) string {
+	msg := err.Err.Error()
+	if err.Severity == SeverityWarning {
+		msg = err.Severity.String() + ": " + msg
+	}
 	if err.Pos == nil || err.Pos.isEmpty() {
-		return fmt.Sprintf("? %s", err.Err.Error())
+		return fmt.Sprintf("? %s", msg)
 	} else {
-		return fmt.Sprintf("%s %s", err.Pos.String(), err.Err.Error())
+		return fmt.Sprintf("%s %s", err.Pos.String(), msg)
 
 	}
 }
@@ -178,11 +182,19 @@ func humanString(err *Err, lines []string, context int) string {
 		out.WriteString(err.Ctx.String())
 		out.WriteString("\n")
 	}
+	if err.Severity == SeverityWarning {
+		fmt.Fprintf(out, "Severity: %s\n", err.Severity)
+	}
 	if err.Err != nil {
 		out.WriteString("Message: ")
 		out.WriteString(err.Err.Error())
 		out.WriteString("\n")
 	}
+	if err.Fix != nil {
+		out.WriteString("Fix: ")
+		out.WriteString(err.Fix.Title)
+		out.WriteString("\n")
+	}
 	return out.String()
 }
 
@@ -0,0 +1,29 @@
+package errpos
+
+// Severity of an error. The zero value is an error, warnings are reported
+// but don't fail the build.
+type Severity int
+
+const (
+	SeverityError Severity = iota
+	SeverityWarning
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityWarning:
+		return "warning"
+	default:
+		return "error"
+	}
+}
+
+// HasErrors returns true if any of the errors is not a warning.
+func (e Errors) HasErrors() bool {
+	for _, err := range e {
+		if err.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
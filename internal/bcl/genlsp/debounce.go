@@ -6,33 +6,44 @@ import (
 	"time"
 )
 
-type debounce[T any] struct {
-	timer     *time.Timer
-	duration  time.Duration
-	process   func(context.Context, T)
-	logicLock sync.Mutex
+// debounce processes the latest value requested for each key, once no new
+// value has been requested for that key within the duration. Values of
+// different keys don't delay each other.
+type debounce[K comparable, T any] struct {
+	duration time.Duration
+	key      func(T) K
+	process  func(context.Context, T)
+
+	lock    sync.Mutex
+	pending map[K]*time.Timer
 }
 
-func newDebounce[T any](duration time.Duration, process func(context.Context, T)) *debounce[T] {
-	return &debounce[T]{
+func newDebounce[K comparable, T any](duration time.Duration, key func(T) K, process func(context.Context, T)) *debounce[K, T] {
+	return &debounce[K, T]{
 		duration: duration,
+		key:      key,
 		process:  process,
+		pending:  map[K]*time.Timer{},
 	}
 }
 
-func (d *debounce[T]) request(ctx context.Context, t T) {
-	d.logicLock.Lock()
-	defer d.logicLock.Unlock()
-	if d.timer != nil {
-		d.timer.Reset(d.duration)
-		return
+func (d *debounce[K, T]) request(ctx context.Context, t T) {
+	key := d.key(t)
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if timer, ok := d.pending[key]; ok {
+		timer.Stop()
 	}
-	d.timer = time.AfterFunc(d.duration, func() {
-		d.logicLock.Lock()
-		defer d.logicLock.Unlock()
-		d.timer = nil
-		go func() {
-			d.process(ctx, t)
-		}()
+
+	var timer *time.Timer
+	timer = time.AfterFunc(d.duration, func() {
+		d.lock.Lock()
+		if d.pending[key] == timer {
+			delete(d.pending, key)
+		}
+		d.lock.Unlock()
+		d.process(ctx, t)
 	})
+	d.pending[key] = timer
 }
@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 
 	"github.com/pentops/log.go/log"
 	"go.lsp.dev/protocol"
@@ -18,6 +19,10 @@ type fileSet struct {
 	files  map[string]*protocol.TextDocumentItem
 	prefix string
 
+	// lock guards files. Documents are replaced rather than modified on
+	// change, so a document read under the lock can be used after it.
+	lock sync.Mutex
+
 	onChange func(context.Context, *protocol.TextDocumentItem)
 }
 
@@ -46,13 +51,31 @@ func (fs *fileSet) relativeURL(uri protocol.DocumentURI) (string, error) {
 	return relPath, nil
 }
 
+// documentURI is the URI of a file relative to the root.
+func (fs *fileSet) documentURI(relPath string) protocol.DocumentURI {
+	return protocol.DocumentURI(fs.prefix + relPath)
+}
+
+// openDocuments returns the open documents by path relative to the root.
+func (fs *fileSet) openDocuments() map[string]*protocol.TextDocumentItem {
+	fs.lock.Lock()
+	defer fs.lock.Unlock()
+	docs := make(map[string]*protocol.TextDocumentItem, len(fs.files))
+	for local, doc := range fs.files {
+		docs[local] = doc
+	}
+	return docs
+}
+
 func (fs *fileSet) getDocument(_ context.Context, docID protocol.TextDocumentIdentifier) (*protocol.TextDocumentItem, error) {
 	uri := docID.URI
 	local, err := fs.relativeURL(uri)
 	if err != nil {
 		return nil, err
 	}
+	fs.lock.Lock()
 	doc, ok := fs.files[local]
+	fs.lock.Unlock()
 	if !ok {
 		return nil, fmt.Errorf("document not open: %v", uri)
 	}
@@ -73,7 +96,9 @@ func (fs *fileSet) DidOpen(ctx context.Context, params *protocol.DidOpenTextDocu
 		"textLen":  len(params.TextDocument.Text),
 		"local":    local,
 	}).Debug("DidOpen")
+	fs.lock.Lock()
 	fs.files[local] = file
+	fs.lock.Unlock()
 
 	if fs.onChange != nil {
 		fs.onChange(ctx, file)
@@ -96,12 +121,20 @@ func (fs *fileSet) DidChange(ctx context.Context, params *protocol.DidChangeText
 		return fmt.Errorf("expected exactly one content change, got %v", len(params.ContentChanges))
 	}
 
-	file, ok := fs.files[local]
+	fs.lock.Lock()
+	existing, ok := fs.files[local]
 	if !ok {
+		fs.lock.Unlock()
 		return fmt.Errorf("document not open: %v", params.TextDocument.URI)
 	}
-	file.Text = params.ContentChanges[0].Text
-	file.Version = params.TextDocument.Version
+	file := &protocol.TextDocumentItem{
+		URI:        existing.URI,
+		LanguageID: existing.LanguageID,
+		Version:    params.TextDocument.Version,
+		Text:       params.ContentChanges[0].Text,
+	}
+	fs.files[local] = file
+	fs.lock.Unlock()
 
 	if fs.onChange != nil {
 		fs.onChange(ctx, file)
@@ -119,7 +152,9 @@ func (fs *fileSet) DidClose(ctx context.Context, params *protocol.DidCloseTextDo
 	log.WithFields(ctx, map[string]any{
 		"local": local,
 	}).Debug("DidClose")
+	fs.lock.Lock()
 	delete(fs.files, local)
+	fs.lock.Unlock()
 	return nil
 }
 
@@ -8,6 +8,7 @@ import (
 	"github.com/pentops/j5/internal/bcl"
 	"github.com/pentops/j5/internal/bcl/internal/linter"
 	"github.com/pentops/log.go/log"
+	"go.lsp.dev/protocol"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
@@ -15,26 +16,67 @@ type Config struct {
 	ProjectRoot string
 
 	FileTypes []FileTypeConfig
+
+	// Workspace optionally lints whole packages, publishing diagnostics for
+	// files which depend on a change, including files which are not open.
+	Workspace Workspace
 }
 
 type FileTypeConfig struct {
 	Match       func(filename string) bool
 	FileFactory func(filename string) protoreflect.Message
 	OnChange    func(ctx context.Context, filename string, sourceLocs *bcl_j5pb.SourceLocation, parsed protoreflect.Message) error
+
+	// Navigator optionally serves definition, references and hover requests
+	// for the file type.
+	Navigator Navigator
+
+	// Completer optionally serves completion requests for the file type.
+	Completer Completer
+
+	// Renamer optionally serves rename requests for the file type.
+	Renamer Renamer
+
+	// SymbolKinds maps the schema names of blocks to the kind of their
+	// document symbol, e.g. `j5.sourcedef.v1.Entity` to a class. Blocks not in
+	// the map are objects.
+	SymbolKinds map[string]protocol.SymbolKind
 }
 
 type fileType struct {
 	match func(filename string) bool
 	FileHandler
+
+	navigator Navigator
+	completer Completer
+	renamer   Renamer
 }
 
 func (ft fileType) MatchFilename(filename string) bool {
 	return ft.match(filename)
 }
 
+func (ft fileType) fileNavigator() Navigator {
+	return ft.navigator
+}
+
+func (ft fileType) fileCompleter() Completer {
+	return ft.completer
+}
+
+func (ft fileType) fileRenamer() Renamer {
+	return ft.renamer
+}
+
+func (ft fileType) fileOutliner() Outliner {
+	outliner, _ := ft.FileHandler.(Outliner)
+	return outliner
+}
+
 func BuildLSPHandler(config Config) (*lspConfig, error) {
 	lspc := lspConfig{
 		ProjectRoot: config.ProjectRoot,
+		Workspace:   config.Workspace,
 	}
 
 	if config.ProjectRoot == "" {
@@ -47,7 +89,10 @@ func BuildLSPHandler(config Config) (*lspConfig, error) {
 
 	for _, ft := range config.FileTypes {
 		built := fileType{
-			match: ft.Match,
+			match:     ft.Match,
+			navigator: ft.Navigator,
+			completer: ft.Completer,
+			renamer:   ft.Renamer,
 		}
 
 		if ft.FileFactory != nil {
@@ -55,7 +100,9 @@ func BuildLSPHandler(config Config) (*lspConfig, error) {
 			if err != nil {
 				return nil, err
 			}
-			built.FileHandler = linter.New(parser, ft.FileFactory, ft.OnChange, config.ProjectRoot)
+			fileLinter := linter.New(parser, ft.FileFactory, ft.OnChange, config.ProjectRoot)
+			fileLinter.SymbolKinds = ft.SymbolKinds
+			built.FileHandler = fileLinter
 		} else {
 			built.FileHandler = linter.NewGeneric(config.ProjectRoot)
 		}
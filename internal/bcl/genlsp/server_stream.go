@@ -5,7 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"sync"
+	"time"
 
+	"github.com/pentops/j5/internal/bcl/internal/linter"
 	"github.com/pentops/log.go/log"
 	"go.lsp.dev/jsonrpc2"
 	"go.lsp.dev/protocol"
@@ -24,8 +27,81 @@ type FileHandler interface {
 	FileChanged(context.Context, *protocol.TextDocumentItem) ([]protocol.Diagnostic, error)
 }
 
+// Navigator answers position queries in a document. ChangeHandlers which also
+// implement Navigator serve definition, references and hover requests.
+type Navigator interface {
+	Definition(context.Context, *protocol.TextDocumentItem, protocol.Position) ([]protocol.Location, error)
+	References(ctx context.Context, doc *protocol.TextDocumentItem, pos protocol.Position, includeDeclaration bool) ([]protocol.Location, error)
+	Hover(context.Context, *protocol.TextDocumentItem, protocol.Position) (*protocol.Hover, error)
+}
+
+// Completer lists the completions at a position in a document. ChangeHandlers
+// which also implement Completer serve completion requests.
+type Completer interface {
+	Completion(context.Context, *protocol.TextDocumentItem, protocol.Position) (*protocol.CompletionList, error)
+}
+
+// Renamer renames the symbol at a position in a document, returning the
+// edits across the workspace. ChangeHandlers which also implement Renamer
+// serve rename requests.
+type Renamer interface {
+	Rename(ctx context.Context, doc *protocol.TextDocumentItem, pos protocol.Position, newName string) (*protocol.WorkspaceEdit, error)
+}
+
+// Outliner lists the structure of a document. ChangeHandlers which also
+// implement Outliner serve document symbol and semantic token requests, the
+// tokens use the legend of the linter.
+type Outliner interface {
+	DocumentSymbols(context.Context, *protocol.TextDocumentItem) ([]protocol.DocumentSymbol, error)
+	SemanticTokens(context.Context, *protocol.TextDocumentItem) (*protocol.SemanticTokens, error)
+}
+
+// navigatorOf returns the Navigator of the handler, nil when it has none.
+func navigatorOf(handler ChangeHandler) Navigator {
+	if ft, ok := handler.(interface{ fileNavigator() Navigator }); ok {
+		return ft.fileNavigator()
+	}
+	nav, _ := handler.(Navigator)
+	return nav
+}
+
+// completerOf returns the Completer of the handler, nil when it has none.
+func completerOf(handler ChangeHandler) Completer {
+	if ft, ok := handler.(interface{ fileCompleter() Completer }); ok {
+		return ft.fileCompleter()
+	}
+	completer, _ := handler.(Completer)
+	return completer
+}
+
+// renamerOf returns the Renamer of the handler, nil when it has none.
+func renamerOf(handler ChangeHandler) Renamer {
+	if ft, ok := handler.(interface{ fileRenamer() Renamer }); ok {
+		return ft.fileRenamer()
+	}
+	renamer, _ := handler.(Renamer)
+	return renamer
+}
+
+// outlinerOf returns the Outliner of the handler, nil when it has none.
+func outlinerOf(handler ChangeHandler) Outliner {
+	if ft, ok := handler.(interface{ fileOutliner() Outliner }); ok {
+		return ft.fileOutliner()
+	}
+	outliner, _ := handler.(Outliner)
+	return outliner
+}
+
+// semanticTokensOptions is the semantic tokens capability, which the protocol
+// package doesn't fully define.
+type semanticTokensOptions struct {
+	Legend protocol.SemanticTokensLegend `json:"legend"`
+	Full   bool                          `json:"full"`
+}
+
 type lspConfig struct {
 	ProjectRoot string
+	Workspace   Workspace
 
 	Formatter Formatter
 	Handlers  []ChangeHandler
@@ -35,6 +111,14 @@ type serverStream struct {
 	files      *fileSet
 	dispatcher replyServer
 
+	// diagnostics are the last published diagnostics of each file, which
+	// carry the quick fixes for code actions.
+	diagnostics     map[protocol.DocumentURI][]protocol.Diagnostic
+	diagnosticsLock sync.Mutex
+
+	// workspace is nil when the project has no Workspace.
+	workspace *workspaceLinter
+
 	Formatter Formatter
 	Handlers  []ChangeHandler
 }
@@ -49,12 +133,19 @@ func newServerStream(cfg lspConfig) (*serverStream, error) {
 		return nil, fmt.Errorf("failed to create file set: %w", err)
 	}
 	ss := &serverStream{
-		files:     files,
-		Formatter: cfg.Formatter,
-		Handlers:  cfg.Handlers,
+		files:       files,
+		Formatter:   cfg.Formatter,
+		Handlers:    cfg.Handlers,
+		diagnostics: map[protocol.DocumentURI][]protocol.Diagnostic{},
 	}
 
-	dbchange := newDebounce(500, ss.fileDidChange)
+	if cfg.Workspace != nil {
+		ss.workspace = newWorkspaceLinter(cfg.Workspace)
+	}
+
+	dbchange := newDebounce(500*time.Millisecond, func(doc *protocol.TextDocumentItem) protocol.DocumentURI {
+		return doc.URI
+	}, ss.fileDidChange)
 	files.onChange = dbchange.request
 
 	return ss, nil
@@ -65,6 +156,9 @@ func (ss *serverStream) fileDidChange(ctx context.Context, doc *protocol.TextDoc
 	if err != nil {
 		log.WithError(ctx, err).Error("failed to handle file change")
 	}
+	if ss.workspace != nil {
+		ss.lintDependents(ctx, doc)
+	}
 }
 
 func (ss *serverStream) findHandler(doc *protocol.TextDocumentItem) (ChangeHandler, error) {
@@ -89,12 +183,19 @@ func (ss *serverStream) fileDidChangeErr(ctx context.Context, doc *protocol.Text
 	if err != nil {
 		return err
 	}
+	return ss.publishDiagnostics(ctx, doc.URI, diagnostics)
+}
+
+func (ss *serverStream) publishDiagnostics(ctx context.Context, uri protocol.DocumentURI, diagnostics []protocol.Diagnostic) error {
 	if diagnostics == nil {
 		// clear the diagnostics
 		diagnostics = []protocol.Diagnostic{}
 	}
+	ss.diagnosticsLock.Lock()
+	ss.diagnostics[uri] = diagnostics
+	ss.diagnosticsLock.Unlock()
 	return ss.dispatcher.Notify(ctx, protocol.MethodTextDocumentPublishDiagnostics, &protocol.PublishDiagnosticsParams{
-		URI:         doc.URI,
+		URI:         uri,
 		Diagnostics: diagnostics,
 	})
 }
@@ -156,27 +257,74 @@ func (h *serverStream) handle(ctx context.Context, reply jsonrpc2.Replier, req j
 		return doReq(ctx, reply, req, h.files.DidSave)
 	case protocol.MethodTextDocumentFormatting:
 		return doReqRes(ctx, reply, req, h.Formatting)
+	case protocol.MethodTextDocumentDefinition:
+		return doReqRes(ctx, reply, req, h.Definition)
+	case protocol.MethodTextDocumentReferences:
+		return doReqRes(ctx, reply, req, h.References)
+	case protocol.MethodTextDocumentHover:
+		return doReqRes(ctx, reply, req, h.Hover)
+	case protocol.MethodTextDocumentCompletion:
+		return doReqRes(ctx, reply, req, h.Completion)
+	case protocol.MethodTextDocumentRename:
+		return doReqRes(ctx, reply, req, h.Rename)
+	case protocol.MethodTextDocumentCodeAction:
+		return doReqRes(ctx, reply, req, h.CodeAction)
+	case protocol.MethodTextDocumentDocumentSymbol:
+		return doReqRes(ctx, reply, req, h.DocumentSymbol)
+	case protocol.MethodSemanticTokensFull:
+		return doReqRes(ctx, reply, req, h.SemanticTokensFull)
 	default:
 		return jsonrpc2.MethodNotFoundHandler(ctx, reply, req)
 	}
 
 }
 func (h *serverStream) Initialize(_ context.Context, req *protocol.InitializeParams) (*protocol.InitializeResult, error) {
-	return &protocol.InitializeResult{
-		Capabilities: protocol.ServerCapabilities{
-			DocumentFormattingProvider: true,
-			TextDocumentSync: protocol.TextDocumentSyncOptions{
-				OpenClose: true,
-				Change:    protocol.TextDocumentSyncKindFull,
-				Save: &protocol.SaveOptions{
-					IncludeText: true,
-				},
+	capabilities := protocol.ServerCapabilities{
+		DocumentFormattingProvider: true,
+		CodeActionProvider: &protocol.CodeActionOptions{
+			CodeActionKinds: []protocol.CodeActionKind{protocol.QuickFix},
+		},
+		TextDocumentSync: protocol.TextDocumentSyncOptions{
+			OpenClose: true,
+			Change:    protocol.TextDocumentSyncKindFull,
+			Save: &protocol.SaveOptions{
+				IncludeText: true,
 			},
 		},
+	}
+
+	for _, handler := range h.Handlers {
+		if navigatorOf(handler) != nil {
+			capabilities.DefinitionProvider = true
+			capabilities.ReferencesProvider = true
+			capabilities.HoverProvider = true
+		}
+		if completerOf(handler) != nil {
+			capabilities.CompletionProvider = &protocol.CompletionOptions{
+				TriggerCharacters: []string{":", ".", " "},
+			}
+		}
+		if renamerOf(handler) != nil {
+			capabilities.RenameProvider = true
+		}
+		if outlinerOf(handler) != nil {
+			capabilities.DocumentSymbolProvider = true
+			capabilities.SemanticTokensProvider = &semanticTokensOptions{
+				Legend: linter.SemanticTokenLegend,
+				Full:   true,
+			}
+		}
+	}
+
+	return &protocol.InitializeResult{
+		Capabilities: capabilities,
 	}, nil
 }
 
-func (h *serverStream) Initialized(_ context.Context, _ *protocol.InitializedParams) error {
+func (h *serverStream) Initialized(ctx context.Context, _ *protocol.InitializedParams) error {
+	if h.workspace != nil {
+		go h.lintWorkspace(context.WithoutCancel(ctx))
+	}
 	return nil
 }
 
@@ -196,3 +344,128 @@ func (h *serverStream) Formatting(ctx context.Context, params *protocol.Document
 
 	return h.Formatter.Format(ctx, doc)
 }
+
+func (h *serverStream) outliner(ctx context.Context, docID protocol.TextDocumentIdentifier) (Outliner, *protocol.TextDocumentItem, error) {
+	handler, doc, err := h.documentHandler(ctx, docID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return outlinerOf(handler), doc, nil
+}
+
+func (h *serverStream) DocumentSymbol(ctx context.Context, params *protocol.DocumentSymbolParams) ([]protocol.DocumentSymbol, error) {
+	outliner, doc, err := h.outliner(ctx, params.TextDocument)
+	if err != nil || outliner == nil {
+		return nil, err
+	}
+	return outliner.DocumentSymbols(ctx, doc)
+}
+
+func (h *serverStream) SemanticTokensFull(ctx context.Context, params *protocol.SemanticTokensParams) (*protocol.SemanticTokens, error) {
+	outliner, doc, err := h.outliner(ctx, params.TextDocument)
+	if err != nil || outliner == nil {
+		return nil, err
+	}
+	return outliner.SemanticTokens(ctx, doc)
+}
+
+// CodeAction returns the quick fixes of the published diagnostics in the
+// range. Handlers attach a fix to a diagnostic as a *protocol.CodeAction in its
+// Data.
+func (h *serverStream) CodeAction(_ context.Context, params *protocol.CodeActionParams) ([]protocol.CodeAction, error) {
+	h.diagnosticsLock.Lock()
+	diagnostics := h.diagnostics[params.TextDocument.URI]
+	h.diagnosticsLock.Unlock()
+
+	actions := []protocol.CodeAction{}
+	for _, diagnostic := range diagnostics {
+		action, ok := diagnostic.Data.(*protocol.CodeAction)
+		if !ok || !rangesOverlap(diagnostic.Range, params.Range) {
+			continue
+		}
+		actions = append(actions, *action)
+	}
+	return actions, nil
+}
+
+func positionBefore(a, b protocol.Position) bool {
+	return a.Line < b.Line || (a.Line == b.Line && a.Character < b.Character)
+}
+
+// rangesOverlap is true when the ranges share a position, including a cursor
+// at either end of the other range.
+func rangesOverlap(a, b protocol.Range) bool {
+	return !positionBefore(a.End, b.Start) && !positionBefore(b.End, a.Start)
+}
+
+// documentHandler returns the open document and its handler.
+func (h *serverStream) documentHandler(ctx context.Context, docID protocol.TextDocumentIdentifier) (ChangeHandler, *protocol.TextDocumentItem, error) {
+	doc, err := h.files.getDocument(ctx, docID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get document: %w", err)
+	}
+
+	handler, err := h.findHandler(doc)
+	if err != nil {
+		return nil, nil, err
+	}
+	return handler, doc, nil
+}
+
+// navigator returns the open document and the Navigator of its handler, or a
+// nil Navigator when the file type has none.
+func (h *serverStream) navigator(ctx context.Context, docID protocol.TextDocumentIdentifier) (Navigator, *protocol.TextDocumentItem, error) {
+	handler, doc, err := h.documentHandler(ctx, docID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return navigatorOf(handler), doc, nil
+}
+
+func (h *serverStream) Definition(ctx context.Context, params *protocol.DefinitionParams) ([]protocol.Location, error) {
+	nav, doc, err := h.navigator(ctx, params.TextDocument)
+	if err != nil || nav == nil {
+		return nil, err
+	}
+	return nav.Definition(ctx, doc, params.Position)
+}
+
+func (h *serverStream) References(ctx context.Context, params *protocol.ReferenceParams) ([]protocol.Location, error) {
+	nav, doc, err := h.navigator(ctx, params.TextDocument)
+	if err != nil || nav == nil {
+		return nil, err
+	}
+	return nav.References(ctx, doc, params.Position, params.Context.IncludeDeclaration)
+}
+
+func (h *serverStream) Hover(ctx context.Context, params *protocol.HoverParams) (*protocol.Hover, error) {
+	nav, doc, err := h.navigator(ctx, params.TextDocument)
+	if err != nil || nav == nil {
+		return nil, err
+	}
+	return nav.Hover(ctx, doc, params.Position)
+}
+
+func (h *serverStream) Completion(ctx context.Context, params *protocol.CompletionParams) (*protocol.CompletionList, error) {
+	handler, doc, err := h.documentHandler(ctx, params.TextDocument)
+	if err != nil {
+		return nil, err
+	}
+	completer := completerOf(handler)
+	if completer == nil {
+		return nil, nil
+	}
+	return completer.Completion(ctx, doc, params.Position)
+}
+
+func (h *serverStream) Rename(ctx context.Context, params *protocol.RenameParams) (*protocol.WorkspaceEdit, error) {
+	handler, doc, err := h.documentHandler(ctx, params.TextDocument)
+	if err != nil {
+		return nil, err
+	}
+	renamer := renamerOf(handler)
+	if renamer == nil {
+		return nil, fmt.Errorf("rename not supported for %s", doc.URI)
+	}
+	return renamer.Rename(ctx, doc, params.Position, params.NewName)
+}
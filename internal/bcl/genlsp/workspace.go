@@ -0,0 +1,252 @@
+package genlsp
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/pentops/j5/internal/bcl/errpos"
+	"github.com/pentops/j5/internal/bcl/internal/linter"
+	"github.com/pentops/j5/internal/dag"
+	"github.com/pentops/log.go/log"
+	"go.lsp.dev/protocol"
+)
+
+// Workspace lints the packages of the project as a whole, so that a change to
+// one file reports errors in the files which depend on it, open or not.
+type Workspace interface {
+	// SetOpenFiles replaces the content of the files open in the editor, by
+	// path relative to the project root. Lints read open files in place of
+	// the files on disk.
+	SetOpenFiles(files map[string]string)
+
+	// Packages lists the local packages of the project.
+	Packages(ctx context.Context) ([]string, error)
+
+	// FilePackage returns the package of a file, by path relative to the
+	// project root, false when the file is not in a local package.
+	FilePackage(filename string) (string, bool)
+
+	// LintPackage lints every file of the package.
+	LintPackage(ctx context.Context, pkg string) (*PackageLint, error)
+}
+
+// PackageLint is the result of linting a package.
+type PackageLint struct {
+	// Imports are the packages which the package depends on. A nil list keeps
+	// the imports of the previous lint, e.g. when the package doesn't parse.
+	Imports []string
+
+	// Errors are positioned by filename relative to the project root.
+	Errors errpos.Errors
+}
+
+// packageGraph holds the imports of each linted package, to find the packages
+// affected by a change.
+type packageGraph struct {
+	imports map[string][]string
+}
+
+func newPackageGraph() *packageGraph {
+	return &packageGraph{
+		imports: map[string][]string{},
+	}
+}
+
+func (g *packageGraph) setImports(pkg string, imports []string) {
+	g.imports[pkg] = imports
+}
+
+// dependents returns the package and every package which imports it, directly
+// or through other packages, with each package after its imports. Packages in
+// an import cycle can't be ordered, the cycle is an error of the lint, so they
+// are returned in name order.
+func (g *packageGraph) dependents(pkg string) []string {
+	importedBy := map[string][]string{}
+	for name, imports := range g.imports {
+		for _, imported := range imports {
+			importedBy[imported] = append(importedBy[imported], name)
+		}
+	}
+
+	affected := map[string]struct{}{pkg: {}}
+	queue := []string{pkg}
+	for len(queue) > 0 {
+		next := queue[0]
+		queue = queue[1:]
+		for _, name := range importedBy[next] {
+			if _, ok := affected[name]; ok {
+				continue
+			}
+			affected[name] = struct{}{}
+			queue = append(queue, name)
+		}
+	}
+
+	names := make([]string, 0, len(affected))
+	for name := range affected {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	nodes := make([]dag.Node, 0, len(names))
+	for _, name := range names {
+		node := dag.Node{Name: name}
+		for _, imported := range g.imports[name] {
+			if _, ok := affected[imported]; ok && imported != name {
+				node.IncomingEdges = append(node.IncomingEdges, imported)
+			}
+		}
+		nodes = append(nodes, node)
+	}
+
+	sorted, err := dag.SortDAG(nodes)
+	if err != nil {
+		return names
+	}
+	return sorted
+}
+
+// workspaceLinter publishes the diagnostics of whole packages.
+type workspaceLinter struct {
+	workspace Workspace
+	graph     *packageGraph
+
+	// published are the files of each package which have diagnostics from
+	// the last lint, to clear them when fixed.
+	published map[string][]string
+
+	// lock serializes lints, which share the graph and open files.
+	lock sync.Mutex
+}
+
+func newWorkspaceLinter(workspace Workspace) *workspaceLinter {
+	return &workspaceLinter{
+		workspace: workspace,
+		graph:     newPackageGraph(),
+		published: map[string][]string{},
+	}
+}
+
+// lintWorkspace lints every package, in the background after the client
+// initializes.
+func (ss *serverStream) lintWorkspace(ctx context.Context) {
+	wl := ss.workspace
+	wl.lock.Lock()
+	defer wl.lock.Unlock()
+
+	packages, err := wl.workspace.Packages(ctx)
+	if err != nil {
+		log.WithError(ctx, err).Error("listing workspace packages")
+		return
+	}
+
+	open := ss.setOpenFiles()
+	for _, pkg := range packages {
+		ss.lintPackage(ctx, pkg, open, "")
+	}
+}
+
+// lintDependents lints the package of the changed document and each package
+// which depends on it. The document itself is already published.
+func (ss *serverStream) lintDependents(ctx context.Context, doc *protocol.TextDocumentItem) {
+	wl := ss.workspace
+	relPath, err := ss.files.relativeURL(doc.URI)
+	if err != nil {
+		return
+	}
+	pkg, ok := wl.workspace.FilePackage(relPath)
+	if !ok {
+		return
+	}
+
+	wl.lock.Lock()
+	defer wl.lock.Unlock()
+
+	open := ss.setOpenFiles()
+	for _, dependent := range wl.graph.dependents(pkg) {
+		ss.lintPackage(ctx, dependent, open, doc.URI)
+	}
+}
+
+func (ss *serverStream) setOpenFiles() map[string]*protocol.TextDocumentItem {
+	open := ss.files.openDocuments()
+	texts := make(map[string]string, len(open))
+	for local, doc := range open {
+		texts[local] = doc.Text
+	}
+	ss.workspace.workspace.SetOpenFiles(texts)
+	return open
+}
+
+// lintPackage publishes the diagnostics of each file in the package. Open
+// documents are linted as a single file, which includes syntax and format
+// diagnostics, skipping the document which triggered the lint.
+func (ss *serverStream) lintPackage(ctx context.Context, pkg string, open map[string]*protocol.TextDocumentItem, skip protocol.DocumentURI) {
+	wl := ss.workspace
+	ctx = log.WithField(ctx, "package", pkg)
+
+	lint, err := wl.workspace.LintPackage(ctx, pkg)
+	if err != nil {
+		log.WithError(ctx, err).Error("linting package")
+		return
+	}
+	if lint.Imports != nil {
+		wl.graph.setImports(pkg, lint.Imports)
+	}
+
+	fileErrors := map[string]errpos.Errors{}
+	for _, err := range lint.Errors {
+		if err.Pos == nil || err.Pos.Filename == nil {
+			continue
+		}
+		fileErrors[*err.Pos.Filename] = append(fileErrors[*err.Pos.Filename], err)
+	}
+
+	for local, doc := range open {
+		if doc.URI == skip {
+			continue
+		}
+		if docPkg, ok := wl.workspace.FilePackage(local); !ok || docPkg != pkg {
+			continue
+		}
+		if err := ss.fileDidChangeErr(ctx, doc); err != nil {
+			log.WithError(ctx, err).Error("failed to lint open document")
+		}
+	}
+
+	filenames := make([]string, 0, len(fileErrors))
+	for filename := range fileErrors {
+		filenames = append(filenames, filename)
+	}
+	for _, filename := range wl.published[pkg] {
+		if _, ok := fileErrors[filename]; !ok {
+			filenames = append(filenames, filename)
+		}
+	}
+	sort.Strings(filenames)
+
+	published := make([]string, 0, len(fileErrors))
+	for _, filename := range filenames {
+		if _, ok := open[filename]; ok {
+			continue
+		}
+
+		var fileErr error
+		if errs := fileErrors[filename]; len(errs) > 0 {
+			fileErr = errs
+			published = append(published, filename)
+		}
+
+		uri := ss.files.documentURI(filename)
+		diagnostics, err := linter.ErrorDiagnostics(ctx, uri, filename, fileErr)
+		if err != nil {
+			log.WithError(ctx, err).Error("package diagnostics")
+			continue
+		}
+		if err := ss.publishDiagnostics(ctx, uri, diagnostics); err != nil {
+			log.WithError(ctx, err).Error("publishing package diagnostics")
+		}
+	}
+	wl.published[pkg] = published
+}
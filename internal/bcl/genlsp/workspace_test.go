@@ -0,0 +1,36 @@
+package genlsp
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPackageGraphDependents(t *testing.T) {
+	graph := newPackageGraph()
+	graph.setImports("base.v1", []string{"j5.state.v1"})
+	graph.setImports("foo.v1", []string{"base.v1"})
+	graph.setImports("bar.v1", []string{"foo.v1", "base.v1"})
+	graph.setImports("other.v1", []string{"j5.state.v1"})
+
+	for _, tc := range []struct {
+		pkg  string
+		want []string
+	}{
+		{pkg: "base.v1", want: []string{"base.v1", "foo.v1", "bar.v1"}},
+		{pkg: "foo.v1", want: []string{"foo.v1", "bar.v1"}},
+		{pkg: "bar.v1", want: []string{"bar.v1"}},
+		{pkg: "new.v1", want: []string{"new.v1"}},
+	} {
+		got := graph.dependents(tc.pkg)
+		if !slices.Equal(got, tc.want) {
+			t.Errorf("dependents of %s: want %v, got %v", tc.pkg, tc.want, got)
+		}
+	}
+
+	// a cycle can't be sorted, the packages are still all linted.
+	graph.setImports("base.v1", []string{"bar.v1"})
+	got := graph.dependents("foo.v1")
+	if !slices.Equal(got, []string{"bar.v1", "base.v1", "foo.v1"}) {
+		t.Errorf("dependents in a cycle: got %v", got)
+	}
+}
@@ -22,6 +22,10 @@ type Linter struct {
 	rootDir     string
 	fileFactory FileFactory
 	onChange    OnChange
+
+	// SymbolKinds maps block schema names to the kind of their document
+	// symbol, blocks not in the map are objects.
+	SymbolKinds map[string]protocol.SymbolKind
 }
 
 func New(parser *bcl.Parser, fileFactory FileFactory, validate OnChange, rootDir string) *Linter {
@@ -39,7 +43,10 @@ func NewGeneric(rootDir string) *Linter {
 	}
 }
 
-func errorToDiagnostics(ctx context.Context, relFilename string, mainError error) ([]protocol.Diagnostic, error) {
+// ErrorDiagnostics converts the errpos errors positioned in the file to
+// diagnostics, errors in other files are skipped. A nil error is an empty list,
+// which clears the diagnostics of the file.
+func ErrorDiagnostics(ctx context.Context, uri protocol.DocumentURI, relFilename string, mainError error) ([]protocol.Diagnostic, error) {
 	if mainError == nil {
 		log.Debug(ctx, "No errors")
 		return []protocol.Diagnostic{}, nil
@@ -72,7 +79,7 @@ func errorToDiagnostics(ctx context.Context, relFilename string, mainError error
 			continue
 		}
 
-		diagnostics = append(diagnostics, protocol.Diagnostic{
+		diagnostic := protocol.Diagnostic{
 			Range: protocol.Range{
 				Start: protocol.Position{
 					Line:      uint32(err.Pos.Start.Line),
@@ -87,7 +94,14 @@ func errorToDiagnostics(ctx context.Context, relFilename string, mainError error
 			Message:  err.Err.Error(),
 			Severity: protocol.DiagnosticSeverityError,
 			Source:   "bcl",
-		})
+		}
+		if err.Severity == errpos.SeverityWarning {
+			diagnostic.Severity = protocol.DiagnosticSeverityWarning
+		}
+		if err.Fix != nil {
+			diagnostic.Data = fixAction(uri, diagnostic, err.Fix)
+		}
+		diagnostics = append(diagnostics, diagnostic)
 	}
 
 	return diagnostics, nil
@@ -104,12 +118,24 @@ func (l *Linter) FileChanged(ctx context.Context, req *protocol.TextDocumentItem
 	if err != nil {
 		log.WithError(ctx, err).Error("parser.ParseFile error")
 		if ews, ok := errpos.AsErrorsWithSource(err); ok {
-			return errorToDiagnostics(ctx, relFilename, ews)
+			return ErrorDiagnostics(ctx, req.URI, relFilename, ews)
 		} else {
 			return nil, fmt.Errorf("parse file not HadErrors - : %w", err)
 		}
 	}
 
+	diagnostics, err := l.validate(ctx, req, relFilename, tree)
+	if err != nil {
+		return nil, err
+	}
+
+	if fmtDiagnostic := formatDiagnostic(ctx, req, relFilename); fmtDiagnostic != nil {
+		diagnostics = append(diagnostics, *fmtDiagnostic)
+	}
+	return diagnostics, nil
+}
+
+func (l *Linter) validate(ctx context.Context, req *protocol.TextDocumentItem, relFilename string, tree *parser.File) ([]protocol.Diagnostic, error) {
 	if l.fileFactory == nil || l.parser == nil {
 		return nil, nil
 	}
@@ -119,9 +145,9 @@ func (l *Linter) FileChanged(ctx context.Context, req *protocol.TextDocumentItem
 	sourceLocs, err := l.parser.ParseAST(tree, msg)
 	if err != nil {
 		if ep, ok := errpos.AsErrors(err); ok {
-			return errorToDiagnostics(ctx, relFilename, ep.AsErrorsWithSource(relFilename, req.Text))
+			return ErrorDiagnostics(ctx, req.URI, relFilename, ep.AsErrorsWithSource(relFilename, req.Text))
 		}
-		return errorToDiagnostics(ctx, relFilename, err)
+		return ErrorDiagnostics(ctx, req.URI, relFilename, err)
 	}
 
 	// Step 3: Validate
@@ -133,7 +159,7 @@ func (l *Linter) FileChanged(ctx context.Context, req *protocol.TextDocumentItem
 	if err != nil {
 
 		//err = errpos.AddSourceFile(err, req.URI.Filename(), req.Text)
-		return errorToDiagnostics(ctx, relFilename, err)
+		return ErrorDiagnostics(ctx, req.URI, relFilename, err)
 	}
 
 	log.Debug(ctx, "No errors")
@@ -141,6 +167,75 @@ func (l *Linter) FileChanged(ctx context.Context, req *protocol.TextDocumentItem
 
 }
 
+// formatDiagnostic returns a hint, with a fix, when the file is not formatted.
+func formatDiagnostic(ctx context.Context, req *protocol.TextDocumentItem, relFilename string) *protocol.Diagnostic {
+	diffs, err := parser.FmtDiffs(relFilename, req.Text)
+	if err != nil {
+		log.WithError(ctx, err).Error("parser.FmtDiffs error")
+		return nil
+	}
+	if len(diffs) == 0 {
+		return nil
+	}
+
+	fix := &errpos.Fix{
+		Title: "Format file",
+	}
+	for _, diff := range diffs {
+		fix.Edits = append(fix.Edits, errpos.Edit{
+			Start:   errpos.Point{Line: diff.FromLine},
+			End:     errpos.Point{Line: diff.ToLine},
+			NewText: diff.NewText,
+		})
+	}
+
+	diagnostic := protocol.Diagnostic{
+		Range: protocol.Range{
+			Start: protocol.Position{Line: uint32(diffs[0].FromLine)},
+			End:   protocol.Position{Line: uint32(diffs[0].ToLine)},
+		},
+		Code:     ptr("FMT"),
+		Message:  "file is not formatted",
+		Severity: protocol.DiagnosticSeverityHint,
+		Source:   "bcl",
+	}
+	diagnostic.Data = fixAction(req.URI, diagnostic, fix)
+	return &diagnostic
+}
+
+// fixAction converts the fix of a diagnostic to a quick fix code action. The
+// action is carried in the data of the diagnostic, for the code action
+// request.
+func fixAction(uri protocol.DocumentURI, diagnostic protocol.Diagnostic, fix *errpos.Fix) *protocol.CodeAction {
+	edits := make([]protocol.TextEdit, 0, len(fix.Edits))
+	for _, edit := range fix.Edits {
+		edits = append(edits, protocol.TextEdit{
+			Range: protocol.Range{
+				Start: protocol.Position{
+					Line:      uint32(edit.Start.Line),
+					Character: uint32(edit.Start.Column),
+				},
+				End: protocol.Position{
+					Line:      uint32(edit.End.Line),
+					Character: uint32(edit.End.Column),
+				},
+			},
+			NewText: edit.NewText,
+		})
+	}
+	return &protocol.CodeAction{
+		Title:       fix.Title,
+		Kind:        protocol.QuickFix,
+		Diagnostics: []protocol.Diagnostic{diagnostic},
+		IsPreferred: true,
+		Edit: &protocol.WorkspaceEdit{
+			Changes: map[protocol.DocumentURI][]protocol.TextEdit{
+				uri: edits,
+			},
+		},
+	}
+}
+
 func ptr[T any](v T) *T {
 	return &v
 }
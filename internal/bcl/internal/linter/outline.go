@@ -0,0 +1,156 @@
+package linter
+
+import (
+	"context"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pentops/j5/internal/bcl"
+	"github.com/pentops/j5/internal/bcl/errpos"
+	"github.com/pentops/log.go/log"
+	"go.lsp.dev/protocol"
+)
+
+// SemanticTokenLegend lists the token types and modifiers, the index of each
+// is used in encoded tokens.
+var SemanticTokenLegend = protocol.SemanticTokensLegend{
+	TokenTypes: []protocol.SemanticTokenTypes{
+		protocol.SemanticTokenKeyword,
+		protocol.SemanticTokenVariable,
+		protocol.SemanticTokenType,
+		protocol.SemanticTokenProperty,
+		protocol.SemanticTokenComment,
+	},
+	TokenModifiers: []protocol.SemanticTokenModifiers{
+		protocol.SemanticTokenModifierDeclaration,
+		protocol.SemanticTokenModifierDocumentation,
+	},
+}
+
+const (
+	tokenModifierDeclaration = 1 << iota
+	tokenModifierDocumentation
+)
+
+// semanticTokenTypes maps bcl token kinds to the index of the type in the
+// legend and the modifier bits.
+var semanticTokenTypes = map[bcl.TokenKind][2]uint32{
+	bcl.TokenKeyword:     {0, 0},
+	bcl.TokenName:        {1, tokenModifierDeclaration},
+	bcl.TokenTypeRef:     {2, 0},
+	bcl.TokenProperty:    {3, 0},
+	bcl.TokenDescription: {4, tokenModifierDocumentation},
+}
+
+func (l *Linter) outline(ctx context.Context, doc *protocol.TextDocumentItem) (*bcl.Outline, error) {
+	relFilename, err := filepath.Rel(l.rootDir, doc.URI.Filename())
+	if err != nil {
+		return nil, err
+	}
+
+	var outline *bcl.Outline
+	if l.parser != nil && l.fileFactory != nil {
+		outline, err = l.parser.Outline(relFilename, doc.Text, l.fileFactory(doc.URI.Filename()))
+	} else {
+		outline, err = bcl.OutlineFile(relFilename, doc.Text)
+	}
+	if err != nil {
+		// The file doesn't parse, the errors are in the diagnostics.
+		log.WithError(ctx, err).Debug("outline parse error")
+		return &bcl.Outline{}, nil
+	}
+	return outline, nil
+}
+
+// DocumentSymbols lists the blocks of the document as nested symbols.
+func (l *Linter) DocumentSymbols(ctx context.Context, doc *protocol.TextDocumentItem) ([]protocol.DocumentSymbol, error) {
+	outline, err := l.outline(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+	return l.documentSymbols(outline.Symbols), nil
+}
+
+func (l *Linter) documentSymbols(symbols []*bcl.Symbol) []protocol.DocumentSymbol {
+	out := make([]protocol.DocumentSymbol, 0, len(symbols))
+	for _, sym := range symbols {
+		name := sym.Name
+		detail := strings.TrimSpace(sym.Type + " " + sym.Detail)
+		if name == "" {
+			name = sym.Type
+			detail = sym.Detail
+		}
+
+		kind, ok := l.SymbolKinds[sym.Schema]
+		if !ok {
+			kind = protocol.SymbolKindObject
+		}
+
+		out = append(out, protocol.DocumentSymbol{
+			Name:           name,
+			Detail:         detail,
+			Kind:           kind,
+			Range:          positionRange(sym.Position),
+			SelectionRange: positionRange(sym.NamePosition),
+			Children:       l.documentSymbols(sym.Children),
+		})
+	}
+	return out
+}
+
+// positionRange converts a parsed position, where End is the last character,
+// to a range.
+func positionRange(pos errpos.Position) protocol.Range {
+	return protocol.Range{
+		Start: protocol.Position{
+			Line:      uint32(pos.Start.Line),
+			Character: uint32(pos.Start.Column),
+		},
+		End: protocol.Position{
+			Line:      uint32(pos.End.Line),
+			Character: uint32(pos.End.Column + 1),
+		},
+	}
+}
+
+// SemanticTokens encodes the tokens of the document, relative to the previous
+// token, as described in the LSP spec.
+func (l *Linter) SemanticTokens(ctx context.Context, doc *protocol.TextDocumentItem) (*protocol.SemanticTokens, error) {
+	outline, err := l.outline(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := outline.Tokens
+	sort.SliceStable(tokens, func(i, j int) bool {
+		a, b := tokens[i].Position.Start, tokens[j].Position.Start
+		return a.Line < b.Line || (a.Line == b.Line && a.Column < b.Column)
+	})
+
+	data := make([]uint32, 0, len(tokens)*5)
+	prevLine, prevColumn := 0, 0
+	for _, tok := range tokens {
+		tokenType, ok := semanticTokenTypes[tok.Kind]
+		if !ok {
+			continue
+		}
+		start := tok.Position.Start
+		deltaColumn := start.Column
+		if start.Line == prevLine {
+			deltaColumn = start.Column - prevColumn
+		}
+		data = append(data,
+			uint32(start.Line-prevLine),
+			uint32(deltaColumn),
+			uint32(tok.Position.End.Column-start.Column+1),
+			tokenType[0],
+			tokenType[1],
+		)
+		prevLine, prevColumn = start.Line, start.Column
+	}
+
+	return &protocol.SemanticTokens{
+		Data: data,
+	}, nil
+}
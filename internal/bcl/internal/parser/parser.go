@@ -68,6 +68,7 @@ func Walk(tokens []Token, failFast bool) (*File, error) {
 func fragmentsToFile(fragments []Fragment) (*File, error) {
 	type walkingBlock struct {
 		parent *walkingBlock
+		block  *Block
 		body   *Body
 	}
 
@@ -97,6 +98,7 @@ func fragmentsToFile(fragments []Fragment) (*File, error) {
 
 			newBlock := &walkingBlock{
 				parent: currentBlock,
+				block:  block,
 				body:   &block.Body,
 			}
 			currentBlock = newBlock
@@ -119,6 +121,8 @@ func fragmentsToFile(fragments []Fragment) (*File, error) {
 				})
 				continue
 			}
+			closeNode := s.SourceNode
+			currentBlock.block.Close = &closeNode
 			currentBlock = currentBlock.parent
 
 		default:
@@ -158,6 +158,10 @@ func (bs BlockHeader) RootName() string {
 type Block struct {
 	BlockHeader
 	Body Body
+
+	// Close is the closing brace of an open block, nil when the block is not
+	// closed.
+	Close *SourceNode
 }
 
 var _ Statement = &Block{}
@@ -56,38 +56,51 @@ var ErrUnexpectedTag = fmt.Errorf("unexpected tag")
 var ErrUnexpectedQualifier = fmt.Errorf("unexpected qualifier")
 
 func doBody(sc Context, body parser.Body) error {
+	if cur := sc.cursor(); cur != nil {
+		return doBodyAt(sc, body, cur)
+	}
+	if sc.outliner() != nil {
+		return doBodyOutline(sc, body)
+	}
 	for _, decl := range body.Statements {
-		switch decl := decl.(type) {
+		if err := doStatement(sc, decl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-		case *parser.Description:
-			sc.Logf("Description Statement %#v", decl)
-			err := doDescription(sc, decl)
-			if err != nil {
-				err = errpos.AddPosition(err, decl.Position())
-				return err
-			}
+func doStatement(sc Context, decl parser.Statement) error {
+	switch decl := decl.(type) {
 
-		case *parser.Assignment:
-			sc.Logf("Assign Statement %#v <- %#v (%s)", decl.Key, decl.Value, decl.Start)
-			err := doAssign(sc, decl)
-			if err != nil {
-				err = errpos.AddPosition(err, decl.Position())
-				return err
-			}
-			sc.Logf("Assign OK")
+	case *parser.Description:
+		sc.Logf("Description Statement %#v", decl)
+		err := doDescription(sc, decl)
+		if err != nil {
+			err = errpos.AddPosition(err, decl.Position())
+			return err
+		}
 
-		case *parser.Block:
-			sc.Logf("Block Statement %#v", decl.BlockHeader)
-			err := doFullBlock(sc, decl)
-			if err != nil {
-				err = errpos.AddPosition(err, decl.Position())
-				return err
-			}
-			sc.Logf("Block OK")
+	case *parser.Assignment:
+		sc.Logf("Assign Statement %#v <- %#v (%s)", decl.Key, decl.Value, decl.Start)
+		err := doAssign(sc, decl)
+		if err != nil {
+			err = errpos.AddPosition(err, decl.Position())
+			return err
+		}
+		sc.Logf("Assign OK")
 
-		default:
-			return fmt.Errorf("unexpected statement type %T", decl)
+	case *parser.Block:
+		sc.Logf("Block Statement %#v", decl.BlockHeader)
+		err := doFullBlock(sc, decl)
+		if err != nil {
+			err = errpos.AddPosition(err, decl.Position())
+			return err
 		}
+		sc.Logf("Block OK")
+
+	default:
+		return fmt.Errorf("unexpected statement type %T", decl)
 	}
 	return nil
 }
@@ -153,6 +166,10 @@ func (ps *popSet) hasMore() bool {
 func doBlock(sc Context, spec schema.BlockSpec, bs *parser.Block) error {
 	rootBlockSpec := spec
 
+	if outline := sc.outliner(); outline != nil {
+		outline.block(sc, bs)
+	}
+
 	gotTags := newPopSet(bs.Tags, bs.Type.End)
 
 	return walkTags(sc, spec, gotTags, func(sc Context, spec schema.BlockSpec) error {
@@ -213,6 +230,10 @@ func walkTags(sc Context, spec schema.BlockSpec, gotTags popSet, outerCallback S
 	if spec.Name != nil {
 		gotTag, ok := gotTags.popFirst()
 		if !ok {
+			if cur := sc.cursor(); cur != nil && cur.slot == slotTag {
+				cur.setName(sc, *spec.Name)
+				return nil
+			}
 			if spec.Name.IsOptional {
 				return outerCallback(sc, spec)
 			}
@@ -230,6 +251,9 @@ func walkTags(sc Context, spec schema.BlockSpec, gotTags popSet, outerCallback S
 		}
 
 		sc.Logf("Applying Name tag, %#v %#v", tagSpec, gotTag)
+		if outline := sc.outliner(); outline != nil {
+			outline.tag(gotTag, TagRoleName)
+		}
 		err := sc.SetAttribute(ScopePath{Schema: schema.PathSpec{tagSpec.FieldName}}, gotTag)
 		if err != nil {
 			return err
@@ -240,6 +264,9 @@ func walkTags(sc Context, spec schema.BlockSpec, gotTags popSet, outerCallback S
 	if spec.TypeSelect != nil {
 		gotTag, ok := gotTags.popFirst()
 		if !ok {
+			if cur := sc.cursor(); cur != nil && cur.slot == slotTag {
+				return cur.setTypeSelect(sc, *spec.TypeSelect)
+			}
 			err := &ErrExpectedTag{
 				Label:  "type-select",
 				Schema: spec.ErrName(),
@@ -250,6 +277,9 @@ func walkTags(sc Context, spec schema.BlockSpec, gotTags popSet, outerCallback S
 		tagSpec := *spec.TypeSelect
 
 		sc.Logf("TypeSelect %#v %#v", tagSpec, gotTag)
+		if outline := sc.outliner(); outline != nil {
+			outline.tag(gotTag, TagRoleType)
+		}
 		if gotTag.Reference == nil {
 			return fmt.Errorf("type-select %s needs to be a reference", tagSpec.FieldName)
 		}
@@ -284,6 +314,9 @@ func walkTags(sc Context, spec schema.BlockSpec, gotTags popSet, outerCallback S
 			sc.Logf("Applying ScalarSplit %#v %#v", spec.ScalarSplit, gotTags.items[0])
 
 			ref := gotTags.items[0]
+			if outline := sc.outliner(); outline != nil {
+				outline.tag(ref, TagRoleType)
+			}
 
 			if err := sc.setContainerFromScalar(spec, ref); err != nil {
 				return err
@@ -302,6 +335,9 @@ func walkTags(sc Context, spec schema.BlockSpec, gotTags popSet, outerCallback S
 func walkQualifiers(sc Context, spec schema.BlockSpec, gotQualifiers popSet, outerCallback SpanCallback) error {
 	qualifier, ok := gotQualifiers.popFirst()
 	if !ok {
+		if cur := sc.cursor(); cur != nil && cur.slot == slotQualifier && spec.Qualifier != nil {
+			return cur.setQualifier(sc, *spec.Qualifier)
+		}
 		return outerCallback(sc, spec)
 	}
 
@@ -312,6 +348,9 @@ func walkQualifiers(sc Context, spec schema.BlockSpec, gotQualifiers popSet, out
 
 	tagSpec := spec.Qualifier
 	sc.Logf("Qualifier %#v %#v", tagSpec, qualifier)
+	if outline := sc.outliner(); outline != nil {
+		outline.tag(qualifier, TagRoleType)
+	}
 
 	if !tagSpec.IsBlock {
 		if err := checkBang(sc, *tagSpec, qualifier); err != nil {
@@ -0,0 +1,249 @@
+package walker
+
+import (
+	"github.com/pentops/j5/internal/bcl/errpos"
+	"github.com/pentops/j5/internal/bcl/internal/parser"
+	"github.com/pentops/j5/internal/bcl/internal/walker/schema"
+)
+
+// Completions are the names which are valid at a point in a file.
+type Completions struct {
+	Options []schema.Completion
+
+	// Block is the schema name of the innermost block at the point.
+	Block string
+
+	// Attribute is the field set by the tag or value at the point, empty when
+	// the point is in the body of a block.
+	Attribute string
+
+	// Schema is the type of the Attribute, e.g. `object(j5.schema.v1.Ref)`
+	Schema string
+}
+
+type cursorSlot int
+
+const (
+	slotNone cursorSlot = iota
+	slotTag
+	slotQualifier
+)
+
+// cursor tracks the walk to the point being completed. The walk is paused
+// while walking statements away from the point, which only set values.
+type cursor struct {
+	point  errpos.Point
+	slot   cursorSlot
+	paused bool
+	result Completions
+}
+
+// CompleteSchema walks the body to the point, returning the names which are
+// valid at the point. Statements away from the point are walked as far as
+// possible, ignoring errors, so that the root object holds the rest of the
+// file.
+func CompleteSchema(scope *schema.Scope, body parser.Body, point errpos.Point, verbose bool) (*Completions, error) {
+	cur := &cursor{
+		point: point,
+	}
+	rootContext := &walkContext{
+		scope:      scope,
+		path:       []string{""},
+		verbose:    verbose,
+		completion: cur,
+	}
+
+	err := doBody(rootContext, body)
+	if err != nil && verbose {
+		logError(err)
+	}
+	return &cur.result, err
+}
+
+func pointBefore(a, b errpos.Point) bool {
+	return a.Line < b.Line || (a.Line == b.Line && a.Column < b.Column)
+}
+
+// touches is true when the cursor is on the token at the position, or just
+// after its last character.
+func (cur *cursor) touches(pos errpos.Position) bool {
+	after := errpos.Point{Line: pos.End.Line, Column: pos.End.Column + 1}
+	return !pointBefore(cur.point, pos.Start) && !pointBefore(after, cur.point)
+}
+
+func (cur *cursor) contains(decl parser.Statement) bool {
+	switch decl := decl.(type) {
+	case *parser.Assignment:
+		return cur.touches(decl.Position())
+
+	case *parser.Block:
+		if pointBefore(cur.point, decl.Start) {
+			return false
+		}
+		if !pointBefore(decl.End, cur.point) {
+			// in the header
+			return true
+		}
+		if !decl.Open {
+			return false
+		}
+		return decl.Close == nil || !pointBefore(decl.Close.Start, cur.point)
+
+	default:
+		return false
+	}
+}
+
+func doBodyAt(sc Context, body parser.Body, cur *cursor) error {
+	cur.setBody(sc.currentScope())
+
+	var cursorErr error
+	for _, decl := range body.Statements {
+		if cur.contains(decl) {
+			cursorErr = doStatementAt(sc, decl, cur)
+			continue
+		}
+
+		// Errors are expected in a file which is being edited.
+		cur.paused = true
+		err := doStatement(sc, decl)
+		cur.paused = false
+		if err != nil {
+			sc.Logf("Ignoring error away from cursor: %s", err)
+		}
+	}
+	return cursorErr
+}
+
+func doStatementAt(sc Context, decl parser.Statement, cur *cursor) error {
+	cur.result = Completions{}
+
+	switch decl := decl.(type) {
+	case *parser.Assignment:
+		idents := decl.Key.Idents
+		for idx, ident := range idents {
+			if cur.touches(ident.Position()) {
+				return cur.completePath(sc, idents[:idx])
+			}
+		}
+		if len(idents) == 0 {
+			return nil
+		}
+
+		attribute := idents[len(idents)-1].String()
+		pathToBlock := idents[:len(idents)-1]
+		if len(pathToBlock) == 0 {
+			cur.setValue(sc.currentScope(), attribute)
+			return nil
+		}
+		return sc.WithFreshScope(ScopePath{User: pathToBlock}, func(sc Context, _ schema.BlockSpec) error {
+			cur.setValue(sc.currentScope(), attribute)
+			return nil
+		})
+
+	case *parser.Block:
+		idents := decl.Type.Idents
+		for idx, ident := range idents {
+			if cur.touches(ident.Position()) {
+				return cur.completePath(sc, idents[:idx])
+			}
+		}
+
+		for idx, tag := range decl.Tags {
+			if cur.touches(tag.Position()) {
+				cur.slot = slotTag
+				return doHeaderAt(sc, decl, decl.Tags[:idx], nil)
+			}
+		}
+
+		for idx, qualifier := range decl.Qualifiers {
+			if cur.touches(qualifier.Position()) {
+				cur.slot = slotQualifier
+				return doHeaderAt(sc, decl, decl.Tags, decl.Qualifiers[:idx])
+			}
+		}
+
+		if !pointBefore(decl.End, cur.point) {
+			// in the header, but not on a tag
+			return nil
+		}
+
+		return doFullBlock(sc, decl)
+
+	default:
+		return nil
+	}
+}
+
+// doHeaderAt walks the tags of the block before the cursor, the hooks in
+// walkTags and walkQualifiers complete the tag expected at the cursor.
+func doHeaderAt(sc Context, decl *parser.Block, tags, qualifiers []parser.TagValue) error {
+	return sc.WithFreshScope(ScopePath{
+		User: decl.Type.Idents,
+	}, func(sc Context, spec schema.BlockSpec) error {
+		return walkTags(sc, spec, newPopSet(tags, decl.Type.End), func(sc Context, spec schema.BlockSpec) error {
+			return walkQualifiers(sc, spec, newPopSet(qualifiers, decl.Start), func(Context, schema.BlockSpec) error {
+				// The block takes no further tags.
+				return nil
+			})
+		})
+	})
+}
+
+func (cur *cursor) completePath(sc Context, idents []parser.Ident) error {
+	if len(idents) == 0 {
+		cur.setBody(sc.currentScope())
+		return nil
+	}
+	return sc.WithFreshScope(ScopePath{User: idents}, func(sc Context, _ schema.BlockSpec) error {
+		cur.setBody(sc.currentScope())
+		return nil
+	})
+}
+
+func (cur *cursor) setBody(scope *schema.Scope) {
+	cur.result = Completions{
+		Options: scope.Completions(),
+		Block:   scope.CurrentBlock().SchemaName(),
+	}
+}
+
+func (cur *cursor) setValue(scope *schema.Scope, attribute string) {
+	options, fieldSchema := scope.ValueCompletions(attribute)
+	cur.result = Completions{
+		Options:   options,
+		Block:     scope.CurrentBlock().SchemaName(),
+		Attribute: attribute,
+		Schema:    fieldSchema,
+	}
+}
+
+func (cur *cursor) setName(sc Context, tag schema.Tag) {
+	cur.result = Completions{
+		Block:     sc.currentScope().CurrentBlock().SchemaName(),
+		Attribute: tag.FieldName,
+	}
+}
+
+func (cur *cursor) setTypeSelect(sc Context, tag schema.Tag) error {
+	if tag.FieldName == "" || tag.FieldName == "." {
+		cur.setBody(sc.currentScope().Orphan())
+		cur.result.Attribute = tag.FieldName
+		return nil
+	}
+	return sc.WithFreshScope(ScopePath{
+		Schema: schema.PathSpec{tag.FieldName},
+	}, func(sc Context, _ schema.BlockSpec) error {
+		cur.setBody(sc.currentScope())
+		cur.result.Attribute = tag.FieldName
+		return nil
+	})
+}
+
+func (cur *cursor) setQualifier(sc Context, tag schema.Tag) error {
+	if !tag.IsBlock {
+		cur.setValue(sc.currentScope(), tag.FieldName)
+		return nil
+	}
+	return cur.setTypeSelect(sc, tag)
+}
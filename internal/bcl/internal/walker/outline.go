@@ -0,0 +1,74 @@
+package walker
+
+import (
+	"github.com/pentops/j5/internal/bcl/errpos"
+	"github.com/pentops/j5/internal/bcl/internal/parser"
+	"github.com/pentops/j5/internal/bcl/internal/walker/schema"
+)
+
+// TagRole is the part of the schema which a block header tag sets.
+type TagRole int
+
+const (
+	TagRoleUnknown TagRole = iota
+
+	// TagRoleName is the name tag of a block, e.g. `foo` in `field foo string`
+	TagRoleName
+
+	// TagRoleType selects or qualifies the type of a block, e.g. `string` in
+	// `field foo string`, or `Bar` in `field bar object:Bar`
+	TagRoleType
+)
+
+// Outline holds the schema types of the blocks in a file, and the roles of
+// their tags, as resolved by the walk.
+type Outline struct {
+	// Blocks maps each walked block to the schema name of its type, before any
+	// type-select or qualifier tags, e.g. `j5.sourcedef.v1.Entity`
+	Blocks map[*parser.Block]string
+
+	// Tags maps the start of each walked tag to its role.
+	Tags map[errpos.Point]TagRole
+}
+
+// OutlineSchema walks the body, recording the types of blocks and the roles of
+// tags. Errors don't stop the walk of following statements, so the outline
+// covers as much of a file with errors as possible.
+func OutlineSchema(scope *schema.Scope, body parser.Body, verbose bool) (*Outline, error) {
+	outline := &Outline{
+		Blocks: map[*parser.Block]string{},
+		Tags:   map[errpos.Point]TagRole{},
+	}
+	rootContext := &walkContext{
+		scope:   scope,
+		path:    []string{""},
+		verbose: verbose,
+		outline: outline,
+	}
+
+	err := doBody(rootContext, body)
+	if err != nil && verbose {
+		logError(err)
+	}
+	return outline, err
+}
+
+func (o *Outline) block(sc Context, decl *parser.Block) {
+	o.Blocks[decl] = sc.currentScope().CurrentBlock().SchemaName()
+}
+
+func (o *Outline) tag(tag parser.TagValue, role TagRole) {
+	o.Tags[tag.Start] = role
+}
+
+// doBodyOutline walks every statement of the body, returning the first error.
+func doBodyOutline(sc Context, body parser.Body) error {
+	var firstErr error
+	for _, decl := range body.Statements {
+		err := doStatement(sc, decl)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
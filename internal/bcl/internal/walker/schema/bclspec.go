@@ -138,6 +138,10 @@ type BlockSpec struct {
 
 	Aliases map[string]PathSpec
 
+	// implicitAliases were added from the schema rather than the spec, e.g. the
+	// single form of an array.
+	implicitAliases map[string]bool
+
 	Name       *Tag
 	TypeSelect *Tag
 
@@ -0,0 +1,234 @@
+package schema
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pentops/j5/lib/j5schema"
+)
+
+type CompletionKind int
+
+const (
+	_noCompletion CompletionKind = iota
+
+	// A child block, e.g. `field` in an object
+	CompletionBlock
+
+	// An attribute, set with `=`
+	CompletionAttribute
+
+	// A value for a tag or attribute, e.g. an enum option
+	CompletionValue
+)
+
+// Completion is a name which is valid at a position in the file, used for
+// editor completion.
+type Completion struct {
+	Name        string
+	Kind        CompletionKind
+	Description string
+	Detail      string // The schema type of the name, e.g. object(j5.schema.v1.Ref)
+}
+
+// Completions lists the blocks and attributes available in the scope,
+// searching parents first as in findBlock.
+func (sw *Scope) Completions() []Completion {
+	scopes := []*Scope{}
+	for scope := sw; scope != nil; scope = scope.parent {
+		scopes = append([]*Scope{scope}, scopes...)
+	}
+
+	seen := map[string]bool{}
+	completions := []Completion{}
+	for _, scope := range scopes {
+		for _, completion := range scope.leafBlock.completions() {
+			if seen[completion.Name] {
+				continue
+			}
+			seen[completion.Name] = true
+			completions = append(completions, completion)
+		}
+	}
+
+	sort.Slice(completions, func(i, j int) bool {
+		return completions[i].Name < completions[j].Name
+	})
+	return completions
+}
+
+// ValueCompletions lists the values which can be set for the named attribute,
+// the options of an enum. The second return value is the schema of the
+// attribute, e.g. `object(j5.schema.v1.Ref)`, to allow callers to complete
+// values the schema does not list.
+func (sw *Scope) ValueCompletions(name string) ([]Completion, string) {
+	root, spec, ok := sw.findBlock(name)
+	if !ok || len(spec.Path) == 0 {
+		return nil, ""
+	}
+	props, ok := root.container.ContainerSchema().(j5schema.PropertySet)
+	if !ok {
+		return nil, ""
+	}
+	prop := propertyAtPath(props, spec.Path)
+	if prop == nil {
+		return nil, ""
+	}
+
+	field := prop.Schema
+	if array, ok := field.(*j5schema.ArrayField); ok {
+		field = array.ItemSchema
+	}
+
+	enumField, ok := field.(*j5schema.EnumField)
+	if !ok {
+		return nil, field.TypeName()
+	}
+
+	enum := enumField.Schema()
+	completions := make([]Completion, 0, len(enum.Options))
+	for _, opt := range enum.Options {
+		if opt.Number() == 0 {
+			// UNSPECIFIED
+			continue
+		}
+		completions = append(completions, Completion{
+			Name:        opt.Name(),
+			Kind:        CompletionValue,
+			Description: opt.Description(),
+			Detail:      enum.FullName(),
+		})
+	}
+	return completions, field.TypeName()
+}
+
+func (container *containerField) completions() []Completion {
+	props, ok := container.container.ContainerSchema().(j5schema.PropertySet)
+	if !ok {
+		// maps have no fixed names
+		return nil
+	}
+
+	spec := container.spec
+
+	// Fields set by the block header are not repeated in the body.
+	headerFields := map[string]bool{}
+	for _, tag := range []*Tag{spec.Name, spec.TypeSelect, spec.Qualifier} {
+		if tag != nil {
+			headerFields[tag.FieldName] = true
+		}
+	}
+	if spec.Description != nil {
+		headerFields[*spec.Description] = true
+	}
+
+	// Implicit aliases are only listed when the spec has no alias for the
+	// same path, e.g. `objectProperty` when `field` is defined.
+	explicitPaths := map[string]bool{}
+	for name, path := range spec.Aliases {
+		if !spec.implicitAliases[name] {
+			explicitPaths[strings.Join(path, ".")] = true
+		}
+	}
+
+	completions := []Completion{}
+	aliased := map[string]bool{}
+	for name, path := range spec.Aliases {
+		if len(path) == 0 || !container.container.HasAvailableProperty(path[0]) {
+			continue
+		}
+		if spec.implicitAliases[name] && explicitPaths[strings.Join(path, ".")] {
+			continue
+		}
+		prop := propertyAtPath(props, path)
+		if prop == nil {
+			continue
+		}
+		// The property is reached by the alias, e.g. `field` for properties
+		aliased[path[0]] = true
+		completions = append(completions, propertyCompletion(name, prop))
+	}
+
+	for _, prop := range props {
+		name := prop.JSONName
+		if aliased[name] || headerFields[name] || !container.container.HasAvailableProperty(name) {
+			continue
+		}
+		if isInternalField(prop.Schema) {
+			continue
+		}
+		completions = append(completions, propertyCompletion(name, prop))
+	}
+	return completions
+}
+
+// isInternalField is true for fields which can't be set from a file, or which
+// are set by the parser.
+func isInternalField(field j5schema.FieldSchema) bool {
+	switch ft := field.(type) {
+	case *j5schema.AnyField:
+		return true
+	case *j5schema.ObjectField:
+		return ft.Ref.FullName() == sourceLocationSchema
+	}
+	return false
+}
+
+const sourceLocationSchema = "j5.bcl.v1.SourceLocation"
+
+// propertyAtPath walks the property set to the property at the path, walking
+// into the items of arrays, as blocks do.
+func propertyAtPath(props j5schema.PropertySet, path []string) *j5schema.ObjectProperty {
+	for {
+		prop := props.ByJSONName(path[0])
+		if prop == nil || len(path) == 1 {
+			return prop
+		}
+
+		field := prop.Schema
+		if array, ok := field.(*j5schema.ArrayField); ok {
+			field = array.ItemSchema
+		}
+		container, ok := field.AsContainer()
+		if !ok {
+			return nil
+		}
+		props, ok = container.(j5schema.PropertySet)
+		if !ok {
+			return nil
+		}
+		path = path[1:]
+	}
+}
+
+func propertyCompletion(name string, prop *j5schema.ObjectProperty) Completion {
+	field := prop.Schema
+	if array, ok := field.(*j5schema.ArrayField); ok {
+		field = array.ItemSchema
+	}
+
+	kind := CompletionAttribute
+	switch field.(type) {
+	case *j5schema.ObjectField, *j5schema.OneofField, *j5schema.MapField, *j5schema.PolymorphField:
+		kind = CompletionBlock
+	}
+
+	description := prop.Description
+	if description == "" {
+		switch ft := field.(type) {
+		case *j5schema.ObjectField:
+			description = ft.ObjectSchema().Description()
+		case *j5schema.OneofField:
+			description = ft.OneofSchema().Description()
+		case *j5schema.EnumField:
+			description = ft.Schema().Description()
+		}
+	}
+
+	return Completion{
+		Name:        name,
+		Kind:        kind,
+		Description: description,
+		Detail:      prop.Schema.TypeName(),
+	}
+}
@@ -178,9 +178,11 @@ func (ss *SchemaSet) _buildSpec(node j5PropSet) (*BlockSpec, error) {
 		return nil, err
 	}
 
+	blockSpec.implicitAliases = map[string]bool{}
 	for alias, path := range newAliases {
 		if _, ok := blockSpec.Aliases[alias]; !ok {
 			blockSpec.Aliases[alias] = path
+			blockSpec.implicitAliases[alias] = true
 		}
 	}
 
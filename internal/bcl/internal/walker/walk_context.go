@@ -22,6 +22,12 @@ type Context interface {
 
 	setContainerFromScalar(bs schema.BlockSpec, vals parser.ASTValue) error
 
+	// cursor is set when walking to a point for completion, see CompleteSchema
+	cursor() *cursor
+	// outliner is set when recording the types of blocks, see OutlineSchema
+	outliner() *Outline
+	currentScope() *schema.Scope
+
 	Logf(format string, args ...any)
 	WrapErr(err error, pos HasPosition) error
 }
@@ -40,6 +46,9 @@ type walkContext struct {
 	blockLocation schema.SourceLocation
 
 	verbose bool
+
+	completion *cursor
+	outline    *Outline
 }
 
 func newSchemaError(err error) error {
@@ -92,6 +101,21 @@ func combinePath(path schema.PathSpec, ref []parser.Ident) []pathElement {
 	return pathToBlock
 }
 
+func (sc *walkContext) cursor() *cursor {
+	if sc.completion == nil || sc.completion.paused {
+		return nil
+	}
+	return sc.completion
+}
+
+func (sc *walkContext) outliner() *Outline {
+	return sc.outline
+}
+
+func (sc *walkContext) currentScope() *schema.Scope {
+	return sc.scope
+}
+
 func (sc *walkContext) SetLocation(loc schema.SourceLocation) {
 	sc.blockLocation = loc
 }
@@ -452,6 +476,8 @@ func (wc *walkContext) withScope(newScope *schema.Scope, fn SpanCallback) error
 		depth:         wc.depth + 1,
 		verbose:       wc.verbose,
 		blockLocation: wc.blockLocation,
+		completion:    wc.completion,
+		outline:       wc.outline,
 	}
 
 	err := fn(childContext, lastBlock.Spec())
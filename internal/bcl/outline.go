@@ -0,0 +1,237 @@
+package bcl
+
+import (
+	"strings"
+
+	"github.com/pentops/j5/gen/j5/bcl/v1/bcl_j5pb"
+	"github.com/pentops/j5/internal/bcl/errpos"
+	"github.com/pentops/j5/internal/bcl/internal/parser"
+	"github.com/pentops/j5/internal/bcl/internal/walker"
+	"github.com/pentops/j5/internal/bcl/internal/walker/schema"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Symbol is a block in the outline of a file.
+type Symbol struct {
+	// Type is the block type as written, e.g. `field` or `entity`
+	Type string
+
+	// Name is the name tag of the block, empty for blocks without one.
+	Name string
+
+	// Detail is the remaining tags of the header, e.g. `object:Foo`
+	Detail string
+
+	// Schema is the resolved type of the block, e.g. `j5.sourcedef.v1.Entity`,
+	// empty when the file is parsed without a schema, or the walk didn't reach
+	// the block.
+	Schema string
+
+	Description string
+
+	// Position covers the whole block, including the body.
+	Position errpos.Position
+
+	// NamePosition is the name tag, or the type when the block has no name.
+	NamePosition errpos.Position
+
+	Children []*Symbol
+}
+
+type TokenKind int
+
+const (
+	// TokenKeyword is a block type or the path of an assignment into a block
+	TokenKeyword TokenKind = iota
+
+	// TokenName is the name tag of a block, e.g. a field name
+	TokenName
+
+	// TokenTypeRef is a type tag or qualifier, e.g. `object:Foo`
+	TokenTypeRef
+
+	// TokenProperty is the attribute set by an assignment
+	TokenProperty
+
+	TokenDescription
+)
+
+// Token is a single line span of a file with a semantic kind. End is the last
+// character of the token.
+type Token struct {
+	Kind     TokenKind
+	Position errpos.Position
+}
+
+// Outline is the structure of a file, for editors.
+type Outline struct {
+	Symbols []*Symbol
+
+	// Tokens are in order of their position in the file.
+	Tokens []Token
+}
+
+// Outline parses the file, walking it with the schema of msg to resolve the
+// types of blocks and the roles of tags. Errors in the walk are ignored, the
+// outline covers as much of the file as parses.
+func (p *Parser) Outline(filename string, data string, msg protoreflect.Message) (*Outline, error) {
+	tree, err := parser.ParseFile(filename, data, false)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, err := p.refl.NewObject(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	scope, err := schema.NewRootSchemaWalker(p.schema, obj, &bcl_j5pb.SourceLocation{})
+	if err != nil {
+		return nil, err
+	}
+
+	walked, _ := walker.OutlineSchema(scope, tree.Body, p.Verbose)
+	return buildOutline(tree, walked), nil
+}
+
+// OutlineFile parses the file without a schema. The role of tags is assumed
+// from their position: the first tag is the name, and any others are types.
+func OutlineFile(filename string, data string) (*Outline, error) {
+	tree, err := parser.ParseFile(filename, data, false)
+	if err != nil {
+		return nil, err
+	}
+	return buildOutline(tree, nil), nil
+}
+
+type outlineBuilder struct {
+	walked *walker.Outline
+	tokens []Token
+}
+
+func buildOutline(tree *parser.File, walked *walker.Outline) *Outline {
+	ob := &outlineBuilder{
+		walked: walked,
+	}
+	symbols := ob.body(tree.Body)
+	return &Outline{
+		Symbols: symbols,
+		Tokens:  ob.tokens,
+	}
+}
+
+func (ob *outlineBuilder) token(kind TokenKind, node parser.SourceNode) {
+	pos := node.Position()
+	if pos.Start.Line != pos.End.Line {
+		return
+	}
+	ob.tokens = append(ob.tokens, Token{
+		Kind:     kind,
+		Position: pos,
+	})
+}
+
+func (ob *outlineBuilder) description(desc *parser.Description) {
+	for _, tok := range desc.Tokens {
+		ob.token(TokenDescription, parser.SourceNode{Start: tok.Start, End: tok.End})
+	}
+}
+
+func (ob *outlineBuilder) tagRole(idx int, tag parser.TagValue) walker.TagRole {
+	if ob.walked != nil {
+		if role, ok := ob.walked.Tags[tag.Start]; ok {
+			return role
+		}
+	}
+	if idx == 0 {
+		return walker.TagRoleName
+	}
+	return walker.TagRoleType
+}
+
+func (ob *outlineBuilder) body(body parser.Body) []*Symbol {
+	symbols := make([]*Symbol, 0)
+	for _, stmt := range body.Statements {
+		switch stmt := stmt.(type) {
+		case *parser.Block:
+			symbols = append(symbols, ob.block(stmt))
+
+		case *parser.Assignment:
+			idents := stmt.Key.Idents
+			for idx, ident := range idents {
+				kind := TokenKeyword
+				if idx == len(idents)-1 {
+					kind = TokenProperty
+				}
+				ob.token(kind, ident.SourceNode)
+			}
+
+		case *parser.Description:
+			ob.description(stmt)
+		}
+	}
+	return symbols
+}
+
+func (ob *outlineBuilder) block(block *parser.Block) *Symbol {
+	sym := &Symbol{
+		Type:         block.Type.String(),
+		Description:  block.DescriptionString(),
+		Position:     block.Position(),
+		NamePosition: block.Type.Position(),
+	}
+	if block.Close != nil {
+		sym.Position.End = block.Close.End
+	}
+	if ob.walked != nil {
+		sym.Schema = ob.walked.Blocks[block]
+	}
+
+	for _, ident := range block.Type.Idents {
+		ob.token(TokenKeyword, ident.SourceNode)
+	}
+
+	detail := make([]string, 0)
+	for idx, tag := range block.Tags {
+		if ob.tagRole(idx, tag) == walker.TagRoleName && sym.Name == "" {
+			sym.Name = tagString(tag)
+			sym.NamePosition = tag.Position()
+			ob.token(TokenName, tag.SourceNode)
+			continue
+		}
+		detail = append(detail, tagString(tag))
+		ob.token(TokenTypeRef, tag.SourceNode)
+	}
+	if len(block.Qualifiers) > 0 {
+		qualifiers := make([]string, 0, len(block.Qualifiers))
+		for _, qualifier := range block.Qualifiers {
+			qualifiers = append(qualifiers, tagString(qualifier))
+			ob.token(TokenTypeRef, qualifier.SourceNode)
+		}
+		if len(detail) == 0 {
+			detail = append(detail, "")
+		}
+		detail[len(detail)-1] += ":" + strings.Join(qualifiers, ":")
+	}
+	sym.Detail = strings.Join(detail, " ")
+
+	if block.Description != nil {
+		ob.description(block.Description)
+	}
+
+	sym.Children = ob.body(block.Body)
+	return sym
+}
+
+func tagString(tag parser.TagValue) string {
+	if tag.Reference != nil {
+		return tag.Reference.String()
+	}
+	if tag.Value != nil {
+		str, err := tag.Value.AsString()
+		if err == nil {
+			return str
+		}
+	}
+	return ""
+}
@@ -0,0 +1,254 @@
+// Package breaking finds changes between two versions of a J5 API which break
+// existing clients.
+package breaking
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pentops/j5/gen/j5/schema/v1/schema_j5pb"
+	"github.com/pentops/j5/gen/j5/source/v1/source_j5pb"
+	"github.com/pentops/j5/internal/structure"
+)
+
+type Kind string
+
+const (
+	PackageRemoved         Kind = "PACKAGE_REMOVED"
+	SchemaRemoved          Kind = "SCHEMA_REMOVED"
+	SchemaTypeChanged      Kind = "SCHEMA_TYPE_CHANGED"
+	FieldRemoved           Kind = "FIELD_REMOVED"
+	FieldTypeChanged       Kind = "FIELD_TYPE_CHANGED"
+	FieldRequired          Kind = "FIELD_REQUIRED"
+	RuleTightened          Kind = "RULE_TIGHTENED"
+	EnumValueRemoved       Kind = "ENUM_VALUE_REMOVED"
+	StatusRemoved          Kind = "STATUS_REMOVED"
+	PolymorphMemberRemoved Kind = "POLYMORPH_MEMBER_REMOVED"
+	EntityKeyChanged       Kind = "ENTITY_KEY_CHANGED"
+	ServiceRemoved         Kind = "SERVICE_REMOVED"
+	MethodRemoved          Kind = "METHOD_REMOVED"
+	MethodSchemaChanged    Kind = "METHOD_SCHEMA_CHANGED"
+	HTTPPathChanged        Kind = "HTTP_PATH_CHANGED"
+	HTTPMethodChanged      Kind = "HTTP_METHOD_CHANGED"
+	TopicRemoved           Kind = "TOPIC_REMOVED"
+	TopicMessageRemoved    Kind = "TOPIC_MESSAGE_REMOVED"
+	TopicMessageChanged    Kind = "TOPIC_MESSAGE_CHANGED"
+)
+
+// Change is a single breaking change.
+type Change struct {
+	Kind Kind `json:"kind"`
+
+	// Path is the dot separated location of the change in the API before the
+	// change, e.g. `foo.v1.Foo.bar` for a property, or
+	// `foo.v1.service.FooService.getFoo` for a method.
+	Path string `json:"path"`
+
+	Message string `json:"message"`
+}
+
+type Report struct {
+	Changes []*Change `json:"changes"`
+}
+
+// Breaking is true when the report has any changes.
+func (r *Report) Breaking() bool {
+	return len(r.Changes) > 0
+}
+
+// HumanString lists the changes one per line, followed by a count.
+func (r *Report) HumanString() string {
+	if len(r.Changes) == 0 {
+		return "No breaking changes\n"
+	}
+	lines := make([]string, 0, len(r.Changes)+1)
+	for _, change := range r.Changes {
+		lines = append(lines, fmt.Sprintf("%s: %s [%s]", change.Path, change.Message, change.Kind))
+	}
+	lines = append(lines, fmt.Sprintf("%d breaking changes", len(r.Changes)))
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func (r *Report) add(kind Kind, path string, format string, args ...any) {
+	r.Changes = append(r.Changes, &Change{
+		Kind:    kind,
+		Path:    path,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// CompareImages builds the J5 API of each image, and compares them. Includes
+// of both images must already be resolved.
+func CompareImages(before, after *source_j5pb.SourceImage) (*Report, error) {
+	beforeAPI, err := structure.APIFromImage(before)
+	if err != nil {
+		return nil, fmt.Errorf("building API before: %w", err)
+	}
+	afterAPI, err := structure.APIFromImage(after)
+	if err != nil {
+		return nil, fmt.Errorf("building API after: %w", err)
+	}
+	return CompareAPIs(beforeAPI, afterAPI), nil
+}
+
+// CompareAPIs lists the changes from before to after which break clients of
+// before. Indirect packages are not compared, changes to them are found
+// through the schemas which refer to them.
+func CompareAPIs(before, after *schema_j5pb.API) *Report {
+	cmp := &comparer{
+		report:   &Report{},
+		statuses: statusEnums(before),
+	}
+
+	afterPackages := map[string]*schema_j5pb.Package{}
+	for _, pkg := range after.Packages {
+		if !pkg.Indirect {
+			afterPackages[pkg.Name] = pkg
+		}
+	}
+
+	for _, pkg := range before.Packages {
+		if pkg.Indirect {
+			continue
+		}
+		afterPkg, ok := afterPackages[pkg.Name]
+		if !ok {
+			cmp.report.add(PackageRemoved, pkg.Name, "package removed")
+			continue
+		}
+		cmp.pkg(pkg, afterPkg)
+	}
+
+	sort.SliceStable(cmp.report.Changes, func(i, j int) bool {
+		return cmp.report.Changes[i].Path < cmp.report.Changes[j].Path
+	})
+	return cmp.report
+}
+
+type comparer struct {
+	report *Report
+
+	// statuses are the full names of the status enums of entities.
+	statuses map[string]bool
+}
+
+// statusEnums finds the enums of the status property of entity states.
+func statusEnums(api *schema_j5pb.API) map[string]bool {
+	statuses := map[string]bool{}
+	for _, pkg := range api.Packages {
+		for _, schema := range pkg.Schemas {
+			obj := schema.GetObject()
+			if obj.GetEntity().GetPart() != schema_j5pb.EntityPart_STATE {
+				continue
+			}
+			for _, prop := range obj.Properties {
+				if prop.Name != "status" {
+					continue
+				}
+				if ref := prop.Schema.GetEnum().GetRef(); ref != nil {
+					statuses[ref.Package+"."+ref.Schema] = true
+				}
+			}
+		}
+	}
+	return statuses
+}
+
+func (cmp *comparer) pkg(before, after *schema_j5pb.Package) {
+	cmp.schemas(before.Name, before.Schemas, after.Schemas)
+
+	afterSubs := map[string]*schema_j5pb.SubPackage{}
+	for _, sub := range after.SubPackages {
+		afterSubs[sub.Name] = sub
+	}
+
+	for _, sub := range before.SubPackages {
+		afterSub, ok := afterSubs[sub.Name]
+		if !ok {
+			afterSub = &schema_j5pb.SubPackage{}
+		}
+		path := before.Name + "." + sub.Name
+		cmp.schemas(path, sub.Schemas, afterSub.Schemas)
+		cmp.services(path, sub.Services, afterSub.Services)
+		cmp.topics(path, sub.Topics, afterSub.Topics)
+	}
+}
+
+func (cmp *comparer) services(path string, before, after []*schema_j5pb.Service) {
+	afterServices := map[string]*schema_j5pb.Service{}
+	for _, service := range after {
+		afterServices[service.Name] = service
+	}
+
+	for _, service := range before {
+		servicePath := path + "." + service.Name
+		afterService, ok := afterServices[service.Name]
+		if !ok {
+			cmp.report.add(ServiceRemoved, servicePath, "service removed")
+			continue
+		}
+
+		afterMethods := map[string]*schema_j5pb.Method{}
+		for _, method := range afterService.Methods {
+			afterMethods[method.Name] = method
+		}
+
+		for _, method := range service.Methods {
+			methodPath := servicePath + "." + method.Name
+			afterMethod, ok := afterMethods[method.Name]
+			if !ok {
+				cmp.report.add(MethodRemoved, methodPath, "method removed")
+				continue
+			}
+			if method.HttpMethod != afterMethod.HttpMethod {
+				cmp.report.add(HTTPMethodChanged, methodPath, "HTTP method changed from %s to %s", httpMethodName(method.HttpMethod), httpMethodName(afterMethod.HttpMethod))
+			}
+			if method.HttpPath != afterMethod.HttpPath {
+				cmp.report.add(HTTPPathChanged, methodPath, "HTTP path changed from %s to %s", method.HttpPath, afterMethod.HttpPath)
+			}
+			if method.RequestSchema != afterMethod.RequestSchema {
+				cmp.report.add(MethodSchemaChanged, methodPath, "request schema changed from %s to %s", method.RequestSchema, afterMethod.RequestSchema)
+			}
+			if method.ResponseSchema != afterMethod.ResponseSchema {
+				cmp.report.add(MethodSchemaChanged, methodPath, "response schema changed from %s to %s", method.ResponseSchema, afterMethod.ResponseSchema)
+			}
+		}
+	}
+}
+
+func httpMethodName(method schema_j5pb.HTTPMethod) string {
+	return strings.TrimPrefix(method.String(), "HTTP_METHOD_")
+}
+
+func (cmp *comparer) topics(path string, before, after []*schema_j5pb.Topic) {
+	afterTopics := map[string]*schema_j5pb.Topic{}
+	for _, topic := range after {
+		afterTopics[topic.Name] = topic
+	}
+
+	for _, topic := range before {
+		topicPath := path + "." + topic.Name
+		afterTopic, ok := afterTopics[topic.Name]
+		if !ok {
+			cmp.report.add(TopicRemoved, topicPath, "topic removed")
+			continue
+		}
+
+		afterMessages := map[string]*schema_j5pb.TopicMessage{}
+		for _, msg := range afterTopic.Messages {
+			afterMessages[msg.Name] = msg
+		}
+		for _, msg := range topic.Messages {
+			msgPath := topicPath + "." + msg.Name
+			afterMsg, ok := afterMessages[msg.Name]
+			if !ok {
+				cmp.report.add(TopicMessageRemoved, msgPath, "topic message removed")
+				continue
+			}
+			if msg.Schema != afterMsg.Schema {
+				cmp.report.add(TopicMessageChanged, msgPath, "message schema changed from %s to %s", msg.Schema, afterMsg.Schema)
+			}
+		}
+	}
+}
@@ -0,0 +1,249 @@
+package breaking
+
+import (
+	"testing"
+
+	"github.com/pentops/golib/gl"
+	"github.com/pentops/j5/gen/j5/schema/v1/schema_j5pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func stringField(rules *schema_j5pb.StringField_Rules) *schema_j5pb.Field {
+	return &schema_j5pb.Field{
+		Type: &schema_j5pb.Field_String_{
+			String_: &schema_j5pb.StringField{Rules: rules},
+		},
+	}
+}
+
+func integerField(format schema_j5pb.IntegerField_Format) *schema_j5pb.Field {
+	return &schema_j5pb.Field{
+		Type: &schema_j5pb.Field_Integer{
+			Integer: &schema_j5pb.IntegerField{Format: format},
+		},
+	}
+}
+
+func enumRefField(pkg, name string) *schema_j5pb.Field {
+	return &schema_j5pb.Field{
+		Type: &schema_j5pb.Field_Enum{
+			Enum: &schema_j5pb.EnumField{
+				Schema: &schema_j5pb.EnumField_Ref{
+					Ref: &schema_j5pb.Ref{Package: pkg, Schema: name},
+				},
+			},
+		},
+	}
+}
+
+func objectSchema(obj *schema_j5pb.Object) *schema_j5pb.RootSchema {
+	return &schema_j5pb.RootSchema{
+		Type: &schema_j5pb.RootSchema_Object{Object: obj},
+	}
+}
+
+func enumSchema(name string, options ...string) *schema_j5pb.RootSchema {
+	enum := &schema_j5pb.Enum{Name: name}
+	for idx, option := range options {
+		enum.Options = append(enum.Options, &schema_j5pb.Enum_Option{
+			Name:   option,
+			Number: int32(idx),
+		})
+	}
+	return &schema_j5pb.RootSchema{
+		Type: &schema_j5pb.RootSchema_Enum{Enum: enum},
+	}
+}
+
+func testAPI() *schema_j5pb.API {
+	return &schema_j5pb.API{
+		Packages: []*schema_j5pb.Package{{
+			Name: "foo.v1",
+			Schemas: map[string]*schema_j5pb.RootSchema{
+				"FooKeys": objectSchema(&schema_j5pb.Object{
+					Name:   "FooKeys",
+					Entity: &schema_j5pb.EntityObject{Entity: "Foo", Part: schema_j5pb.EntityPart_KEYS},
+					Properties: []*schema_j5pb.ObjectProperty{{
+						Name:      "fooId",
+						Required:  true,
+						Schema:    stringField(nil),
+						EntityKey: &schema_j5pb.EntityKey{Primary: true},
+					}},
+				}),
+				"FooState": objectSchema(&schema_j5pb.Object{
+					Name:   "FooState",
+					Entity: &schema_j5pb.EntityObject{Entity: "Foo", Part: schema_j5pb.EntityPart_STATE},
+					Properties: []*schema_j5pb.ObjectProperty{{
+						Name:   "status",
+						Schema: enumRefField("foo.v1", "FooStatus"),
+					}},
+				}),
+				"FooStatus": enumSchema("FooStatus", "UNSPECIFIED", "ACTIVE", "ARCHIVED"),
+				"Color":     enumSchema("Color", "UNSPECIFIED", "RED", "BLUE"),
+				"Bar": objectSchema(&schema_j5pb.Object{
+					Name: "Bar",
+					Properties: []*schema_j5pb.ObjectProperty{{
+						Name:   "name",
+						Schema: stringField(&schema_j5pb.StringField_Rules{MaxLength: gl.Ptr(uint64(100))}),
+					}, {
+						Name:   "count",
+						Schema: integerField(schema_j5pb.IntegerField_FORMAT_INT32),
+					}, {
+						Name:   "label",
+						Schema: stringField(nil),
+					}, {
+						Name:   "note",
+						Schema: stringField(&schema_j5pb.StringField_Rules{MinLength: gl.Ptr(uint64(5))}),
+					}},
+				}),
+			},
+			SubPackages: []*schema_j5pb.SubPackage{{
+				Name: "service",
+				Services: []*schema_j5pb.Service{{
+					Name: "FooQueryService",
+					Methods: []*schema_j5pb.Method{{
+						Name:           "getFoo",
+						HttpMethod:     schema_j5pb.HTTPMethod_GET,
+						HttpPath:       "/foo/v1/foo/:fooId",
+						RequestSchema:  "GetFooRequest",
+						ResponseSchema: "GetFooResponse",
+					}, {
+						Name:           "listFoos",
+						HttpMethod:     schema_j5pb.HTTPMethod_GET,
+						HttpPath:       "/foo/v1/foos",
+						RequestSchema:  "ListFoosRequest",
+						ResponseSchema: "ListFoosResponse",
+					}},
+				}},
+				Topics: []*schema_j5pb.Topic{{
+					Name: "FooPublishTopic",
+					Messages: []*schema_j5pb.TopicMessage{{
+						Name:   "FooEvent",
+						Schema: "FooEventMessage",
+					}},
+				}},
+			}},
+		}},
+	}
+}
+
+func TestCompareUnchanged(t *testing.T) {
+	report := CompareAPIs(testAPI(), testAPI())
+	if report.Breaking() {
+		t.Fatalf("expected no changes, got:\n%s", report.HumanString())
+	}
+}
+
+func TestCompareBreaking(t *testing.T) {
+	before := testAPI()
+	after := proto.Clone(before).(*schema_j5pb.API)
+
+	pkg := after.Packages[0]
+
+	bar := pkg.Schemas["Bar"].GetObject()
+	bar.Properties[0].Schema = stringField(&schema_j5pb.StringField_Rules{
+		MaxLength: gl.Ptr(uint64(50)),
+		Pattern:   gl.Ptr("^[a-z]+$"),
+	})
+	bar.Properties[1].Schema = integerField(schema_j5pb.IntegerField_FORMAT_INT64)
+	bar.Properties = append(bar.Properties[:2], bar.Properties[3])
+	// loosened rules don't break clients
+	bar.Properties[2].Schema = stringField(&schema_j5pb.StringField_Rules{MinLength: gl.Ptr(uint64(1))})
+	bar.Properties = append(bar.Properties, &schema_j5pb.ObjectProperty{
+		Name:     "extra",
+		Required: true,
+		Schema:   stringField(nil),
+	})
+
+	pkg.Schemas["FooKeys"].GetObject().Properties[0].EntityKey.ShardKey = true
+	pkg.Schemas["FooStatus"] = enumSchema("FooStatus", "UNSPECIFIED", "ACTIVE")
+	pkg.Schemas["Color"] = enumSchema("Color", "UNSPECIFIED", "RED")
+
+	service := pkg.SubPackages[0].Services[0]
+	service.Methods[0].HttpPath = "/foo/v1/foo/:id"
+	service.Methods[0].HttpMethod = schema_j5pb.HTTPMethod_POST
+	service.Methods = service.Methods[:1]
+	pkg.SubPackages[0].Topics = nil
+
+	report := CompareAPIs(before, after)
+
+	want := []Change{
+		{Kind: FieldTypeChanged, Path: "foo.v1.Bar.count"},
+		{Kind: FieldRequired, Path: "foo.v1.Bar.extra"},
+		{Kind: FieldRemoved, Path: "foo.v1.Bar.label"},
+		{Kind: RuleTightened, Path: "foo.v1.Bar.name"},
+		{Kind: RuleTightened, Path: "foo.v1.Bar.name"},
+		{Kind: EnumValueRemoved, Path: "foo.v1.Color.BLUE"},
+		{Kind: EntityKeyChanged, Path: "foo.v1.FooKeys.fooId"},
+		{Kind: StatusRemoved, Path: "foo.v1.FooStatus.ARCHIVED"},
+		{Kind: TopicRemoved, Path: "foo.v1.service.FooPublishTopic"},
+		{Kind: HTTPMethodChanged, Path: "foo.v1.service.FooQueryService.getFoo"},
+		{Kind: HTTPPathChanged, Path: "foo.v1.service.FooQueryService.getFoo"},
+		{Kind: MethodRemoved, Path: "foo.v1.service.FooQueryService.listFoos"},
+	}
+
+	for _, change := range report.Changes {
+		t.Logf("%s %s %s", change.Path, change.Kind, change.Message)
+	}
+	if len(report.Changes) != len(want) {
+		t.Fatalf("expected %d changes, got %d", len(want), len(report.Changes))
+	}
+	for idx, want := range want {
+		got := report.Changes[idx]
+		if got.Kind != want.Kind || got.Path != want.Path {
+			t.Errorf("change %d: want %s %s, got %s %s", idx, want.Path, want.Kind, got.Path, got.Kind)
+		}
+	}
+}
+
+func TestComparePackageRemoved(t *testing.T) {
+	before := testAPI()
+	before.Packages = append(before.Packages, &schema_j5pb.Package{
+		Name:     "indirect.v1",
+		Indirect: true,
+	})
+	after := &schema_j5pb.API{}
+
+	report := CompareAPIs(before, after)
+	if len(report.Changes) != 1 || report.Changes[0].Kind != PackageRemoved {
+		t.Fatalf("expected only foo.v1 to be removed, got:\n%s", report.HumanString())
+	}
+}
+
+func TestCompareRuleValues(t *testing.T) {
+	decimalRules := (&schema_j5pb.DecimalField_Rules{}).ProtoReflect().Descriptor().Fields()
+	minimum := decimalRules.ByName("minimum")
+
+	for _, tc := range []struct {
+		a, b string
+		want int
+	}{
+		{a: "1.10", b: "1.1", want: 0},
+		{a: "0.30000000000000000001", b: "0.3", want: 1},
+		{a: "-2", b: "10", want: -1},
+		{a: "2020-01-02", b: "2020-01-10", want: -1},
+	} {
+		got := compareValues(minimum, protoreflect.ValueOfString(tc.a), protoreflect.ValueOfString(tc.b))
+		if got != tc.want {
+			t.Errorf("compare %s to %s: got %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestComparePatterns(t *testing.T) {
+	withPattern := func(pattern *string) *schema_j5pb.API {
+		api := testAPI()
+		api.Packages[0].Schemas["Bar"].GetObject().Properties[2].Schema = stringField(&schema_j5pb.StringField_Rules{
+			Pattern: pattern,
+		})
+		return api
+	}
+
+	if report := CompareAPIs(withPattern(gl.Ptr("^[a-z]+$")), withPattern(nil)); report.Breaking() {
+		t.Errorf("removing a pattern should not break, got:\n%s", report.HumanString())
+	}
+	if report := CompareAPIs(withPattern(gl.Ptr("^[a-z]+$")), withPattern(gl.Ptr("^[a-z0-9]+$"))); !report.Breaking() {
+		t.Error("changing a pattern should break")
+	}
+}
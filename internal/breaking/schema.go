@@ -0,0 +1,403 @@
+package breaking
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pentops/j5/gen/j5/schema/v1/schema_j5pb"
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func (cmp *comparer) schemas(path string, before, after map[string]*schema_j5pb.RootSchema) {
+	names := make([]string, 0, len(before))
+	for name := range before {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		schemaPath := path + "." + name
+		beforeSchema := before[name]
+		afterSchema, ok := after[name]
+		if !ok {
+			cmp.report.add(SchemaRemoved, schemaPath, "schema removed")
+			continue
+		}
+
+		beforeType, afterType := oneofName(beforeSchema, "type"), oneofName(afterSchema, "type")
+		if beforeType != afterType {
+			cmp.report.add(SchemaTypeChanged, schemaPath, "schema changed from %s to %s", beforeType, afterType)
+			continue
+		}
+
+		switch st := beforeSchema.Type.(type) {
+		case *schema_j5pb.RootSchema_Object:
+			cmp.properties(schemaPath, st.Object.Properties, afterSchema.GetObject().Properties)
+		case *schema_j5pb.RootSchema_Oneof:
+			cmp.properties(schemaPath, st.Oneof.Properties, afterSchema.GetOneof().Properties)
+		case *schema_j5pb.RootSchema_Enum:
+			cmp.enum(schemaPath, st.Enum, afterSchema.GetEnum(), cmp.statuses[schemaPath])
+		case *schema_j5pb.RootSchema_Polymorph:
+			cmp.polymorph(schemaPath, st.Polymorph, afterSchema.GetPolymorph())
+		}
+	}
+}
+
+func (cmp *comparer) enum(path string, before, after *schema_j5pb.Enum, isStatus bool) {
+	afterOptions := map[string]struct{}{}
+	for _, option := range after.Options {
+		afterOptions[option.Name] = struct{}{}
+	}
+	for _, option := range before.Options {
+		if _, ok := afterOptions[option.Name]; ok {
+			continue
+		}
+		if isStatus {
+			cmp.report.add(StatusRemoved, path+"."+option.Name, "status removed")
+		} else {
+			cmp.report.add(EnumValueRemoved, path+"."+option.Name, "enum value removed")
+		}
+	}
+}
+
+func (cmp *comparer) polymorph(path string, before, after *schema_j5pb.Polymorph) {
+	afterMembers := map[string]struct{}{}
+	for _, member := range after.Members {
+		afterMembers[member] = struct{}{}
+	}
+	for _, member := range before.Members {
+		if _, ok := afterMembers[member]; !ok {
+			cmp.report.add(PolymorphMemberRemoved, path, "member %s removed", member)
+		}
+	}
+}
+
+func (cmp *comparer) properties(path string, before, after []*schema_j5pb.ObjectProperty) {
+	beforeProps := map[string]*schema_j5pb.ObjectProperty{}
+	for _, prop := range before {
+		beforeProps[prop.Name] = prop
+	}
+	afterProps := map[string]*schema_j5pb.ObjectProperty{}
+	for _, prop := range after {
+		afterProps[prop.Name] = prop
+	}
+
+	for _, prop := range before {
+		propPath := path + "." + prop.Name
+		afterProp, ok := afterProps[prop.Name]
+		if !ok {
+			if prop.EntityKey != nil {
+				cmp.report.add(EntityKeyChanged, propPath, "key field removed")
+			} else {
+				cmp.report.add(FieldRemoved, propPath, "field removed")
+			}
+			continue
+		}
+		cmp.property(propPath, prop, afterProp)
+	}
+
+	for _, prop := range after {
+		if _, ok := beforeProps[prop.Name]; ok {
+			continue
+		}
+		propPath := path + "." + prop.Name
+		if prop.EntityKey.GetPrimary() {
+			cmp.report.add(EntityKeyChanged, propPath, "primary key field added")
+		} else if prop.Required {
+			cmp.report.add(FieldRequired, propPath, "required field added")
+		}
+	}
+}
+
+func (cmp *comparer) property(path string, before, after *schema_j5pb.ObjectProperty) {
+	if !before.Required && after.Required {
+		cmp.report.add(FieldRequired, path, "field is now required")
+	}
+
+	if before.EntityKey != nil || after.EntityKey != nil {
+		beforeKey, afterKey := before.EntityKey, after.EntityKey
+		if beforeKey.GetPrimary() != afterKey.GetPrimary() {
+			cmp.report.add(EntityKeyChanged, path, "primary key changed from %t to %t", beforeKey.GetPrimary(), afterKey.GetPrimary())
+		}
+		if beforeKey.GetShardKey() != afterKey.GetShardKey() {
+			cmp.report.add(EntityKeyChanged, path, "shard key changed from %t to %t", beforeKey.GetShardKey(), afterKey.GetShardKey())
+		}
+		if beforeKey.GetTenant() != afterKey.GetTenant() {
+			cmp.report.add(EntityKeyChanged, path, "tenant changed from %q to %q", beforeKey.GetTenant(), afterKey.GetTenant())
+		}
+	}
+
+	cmp.field(path, before.Schema, after.Schema)
+}
+
+func (cmp *comparer) field(path string, before, after *schema_j5pb.Field) {
+	beforeType, afterType := fieldType(before), fieldType(after)
+	if beforeType != afterType {
+		cmp.report.add(FieldTypeChanged, path, "type changed from %s to %s", beforeType, afterType)
+		return
+	}
+
+	beforeValue, afterValue := oneofValue(before, "type"), oneofValue(after, "type")
+	if beforeValue == nil || afterValue == nil {
+		return
+	}
+	cmp.rules(path, messageField(beforeValue, "rules"), messageField(afterValue, "rules"))
+
+	switch ft := before.Type.(type) {
+	case *schema_j5pb.Field_Object:
+		if obj := ft.Object.GetObject(); obj != nil {
+			cmp.properties(path, obj.Properties, after.GetObject().GetObject().Properties)
+		}
+	case *schema_j5pb.Field_Oneof:
+		if oneof := ft.Oneof.GetOneof(); oneof != nil {
+			cmp.properties(path, oneof.Properties, after.GetOneof().GetOneof().Properties)
+		}
+	case *schema_j5pb.Field_Enum:
+		if enum := ft.Enum.GetEnum(); enum != nil {
+			cmp.enum(path, enum, after.GetEnum().GetEnum(), false)
+		}
+	case *schema_j5pb.Field_Polymorph:
+		if poly := ft.Polymorph.GetPolymorph(); poly != nil {
+			cmp.polymorph(path, poly, after.GetPolymorph().GetPolymorph())
+		}
+	case *schema_j5pb.Field_Array:
+		cmp.field(path+"[]", ft.Array.Items, after.GetArray().Items)
+	case *schema_j5pb.Field_Map:
+		cmp.field(path+"{}", ft.Map.ItemSchema, after.GetMap().ItemSchema)
+	}
+}
+
+// fieldType describes the type of the field, as far as it is encoded. Two
+// fields of the same type are compatible apart from rules and the contents of
+// inline schemas.
+func fieldType(field *schema_j5pb.Field) string {
+	if field == nil {
+		return "none"
+	}
+	name := oneofName(field, "type")
+	switch ft := field.Type.(type) {
+	case *schema_j5pb.Field_Object:
+		return name + refName(ft.Object.GetRef())
+	case *schema_j5pb.Field_Oneof:
+		return name + refName(ft.Oneof.GetRef())
+	case *schema_j5pb.Field_Enum:
+		return name + refName(ft.Enum.GetRef())
+	case *schema_j5pb.Field_Polymorph:
+		return name + refName(ft.Polymorph.GetRef())
+	case *schema_j5pb.Field_Array:
+		return name + "<" + fieldType(ft.Array.Items) + ">"
+	case *schema_j5pb.Field_Map:
+		return name + "<" + fieldType(ft.Map.ItemSchema) + ">"
+	case *schema_j5pb.Field_String_:
+		if ft.String_.Format != nil {
+			return name + ":" + *ft.String_.Format
+		}
+	case *schema_j5pb.Field_Integer:
+		return name + ":" + strings.TrimPrefix(ft.Integer.Format.String(), "FORMAT_")
+	case *schema_j5pb.Field_Float:
+		return name + ":" + strings.TrimPrefix(ft.Float.Format.String(), "FORMAT_")
+	case *schema_j5pb.Field_Key:
+		if ft.Key.Format != nil {
+			format := oneofName(ft.Key.Format, "type")
+			if named := ft.Key.Format.GetNamed(); named != nil {
+				format += refName(named.Ref)
+			}
+			return name + ":" + format
+		}
+	}
+	return name
+}
+
+func refName(ref *schema_j5pb.Ref) string {
+	if ref == nil {
+		return ""
+	}
+	return ":" + ref.Package + "." + ref.Schema
+}
+
+func oneofName(msg protoreflect.ProtoMessage, oneof protoreflect.Name) string {
+	field := whichOneof(msg.ProtoReflect(), oneof)
+	if field == nil {
+		return "none"
+	}
+	return string(field.Name())
+}
+
+func oneofValue(msg protoreflect.ProtoMessage, oneof protoreflect.Name) protoreflect.Message {
+	refl := msg.ProtoReflect()
+	field := whichOneof(refl, oneof)
+	if field == nil || field.Message() == nil {
+		return nil
+	}
+	return refl.Get(field).Message()
+}
+
+func whichOneof(msg protoreflect.Message, oneof protoreflect.Name) protoreflect.FieldDescriptor {
+	desc := msg.Descriptor().Oneofs().ByName(oneof)
+	if desc == nil {
+		return nil
+	}
+	return msg.WhichOneof(desc)
+}
+
+// messageField returns the message in the named field, an invalid message
+// when the field doesn't exist or isn't set.
+func messageField(msg protoreflect.Message, name protoreflect.Name) protoreflect.Message {
+	field := msg.Descriptor().Fields().ByName(name)
+	if field == nil || field.Message() == nil || !msg.Has(field) {
+		return nil
+	}
+	return msg.Get(field).Message()
+}
+
+// rules reports rules which reject values the before rules allowed: raised
+// minimums, lowered maximums, and new or changed patterns and constants.
+// Removed or loosened rules don't break clients.
+//
+// The rules messages are compared generically, by field name: fields starting
+// with "min" (minimum, minLength, minItems...) tighten when raised, and those
+// starting with "max" when lowered. Other bool fields, e.g. exclusiveMinimum or
+// uniqueItems, tighten when set, `in` lists when a value is dropped and
+// `not_in` lists when one is added. Anything else tightens on any change, so
+// removing a pattern is safe but any edit to one is breaking, as whether the
+// new pattern accepts everything the old one did can't be checked.
+func (cmp *comparer) rules(path string, before, after protoreflect.Message) {
+	if after == nil {
+		return
+	}
+
+	fields := after.Descriptor().Fields()
+	for idx := 0; idx < fields.Len(); idx++ {
+		field := fields.Get(idx)
+		if !after.Has(field) {
+			continue
+		}
+		name := string(field.Name())
+		afterValue := after.Get(field)
+
+		had := before != nil && before.Has(field)
+		if !had {
+			if field.Kind() == protoreflect.BoolKind && !afterValue.Bool() && name != "const" {
+				continue
+			}
+			cmp.report.add(RuleTightened, path, "rule %s added: %s", field.JSONName(), valueString(field, afterValue))
+			continue
+		}
+		beforeValue := before.Get(field)
+
+		var tightened bool
+		switch {
+		case field.IsList():
+			tightened = listTightened(name, beforeValue.List(), afterValue.List())
+		case strings.HasPrefix(name, "min"):
+			tightened = compareValues(field, afterValue, beforeValue) > 0
+		case strings.HasPrefix(name, "max"):
+			tightened = compareValues(field, afterValue, beforeValue) < 0
+		case field.Kind() == protoreflect.BoolKind && name != "const":
+			tightened = afterValue.Bool() && !beforeValue.Bool()
+		default:
+			tightened = !afterValue.Equal(beforeValue)
+		}
+		if tightened {
+			cmp.report.add(RuleTightened, path, "rule %s changed from %s to %s", field.JSONName(), valueString(field, beforeValue), valueString(field, afterValue))
+		}
+	}
+}
+
+// listTightened compares the allowed values of `in` lists, and the disallowed
+// values of `not_in` lists.
+func listTightened(name string, before, after protoreflect.List) bool {
+	beforeValues := map[string]struct{}{}
+	for idx := 0; idx < before.Len(); idx++ {
+		beforeValues[before.Get(idx).String()] = struct{}{}
+	}
+	afterValues := map[string]struct{}{}
+	for idx := 0; idx < after.Len(); idx++ {
+		afterValues[after.Get(idx).String()] = struct{}{}
+	}
+
+	if name == "not_in" {
+		for value := range afterValues {
+			if _, ok := beforeValues[value]; !ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(beforeValues) == 0 {
+		return len(afterValues) > 0
+	}
+	for value := range beforeValues {
+		if _, ok := afterValues[value]; !ok {
+			return true
+		}
+	}
+	return false
+}
+
+// compareValues orders two values of the field. Decimals and dates are
+// strings, compared as decimals when both parse, otherwise as strings, which
+// orders ISO dates.
+func compareValues(field protoreflect.FieldDescriptor, a, b protoreflect.Value) int {
+	switch field.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Sint32Kind, protoreflect.Sint64Kind, protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind:
+		return compareOrdered(a.Int(), b.Int())
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind, protoreflect.Fixed32Kind, protoreflect.Fixed64Kind:
+		return compareOrdered(a.Uint(), b.Uint())
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return compareOrdered(a.Float(), b.Float())
+	case protoreflect.StringKind:
+		aDecimal, aErr := decimal.NewFromString(a.String())
+		bDecimal, bErr := decimal.NewFromString(b.String())
+		if aErr == nil && bErr == nil {
+			return aDecimal.Cmp(bDecimal)
+		}
+		return strings.Compare(a.String(), b.String())
+	case protoreflect.MessageKind:
+		// google.protobuf.Timestamp
+		aMsg, bMsg := a.Message(), b.Message()
+		fields := aMsg.Descriptor().Fields()
+		for _, name := range []protoreflect.Name{"seconds", "nanos"} {
+			sub := fields.ByName(name)
+			if sub == nil {
+				return 0
+			}
+			if cmp := compareValues(sub, aMsg.Get(sub), bMsg.Get(sub)); cmp != 0 {
+				return cmp
+			}
+		}
+	}
+	return 0
+}
+
+func compareOrdered[T int64 | uint64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func valueString(field protoreflect.FieldDescriptor, value protoreflect.Value) string {
+	if field.IsList() {
+		list := value.List()
+		values := make([]string, 0, list.Len())
+		for idx := 0; idx < list.Len(); idx++ {
+			values = append(values, list.Get(idx).String())
+		}
+		return "[" + strings.Join(values, ", ") + "]"
+	}
+	if field.Kind() == protoreflect.MessageKind {
+		msg := value.Message()
+		seconds := msg.Descriptor().Fields().ByName("seconds")
+		if seconds != nil {
+			return fmt.Sprintf("%ds", msg.Get(seconds).Int())
+		}
+	}
+	return value.String()
+}
@@ -0,0 +1,56 @@
+package codec
+
+import (
+	"github.com/pentops/j5/lib/j5reflect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var canonicalMarshal = proto.MarshalOptions{
+	Deterministic: true,
+}
+
+var canonicalUnmarshal = proto.UnmarshalOptions{
+	DiscardUnknown: true,
+}
+
+// encodeProtoBinary marshals a copy of the message without unknown fields, so
+// that equal messages produce equal bytes.
+func (c *Codec) encodeProtoBinary(root j5reflect.Root) ([]byte, error) {
+	msg := proto.Clone(root.ProtoReflect().Interface())
+	discardUnknown(msg.ProtoReflect())
+	return canonicalMarshal.Marshal(msg)
+}
+
+func (c *Codec) decodeProtoBinary(data []byte, root j5reflect.Root) error {
+	return canonicalUnmarshal.Unmarshal(data, root.ProtoReflect().Interface())
+}
+
+func discardUnknown(msg protoreflect.Message) {
+	msg.Range(func(fd protoreflect.FieldDescriptor, val protoreflect.Value) bool {
+		switch {
+		case fd.IsList():
+			if fd.Message() == nil {
+				return true
+			}
+			list := val.List()
+			for idx := range list.Len() {
+				discardUnknown(list.Get(idx).Message())
+			}
+		case fd.IsMap():
+			if fd.MapValue().Message() == nil {
+				return true
+			}
+			val.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				discardUnknown(mv.Message())
+				return true
+			})
+		case fd.Message() != nil:
+			discardUnknown(val.Message())
+		}
+		return true
+	})
+	if msg.GetUnknown() != nil {
+		msg.SetUnknown(nil)
+	}
+}
@@ -0,0 +1,136 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/pentops/j5/lib/j5reflect"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+func (enc *encoder) encodeSortedMap(field j5reflect.MapField) error {
+	entries := map[string]j5reflect.Field{}
+	keys := []string{}
+	err := field.Range(func(key string, val j5reflect.Field) error {
+		entries[key] = val
+		keys = append(keys, key)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	sort.Strings(keys)
+
+	enc.openObject()
+	defer enc.closeObject()
+	for idx, key := range keys {
+		if idx > 0 {
+			enc.fieldSep()
+		}
+		if err := enc.fieldLabel(key); err != nil {
+			return err
+		}
+		if err := enc.encodeValue(entries[key], nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// canonicalAnyJSON re-encodes the J5 JSON of an Any value. When the type is
+// not in the resolver, the JSON is canonicalized without a schema, which may
+// differ from the schema based encoding, e.g. for -0.
+func (c *Codec) canonicalAnyJSON(typeName string, jsonData []byte) ([]byte, error) {
+	mt, err := c.resolver.FindMessageByName(protoreflect.FullName(typeName))
+	if err == nil {
+		msg := mt.New()
+		if err := c.decode(jsonData, msg); err != nil {
+			return nil, fmt.Errorf("decoding any type %q: %w", typeName, err)
+		}
+		return c.encode(msg)
+	}
+	if !errors.Is(err, protoregistry.NotFound) {
+		return nil, fmt.Errorf("decoding any type %q: %w", typeName, err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(jsonData))
+	dec.UseNumber()
+	var val jsonValue
+	if err := val.decode(dec, 0); err != nil {
+		return nil, fmt.Errorf("decoding any type %q: %w", typeName, err)
+	}
+	return val.appendCanonicalJSON(nil)
+}
+
+// appendCanonicalJSON writes the value with sorted object keys, integers
+// without exponents or fractions, and other numbers in the shortest form.
+func (jv jsonValue) appendCanonicalJSON(out []byte) ([]byte, error) {
+	var err error
+	switch tok := jv.token.(type) {
+	case json.Delim:
+		if tok == '[' {
+			out = append(out, '[')
+			for idx, item := range jv.items {
+				if idx > 0 {
+					out = append(out, ',')
+				}
+				out, err = item.appendCanonicalJSON(out)
+				if err != nil {
+					return nil, err
+				}
+			}
+			return append(out, ']'), nil
+		}
+
+		members := append([]jsonMember{}, jv.members...)
+		sort.SliceStable(members, func(i, j int) bool {
+			return members[i].key < members[j].key
+		})
+		out = append(out, '{')
+		for idx, member := range members {
+			if idx > 0 {
+				out = append(out, ',')
+			}
+			out, err = appendString(out, member.key)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, ':')
+			out, err = member.value.appendCanonicalJSON(out)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return append(out, '}'), nil
+
+	case string:
+		return appendString(out, tok)
+
+	case bool:
+		return strconv.AppendBool(out, tok), nil
+
+	case json.Number:
+		if i64, err := strconv.ParseInt(tok.String(), 10, 64); err == nil {
+			return strconv.AppendInt(out, i64, 10), nil
+		}
+		if u64, err := strconv.ParseUint(tok.String(), 10, 64); err == nil {
+			return strconv.AppendUint(out, u64, 10), nil
+		}
+		f64, err := tok.Float64()
+		if err != nil {
+			return nil, err
+		}
+		if f64 == 0 {
+			f64 = 0 // -0
+		}
+		return strconv.AppendFloat(out, f64, 'g', -1, 64), nil
+
+	default: // nil
+		return append(out, "null"...), nil
+	}
+}
@@ -0,0 +1,90 @@
+package codec
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/pentops/j5/internal/gen/test/schema/v1/schema_testpb"
+	"github.com/pentops/j5/j5types/any_j5t"
+	"github.com/pentops/j5/j5types/decimal_j5t"
+)
+
+func TestCanonical(t *testing.T) {
+	codec := NewCodec(WithCanonical(), WithIncludeEmpty())
+
+	for _, tc := range []struct {
+		name string
+		msg  proto.Message
+		want string
+	}{{
+		name: "sorted keys",
+		msg: &schema_testpb.FullSchema{
+			SString: "a",
+			Enum:    schema_testpb.Enum_ENUM_VALUE1,
+			SBar: &schema_testpb.Bar{
+				BarId:    "id",
+				BarField: "field",
+			},
+			MapStringString: map[string]string{
+				"b": "2", "a": "1", "c": "3", "B": "0",
+			},
+		},
+		want: `{"enum":"VALUE1","mapStringString":{"B":"0","a":"1","b":"2","c":"3"},"sBar":{"barField":"field","barId":"id"},"sString":"a"}`,
+	}, {
+		name: "numbers",
+		msg: &schema_testpb.FullSchema{
+			OFloat:   proto.Float32(float32(negZero())),
+			RFloat:   []float32{1e21, 0.1},
+			SInt64:   -5,
+			Decimal:  decimal_j5t.FromString("-0.00"),
+			RDecimal: []*decimal_j5t.Decimal{decimal_j5t.FromString("1.50"), decimal_j5t.FromString("010")},
+		},
+		want: `{"decimal":"0","oFloat":0,"rDecimal":["1.5","10"],"rFloat":[1e+21,0.1],"sInt64":"-5"}`,
+	}, {
+		name: "oneof",
+		msg: &schema_testpb.FullSchema{
+			WrappedOneof: &schema_testpb.WrappedOneof{
+				Type: &schema_testpb.WrappedOneof_WOneofBar{
+					WOneofBar: &schema_testpb.Bar{BarId: "x"},
+				},
+			},
+		},
+		want: `{"wrappedOneof":{"!type":"wOneofBar","wOneofBar":{"barId":"x"}}}`,
+	}, {
+		name: "known any",
+		msg: &schema_testpb.FullSchema{
+			J5Any: &any_j5t.Any{
+				TypeName: "test.schema.v1.Bar",
+				J5Json:   []byte(`{ "barId": "b", "barField": "a" }`),
+			},
+		},
+		want: `{"j5any":{"!type":"test.schema.v1.Bar","value":{"barField":"a","barId":"b"}}}`,
+	}, {
+		name: "unknown any",
+		msg: &schema_testpb.FullSchema{
+			J5Any: &any_j5t.Any{
+				TypeName: "test.unknown.v1.Foo",
+				J5Json:   []byte(`{"z": [1.50, -0, 2e2], "a": {"y": null, "x": true}}`),
+			},
+		},
+		want: `{"j5any":{"!type":"test.unknown.v1.Foo","value":{"a":{"x":true,"y":null},"z":[1.5,0,200]}}}`,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			for range 5 { // map order is random
+				got, err := codec.ProtoToJSON(tc.msg.ProtoReflect())
+				if err != nil {
+					t.Fatal(err)
+				}
+				if string(got) != tc.want {
+					t.Fatalf("got  %s\nwant %s", got, tc.want)
+				}
+			}
+		})
+	}
+}
+
+func negZero() float64 {
+	zero := 0.0
+	return -zero
+}
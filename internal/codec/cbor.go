@@ -0,0 +1,204 @@
+package codec
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// CBOR (RFC 8949) major types
+const (
+	cborUint   byte = 0
+	cborNegInt byte = 1
+	cborBytes  byte = 2
+	cborText   byte = 3
+	cborArray  byte = 4
+	cborMap    byte = 5
+	cborTag    byte = 6
+	cborSimple byte = 7
+)
+
+const (
+	cborFalse     byte = 0xf4
+	cborTrue      byte = 0xf5
+	cborNull      byte = 0xf6
+	cborUndefined byte = 0xf7
+	cborFloat16   byte = 0xf9
+	cborFloat32   byte = 0xfa
+	cborFloat64   byte = 0xfb
+	cborBreak     byte = 0xff
+
+	// info value for indefinite length strings, arrays and maps
+	cborIndefinite byte = 31
+)
+
+// CBOR tags used for J5 scalar types
+const (
+	cborTagDateTimeString uint64 = 0    // RFC 3339 timestamp, RFC 8949 3.4.1
+	cborTagEpochDateTime  uint64 = 1    // seconds since the epoch, RFC 8949 3.4.2
+	cborTagFullDate       uint64 = 1004 // RFC 3339 full-date, RFC 8943
+)
+
+// maxCBORDepth limits nesting when decoding, matching encoding/json
+const maxCBORDepth = 10000
+
+var errCBORTruncated = errors.New("unexpected end of CBOR data")
+
+// appendCBORHead appends the initial byte and argument of an item, using the
+// shortest form as required for deterministic encoding.
+func appendCBORHead(out []byte, major byte, arg uint64) []byte {
+	major <<= 5
+	switch {
+	case arg < 24:
+		return append(out, major|byte(arg))
+	case arg <= math.MaxUint8:
+		return append(out, major|24, byte(arg))
+	case arg <= math.MaxUint16:
+		return binary.BigEndian.AppendUint16(append(out, major|25), uint16(arg))
+	case arg <= math.MaxUint32:
+		return binary.BigEndian.AppendUint32(append(out, major|26), uint32(arg))
+	default:
+		return binary.BigEndian.AppendUint64(append(out, major|27), arg)
+	}
+}
+
+func appendCBORInt(out []byte, val int64) []byte {
+	if val < 0 {
+		return appendCBORHead(out, cborNegInt, uint64(-(val + 1)))
+	}
+	return appendCBORHead(out, cborUint, uint64(val))
+}
+
+func appendCBORText(out []byte, val string) []byte {
+	out = appendCBORHead(out, cborText, uint64(len(val)))
+	return append(out, val...)
+}
+
+func appendCBORBytes(out []byte, val []byte) []byte {
+	out = appendCBORHead(out, cborBytes, uint64(len(val)))
+	return append(out, val...)
+}
+
+func appendCBORBool(out []byte, val bool) []byte {
+	if val {
+		return append(out, cborTrue)
+	}
+	return append(out, cborFalse)
+}
+
+func appendCBORFloat32(out []byte, val float32) []byte {
+	return binary.BigEndian.AppendUint32(append(out, cborFloat32), math.Float32bits(val))
+}
+
+func appendCBORFloat64(out []byte, val float64) []byte {
+	return binary.BigEndian.AppendUint64(append(out, cborFloat64), math.Float64bits(val))
+}
+
+// cborHead is the initial byte and argument of an item.
+type cborHead struct {
+	major byte
+	info  byte
+	arg   uint64
+}
+
+func (h cborHead) indefinite() bool {
+	return h.info == cborIndefinite
+}
+
+// readCBORHead reads the head of the item at pos, returning the position of
+// the item's content.
+func readCBORHead(data []byte, pos int) (cborHead, int, error) {
+	if pos >= len(data) {
+		return cborHead{}, pos, errCBORTruncated
+	}
+	initial := data[pos]
+	pos++
+	head := cborHead{
+		major: initial >> 5,
+		info:  initial & 0x1f,
+	}
+
+	var size int
+	switch {
+	case head.info < 24:
+		head.arg = uint64(head.info)
+		return head, pos, nil
+	case head.info == 24:
+		size = 1
+	case head.info == 25:
+		size = 2
+	case head.info == 26:
+		size = 4
+	case head.info == 27:
+		size = 8
+	case head.info == cborIndefinite:
+		switch head.major {
+		case cborArray, cborMap:
+			return head, pos, nil
+		case cborBytes, cborText:
+			return head, pos, fmt.Errorf("indefinite length CBOR strings are not supported")
+		default:
+			return head, pos, fmt.Errorf("invalid CBOR initial byte 0x%x", initial)
+		}
+	default:
+		return head, pos, fmt.Errorf("invalid CBOR initial byte 0x%x", initial)
+	}
+
+	if len(data)-pos < size {
+		return head, pos, errCBORTruncated
+	}
+	switch size {
+	case 1:
+		head.arg = uint64(data[pos])
+	case 2:
+		head.arg = uint64(binary.BigEndian.Uint16(data[pos:]))
+	case 4:
+		head.arg = uint64(binary.BigEndian.Uint32(data[pos:]))
+	case 8:
+		head.arg = binary.BigEndian.Uint64(data[pos:])
+	}
+	return head, pos + size, nil
+}
+
+// cborFloat decodes the argument of a major type 7 float item.
+func cborFloat(head cborHead) (float64, error) {
+	var val float64
+	switch head.info {
+	case cborFloat16 & 0x1f:
+		val = float16ToFloat64(uint16(head.arg))
+	case cborFloat32 & 0x1f:
+		val = float64(math.Float32frombits(uint32(head.arg)))
+	case cborFloat64 & 0x1f:
+		val = math.Float64frombits(head.arg)
+	default:
+		return 0, fmt.Errorf("unsupported CBOR simple value %d", head.arg)
+	}
+	// JSON can't represent these either
+	if math.IsNaN(val) || math.IsInf(val, 0) {
+		return 0, fmt.Errorf("non-finite float values are not supported")
+	}
+	return val, nil
+}
+
+func float16ToFloat64(bits uint16) float64 {
+	exp := int(bits>>10) & 0x1f
+	mant := float64(bits & 0x3ff)
+	var val float64
+	switch exp {
+	case 0:
+		val = math.Ldexp(mant, -24)
+	case 0x1f:
+		if mant == 0 {
+			val = math.Inf(1)
+		} else {
+			val = math.NaN()
+		}
+	default:
+		val = math.Ldexp(mant+1024, exp-25)
+	}
+	if bits&0x8000 != 0 {
+		return -val
+	}
+	return val
+}
@@ -0,0 +1,344 @@
+package codec
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pentops/j5/lib/j5reflect"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func (c *Codec) decodeCBOR(data []byte, msg protoreflect.Message) error {
+	root, err := c.refl.NewRoot(msg)
+	if err != nil {
+		return err
+	}
+
+	return c.decodeCBORRoot(data, root)
+}
+
+func (c *Codec) decodeCBORRoot(data []byte, root j5reflect.Root) error {
+	tokens := &cborTokens{
+		data: data,
+	}
+	if err := c.decodeTokens(tokens, root); err != nil {
+		return err
+	}
+	if tokens.pos != len(data) {
+		return fmt.Errorf("unexpected data after CBOR item at offset %d", tokens.pos)
+	}
+	return nil
+}
+
+// cborTokens reads CBOR as the tokens of the equivalent J5 JSON, so that the
+// JSON decoder applies the same rules to both.
+//
+// Integers and floats are returned as json.Number, byte strings as []byte and
+// epoch timestamps (tag 1) as time.Time. Other tags are ignored.
+type cborTokens struct {
+	data  []byte
+	pos   int
+	stack []cborFrame
+}
+
+type cborFrame struct {
+	isMap bool
+
+	// remaining items, counting keys and values separately in maps, -1 for
+	// indefinite length
+	remaining int
+}
+
+func (ct *cborTokens) atBreak() bool {
+	return ct.pos < len(ct.data) && ct.data[ct.pos] == cborBreak
+}
+
+func (ct *cborTokens) More() bool {
+	if len(ct.stack) == 0 {
+		return ct.pos < len(ct.data)
+	}
+	top := ct.stack[len(ct.stack)-1]
+	if top.remaining < 0 {
+		return ct.pos < len(ct.data) && !ct.atBreak()
+	}
+	return top.remaining > 0
+}
+
+// closeFrame pops the current array or map when it has no more items.
+func (ct *cborTokens) closeFrame() (json.Token, bool) {
+	if len(ct.stack) == 0 {
+		return nil, false
+	}
+	top := &ct.stack[len(ct.stack)-1]
+	if top.remaining < 0 {
+		if !ct.atBreak() {
+			return nil, false
+		}
+		ct.pos++
+	} else if top.remaining > 0 {
+		return nil, false
+	}
+
+	ct.stack = ct.stack[:len(ct.stack)-1]
+	if top.isMap {
+		return json.Delim('}'), true
+	}
+	return json.Delim(']'), true
+}
+
+func (ct *cborTokens) takeSlot() {
+	if len(ct.stack) == 0 {
+		return
+	}
+	top := &ct.stack[len(ct.stack)-1]
+	if top.remaining > 0 {
+		top.remaining--
+	}
+}
+
+func (ct *cborTokens) Token() (json.Token, error) {
+	if tok, ok := ct.closeFrame(); ok {
+		return tok, nil
+	}
+	ct.takeSlot()
+	return ct.readToken()
+}
+
+func (ct *cborTokens) readToken() (json.Token, error) {
+	head, pos, err := readCBORHead(ct.data, ct.pos)
+	if err != nil {
+		return nil, err
+	}
+	ct.pos = pos
+
+	// tags are read in a loop as they can be nested without limit, only the
+	// innermost tag applies.
+	isEpoch := false
+	for head.major == cborTag {
+		isEpoch = head.arg == cborTagEpochDateTime
+		head, ct.pos, err = readCBORHead(ct.data, ct.pos)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if isEpoch {
+		tok, err := ct.readScalar(head)
+		if err != nil {
+			return nil, err
+		}
+		return epochToTime(tok)
+	}
+
+	switch head.major {
+	case cborArray, cborMap:
+		if len(ct.stack) >= maxCBORDepth {
+			return nil, fmt.Errorf("exceeded max depth of %d", maxCBORDepth)
+		}
+		frame := cborFrame{
+			isMap:     head.major == cborMap,
+			remaining: -1,
+		}
+		if !head.indefinite() {
+			// every item is at least one byte
+			if head.arg > uint64(len(ct.data)-ct.pos) {
+				return nil, errCBORTruncated
+			}
+			frame.remaining = int(head.arg)
+			if frame.isMap {
+				frame.remaining *= 2
+			}
+		}
+		ct.stack = append(ct.stack, frame)
+		if frame.isMap {
+			return json.Delim('{'), nil
+		}
+		return json.Delim('['), nil
+
+	default:
+		return ct.readScalar(head)
+	}
+}
+
+// readScalar reads the content of a scalar item after its head.
+func (ct *cborTokens) readScalar(head cborHead) (json.Token, error) {
+	switch head.major {
+	case cborUint:
+		return json.Number(strconv.FormatUint(head.arg, 10)), nil
+
+	case cborNegInt:
+		if head.arg > math.MaxInt64 {
+			return nil, fmt.Errorf("negative integer overflows int64")
+		}
+		return json.Number(strconv.FormatInt(-1-int64(head.arg), 10)), nil
+
+	case cborBytes, cborText:
+		if head.arg > uint64(len(ct.data)-ct.pos) {
+			return nil, errCBORTruncated
+		}
+		val := ct.data[ct.pos : ct.pos+int(head.arg)]
+		ct.pos += int(head.arg)
+		if head.major == cborBytes {
+			return append([]byte{}, val...), nil
+		}
+		if !utf8.Valid(val) {
+			return nil, errInvalidUTF8
+		}
+		return string(val), nil
+
+	case cborSimple:
+		switch head.info {
+		case cborFalse & 0x1f:
+			return false, nil
+		case cborTrue & 0x1f:
+			return true, nil
+		case cborNull & 0x1f, cborUndefined & 0x1f:
+			return nil, nil
+		}
+		val, err := cborFloat(head)
+		if err != nil {
+			return nil, err
+		}
+		bitSize := 64
+		if head.info != cborFloat64&0x1f {
+			bitSize = 32
+		}
+		return json.Number(strconv.FormatFloat(val, 'g', -1, bitSize)), nil
+
+	default:
+		return nil, fmt.Errorf("unexpected CBOR major type %d", head.major)
+	}
+}
+
+// earliest and latest RFC 3339 timestamps
+var (
+	minEpochSeconds = time.Date(1, 1, 1, 0, 0, 0, 0, time.UTC).Unix()
+	maxEpochSeconds = time.Date(9999, 12, 31, 23, 59, 59, 0, time.UTC).Unix()
+)
+
+func epochToTime(tok json.Token) (json.Token, error) {
+	num, ok := tok.(json.Number)
+	if !ok {
+		return nil, unexpectedTokenError(tok, "epoch seconds")
+	}
+	seconds, err := num.Float64()
+	if err != nil {
+		return nil, err
+	}
+	if seconds < float64(minEpochSeconds) || seconds > float64(maxEpochSeconds) {
+		return nil, fmt.Errorf("epoch time %s out of range", num)
+	}
+	whole, frac := math.Modf(seconds)
+	return time.Unix(int64(whole), int64(frac*1e9)).UTC(), nil
+}
+
+// RawValue transcodes the next item to JSON, for Any values.
+func (ct *cborTokens) RawValue() (json.RawMessage, error) {
+	ct.takeSlot()
+	return ct.appendJSON(nil, 0)
+}
+
+func (ct *cborTokens) appendJSON(out []byte, depth int) ([]byte, error) {
+	if depth > maxCBORDepth {
+		return nil, fmt.Errorf("exceeded max depth of %d", maxCBORDepth)
+	}
+
+	head, pos, err := readCBORHead(ct.data, ct.pos)
+	if err != nil {
+		return nil, err
+	}
+
+	switch head.major {
+	case cborArray, cborMap:
+		ct.pos = pos
+		isMap := head.major == cborMap
+		count := -1
+		if !head.indefinite() {
+			if head.arg > uint64(len(ct.data)-ct.pos) {
+				return nil, errCBORTruncated
+			}
+			count = int(head.arg)
+		}
+		if isMap {
+			out = append(out, '{')
+		} else {
+			out = append(out, '[')
+		}
+		for idx := 0; count < 0 || idx < count; idx++ {
+			if count < 0 {
+				if ct.pos >= len(ct.data) {
+					return nil, errCBORTruncated
+				}
+				if ct.atBreak() {
+					ct.pos++
+					break
+				}
+			}
+			if idx > 0 {
+				out = append(out, ',')
+			}
+			if isMap {
+				key, err := ct.readToken()
+				if err != nil {
+					return nil, err
+				}
+				keyStr, ok := key.(string)
+				if !ok {
+					return nil, unexpectedTokenError(key, "string (object key)")
+				}
+				out, err = appendString(out, keyStr)
+				if err != nil {
+					return nil, err
+				}
+				out = append(out, ':')
+			}
+			out, err = ct.appendJSON(out, depth+1)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if isMap {
+			return append(out, '}'), nil
+		}
+		return append(out, ']'), nil
+
+	case cborTag:
+		ct.pos = pos
+		if head.arg == cborTagEpochDateTime {
+			tok, err := ct.readToken()
+			if err != nil {
+				return nil, err
+			}
+			tok, err = epochToTime(tok)
+			if err != nil {
+				return nil, err
+			}
+			return appendString(out, tok.(time.Time).Format(time.RFC3339Nano))
+		}
+		return ct.appendJSON(out, depth+1)
+	}
+
+	tok, err := ct.readToken()
+	if err != nil {
+		return nil, err
+	}
+	switch tok := tok.(type) {
+	case nil:
+		return append(out, "null"...), nil
+	case bool:
+		return strconv.AppendBool(out, tok), nil
+	case json.Number:
+		return append(out, tok...), nil
+	case string:
+		return appendString(out, tok)
+	case []byte:
+		return appendString(out, base64.StdEncoding.EncodeToString(tok))
+	default:
+		return nil, fmt.Errorf("unexpected CBOR value %T", tok)
+	}
+}
@@ -0,0 +1,420 @@
+package codec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pentops/j5/j5types/date_j5t"
+	"github.com/pentops/j5/j5types/decimal_j5t"
+	"github.com/pentops/j5/lib/j5reflect"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func (c *Codec) encodeCBOR(msg protoreflect.Message) ([]byte, error) {
+	root, err := c.refl.NewRoot(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.encodeCBORRoot(root)
+}
+
+func (c *Codec) encodeCBORRoot(root j5reflect.Root) ([]byte, error) {
+	enc := &cborEncoder{
+		codec: c,
+	}
+
+	switch schema := root.(type) {
+	case j5reflect.Object:
+		if err := enc.encodeObject(schema); err != nil {
+			return nil, err
+		}
+	case j5reflect.Oneof:
+		if err := enc.encodeOneofBody(schema); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported root schema type %T", root)
+	}
+	return enc.b, nil
+}
+
+// cborEncoder follows the same structure as the JSON encoder, objects and
+// oneofs are CBOR maps keyed by the JSON name.
+type cborEncoder struct {
+	b     []byte
+	codec *Codec
+}
+
+func (enc *cborEncoder) encodeObject(fieldSet j5reflect.PropertySet) error {
+	fields := []j5reflect.Field{}
+	if !enc.codec.includeEmpty {
+		err := fieldSet.RangeValues(func(field j5reflect.Field) error {
+			if field.IsSet() {
+				fields = append(fields, field)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	} else {
+		err := fieldSet.RangeProperties(func(prop j5reflect.Property) error {
+			field, err := prop.Field()
+			if err != nil {
+				return err
+			}
+			fields = append(fields, field)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	enc.b = appendCBORHead(enc.b, cborMap, uint64(len(fields)))
+	for _, field := range fields {
+		enc.b = appendCBORText(enc.b, field.NameInParent())
+		if err := enc.encodeValue(field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (enc *cborEncoder) encodeOneofBody(fieldSet j5reflect.Oneof) error {
+	prop, isSet, err := fieldSet.GetOne()
+	if err != nil {
+		return err
+	}
+
+	if !isSet {
+		enc.b = appendCBORHead(enc.b, cborMap, 0)
+		return nil
+	}
+
+	enc.b = appendCBORHead(enc.b, cborMap, 2)
+	enc.b = appendCBORText(enc.b, "!type")
+	enc.b = appendCBORText(enc.b, prop.NameInParent())
+	enc.b = appendCBORText(enc.b, prop.NameInParent())
+	return enc.encodeValue(prop)
+}
+
+func (enc *cborEncoder) encodeAny(anyField j5reflect.AnyField) error {
+	val, err := anyField.GetJ5Any()
+	if err != nil {
+		return err
+	}
+
+	if val == nil {
+		enc.b = append(enc.b, cborNull)
+		return nil
+	}
+
+	if val.TypeName == "" {
+		return fmt.Errorf("any type has no TypeName set")
+	}
+
+	enc.b = appendCBORHead(enc.b, cborMap, 2)
+	enc.b = appendCBORText(enc.b, "!type")
+	enc.b = appendCBORText(enc.b, val.TypeName)
+	enc.b = appendCBORText(enc.b, "value")
+
+	if len(val.J5Json) > 0 {
+		enc.b, err = appendJSONAsCBOR(enc.b, val.J5Json)
+		if err != nil {
+			return fmt.Errorf("encoding any type %q: %w", val.TypeName, err)
+		}
+		return nil
+	}
+
+	if len(val.Proto) == 0 {
+		return fmt.Errorf("any type %q has no J5Json or Proto data", val.TypeName)
+	}
+
+	mt, err := enc.codec.resolver.FindMessageByName(protoreflect.FullName(val.TypeName))
+	if err != nil {
+		return fmt.Errorf("decoding any type %q: %w", val.TypeName, err)
+	}
+
+	dst := mt.New()
+	if err := proto.Unmarshal(val.Proto, dst.Interface()); err != nil {
+		return fmt.Errorf("decoding any type %q: %w", val.TypeName, err)
+	}
+
+	inner, err := enc.codec.refl.NewRoot(dst)
+	if err != nil {
+		return err
+	}
+
+	switch inner := inner.(type) {
+	case j5reflect.Object:
+		return enc.encodeObject(inner)
+	case j5reflect.Oneof:
+		return enc.encodeOneofBody(inner)
+	default:
+		return fmt.Errorf("unsupported root schema type %T", inner)
+	}
+}
+
+func (enc *cborEncoder) encodeValue(field j5reflect.Field) error {
+	switch ft := field.(type) {
+	case j5reflect.ObjectField:
+		if !field.IsSet() {
+			enc.b = append(enc.b, cborNull)
+			return nil
+		}
+		return enc.encodeObject(ft)
+
+	case j5reflect.OneofField:
+		if !field.IsSet() {
+			enc.b = append(enc.b, cborNull)
+			return nil
+		}
+		return enc.encodeOneofBody(ft)
+
+	case j5reflect.AnyField:
+		if !field.IsSet() {
+			enc.b = append(enc.b, cborNull)
+			return nil
+		}
+		return enc.encodeAny(ft)
+
+	case j5reflect.PolymorphField:
+		if !field.IsSet() {
+			enc.b = append(enc.b, cborNull)
+			return nil
+		}
+		wrapper, err := ft.Unwrap()
+		if err != nil {
+			return err
+		}
+		return enc.encodeAny(wrapper)
+
+	case j5reflect.EnumField:
+		if !ft.IsSet() {
+			enc.b = append(enc.b, cborNull)
+			return nil
+		}
+		val, err := ft.GetValue()
+		if err != nil {
+			return err
+		}
+		enc.b = appendCBORText(enc.b, val.Name())
+		return nil
+
+	case j5reflect.ArrayField:
+		return enc.encodeArray(ft)
+
+	case j5reflect.MapField:
+		return enc.encodeMap(ft)
+
+	case j5reflect.ScalarField:
+		return enc.encodeScalarField(ft)
+
+	default:
+		return fmt.Errorf("encode value of type %q, unsupported", field.FullTypeName())
+	}
+}
+
+// encodeMap sorts the entries by key, so that the output is deterministic.
+func (enc *cborEncoder) encodeMap(field j5reflect.MapField) error {
+	entries := map[string]j5reflect.Field{}
+	keys := []string{}
+	err := field.Range(func(key string, val j5reflect.Field) error {
+		entries[key] = val
+		keys = append(keys, key)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	sort.Strings(keys)
+
+	enc.b = appendCBORHead(enc.b, cborMap, uint64(len(keys)))
+	for _, key := range keys {
+		enc.b = appendCBORText(enc.b, key)
+		if err := enc.encodeValue(entries[key]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (enc *cborEncoder) encodeArray(array j5reflect.ArrayField) error {
+	items := []j5reflect.Field{}
+	err := array.RangeValues(func(idx int, prop j5reflect.Field) error {
+		items = append(items, prop)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	enc.b = appendCBORHead(enc.b, cborArray, uint64(len(items)))
+	for _, item := range items {
+		if err := enc.encodeValue(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (enc *cborEncoder) encodeScalarField(scalar j5reflect.ScalarField) error {
+	val, err := scalar.ToGoValue()
+	if err != nil {
+		return err
+	}
+	switch vt := val.(type) {
+	case string:
+		enc.b = appendCBORText(enc.b, vt)
+	case bool:
+		enc.b = appendCBORBool(enc.b, vt)
+	case int32:
+		enc.b = appendCBORInt(enc.b, int64(vt))
+	case int64:
+		enc.b = appendCBORInt(enc.b, vt)
+	case uint32:
+		enc.b = appendCBORHead(enc.b, cborUint, uint64(vt))
+	case uint64:
+		enc.b = appendCBORHead(enc.b, cborUint, vt)
+	case float32:
+		enc.b = appendCBORFloat32(enc.b, vt)
+	case float64:
+		enc.b = appendCBORFloat64(enc.b, vt)
+	case []byte:
+		enc.b = appendCBORBytes(enc.b, vt)
+	case *date_j5t.Date:
+		enc.b = appendCBORHead(enc.b, cborTag, cborTagFullDate)
+		enc.b = appendCBORText(enc.b, vt.DateString())
+	case *decimal_j5t.Decimal:
+		// A string, as in JSON, CBOR decimal fractions (tag 4) lose the
+		// original formatting.
+		enc.b = appendCBORText(enc.b, vt.Value)
+	case time.Time:
+		enc.b = appendCBORHead(enc.b, cborTag, cborTagDateTimeString)
+		enc.b = appendCBORText(enc.b, vt.In(time.UTC).Format(time.RFC3339Nano))
+	case nil:
+		enc.b = append(enc.b, cborNull)
+	default:
+		return fmt.Errorf("unsupported scalar type %T", vt)
+	}
+	return nil
+}
+
+// appendJSONAsCBOR transcodes the J5 JSON of an Any value, which has no schema
+// in the encoder.
+func appendJSONAsCBOR(out []byte, jsonData []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(jsonData))
+	dec.UseNumber()
+	var val jsonValue
+	if err := val.decode(dec, 0); err != nil {
+		return nil, err
+	}
+	if dec.More() {
+		return nil, fmt.Errorf("unexpected data after JSON value")
+	}
+	return val.appendCBOR(out), nil
+}
+
+// jsonValue holds a generic JSON value, keeping the order of object keys.
+type jsonValue struct {
+	token   json.Token
+	members []jsonMember // when token is '{'
+	items   []jsonValue  // when token is '['
+}
+
+type jsonMember struct {
+	key   string
+	value jsonValue
+}
+
+func (jv *jsonValue) decode(dec *json.Decoder, depth int) error {
+	if depth > maxCBORDepth {
+		return fmt.Errorf("exceeded max depth of %d", maxCBORDepth)
+	}
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	jv.token = tok
+
+	switch tok {
+	case json.Delim('{'):
+		for dec.More() {
+			keyTok, err := dec.Token()
+			if err != nil {
+				return err
+			}
+			key, ok := keyTok.(string)
+			if !ok {
+				return unexpectedTokenError(keyTok, "string (object key)")
+			}
+			member := jsonMember{key: key}
+			if err := member.value.decode(dec, depth+1); err != nil {
+				return err
+			}
+			jv.members = append(jv.members, member)
+		}
+	case json.Delim('['):
+		for dec.More() {
+			item := jsonValue{}
+			if err := item.decode(dec, depth+1); err != nil {
+				return err
+			}
+			jv.items = append(jv.items, item)
+		}
+	default:
+		return nil
+	}
+
+	_, err = dec.Token() // the closing delim
+	return err
+}
+
+func (jv jsonValue) appendCBOR(out []byte) []byte {
+	switch tok := jv.token.(type) {
+	case json.Delim:
+		if tok == '{' {
+			out = appendCBORHead(out, cborMap, uint64(len(jv.members)))
+			for _, member := range jv.members {
+				out = appendCBORText(out, member.key)
+				out = member.value.appendCBOR(out)
+			}
+			return out
+		}
+		out = appendCBORHead(out, cborArray, uint64(len(jv.items)))
+		for _, item := range jv.items {
+			out = item.appendCBOR(out)
+		}
+		return out
+
+	case string:
+		return appendCBORText(out, tok)
+
+	case bool:
+		return appendCBORBool(out, tok)
+
+	case json.Number:
+		if i64, err := strconv.ParseInt(tok.String(), 10, 64); err == nil {
+			return appendCBORInt(out, i64)
+		}
+		if u64, err := strconv.ParseUint(tok.String(), 10, 64); err == nil {
+			return appendCBORHead(out, cborUint, u64)
+		}
+		f64, err := tok.Float64()
+		if err != nil {
+			// out of range, keep the original text
+			return appendCBORText(out, tok.String())
+		}
+		return appendCBORFloat64(out, f64)
+
+	default: // nil
+		return append(out, cborNull)
+	}
+}
@@ -0,0 +1,485 @@
+package codec
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/pentops/j5/internal/gen/test/schema/v1/schema_testpb"
+	"github.com/pentops/j5/j5types/date_j5t"
+	"github.com/pentops/j5/j5types/decimal_j5t"
+	"github.com/pentops/j5/lib/j5reflect"
+)
+
+func cborTestMessages(t testing.TB) []proto.Message {
+	return []proto.Message{
+		&schema_testpb.FullSchema{},
+		&schema_testpb.FullSchema{
+			SString: "nameVal",
+			OString: proto.String(""),
+			RString: []string{"r1", "r2"},
+			SFloat:  1.1,
+			RFloat:  []float32{-3.3, 0},
+			Ts:      timestamppb.New(time.Date(2020, 1, 2, 3, 4, 5, 600, time.UTC)),
+			OBool:   proto.Bool(false),
+			SInt32:  -24,
+			SUint32: 24,
+			SInt64:  -1 << 40,
+			SUint64: 1<<64 - 1,
+			SBytes:  []byte{0, 1, 2},
+			Enum:    schema_testpb.Enum_ENUM_VALUE1,
+			REnum:   []schema_testpb.Enum{schema_testpb.Enum_ENUM_VALUE2},
+			MapStringString: map[string]string{
+				"b": "2",
+				"a": "1",
+			},
+			MapStringBar: map[string]*schema_testpb.Bar{
+				"a": {BarId: "bar"},
+			},
+			Date:     date_j5t.NewDate(2020, 1, 2),
+			Decimal:  decimal_j5t.FromString("1.25"),
+			RDecimal: []*decimal_j5t.Decimal{decimal_j5t.FromString("-0.5")},
+		},
+		&schema_testpb.FullSchema{
+			AnonOneof: &schema_testpb.FullSchema_AOneofBar{
+				AOneofBar: &schema_testpb.Bar{BarId: "barId"},
+			},
+			WrappedOneof: &schema_testpb.WrappedOneof{
+				Type: &schema_testpb.WrappedOneof_WOneofFloat{
+					WOneofFloat: 2.5,
+				},
+			},
+			SImplicitOneof: &schema_testpb.ImplicitOneof{
+				Type: &schema_testpb.ImplicitOneof_IoBaz{
+					IoBaz: &schema_testpb.Baz{BazId: "baz"},
+				},
+			},
+			J5Any: mustJ5Any(t, &schema_testpb.Bar{
+				BarId: "barId",
+			}, []byte(`{"barId":"barId","n":[1,-2.5,18446744073709551615]}`)),
+			Polymorph: &schema_testpb.PolyMessage{
+				Value: mustJ5Any(t, &schema_testpb.Baz{
+					BazId: "bazId",
+				}, nil),
+			},
+		},
+	}
+}
+
+func TestCBORRoundTrip(t *testing.T) {
+	codec := NewCodec()
+
+	for _, msg := range cborTestMessages(t) {
+		cborData, err := codec.ProtoToCBOR(msg.ProtoReflect())
+		if err != nil {
+			t.Fatalf("ProtoToCBOR: %s", err)
+		}
+
+		again, err := codec.ProtoToCBOR(msg.ProtoReflect())
+		if err != nil {
+			t.Fatalf("ProtoToCBOR: %s", err)
+		}
+		if hex.EncodeToString(cborData) != hex.EncodeToString(again) {
+			t.Fatalf("CBOR encoding is not deterministic")
+		}
+
+		decoded := msg.ProtoReflect().New().Interface()
+		if err := codec.CBORToProto(cborData, decoded.ProtoReflect()); err != nil {
+			t.Fatalf("CBORToProto: %s", err)
+		}
+
+		assertSameJSON(t, codec, msg, decoded)
+	}
+}
+
+func TestCBOREncoding(t *testing.T) {
+	codec := NewCodec()
+
+	for _, tc := range []struct {
+		name string
+		msg  proto.Message
+		want string
+	}{{
+		name: "empty",
+		msg:  &schema_testpb.FullSchema{},
+		want: "a0",
+	}, {
+		name: "scalars",
+		msg: &schema_testpb.FullSchema{
+			SString: "a",
+			SInt64:  -2,
+			SBytes:  []byte{0xff},
+		},
+		// {"sString": "a", "sInt64": -2, "sBytes": h'ff'}
+		want: "a3" + "67" + hex.EncodeToString([]byte("sString")) + "6161" +
+			"66" + hex.EncodeToString([]byte("sInt64")) + "21" +
+			"66" + hex.EncodeToString([]byte("sBytes")) + "41ff",
+	}, {
+		name: "date",
+		msg: &schema_testpb.FullSchema{
+			Date: date_j5t.NewDate(2020, 1, 2),
+		},
+		// {"date": 1004("2020-01-02")}
+		want: "a1" + "64" + hex.EncodeToString([]byte("date")) +
+			"d903ec" + "6a" + hex.EncodeToString([]byte("2020-01-02")),
+	}, {
+		name: "oneof",
+		msg: &schema_testpb.FullSchema{
+			WrappedOneof: &schema_testpb.WrappedOneof{
+				Type: &schema_testpb.WrappedOneof_WOneofString{
+					WOneofString: "s",
+				},
+			},
+		},
+		// {"wrappedOneof": {"!type": "wOneofString", "wOneofString": "s"}}
+		want: "a1" + "6c" + hex.EncodeToString([]byte("wrappedOneof")) +
+			"a2" + "65" + hex.EncodeToString([]byte("!type")) +
+			"6c" + hex.EncodeToString([]byte("wOneofString")) +
+			"6c" + hex.EncodeToString([]byte("wOneofString")) + "6173",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := codec.ProtoToCBOR(tc.msg.ProtoReflect())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if hex.EncodeToString(got) != tc.want {
+				t.Fatalf("got %x, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCBORDecode(t *testing.T) {
+	codec := NewCodec()
+
+	key := func(out []byte, name string) []byte {
+		return appendCBORText(out, name)
+	}
+
+	for _, tc := range []struct {
+		name    string
+		input   []byte
+		want    proto.Message
+		wantErr bool
+	}{{
+		name: "indefinite map and array",
+		input: func() []byte {
+			out := []byte{cborMap<<5 | cborIndefinite}
+			out = key(out, "rString")
+			out = append(out, cborArray<<5|cborIndefinite)
+			out = appendCBORText(out, "a")
+			out = append(out, cborBreak, cborBreak)
+			return out
+		}(),
+		want: &schema_testpb.FullSchema{
+			RString: []string{"a"},
+		},
+	}, {
+		name: "epoch timestamp",
+		input: func() []byte {
+			out := appendCBORHead(nil, cborMap, 1)
+			out = key(out, "ts")
+			out = appendCBORHead(out, cborTag, cborTagEpochDateTime)
+			return appendCBORFloat64(out, 1.5)
+		}(),
+		want: &schema_testpb.FullSchema{
+			Ts: &timestamppb.Timestamp{Seconds: 1, Nanos: 500000000},
+		},
+	}, {
+		name: "unsigned integer above int64",
+		input: func() []byte {
+			out := appendCBORHead(nil, cborMap, 1)
+			out = key(out, "sUint64")
+			return appendCBORHead(out, cborUint, 1<<64-1)
+		}(),
+		want: &schema_testpb.FullSchema{
+			SUint64: 1<<64 - 1,
+		},
+	}, {
+		name: "unsigned integer overflows int64 field",
+		input: func() []byte {
+			out := appendCBORHead(nil, cborMap, 1)
+			out = key(out, "sInt64")
+			return appendCBORHead(out, cborUint, 1<<63)
+		}(),
+		wantErr: true,
+	}, {
+		name: "half float",
+		input: func() []byte {
+			out := appendCBORHead(nil, cborMap, 1)
+			out = key(out, "sFloat")
+			return append(out, cborFloat16, 0x3e, 0x00) // 1.5
+		}(),
+		want: &schema_testpb.FullSchema{
+			SFloat: 1.5,
+		},
+	}, {
+		name: "int as float",
+		input: func() []byte {
+			out := appendCBORHead(nil, cborMap, 1)
+			out = key(out, "sFloat")
+			return appendCBORInt(out, 2)
+		}(),
+		want: &schema_testpb.FullSchema{
+			SFloat: 2,
+		},
+	}, {
+		name: "any",
+		input: func() []byte {
+			out := appendCBORHead(nil, cborMap, 1)
+			out = key(out, "j5any")
+			out = appendCBORHead(out, cborMap, 2)
+			out = key(out, "!type")
+			out = appendCBORText(out, "test.schema.v1.Bar")
+			out = key(out, "value")
+			out = appendCBORHead(out, cborMap, 1)
+			out = key(out, "barId")
+			return appendCBORBytes(out, []byte{1})
+		}(),
+		want: &schema_testpb.FullSchema{
+			J5Any: mustJ5Any(t, &schema_testpb.Bar{}, []byte(`{"barId":"AQ=="}`)),
+		},
+	}, {
+		name: "NaN",
+		input: func() []byte {
+			out := appendCBORHead(nil, cborMap, 1)
+			out = key(out, "sFloat")
+			return append(out, cborFloat16, 0x7e, 0x00)
+		}(),
+		wantErr: true,
+	}, {
+		name: "trailing data",
+		input: func() []byte {
+			out := appendCBORHead(nil, cborMap, 0)
+			return append(out, 0x00)
+		}(),
+		wantErr: true,
+	}, {
+		name:    "truncated",
+		input:   appendCBORHead(nil, cborMap, 2),
+		wantErr: true,
+	}, {
+		name: "invalid UTF-8",
+		input: func() []byte {
+			out := appendCBORHead(nil, cborMap, 1)
+			out = key(out, "sString")
+			return append(appendCBORHead(out, cborText, 1), 0xff)
+		}(),
+		wantErr: true,
+	}, {
+		name: "unknown oneof type only",
+		input: func() []byte {
+			out := appendCBORHead(nil, cborMap, 1)
+			out = key(out, "wrappedOneof")
+			out = appendCBORHead(out, cborMap, 1)
+			out = key(out, "!type")
+			return appendCBORText(out, "x")
+		}(),
+		wantErr: true,
+	}, {
+		name: "wrong type",
+		input: func() []byte {
+			out := appendCBORHead(nil, cborMap, 1)
+			out = key(out, "sString")
+			return appendCBORInt(out, 1)
+		}(),
+		wantErr: true,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := &schema_testpb.FullSchema{}
+			err := codec.CBORToProto(tc.input, got.ProtoReflect())
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %s", prototext.Format(got))
+				}
+				t.Log(err)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.want != nil {
+				if !proto.Equal(tc.want, got) {
+					t.Fatalf("got %s, want %s", prototext.Format(got), prototext.Format(tc.want))
+				}
+			}
+		})
+	}
+}
+
+func TestProtoBinary(t *testing.T) {
+	codec := NewCodec()
+
+	msg := &schema_testpb.FullSchema{
+		SString: "a",
+		MapStringString: map[string]string{
+			"a": "1", "b": "2", "c": "3", "d": "4",
+		},
+		SBar: &schema_testpb.Bar{BarId: "bar"},
+	}
+	msg.SBar.ProtoReflect().SetUnknown([]byte{0xa0, 0x06, 0x01}) // field 100 = 1
+
+	root := j5reflect.MustReflect(msg.ProtoReflect())
+	first, err := codec.ReflectToProtoBinary(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range 10 {
+		again, err := codec.ReflectToProtoBinary(root)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex.EncodeToString(first) != hex.EncodeToString(again) {
+			t.Fatalf("binary encoding is not deterministic")
+		}
+	}
+
+	if len(msg.SBar.ProtoReflect().GetUnknown()) == 0 {
+		t.Fatalf("encoding modified the input message")
+	}
+
+	decoded := &schema_testpb.FullSchema{
+		SInt32: 1, // replaced, not merged
+	}
+	if err := codec.ProtoBinaryToReflect(first, j5reflect.MustReflect(decoded.ProtoReflect())); err != nil {
+		t.Fatal(err)
+	}
+
+	msg.SBar.ProtoReflect().SetUnknown(nil)
+	if !proto.Equal(msg, decoded) {
+		t.Fatalf("got %s, want %s", prototext.Format(decoded), prototext.Format(msg))
+	}
+}
+
+// assertSameJSON compares the JSON encoding of the messages. Any values are
+// compared by their JSON value, where the J5Json bytes may differ in
+// formatting.
+func assertSameJSON(t testing.TB, codec *Codec, want, got proto.Message) {
+	t.Helper()
+	wantJSON, err := codec.ProtoToJSON(want.ProtoReflect())
+	if err != nil {
+		t.Fatalf("ProtoToJSON: %s", err)
+	}
+	gotJSON, err := codec.ProtoToJSON(got.ProtoReflect())
+	if err != nil {
+		t.Fatalf("ProtoToJSON: %s", err)
+	}
+
+	assertJSONValueEqual(t, wantJSON, gotJSON)
+}
+
+// assertJSONValueEqual compares decoded JSON, ignoring key order and formatting
+func assertJSONValueEqual(t testing.TB, wantJSON, gotJSON []byte) {
+	t.Helper()
+	var wantVal, gotVal any
+	if err := json.Unmarshal(wantJSON, &wantVal); err != nil {
+		t.Fatalf("invalid JSON %s: %s", wantJSON, err)
+	}
+	if err := json.Unmarshal(gotJSON, &gotVal); err != nil {
+		t.Fatalf("invalid JSON %s: %s", gotJSON, err)
+	}
+	if !reflect.DeepEqual(wantVal, gotVal) {
+		t.Fatalf("JSON mismatch\nwant: %s\ngot:  %s", wantJSON, gotJSON)
+	}
+}
+
+func FuzzCBOR(f *testing.F) {
+	codec := NewCodec()
+	for _, msg := range cborTestMessages(f) {
+		cborData, err := codec.ProtoToCBOR(msg.ProtoReflect())
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(cborData)
+	}
+
+	f.Fuzz(func(t *testing.T, input []byte) {
+		msg := &schema_testpb.FullSchema{}
+		if err := codec.CBORToProto(input, msg.ProtoReflect()); err != nil {
+			return
+		}
+
+		jsonData, err := codec.ProtoToJSON(msg.ProtoReflect())
+		if err != nil {
+			return // e.g. Any without a type
+		}
+
+		fromJSON := &schema_testpb.FullSchema{}
+		if err := codec.JSONToProto(jsonData, fromJSON.ProtoReflect()); err != nil {
+			t.Fatalf("JSONToProto(%s): %s", jsonData, err)
+		}
+
+		cborData, err := codec.ProtoToCBOR(msg.ProtoReflect())
+		if err != nil {
+			t.Fatalf("ProtoToCBOR: %s", err)
+		}
+
+		fromCBOR := &schema_testpb.FullSchema{}
+		if err := codec.CBORToProto(cborData, fromCBOR.ProtoReflect()); err != nil {
+			t.Fatalf("CBORToProto(%x): %s", cborData, err)
+		}
+
+		assertSameJSON(t, codec, fromJSON, fromCBOR)
+	})
+}
+
+func FuzzJSONToCBOR(f *testing.F) {
+	codec := NewCodec()
+	for _, msg := range cborTestMessages(f) {
+		jsonData, err := codec.ProtoToJSON(msg.ProtoReflect())
+		if err != nil {
+			f.Fatal(err)
+		}
+		f.Add(jsonData)
+	}
+
+	f.Fuzz(func(t *testing.T, input []byte) {
+		msg := &schema_testpb.FullSchema{}
+		if err := codec.JSONToProto(input, msg.ProtoReflect()); err != nil {
+			return
+		}
+		jsonData, err := codec.ProtoToJSON(msg.ProtoReflect())
+		if err != nil {
+			return
+		}
+
+		// The JSON round trip is not always exact, e.g. empty objects in maps
+		// are dropped, CBOR should match it.
+		fromJSON := &schema_testpb.FullSchema{}
+		if err := codec.JSONToProto(jsonData, fromJSON.ProtoReflect()); err != nil {
+			t.Fatalf("JSONToProto(%s): %s", jsonData, err)
+		}
+
+		cborData, err := codec.ProtoToCBOR(msg.ProtoReflect())
+		if err != nil {
+			t.Fatalf("ProtoToCBOR: %s", err)
+		}
+
+		fromCBOR := &schema_testpb.FullSchema{}
+		if err := codec.CBORToProto(cborData, fromCBOR.ProtoReflect()); err != nil {
+			t.Fatalf("CBORToProto(%x): %s", cborData, err)
+		}
+
+		assertSameJSON(t, codec, fromJSON, fromCBOR)
+
+		protoData, err := codec.ReflectToProtoBinary(j5reflect.MustReflect(msg.ProtoReflect()))
+		if err != nil {
+			t.Fatalf("ReflectToProtoBinary: %s", err)
+		}
+
+		fromBinary := &schema_testpb.FullSchema{}
+		if err := codec.ProtoBinaryToReflect(protoData, j5reflect.MustReflect(fromBinary.ProtoReflect())); err != nil {
+			t.Fatalf("ProtoBinaryToReflect: %s", err)
+		}
+
+		if !proto.Equal(msg, fromBinary) {
+			t.Fatalf("binary mismatch\nwant: %s\ngot:  %s", prototext.Format(msg), prototext.Format(fromBinary))
+		}
+	})
+}
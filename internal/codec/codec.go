@@ -2,10 +2,12 @@ package codec
 
 import (
 	"fmt"
+	"io"
 	"net/url"
 
 	"github.com/pentops/j5/j5types/any_j5t"
 	"github.com/pentops/j5/lib/j5reflect"
+	"github.com/pentops/j5/lib/j5schema"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/reflect/protoregistry"
@@ -24,6 +26,7 @@ type Codec struct {
 
 	addProtoToAny bool
 	includeEmpty  bool // include empty fields in JSON output
+	canonical     bool // deterministic JSON output, see WithCanonical
 }
 
 type CodecOption func(*Codec)
@@ -56,6 +59,14 @@ func WithIncludeEmpty() CodecOption {
 	}
 }
 
+// WithCanonical encodes JSON in a deterministic form for hashing and
+// signatures, see the README. WithIncludeEmpty has no effect.
+func WithCanonical() CodecOption {
+	return func(c *Codec) {
+		c.canonical = true
+	}
+}
+
 func NewCodec(opts ...CodecOption) *Codec {
 	cc := &Codec{
 		refl:     j5reflect.Global,
@@ -77,6 +88,23 @@ func (c *Codec) JSONToReflect(jsonData []byte, obj j5reflect.Root) error {
 	return c.decodeRoot(jsonData, obj)
 }
 
+// JSONReaderToProto decodes from the reader as it is read, rather than
+// buffering the whole document.
+func (c *Codec) JSONReaderToProto(r io.Reader, msg protoreflect.Message) error {
+	return c.decodeReader(r, msg)
+}
+
+func (c *Codec) JSONReaderToReflect(r io.Reader, obj j5reflect.Root) error {
+	return c.decodeReaderRoot(r, obj)
+}
+
+// DecodeJSONArrayStream decodes an object from the reader, passing each element
+// of the top level array field (by JSON name) to the callback rather than
+// adding it to the object. Other fields are decoded into the object as usual.
+func (c *Codec) DecodeJSONArrayStream(r io.Reader, obj j5reflect.Root, field string, callback ArrayElementFunc) error {
+	return c.decodeArrayStream(r, obj, field, callback)
+}
+
 func (c *Codec) QueryToProto(queryString url.Values, msg protoreflect.Message) error {
 	return c.decodeQuery(queryString, msg)
 }
@@ -89,6 +117,64 @@ func (c *Codec) ReflectToJSON(obj j5reflect.Root) ([]byte, error) {
 	return c.encodeRoot(obj)
 }
 
+// ProtoToJSONMasked encodes only the properties selected by the mask, see
+// j5schema.ParseFieldMask. A nil mask selects everything.
+func (c *Codec) ProtoToJSONMasked(msg protoreflect.Message, mask *j5schema.FieldMask) ([]byte, error) {
+	return c.encodeMasked(msg, mask)
+}
+
+func (c *Codec) ReflectToJSONMasked(obj j5reflect.Root, mask *j5schema.FieldMask) ([]byte, error) {
+	return c.encodeRootMasked(obj, mask)
+}
+
+// ProtoToJSONWriter encodes to the writer as it goes, rather than building the
+// whole document in memory.
+func (c *Codec) ProtoToJSONWriter(w io.Writer, msg protoreflect.Message) error {
+	return c.encodeWriter(w, msg)
+}
+
+func (c *Codec) ReflectToJSONWriter(w io.Writer, obj j5reflect.Root) error {
+	return c.encodeRootTo(w, obj)
+}
+
+// EncodeJSONArrayStream encodes the object to the writer, with the elements
+// yielded by the iterator as the value of the top level array field (by JSON
+// name). The field is written after the other fields of the object, or in its
+// sorted position WithCanonical. Any value set in the object is ignored.
+func (c *Codec) EncodeJSONArrayStream(w io.Writer, obj j5reflect.Root, field string, elements ArrayElements) error {
+	return c.encodeArrayStream(w, obj, field, elements)
+}
+
+// ProtoToCBOR encodes the message as CBOR (RFC 8949), structured in the same way
+// as the J5 JSON encoding.
+func (c *Codec) ProtoToCBOR(msg protoreflect.Message) ([]byte, error) {
+	return c.encodeCBOR(msg)
+}
+
+func (c *Codec) ReflectToCBOR(obj j5reflect.Root) ([]byte, error) {
+	return c.encodeCBORRoot(obj)
+}
+
+func (c *Codec) CBORToProto(cborData []byte, msg protoreflect.Message) error {
+	return c.decodeCBOR(cborData, msg)
+}
+
+func (c *Codec) CBORToReflect(cborData []byte, obj j5reflect.Root) error {
+	return c.decodeCBORRoot(cborData, obj)
+}
+
+// ReflectToProtoBinary encodes the message in the protobuf binary format.
+// The output is deterministic and excludes unknown fields.
+func (c *Codec) ReflectToProtoBinary(obj j5reflect.Root) ([]byte, error) {
+	return c.encodeProtoBinary(obj)
+}
+
+// ProtoBinaryToReflect replaces the message with the protobuf binary data,
+// discarding unknown fields.
+func (c *Codec) ProtoBinaryToReflect(protoData []byte, obj j5reflect.Root) error {
+	return c.decodeProtoBinary(protoData, obj)
+}
+
 func (c *Codec) EncodeAny(msg protoreflect.Message) (*any_j5t.Any, error) {
 	jsonData, err := c.ProtoToJSON(msg)
 	if err != nil {
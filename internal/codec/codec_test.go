@@ -105,6 +105,14 @@ func (dc *testSchemaWithOutput) InputJSON(jsonInput string) *testSchemaWithOutpu
 		dc.t.Errorf("JSON mismatch\n%s", diff)
 	}
 
+	if !dc.codec.includeEmpty {
+		empty, err := dc.codec.refl.NewObject(parsed.ProtoReflect().New())
+		if err != nil {
+			dc.t.Fatalf("NewObject: %s", err)
+		}
+		assertReflectJSON(dc.t, empty, parsed, encodedJSON)
+	}
+
 	return dc
 }
 
@@ -414,6 +422,11 @@ func TestUnmarshal(t *testing.T) {
 			json: `{ "mapStringString": {
 				"k1": "val1"
 			} }`,
+			queries: []url.Values{{
+				"mapStringString[k1]": []string{"val1"},
+			}, {
+				"mapStringString": []string{`{"k1": "val1"}`},
+			}},
 			// TODO: Can only test one key this way while maps are unordered
 			wantProto: &schema_testpb.FullSchema{
 				MapStringString: map[string]string{
@@ -427,6 +440,11 @@ func TestUnmarshal(t *testing.T) {
 					"k1": {"barId": "id"}
 				}
 			}`,
+			queries: []url.Values{{
+				"mapStringBar[k1].barId": []string{"id"},
+			}, {
+				"mapStringBar[k1]": []string{`{"barId": "id"}`},
+			}},
 			// TODO: Can only test one key this way while maps are unordered
 			wantProto: &schema_testpb.FullSchema{
 				MapStringBar: map[string]*schema_testpb.Bar{
@@ -469,6 +487,9 @@ func TestUnmarshal(t *testing.T) {
 				"rDate": []string{"2001-01-02", "2002-01-02"},
 			}, {
 				"rDate": []string{`["2001-01-02","2002-01-02"]`},
+			}, {
+				"rDate[0]": []string{"2001-01-02"},
+				"rDate[1]": []string{"2002-01-02"},
 			}},
 			wantProto: &schema_testpb.FullSchema{
 				RDate: []*date_j5t.Date{
@@ -512,6 +533,12 @@ func TestUnmarshal(t *testing.T) {
 				"rBars": []string{`[{"barId": "bar1"}, {"barId": "bar2"}]`},
 			}, {
 				"rBars": []string{`{"barId": "bar1"}`, `{"barId": "bar2"}`},
+			}, {
+				"rBars[0].barId": []string{"bar1"},
+				"rBars[1].barId": []string{"bar2"},
+			}, {
+				"rBars[1]":       []string{`{"barId": "bar2"}`},
+				"rBars[0].barId": []string{"bar1"},
 			}},
 			wantProto: &schema_testpb.FullSchema{
 				RBars: []*schema_testpb.Bar{{
@@ -565,6 +592,11 @@ func TestUnmarshal(t *testing.T) {
 					"wOneofString": "Wrapped oneofStringVal"
 				}
 			}`,
+			queries: []url.Values{{
+				"wrappedOneof.wOneofString": []string{"Wrapped oneofStringVal"},
+			}, {
+				"wrappedOneof": []string{`{"!type": "wOneofString", "wOneofString": "Wrapped oneofStringVal"}`},
+			}},
 			wantProto: &schema_testpb.FullSchema{
 				WrappedOneof: &schema_testpb.WrappedOneof{
 					Type: &schema_testpb.WrappedOneof_WOneofString{
@@ -667,6 +699,16 @@ func TestUnmarshal(t *testing.T) {
 				logIndent(t, "output", string(encoded))
 
 				CompareJSON(t, []byte(tc.json), encoded)
+
+				empty, err := reflector.NewObject(msg.ProtoReflect().New())
+				if err != nil {
+					t.Fatal(err)
+				}
+				obj, err := reflector.NewObject(msg.ProtoReflect())
+				if err != nil {
+					t.Fatal(err)
+				}
+				assertReflectJSON(t, empty, obj, encoded)
 			}
 
 			for _, query := range tc.queries {
@@ -695,6 +737,55 @@ func TestUnmarshal(t *testing.T) {
 	}
 }
 
+func TestDecodeOneofType(t *testing.T) {
+	codec := NewCodec()
+
+	for _, tc := range []struct {
+		name    string
+		json    string
+		want    proto.Message
+		wantErr bool
+	}{{
+		name: "type only",
+		json: `{"wrappedOneof": {"!type": "wOneofString"}}`,
+		want: &schema_testpb.FullSchema{
+			WrappedOneof: &schema_testpb.WrappedOneof{
+				Type: &schema_testpb.WrappedOneof_WOneofString{},
+			},
+		},
+	}, {
+		name:    "unknown type only",
+		json:    `{"wrappedOneof": {"!type": "x"}}`,
+		wantErr: true,
+	}, {
+		name:    "type mismatch",
+		json:    `{"wrappedOneof": {"!type": "wOneofString", "wOneofFloat": 1.5}}`,
+		wantErr: true,
+	}, {
+		name:    "type not a string",
+		json:    `{"wrappedOneof": {"!type": 1}}`,
+		wantErr: true,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := &schema_testpb.FullSchema{}
+			err := codec.JSONToProto([]byte(tc.json), got.ProtoReflect())
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got %s", prototext.Format(got))
+				}
+				t.Log(err)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !proto.Equal(tc.want, got) {
+				t.Fatalf("got %s, want %s", prototext.Format(got), prototext.Format(tc.want))
+			}
+		})
+	}
+}
+
 func logIndent(t *testing.T, label, jsonStr string) {
 	t.Helper()
 	buffer := &bytes.Buffer{}
@@ -26,9 +26,13 @@ func (c *Codec) decode(jsonData []byte, msg protoreflect.Message) error {
 func (c *Codec) decodeRoot(jsonData []byte, root j5reflect.Root) error {
 	dec := json.NewDecoder(bytes.NewReader(jsonData))
 	dec.UseNumber()
+	return c.decodeTokens(jsonTokens{dec}, root)
+}
+
+func (c *Codec) decodeTokens(tokens tokenReader, root j5reflect.Root) error {
 	d2 := &decoder{
-		jd:    dec,
-		codec: c,
+		tokens: tokens,
+		codec:  c,
 	}
 
 	switch schema := root.(type) {
@@ -41,14 +45,40 @@ func (c *Codec) decodeRoot(jsonData []byte, root j5reflect.Root) error {
 	}
 }
 
+// tokenReader reads a J5 encoding as a stream of JSON tokens. Scalar values
+// may be any type accepted by j5reflect.ScalarField.SetGoValue.
+type tokenReader interface {
+	Token() (json.Token, error)
+	More() bool
+
+	// RawValue pops the next value as compact JSON, for Any values.
+	RawValue() (json.RawMessage, error)
+}
+
+type jsonTokens struct {
+	*json.Decoder
+}
+
+func (jt jsonTokens) RawValue() (json.RawMessage, error) {
+	raw := &json.RawMessage{}
+	if err := jt.Decode(raw); err != nil {
+		return nil, err
+	}
+	buf := &bytes.Buffer{}
+	if err := json.Compact(buf, *raw); err != nil {
+		return nil, newFieldError("value", err.Error())
+	}
+	return json.RawMessage(buf.Bytes()), nil
+}
+
 // decoder is an instance for decoding a single message, not reusable.
 type decoder struct {
-	jd    *json.Decoder
-	codec *Codec
+	tokens tokenReader
+	codec  *Codec
 }
 
 func (d *decoder) Token() (json.Token, error) {
-	return d.jd.Token()
+	return d.tokens.Token()
 }
 
 func (dec *decoder) expectDelimOrNull(delim rune) (isNull bool, err error) {
@@ -80,7 +110,7 @@ func (dec *decoder) expectDelim(delim rune) error {
 }
 
 func (dec *decoder) jsonObjectBody(callback func(key string) error) error {
-	for dec.jd.More() {
+	for dec.tokens.More() {
 		keyToken, err := dec.Token()
 		if err != nil {
 			return err
@@ -99,15 +129,7 @@ func (dec *decoder) jsonObjectBody(callback func(key string) error) error {
 }
 
 func (dec *decoder) popValueAsBytes() (json.RawMessage, error) {
-	raw := &json.RawMessage{}
-	if err := dec.jd.Decode(raw); err != nil {
-		return nil, err
-	}
-	buf := &bytes.Buffer{}
-	if err := json.Compact(buf, *raw); err != nil {
-		return nil, newFieldError("value", err.Error())
-	}
-	return json.RawMessage(buf.Bytes()), nil
+	return dec.tokens.RawValue()
 }
 
 type fieldError struct {
@@ -347,7 +369,7 @@ func (dec *decoder) decodeOneofInner(oneof j5reflect.Oneof) error {
 		if err != nil {
 			return newFieldError(keyTokenStr, err.Error())
 		}
-
+		return nil
 	}
 
 	if len(foundKeys) > 1 {
@@ -663,7 +685,7 @@ func (dec *decoder) decodeArrayProperty(prop j5reflect.Property) error {
 
 	}
 
-	for dec.jd.More() {
+	for dec.tokens.More() {
 		err = dec.decodeArrayFieldValue(field)
 		if err != nil {
 			return err
@@ -1,51 +1,85 @@
 package codec
 
 import (
+	"bufio"
 	"bytes"
 	"fmt"
+	"io"
 	"strconv"
 
 	"github.com/pentops/j5/lib/j5reflect"
+	"github.com/pentops/j5/lib/j5schema"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 func (c *Codec) encode(msg protoreflect.Message) ([]byte, error) {
+	return c.encodeMasked(msg, nil)
+}
+
+func (c *Codec) encodeMasked(msg protoreflect.Message, mask *j5schema.FieldMask) ([]byte, error) {
 	root, err := c.refl.NewRoot(msg)
 	if err != nil {
 		return nil, err
 	}
 
-	return c.encodeRoot(root)
+	return c.encodeRootMasked(root, mask)
 }
 
 func (c *Codec) encodeRoot(root j5reflect.Root) ([]byte, error) {
+	return c.encodeRootMasked(root, nil)
+}
+
+func (c *Codec) encodeRootMasked(root j5reflect.Root, mask *j5schema.FieldMask) ([]byte, error) {
+	buf := &bytes.Buffer{}
 	enc := &encoder{
 		codec: c,
-		b:     &bytes.Buffer{},
+		w:     buf,
 	}
+	if err := enc.encodeRoot(root, mask); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
 
+func (c *Codec) encodeRootTo(w io.Writer, root j5reflect.Root) error {
+	bw := bufio.NewWriter(w)
+	enc := &encoder{
+		codec: c,
+		w:     bw,
+	}
+	if err := enc.encodeRoot(root, nil); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+func (enc *encoder) encodeRoot(root j5reflect.Root, mask *j5schema.FieldMask) error {
 	switch schema := root.(type) {
 	case j5reflect.Object:
-		if err := enc.encodeObject(schema); err != nil {
-			return nil, err
+		if err := enc.encodeObject(schema, mask); err != nil {
+			return err
 		}
 	case j5reflect.Oneof:
-		if err := enc.encodeOneofBody(schema); err != nil {
-			return nil, err
+		if err := enc.encodeOneofBody(schema, mask); err != nil {
+			return err
 		}
 	default:
-		return nil, fmt.Errorf("unsupported root schema type %T", root)
+		return fmt.Errorf("unsupported root schema type %T", root)
 	}
-	return enc.b.Bytes(), nil
+	return enc.err
 }
 
 type encoder struct {
-	b     *bytes.Buffer
+	w     io.Writer
+	err   error // the first error writing to w, later writes are skipped
 	codec *Codec
 }
 
 func (enc *encoder) add(b []byte) {
-	enc.b.Write(b)
+	if enc.err != nil {
+		return
+	}
+	_, enc.err = enc.w.Write(b)
 }
 
 func (enc *encoder) openObject() {
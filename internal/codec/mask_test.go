@@ -0,0 +1,87 @@
+package codec
+
+import (
+	"testing"
+
+	"github.com/pentops/j5/internal/gen/test/schema/v1/schema_testpb"
+	"github.com/pentops/j5/lib/j5schema"
+)
+
+func TestProtoToJSONMasked(t *testing.T) {
+	msg := &schema_testpb.FullSchema{
+		SString: "a",
+		SInt32:  1,
+		SBar: &schema_testpb.Bar{
+			BarId:    "id",
+			BarField: "field",
+		},
+		RBars: []*schema_testpb.Bar{
+			{BarId: "1", BarField: "f1"},
+			{BarId: "2", BarField: "f2"},
+		},
+		MapStringString: map[string]string{"k": "v"},
+		Flattened: &schema_testpb.FlattenedMessage{
+			FieldFromFlattened:   "x",
+			Field_2FromFlattened: "y",
+		},
+		WrappedOneof: &schema_testpb.WrappedOneof{
+			Type: &schema_testpb.WrappedOneof_WOneofBar{
+				WOneofBar: &schema_testpb.Bar{BarId: "w", BarField: "wf"},
+			},
+		},
+		WrappedOneofs: []*schema_testpb.WrappedOneof{{
+			Type: &schema_testpb.WrappedOneof_WOneofString{WOneofString: "s"},
+		}},
+	}
+
+	schema := j5schema.MustObjectSchema(msg.ProtoReflect().Descriptor())
+	codec := NewCodec()
+
+	for _, tc := range []struct {
+		name  string
+		paths []string
+		want  string
+	}{{
+		name:  "scalars",
+		paths: []string{"sString", "sInt32"},
+		want:  `{"sString":"a","sInt32":1}`,
+	}, {
+		name:  "nested",
+		paths: []string{"sBar.barField", "mapStringString"},
+		want:  `{"sBar":{"barField":"field"},"mapStringString":{"k":"v"}}`,
+	}, {
+		name:  "array elements",
+		paths: []string{"rBars.barId"},
+		want:  `{"rBars":[{"barId":"1"},{"barId":"2"}]}`,
+	}, {
+		name:  "flattened",
+		paths: []string{"fieldFromFlattened"},
+		want:  `{"fieldFromFlattened":"x"}`,
+	}, {
+		name:  "oneof option",
+		paths: []string{"wrappedOneof.wOneofBar.barId"},
+		want:  `{"wrappedOneof":{"!type":"wOneofBar","wOneofBar":{"barId":"w"}}}`,
+	}, {
+		name:  "oneof other option",
+		paths: []string{"wrappedOneofs.wOneofFloat"},
+		want:  `{"wrappedOneofs":[{}]}`,
+	}, {
+		name:  "unset",
+		paths: []string{"oString"},
+		want:  `{}`,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			mask, err := j5schema.ParseFieldMask(schema, tc.paths...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := codec.ProtoToJSONMasked(msg.ProtoReflect(), mask)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(got) != tc.want {
+				t.Errorf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
@@ -1,9 +1,12 @@
 package codec
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/iancoleman/strcase"
@@ -13,36 +16,78 @@ import (
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
-func propertyAtPath(root j5reflect.Root, path string) (j5reflect.Property, error) {
-	parts := strings.Split(path, ".")
-	pathParts, tail := parts[:len(parts)-1], parts[len(parts)-1]
-	tail = strcase.ToLowerCamel(tail)
-	for _, part := range pathParts {
-		part = strcase.ToLowerCamel(part)
-		prop, err := root.GetProperty(part)
-		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown property %q", part))
-		}
-		var field j5reflect.Field
-		if prop.IsSet() {
-			field, err = prop.Field()
-			if err != nil {
-				return nil, err
+// querySegment is one step of a query parameter key, either a property name
+// or the contents of brackets, an array index or map key.
+type querySegment struct {
+	name      string
+	isBracket bool
+}
+
+// parseQueryKey splits a key in the form `a.b[0].c[key]` into segments. The
+// contents of brackets are taken literally, so map keys may contain dots.
+func parseQueryKey(key string) ([]querySegment, error) {
+	segments := []querySegment{}
+	rest := key
+	expectName := true
+	for rest != "" || expectName {
+		if expectName {
+			end := strings.IndexAny(rest, ".[")
+			if end == -1 {
+				end = len(rest)
 			}
-		} else {
-			field, err = prop.Field()
-			if err != nil {
-				return nil, err
+			if end == 0 {
+				return nil, fmt.Errorf("empty property name")
 			}
+			segments = append(segments, querySegment{name: rest[:end]})
+			rest = rest[end:]
+			expectName = false
+			continue
 		}
 
-		if propSet, ok := field.AsContainer(); ok {
-			root = propSet
-			continue
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			expectName = true
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if end == -1 {
+				return nil, fmt.Errorf("unclosed '['")
+			}
+			segments = append(segments, querySegment{name: rest[1:end], isBracket: true})
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("unexpected %q after ']'", rest[0])
+		}
+	}
+	return segments, nil
+}
+
+type queryParam struct {
+	key      string
+	segments []querySegment
+	values   []string
+}
+
+// compareSegments orders keys so that array elements are visited in index
+// order, e.g. a[2] before a[10].
+func compareSegments(a, b []querySegment) int {
+	for idx := range min(len(a), len(b)) {
+		sa, sb := a[idx], b[idx]
+		if sa.isBracket && sb.isBracket {
+			ia, errA := strconv.Atoi(sa.name)
+			ib, errB := strconv.Atoi(sb.name)
+			if errA == nil && errB == nil {
+				if ia != ib {
+					return ia - ib
+				}
+				continue
+			}
+		}
+		if cmp := strings.Compare(sa.name, sb.name); cmp != 0 {
+			return cmp
 		}
-		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("property %q is not a container", part))
 	}
-	return root.GetProperty(tail)
+	return len(a) - len(b)
 }
 
 func (c *Codec) decodeQuery(queryString url.Values, msg protoreflect.Message) error {
@@ -51,106 +96,289 @@ func (c *Codec) decodeQuery(queryString url.Values, msg protoreflect.Message) er
 		return err
 	}
 
+	params := make([]queryParam, 0, len(queryString))
 	for key, values := range queryString {
-		prop, err := propertyAtPath(root, key)
+		segments, err := parseQueryKey(key)
 		if err != nil {
-			return err
+			return status.Error(codes.InvalidArgument, fmt.Sprintf("invalid query parameter %q: %s", key, err))
 		}
+		params = append(params, queryParam{
+			key:      key,
+			segments: segments,
+			values:   values,
+		})
+	}
+	sort.Slice(params, func(i, j int) bool {
+		return compareSegments(params[i].segments, params[j].segments) < 0
+	})
 
-		field, err := prop.Field()
-		if err != nil {
-			return status.Error(codes.InvalidArgument, fmt.Sprintf("invalid query parameter %q", key))
+	for _, param := range params {
+		if err := c.decodeQueryParam(root, param); err != nil {
+			return err
 		}
+	}
+
+	return nil
+}
 
-		if scalar, ok := field.AsScalar(); ok {
-			if len(values) > 1 {
-				return status.Error(codes.InvalidArgument, fmt.Sprintf("multiple values provided for non-repeated field %q", key))
+func invalidQuery(key string, format string, args ...any) error {
+	return status.Error(codes.InvalidArgument, fmt.Sprintf("query parameter %q: %s", key, fmt.Sprintf(format, args...)))
+}
+
+func (c *Codec) decodeQueryParam(root j5reflect.PropertySet, param queryParam) error {
+	key := param.key
+	var container j5reflect.PropertySet = root
+	var oneof j5reflect.Oneof   // set when the container is a oneof
+	var prop j5reflect.Property // the property of field, nil for elements
+	var field j5reflect.Field
+
+	last := len(param.segments) - 1
+	for idx, segment := range param.segments {
+		var err error
+		if !segment.isBracket {
+			if container == nil {
+				return invalidQuery(key, "%s is not an object or oneof", field.FullTypeName())
 			}
-			err = scalar.SetGoValue(values[0])
+			name := strcase.ToLowerCamel(segment.name)
+			prop, err = container.GetProperty(name)
 			if err != nil {
-				return status.Error(codes.InvalidArgument, fmt.Sprintf("invalid value %q for field %q", values[0], key))
+				return invalidQuery(key, "unknown property %q", segment.name)
+			}
+			if oneof != nil {
+				if err := checkQueryOneof(oneof, name); err != nil {
+					return invalidQuery(key, "%s", err)
+				}
+			}
+			field, err = prop.Field()
+			if err != nil {
+				return err
+			}
+		} else {
+			if idx == last {
+				return c.setQueryElement(field, segment.name, param)
+			}
+			prop = nil
+			field, err = queryElement(field, segment.name)
+			if err != nil {
+				return invalidQuery(key, "%s", err)
 			}
-			continue
 		}
 
-		if array, ok := field.AsArrayOfScalar(); ok {
-			if len(values) == 1 && strings.HasPrefix(values[0], "[") {
-				// json array
-				val := values[0]
-				items := make([]any, 0)
-				err = json.Unmarshal([]byte(val), &items)
-				if err != nil {
-					return status.Error(codes.InvalidArgument, fmt.Sprintf("invalid value %q for array field %q", val, key))
-				}
-				for _, value := range items {
-					_, err = array.AppendGoValue(value)
-					if err != nil {
-						return status.Error(codes.InvalidArgument, fmt.Sprintf("invalid value %q for array field %q", value, key))
-					}
-				}
-				continue
+		container, oneof = nil, nil
+		if idx < last {
+			if cf, ok := field.AsContainer(); ok {
+				container = cf
 			}
-			for _, value := range values {
-				_, err = array.AppendGoValue(value)
-				if err != nil {
-					return status.Error(codes.InvalidArgument, fmt.Sprintf("invalid value %q for array field %q", value, key))
+			// objects are also oneofs by their methods
+			if _, ok := field.AsObject(); !ok {
+				if of, ok := field.AsOneof(); ok {
+					oneof = of
 				}
 			}
-			continue
 		}
+	}
 
-		if array, ok := field.AsArrayOfContainer(); ok {
-			if len(values) == 1 && strings.HasPrefix(values[0], "[") {
-				// json array
-				val := values[0]
-				items := make([]json.RawMessage, 0)
-				err = json.Unmarshal([]byte(val), &items)
-				if err != nil {
-					return status.Error(codes.InvalidArgument, fmt.Sprintf("invalid value %q for array field %q", val, key))
-				}
-				for _, value := range items {
-					elem, _ := array.NewContainerElement()
+	return c.setQueryField(prop, field, param)
+}
 
-					err = c.decodeRoot([]byte(value), elem)
-					if err != nil {
-						return err
-					}
-				}
-				continue
+// checkQueryOneof rejects setting a second option of a oneof.
+func checkQueryOneof(oneof j5reflect.Oneof, name string) error {
+	option, ok, err := oneof.GetOne()
+	if err != nil {
+		return err
+	}
+	if ok && option.NameInParent() != name {
+		return fmt.Errorf("oneof %s already has %q set", oneof.SchemaName(), option.NameInParent())
+	}
+	return nil
+}
+
+// queryElement returns the container element of an array or map, creating
+// array elements in index order.
+func queryElement(field j5reflect.Field, name string) (j5reflect.Field, error) {
+	if array, ok := field.AsArrayOfContainer(); ok {
+		idx, err := queryIndex(array, name)
+		if err != nil {
+			return nil, err
+		}
+		if idx == array.Length() {
+			elem, _ := array.NewContainerElement()
+			return elem, nil
+		}
+		var elem j5reflect.Field
+		err = array.RangeContainers(func(elemIdx int, container j5reflect.ContainerField) error {
+			if elemIdx == idx {
+				elem = container
 			}
+			return nil
+		})
+		return elem, err
+	}
 
-			for _, value := range values {
-				elem, _ := array.NewContainerElement()
+	if mapField, ok := field.AsMapOfContainer(); ok {
+		elem, ok, err := mapField.GetElement(name)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return elem, nil
+		}
+		return mapField.NewContainerElement(name)
+	}
 
-				err = c.decodeRoot([]byte(value), elem)
-				if err != nil {
-					return err
-				}
-			}
-			continue
+	return nil, fmt.Errorf("%s is not an array or map of objects", field.FullTypeName())
+}
+
+// queryIndex parses an array index, which must be an existing element or the
+// next one.
+func queryIndex(array j5reflect.ArrayField, name string) (int, error) {
+	idx, err := strconv.Atoi(name)
+	if err != nil || idx < 0 || strconv.Itoa(idx) != name {
+		return 0, fmt.Errorf("invalid array index %q", name)
+	}
+	if idx > array.Length() {
+		return 0, fmt.Errorf("array index %d skips %d", idx, array.Length())
+	}
+	return idx, nil
+}
+
+// setQueryElement sets a scalar element of an array or map, or a container
+// element from JSON.
+func (c *Codec) setQueryElement(field j5reflect.Field, name string, param queryParam) error {
+	key := param.key
+	if len(param.values) > 1 {
+		return invalidQuery(key, "multiple values for a single element")
+	}
+	value := param.values[0]
+
+	if array, ok := field.AsArrayOfScalar(); ok {
+		idx, err := queryIndex(array, name)
+		if err != nil {
+			return invalidQuery(key, "%s", err)
+		}
+		if idx < array.Length() {
+			return invalidQuery(key, "element %d is already set", idx)
+		}
+		if _, err := array.AppendGoValue(value); err != nil {
+			return invalidQuery(key, "invalid value %q", value)
 		}
+		return nil
+	}
+
+	if mapField, ok := field.(j5reflect.MapOfEnumField); ok {
+		if err := mapField.SetEnum(name, value); err != nil {
+			return invalidQuery(key, "invalid value %q", value)
+		}
+		return nil
+	}
+
+	if mapField, ok := field.AsMapOfScalar(); ok {
+		if err := mapField.SetGoValue(name, value); err != nil {
+			return invalidQuery(key, "invalid value %q", value)
+		}
+		return nil
+	}
 
-		if container, ok := field.AsContainer(); ok {
-			if len(values) > 1 {
-				return status.Error(codes.InvalidArgument, fmt.Sprintf("multiple values provided for non-repeated field %q", key))
+	elem, err := queryElement(field, name)
+	if err != nil {
+		return invalidQuery(key, "%s", err)
+	}
+	return c.decodeQueryJSON(key, value, func(dec *decoder) error {
+		return dec.decodeContainerField(elem)
+	})
+}
+
+// setQueryField sets the field at the end of a key. Scalars take a single
+// value, arrays of scalars take repeated values or a JSON array, and other
+// fields take a JSON value. JSON is accepted for any container rather than a
+// designated field, see the README.
+func (c *Codec) setQueryField(prop j5reflect.Property, field j5reflect.Field, param queryParam) error {
+	key := param.key
+	values := param.values
+
+	if scalar, ok := field.AsScalar(); ok {
+		if len(values) > 1 {
+			return invalidQuery(key, "multiple values for non-repeated field")
+		}
+		if err := scalar.SetGoValue(values[0]); err != nil {
+			return invalidQuery(key, "invalid value %q", values[0])
+		}
+		return nil
+	}
+
+	if array, ok := field.AsArrayOfScalar(); ok {
+		if len(values) == 1 && strings.HasPrefix(values[0], "[") {
+			items := make([]any, 0)
+			if err := json.Unmarshal([]byte(values[0]), &items); err != nil {
+				return invalidQuery(key, "invalid JSON array %q", values[0])
+			}
+			for _, item := range items {
+				if _, err := array.AppendGoValue(item); err != nil {
+					return invalidQuery(key, "invalid value %v", item)
+				}
 			}
-			val := strings.TrimSpace(values[0])
-			if !strings.HasPrefix(val, "{") {
-				return status.Error(codes.InvalidArgument, fmt.Sprintf("invalid value %q for field %q", val, key))
+			return nil
+		}
+		for _, value := range values {
+			if _, err := array.AppendGoValue(value); err != nil {
+				return invalidQuery(key, "invalid value %q", value)
 			}
+		}
+		return nil
+	}
 
-			err = c.decodeRoot([]byte(val), container)
+	if array, ok := field.AsArrayOfContainer(); ok && !(len(values) == 1 && strings.HasPrefix(strings.TrimSpace(values[0]), "[")) {
+		// each value is a JSON element
+		for _, value := range values {
+			elem, _ := array.NewContainerElement()
+			err := c.decodeQueryJSON(key, value, func(dec *decoder) error {
+				return dec.decodeContainerField(elem)
+			})
 			if err != nil {
 				return err
 			}
-
-			continue
-
 		}
+		return nil
+	}
 
-		return status.Error(codes.InvalidArgument, fmt.Sprintf("field %q is not supported for query %s", field.FullTypeName(), field.TypeName()))
-
+	if len(values) > 1 {
+		return invalidQuery(key, "multiple values for non-repeated field")
 	}
+	value := strings.TrimSpace(values[0])
+	if !strings.HasPrefix(value, "{") && !strings.HasPrefix(value, "[") {
+		return invalidQuery(key, "expected a JSON value for %s", field.TypeName())
+	}
+	if prop == nil {
+		return invalidQuery(key, "%s is not supported in query", field.FullTypeName())
+	}
+	return c.decodeQueryJSON(key, value, func(dec *decoder) error {
+		return dec.decodeValue(prop)
+	})
+}
 
+func (c *Codec) decodeQueryJSON(key string, value string, decode func(*decoder) error) error {
+	jsonDec := json.NewDecoder(bytes.NewReader([]byte(value)))
+	jsonDec.UseNumber()
+	dec := &decoder{
+		tokens: jsonTokens{jsonDec},
+		codec:  c,
+	}
+	if err := decode(dec); err != nil {
+		return invalidQuery(key, "%s", err)
+	}
+	if jsonDec.More() {
+		return invalidQuery(key, "unexpected data after JSON value")
+	}
 	return nil
 }
+
+// decodeContainerField decodes an object or oneof element of an array or map.
+func (dec *decoder) decodeContainerField(field j5reflect.Field) error {
+	if obj, ok := field.AsObject(); ok {
+		return dec.decodeObject(obj)
+	}
+	if oneof, ok := field.AsOneof(); ok {
+		return dec.decodeOneof(oneof)
+	}
+	return fmt.Errorf("%s is not an object or oneof", field.FullTypeName())
+}
@@ -0,0 +1,82 @@
+package codec
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/pentops/j5/internal/gen/test/schema/v1/schema_testpb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseQueryKey(t *testing.T) {
+	for key, want := range map[string][]querySegment{
+		"a":        {{name: "a"}},
+		"a.b":      {{name: "a"}, {name: "b"}},
+		"a[0].b":   {{name: "a"}, {name: "0", isBracket: true}, {name: "b"}},
+		"m[k.x]":   {{name: "m"}, {name: "k.x", isBracket: true}},
+		"m[k][0]":  {{name: "m"}, {name: "k", isBracket: true}, {name: "0", isBracket: true}},
+		"m[].a_b":  {{name: "m"}, {name: "", isBracket: true}, {name: "a_b"}},
+		"a.b[1].c": {{name: "a"}, {name: "b"}, {name: "1", isBracket: true}, {name: "c"}},
+	} {
+		got, err := parseQueryKey(key)
+		if err != nil {
+			t.Errorf("%s: %s", key, err)
+			continue
+		}
+		assert.Equal(t, want, got, key)
+	}
+
+	for _, key := range []string{"", ".a", "a.", "a..b", "a[0", "a[0]b", "[0]"} {
+		if _, err := parseQueryKey(key); err == nil {
+			t.Errorf("expected error for %q", key)
+		}
+	}
+}
+
+func TestQueryIndexOrder(t *testing.T) {
+	query := url.Values{}
+	want := &schema_testpb.FullSchema{}
+	for idx := range 12 {
+		query.Set(fmt.Sprintf("rString[%d]", idx), fmt.Sprint(idx))
+		want.RString = append(want.RString, fmt.Sprint(idx))
+	}
+
+	msg := &schema_testpb.FullSchema{}
+	if err := NewCodec().QueryToProto(query, msg.ProtoReflect()); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, want.RString, msg.RString)
+}
+
+func TestQueryErrors(t *testing.T) {
+	for name, query := range map[string]url.Values{
+		"unknown property": {"nope": {"a"}},
+		"skipped index":    {"rString[1]": {"a"}},
+		"leading zero":     {"rString[00]": {"a"}},
+		"index on scalar":  {"sString[0]": {"a"}},
+		"into scalar":      {"sString.a": {"a"}},
+		"repeated scalar":  {"sString": {"a", "b"}},
+		"two options": {
+			"wrappedOneof.wOneofString": {"a"},
+			"wrappedOneof.wOneofFloat":  {"1"},
+		},
+		"mixed forms": {
+			"rString":    {"a"},
+			"rString[0]": {"b"},
+		},
+		"object not JSON": {"sBar": {"a"}},
+		"trailing JSON":   {"sBar": {`{"barId": "a"} {}`}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			msg := &schema_testpb.FullSchema{}
+			err := NewCodec().QueryToProto(query, msg.ProtoReflect())
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), err.Error())
+		})
+	}
+}
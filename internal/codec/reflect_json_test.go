@@ -0,0 +1,49 @@
+package codec
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pentops/j5/lib/j5reflect"
+)
+
+// assertReflectJSON checks that the values in j5reflect changes, as used for
+// patches and diffs, match the codec's encoding of the object. The changes
+// from an empty object add each set property as a whole.
+func assertReflectJSON(t testing.TB, empty, obj j5reflect.Root, encoded []byte) {
+	t.Helper()
+	changes, err := j5reflect.Diff(empty, obj)
+	if err != nil {
+		t.Fatalf("Diff: %s", err)
+	}
+
+	fromChanges := map[string]any{}
+	for _, change := range changes {
+		if change.Kind != j5reflect.ChangeAdded {
+			t.Fatalf("unexpected %s change at %s", change.Kind, change.JSONPath())
+		}
+		var val any
+		if err := json.Unmarshal(change.New, &val); err != nil {
+			t.Fatalf("change at %s: %s", change.JSONPath(), err)
+		}
+		fromChanges[change.JSONPath()] = val
+	}
+
+	fromCodec := map[string]any{}
+	if err := json.Unmarshal(encoded, &fromCodec); err != nil {
+		t.Fatalf("codec output: %s", err)
+	}
+
+	// json.Marshal sorts map keys
+	want, err := json.Marshal(fromCodec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := json.Marshal(fromChanges)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(want) != string(got) {
+		t.Errorf("j5reflect JSON differs from the codec\ncodec:    %s\nj5reflect: %s", want, got)
+	}
+}
@@ -0,0 +1,226 @@
+package codec
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/pentops/j5/lib/j5reflect"
+	"github.com/pentops/j5/lib/j5schema"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ArrayElementFunc receives each element of a streamed array. The element is
+// an Object or Oneof, matching the array's item schema.
+type ArrayElementFunc func(elem j5reflect.Root) error
+
+// ArrayElements yields the elements of a streamed array. Each message must
+// match the array's item schema.
+type ArrayElements func(yield func(protoreflect.Message) error) error
+
+func (c *Codec) decodeReader(r io.Reader, msg protoreflect.Message) error {
+	root, err := c.refl.NewRoot(msg)
+	if err != nil {
+		return err
+	}
+
+	return c.decodeReaderRoot(r, root)
+}
+
+func (c *Codec) decodeReaderRoot(r io.Reader, root j5reflect.Root) error {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	return c.decodeTokens(jsonTokens{dec}, root)
+}
+
+func (c *Codec) encodeWriter(w io.Writer, msg protoreflect.Message) error {
+	root, err := c.refl.NewRoot(msg)
+	if err != nil {
+		return err
+	}
+
+	return c.encodeRootTo(w, root)
+}
+
+// streamArrayProperty returns the property of a top level array field to be
+// streamed.
+func streamArrayProperty(root j5reflect.Root, field string) (j5reflect.Object, j5reflect.Property, error) {
+	obj, ok := root.(j5reflect.Object)
+	if !ok {
+		return nil, nil, fmt.Errorf("streaming requires an object root, got %T", root)
+	}
+
+	prop, err := obj.GetProperty(field)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if prop.PropertyType() != j5reflect.ArrayProperty {
+		return nil, nil, fmt.Errorf("field %q is not an array", field)
+	}
+
+	arraySchema, ok := prop.Schema().Schema.(*j5schema.ArrayField)
+	if !ok {
+		return nil, nil, fmt.Errorf("field %q is not an array", field)
+	}
+
+	switch arraySchema.ItemSchema.(type) {
+	case *j5schema.ObjectField, *j5schema.OneofField:
+		return obj, prop, nil
+	default:
+		return nil, nil, fmt.Errorf("field %q is not an array of objects or oneofs", field)
+	}
+}
+
+func (c *Codec) decodeArrayStream(r io.Reader, root j5reflect.Root, field string, callback ArrayElementFunc) error {
+	obj, _, err := streamArrayProperty(root, field)
+	if err != nil {
+		return err
+	}
+
+	jd := json.NewDecoder(r)
+	jd.UseNumber()
+	dec := &decoder{
+		tokens: jsonTokens{jd},
+		codec:  c,
+	}
+
+	if err := dec.expectDelim('{'); err != nil {
+		return err
+	}
+
+	err = dec.jsonObjectBody(func(keyTokenStr string) error {
+		prop, err := obj.GetProperty(keyTokenStr)
+		if err != nil {
+			return err
+		}
+		if keyTokenStr == field {
+			return dec.decodeArrayStream(prop, callback)
+		}
+		return dec.decodeValue(prop)
+	})
+	if err != nil {
+		return passUpError("object", err)
+	}
+
+	return dec.expectDelim('}')
+}
+
+// decodeArrayStream decodes each element into the array, then removes it after
+// the callback, so that only one element is held at a time.
+func (dec *decoder) decodeArrayStream(prop j5reflect.Property, callback ArrayElementFunc) error {
+	wasNull, err := dec.expectDelimOrNull('[')
+	if err != nil {
+		return err
+	}
+	if wasNull {
+		return nil
+	}
+
+	genField, err := prop.Field()
+	if err != nil {
+		return err
+	}
+
+	field, ok := genField.AsArray()
+	if !ok {
+		return fmt.Errorf("array property produced non-array field %T", genField)
+	}
+
+	for idx := 0; dec.tokens.More(); idx++ {
+		var elem j5reflect.Root
+		if objArray, ok := field.AsArrayOfObject(); ok {
+			obj, _ := objArray.NewObjectElement()
+			if err := dec.decodeObject(obj); err != nil {
+				return passUpError(strconv.Itoa(idx), err)
+			}
+			elem = obj
+		} else if oneofArray, ok := field.AsArrayOfOneof(); ok {
+			oneof, _, err := oneofArray.NewOneofElement()
+			if err != nil {
+				return err
+			}
+			if err := dec.decodeOneof(oneof); err != nil {
+				return passUpError(strconv.Itoa(idx), err)
+			}
+			elem = oneof
+		} else {
+			return fmt.Errorf("unknown array schema type %T", field)
+		}
+
+		if err := callback(elem); err != nil {
+			return passUpError(strconv.Itoa(idx), err)
+		}
+
+		field.Truncate(0)
+	}
+
+	return dec.expectDelim(']')
+}
+
+func (c *Codec) encodeArrayStream(w io.Writer, root j5reflect.Root, field string, elements ArrayElements) error {
+	obj, prop, err := streamArrayProperty(root, field)
+	if err != nil {
+		return err
+	}
+	itemSchema := prop.Schema().Schema.(*j5schema.ArrayField).ItemSchema
+
+	bw := bufio.NewWriter(w)
+	enc := &encoder{
+		codec: c,
+		w:     bw,
+	}
+
+	stream := &streamedField{
+		name: field,
+		encode: func() error {
+			enc.openArray()
+			idx := 0
+			err := elements(func(msg protoreflect.Message) error {
+				elem, err := c.refl.NewRoot(msg)
+				if err != nil {
+					return err
+				}
+				if itemSchema.TypeName() != elementTypeName(elem) {
+					return fmt.Errorf("element %d: expected %s, got %s", idx, itemSchema.TypeName(), elementTypeName(elem))
+				}
+
+				if idx > 0 {
+					enc.fieldSep()
+				}
+				idx++
+
+				if err := enc.encodeRoot(elem, nil); err != nil {
+					return err
+				}
+				return enc.err
+			})
+			if err != nil {
+				return err
+			}
+			enc.closeArray()
+			return nil
+		},
+	}
+
+	enc.openObject()
+	if err := enc.encodeObjectFields(obj, nil, stream); err != nil {
+		return err
+	}
+	enc.closeObject()
+
+	if enc.err != nil {
+		return enc.err
+	}
+	return bw.Flush()
+}
+
+// elementTypeName matches j5schema.FieldSchema.TypeName for the root
+func elementTypeName(elem j5reflect.Root) string {
+	if _, ok := elem.(j5reflect.Object); ok {
+		return fmt.Sprintf("object(%s)", elem.SchemaName())
+	}
+	return fmt.Sprintf("oneof(%s)", elem.SchemaName())
+}
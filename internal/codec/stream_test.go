@@ -0,0 +1,222 @@
+package codec
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/pentops/j5/internal/gen/test/schema/v1/schema_testpb"
+	"github.com/pentops/j5/lib/j5reflect"
+)
+
+func TestReaderWriter(t *testing.T) {
+	codec := NewCodec()
+
+	for _, msg := range cborTestMessages(t) {
+		want, err := codec.ProtoToJSON(msg.ProtoReflect())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		buf := &bytes.Buffer{}
+		if err := codec.ProtoToJSONWriter(buf, msg.ProtoReflect()); err != nil {
+			t.Fatal(err)
+		}
+		assertJSONValueEqual(t, want, buf.Bytes())
+
+		fromBytes := &schema_testpb.FullSchema{}
+		if err := codec.JSONToProto(want, fromBytes.ProtoReflect()); err != nil {
+			t.Fatal(err)
+		}
+		fromReader := &schema_testpb.FullSchema{}
+		if err := codec.JSONReaderToProto(bytes.NewReader(want), fromReader.ProtoReflect()); err != nil {
+			t.Fatal(err)
+		}
+		if !proto.Equal(fromBytes, fromReader) {
+			t.Fatalf("got %s, want %s", prototext.Format(fromReader), prototext.Format(fromBytes))
+		}
+	}
+}
+
+type failingWriter struct {
+	after int
+}
+
+func (fw *failingWriter) Write(b []byte) (int, error) {
+	if len(b) > fw.after {
+		return 0, errors.New("write failed")
+	}
+	fw.after -= len(b)
+	return len(b), nil
+}
+
+func TestDecodeJSONArrayStream(t *testing.T) {
+	codec := NewCodec()
+
+	t.Run("objects", func(t *testing.T) {
+		input := `{
+			"sString": "before",
+			"rBars": [{"barId": "1"}, {"barId": "2"}, {"barId": "3"}],
+			"sInt32": 5
+		}`
+
+		msg := &schema_testpb.FullSchema{}
+		root := j5reflect.MustReflect(msg.ProtoReflect())
+		got := []string{}
+		err := codec.DecodeJSONArrayStream(strings.NewReader(input), root, "rBars", func(elem j5reflect.Root) error {
+			if len(msg.RBars) != 1 {
+				t.Errorf("expected only the current element, got %d", len(msg.RBars))
+			}
+			bar := elem.ProtoReflect().Interface().(*schema_testpb.Bar)
+			got = append(got, bar.BarId)
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if strings.Join(got, ",") != "1,2,3" {
+			t.Errorf("got elements %v", got)
+		}
+		want := &schema_testpb.FullSchema{
+			SString: "before",
+			SInt32:  5,
+		}
+		if !proto.Equal(want, msg) {
+			t.Errorf("got %s, want %s", prototext.Format(msg), prototext.Format(want))
+		}
+	})
+
+	t.Run("oneofs", func(t *testing.T) {
+		input := `{"wrappedOneofs": [{"!type": "wOneofString", "wOneofString": "a"}, {"wOneofFloat": 1.5}]}`
+
+		msg := &schema_testpb.FullSchema{}
+		got := []string{}
+		err := codec.DecodeJSONArrayStream(strings.NewReader(input), j5reflect.MustReflect(msg.ProtoReflect()), "wrappedOneofs", func(elem j5reflect.Root) error {
+			got = append(got, prototext.Format(elem.ProtoReflect().Interface()))
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != 2 {
+			t.Fatalf("got elements %v", got)
+		}
+	})
+
+	t.Run("callback error", func(t *testing.T) {
+		input := `{"rBars": [{"barId": "1"}, {"barId": "2"}]}`
+
+		msg := &schema_testpb.FullSchema{}
+		err := codec.DecodeJSONArrayStream(strings.NewReader(input), j5reflect.MustReflect(msg.ProtoReflect()), "rBars", func(elem j5reflect.Root) error {
+			return errors.New("stop")
+		})
+		if err == nil || !strings.Contains(err.Error(), "stop") {
+			t.Fatalf("expected callback error, got %v", err)
+		}
+	})
+
+	for _, field := range []string{"rString", "sBar", "missing"} {
+		t.Run("invalid field "+field, func(t *testing.T) {
+			msg := &schema_testpb.FullSchema{}
+			err := codec.DecodeJSONArrayStream(strings.NewReader(`{}`), j5reflect.MustReflect(msg.ProtoReflect()), field, func(elem j5reflect.Root) error {
+				return nil
+			})
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			t.Log(err)
+		})
+	}
+}
+
+func TestEncodeJSONArrayStream(t *testing.T) {
+	codec := NewCodec()
+
+	bars := func(count int) ArrayElements {
+		return func(yield func(protoreflect.Message) error) error {
+			for idx := range count {
+				bar := &schema_testpb.Bar{BarId: fmt.Sprint(idx)}
+				if err := yield(bar.ProtoReflect()); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	t.Run("objects", func(t *testing.T) {
+		msg := &schema_testpb.FullSchema{
+			SString: "a",
+			RBars:   []*schema_testpb.Bar{{BarId: "ignored"}},
+		}
+
+		buf := &bytes.Buffer{}
+		err := codec.EncodeJSONArrayStream(buf, j5reflect.MustReflect(msg.ProtoReflect()), "rBars", bars(3))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		CompareJSON(t, []byte(`{
+			"sString": "a",
+			"rBars": [{"barId": "0"}, {"barId": "1"}, {"barId": "2"}]
+		}`), buf.Bytes())
+	})
+
+	t.Run("canonical", func(t *testing.T) {
+		msg := &schema_testpb.FullSchema{
+			SString: "a",
+			Enum:    schema_testpb.Enum_ENUM_VALUE1,
+		}
+
+		buf := &bytes.Buffer{}
+		err := NewCodec(WithCanonical()).EncodeJSONArrayStream(buf, j5reflect.MustReflect(msg.ProtoReflect()), "rBars", bars(2))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, err := NewCodec(WithCanonical()).ProtoToJSON((&schema_testpb.FullSchema{
+			SString: "a",
+			Enum:    schema_testpb.Enum_ENUM_VALUE1,
+			RBars:   []*schema_testpb.Bar{{BarId: "0"}, {BarId: "1"}},
+		}).ProtoReflect())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if buf.String() != string(want) {
+			t.Errorf("got %s, want %s", buf.String(), want)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		err := codec.EncodeJSONArrayStream(buf, j5reflect.MustReflect((&schema_testpb.FullSchema{}).ProtoReflect()), "rBars", bars(0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		CompareJSON(t, []byte(`{"rBars": []}`), buf.Bytes())
+	})
+
+	t.Run("wrong type", func(t *testing.T) {
+		err := codec.EncodeJSONArrayStream(&bytes.Buffer{}, j5reflect.MustReflect((&schema_testpb.FullSchema{}).ProtoReflect()), "rBars", func(yield func(protoreflect.Message) error) error {
+			return yield((&schema_testpb.Baz{}).ProtoReflect())
+		})
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		t.Log(err)
+	})
+
+	t.Run("write error", func(t *testing.T) {
+		err := codec.EncodeJSONArrayStream(&failingWriter{after: 100}, j5reflect.MustReflect((&schema_testpb.FullSchema{}).ProtoReflect()), "rBars", bars(10000))
+		if err == nil || err.Error() != "write failed" {
+			t.Fatalf("expected write error, got %v", err)
+		}
+	})
+}
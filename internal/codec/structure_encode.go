@@ -3,56 +3,106 @@ package codec
 import (
 	"encoding/base64"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/pentops/j5/j5types/date_j5t"
 	"github.com/pentops/j5/j5types/decimal_j5t"
 	"github.com/pentops/j5/lib/j5reflect"
+	"github.com/pentops/j5/lib/j5schema"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
-func (enc *encoder) encodeObjectBody(fieldSet j5reflect.PropertySet) error {
-	first := true
+func (enc *encoder) encodeObjectBody(fieldSet j5reflect.PropertySet, mask *j5schema.FieldMask) error {
 	enc.openObject()
 	defer enc.closeObject()
+	return enc.encodeObjectFields(fieldSet, mask, nil)
+}
 
-	doField := func(field j5reflect.Field) error {
+// streamedField is written in place of the object's field with the same JSON
+// name, see encodeArrayStream.
+type streamedField struct {
+	name   string
+	encode func() error
+}
 
-		if !field.IsSet() && !enc.codec.includeEmpty {
+// encodeObjectFields writes the fields of the object selected by the mask,
+// without the braces. When stream is set it replaces the object's field of the
+// same name, and is written last, or in its sorted position when canonical.
+func (enc *encoder) encodeObjectFields(fieldSet j5reflect.PropertySet, mask *j5schema.FieldMask, stream *streamedField) error {
+	// The canonical form depends only on the values which are set
+	includeEmpty := enc.codec.includeEmpty && !enc.codec.canonical
+
+	// names has a name for each field, and for the stream, which has no field
+	names := []string{}
+	fields := map[string]j5reflect.Field{}
+	fieldMasks := map[string]*j5schema.FieldMask{}
+	addField := func(field j5reflect.Field) error {
+		if !field.IsSet() && !includeEmpty {
 			return nil
 		}
-
-		if !first {
-			enc.fieldSep()
+		if stream != nil && field.NameInParent() == stream.name {
+			return nil
 		}
-		first = false
-		if err := enc.fieldLabel(field.NameInParent()); err != nil {
-			return err
+		fieldMask, ok := mask.Child(field.NameInParent())
+		if !ok {
+			return nil
 		}
+		names = append(names, field.NameInParent())
+		fields[field.NameInParent()] = field
+		fieldMasks[field.NameInParent()] = fieldMask
+		return nil
+	}
 
-		if err := enc.encodeValue(field); err != nil {
-			return err
-		}
+	var err error
+	if !includeEmpty {
+		err = fieldSet.RangeValues(addField)
+	} else {
+		err = fieldSet.RangeProperties(func(prop j5reflect.Property) error {
+			field, err := prop.Field()
+			if err != nil {
+				return err
+			}
+			return addField(field)
+		})
+	}
+	if err != nil {
+		return err
+	}
 
-		return nil
+	if stream != nil {
+		names = append(names, stream.name)
 	}
 
-	if !enc.codec.includeEmpty {
-		return fieldSet.RangeValues(doField)
+	if enc.codec.canonical {
+		sort.Strings(names)
 	}
 
-	return fieldSet.RangeProperties(func(prop j5reflect.Property) error {
-		field, err := prop.Field()
+	for idx, name := range names {
+		if idx > 0 {
+			enc.fieldSep()
+		}
+		if err := enc.fieldLabel(name); err != nil {
+			return err
+		}
+		field, ok := fields[name]
+		if !ok {
+			err = stream.encode()
+		} else {
+			err = enc.encodeValue(field, fieldMasks[name])
+		}
 		if err != nil {
 			return err
 		}
-		return doField(field)
-	})
+	}
 
+	return nil
 }
 
-func (enc *encoder) encodeOneofBody(fieldSet j5reflect.Oneof) error {
+// encodeOneofBody writes the oneof, or an empty object when the option which is
+// set is not selected by the mask.
+func (enc *encoder) encodeOneofBody(fieldSet j5reflect.Oneof, mask *j5schema.FieldMask) error {
 	prop, isSet, err := fieldSet.GetOne()
 	if err != nil {
 		return err
@@ -64,6 +114,11 @@ func (enc *encoder) encodeOneofBody(fieldSet j5reflect.Oneof) error {
 		return nil
 	}
 
+	optionMask, ok := mask.Child(prop.NameInParent())
+	if !ok {
+		return nil
+	}
+
 	err = enc.fieldLabel("!type")
 	if err != nil {
 		return err
@@ -81,15 +136,15 @@ func (enc *encoder) encodeOneofBody(fieldSet j5reflect.Oneof) error {
 		return err
 	}
 
-	if err := enc.encodeValue(prop); err != nil {
+	if err := enc.encodeValue(prop, optionMask); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (enc *encoder) encodeObject(object j5reflect.Object) error {
-	return enc.encodeObjectBody(object)
+func (enc *encoder) encodeObject(object j5reflect.Object, mask *j5schema.FieldMask) error {
+	return enc.encodeObjectBody(object, mask)
 }
 
 func (enc *encoder) encodePolymorph(polymorph j5reflect.PolymorphField) error {
@@ -119,6 +174,12 @@ func (enc *encoder) encodeAny(anyField j5reflect.AnyField) error {
 	var jsonData []byte
 	if len(val.J5Json) > 0 {
 		jsonData = val.J5Json
+		if enc.codec.canonical {
+			jsonData, err = enc.codec.canonicalAnyJSON(val.TypeName, val.J5Json)
+			if err != nil {
+				return err
+			}
+		}
 	} else if len(val.Proto) > 0 {
 
 		mt, err := enc.codec.resolver.FindMessageByName(protoreflect.FullName(val.TypeName))
@@ -166,7 +227,9 @@ func (enc *encoder) encodeAny(anyField j5reflect.AnyField) error {
 
 }
 
-func (enc *encoder) encodeValue(field j5reflect.Field) error {
+// encodeValue writes the field, the mask selects properties within objects and
+// oneofs, including those in arrays.
+func (enc *encoder) encodeValue(field j5reflect.Field, mask *j5schema.FieldMask) error {
 	switch ft := field.(type) {
 	case j5reflect.ObjectField:
 		if !field.IsSet() {
@@ -174,7 +237,7 @@ func (enc *encoder) encodeValue(field j5reflect.Field) error {
 			return nil
 		}
 
-		return enc.encodeObject(ft)
+		return enc.encodeObject(ft, mask)
 
 	case j5reflect.OneofField:
 		if !field.IsSet() {
@@ -182,7 +245,7 @@ func (enc *encoder) encodeValue(field j5reflect.Field) error {
 			return nil
 		}
 
-		return enc.encodeOneofBody(ft)
+		return enc.encodeOneofBody(ft, mask)
 
 	case j5reflect.AnyField:
 		if !field.IsSet() {
@@ -202,7 +265,7 @@ func (enc *encoder) encodeValue(field j5reflect.Field) error {
 		return enc.encodeEnum(ft)
 
 	case j5reflect.ArrayField:
-		return enc.encodeArray(ft)
+		return enc.encodeArray(ft, mask)
 
 	case j5reflect.MapField:
 		return enc.encodeMap(ft)
@@ -216,6 +279,10 @@ func (enc *encoder) encodeValue(field j5reflect.Field) error {
 }
 
 func (enc *encoder) encodeMap(field j5reflect.MapField) error {
+	if enc.codec.canonical {
+		return enc.encodeSortedMap(field)
+	}
+
 	enc.openObject()
 	first := true
 	defer enc.closeObject()
@@ -229,11 +296,11 @@ func (enc *encoder) encodeMap(field j5reflect.MapField) error {
 		if err != nil {
 			return err
 		}
-		return enc.encodeValue(val)
+		return enc.encodeValue(val, nil)
 	})
 }
 
-func (enc *encoder) encodeArray(array j5reflect.ArrayField) error {
+func (enc *encoder) encodeArray(array j5reflect.ArrayField, mask *j5schema.FieldMask) error {
 	enc.openArray()
 	defer enc.closeArray()
 	first := true
@@ -242,7 +309,7 @@ func (enc *encoder) encodeArray(array j5reflect.ArrayField) error {
 			enc.fieldSep()
 		}
 		first = false
-		return enc.encodeValue(prop)
+		return enc.encodeValue(prop, mask)
 	})
 }
 
@@ -283,9 +350,15 @@ func (enc *encoder) encodeScalarField(scalar j5reflect.ScalarField) error {
 		enc.addUint64(vt)
 		return nil
 	case float32:
+		if vt == 0 && enc.codec.canonical {
+			vt = 0 // -0
+		}
 		enc.addFloat(float64(vt), 32)
 		return nil
 	case float64:
+		if vt == 0 && enc.codec.canonical {
+			vt = 0 // -0
+		}
 		enc.addFloat(vt, 64)
 		return nil
 	case []byte:
@@ -296,6 +369,14 @@ func (enc *encoder) encodeScalarField(scalar j5reflect.ScalarField) error {
 		return enc.addString(vt.DateString())
 
 	case *decimal_j5t.Decimal:
+		if enc.codec.canonical {
+			sd, err := vt.ToShop()
+			if err != nil {
+				return err
+			}
+			// No trailing zeros, and no sign for zero
+			return enc.addString(sd.String())
+		}
 		return enc.addString(vt.Value)
 
 	case time.Time:
@@ -42,6 +42,16 @@ type OperationHeader struct {
 
 	GrpcServiceName string `json:"x-grpc-service"`
 	GrpcMethodName  string `json:"x-grpc-method"`
+
+	RateLimit *RateLimit `json:"x-rate-limit,omitempty"`
+}
+
+// RateLimit describes the per-caller limits the proxy applies to the
+// operation.
+type RateLimit struct {
+	RequestsPerSecond float64 `json:"requestsPerSecond,omitempty"`
+	Burst             int32   `json:"burst,omitempty"`
+	MaxConcurrent     int32   `json:"maxConcurrent,omitempty"`
 }
 
 type Operation struct {
@@ -89,6 +99,8 @@ type SwaggerParameter struct {
 	In          string  `json:"in"`
 	Description string  `json:"description,omitempty"`
 	Required    bool    `json:"required,omitempty"`
+	Style       string  `json:"style,omitempty"`
+	Explode     bool    `json:"explode,omitempty"`
 	Schema      *Schema `json:"schema"`
 }
 
@@ -171,6 +183,14 @@ func (dd *Document) addMethod(service *client_j5pb.Service, method *client_j5pb.
 		},
 	}
 
+	if rl := method.Method.RateLimit; rl != nil {
+		operation.RateLimit = &RateLimit{
+			RequestsPerSecond: rl.RequestsPerSecond,
+			Burst:             rl.Burst,
+			MaxConcurrent:     rl.MaxConcurrent,
+		}
+	}
+
 	for _, property := range method.Request.PathParameters {
 		schema, err := convertSchema(property.Schema)
 		if err != nil {
@@ -186,30 +206,11 @@ func (dd *Document) addMethod(service *client_j5pb.Service, method *client_j5pb.
 	}
 
 	for _, property := range method.Request.QueryParameters {
-		field := property.Schema
-
-		// Objects aren't handled well in query parameters by swagger/postman,
-		// so treat them as strings instead
-		_, ok := property.Schema.Type.(*schema_j5pb.Field_Object)
-		if ok {
-			field = &schema_j5pb.Field{
-				Type: &schema_j5pb.Field_String_{
-					String_: &schema_j5pb.StringField{},
-				},
-			}
-		}
-
-		schema, err := convertSchema(field)
+		param, err := queryParameter(property)
 		if err != nil {
 			return fmt.Errorf("query param %s: %w", property.Name, err)
 		}
-		operation.Parameters = append(operation.Parameters, SwaggerParameter{
-			Name:        property.Name,
-			In:          "query",
-			Description: property.Description,
-			Required:    property.Required,
-			Schema:      schema,
-		})
+		operation.Parameters = append(operation.Parameters, param)
 	}
 
 	if method.Request.Body != nil && len(method.Request.Body.Properties) != 0 {
@@ -262,6 +263,67 @@ func (dd *Document) addMethod(service *client_j5pb.Service, method *client_j5pb.
 	return nil
 }
 
+// queryParameter describes the property in the query grammar of the codec:
+// scalars are plain values, arrays of scalars repeat the parameter, and other
+// fields are a JSON value, or set by nested keys such as `name.property`,
+// `name[0].property` and `name[key]`.
+func queryParameter(property *schema_j5pb.ObjectProperty) (SwaggerParameter, error) {
+	param := SwaggerParameter{
+		Name:        property.Name,
+		In:          "query",
+		Description: property.Description,
+		Required:    property.Required,
+	}
+
+	field := property.Schema
+	var nestedKey string
+	switch ft := field.Type.(type) {
+	case *schema_j5pb.Field_Object, *schema_j5pb.Field_Oneof:
+		nestedKey = property.Name + ".property"
+	case *schema_j5pb.Field_Map:
+		nestedKey = property.Name + "[key]"
+	case *schema_j5pb.Field_Array:
+		switch ft.Array.Items.Type.(type) {
+		case *schema_j5pb.Field_Object, *schema_j5pb.Field_Oneof:
+			nestedKey = property.Name + "[0].property"
+		default:
+			param.Style = "form"
+			param.Explode = true
+		}
+	case *schema_j5pb.Field_Any, *schema_j5pb.Field_Polymorph:
+		// JSON only
+	default:
+		schema, err := convertSchema(field)
+		if err != nil {
+			return param, err
+		}
+		param.Schema = schema
+		return param, nil
+	}
+
+	if param.Style == "" {
+		// Objects aren't handled well in query parameters by swagger/postman,
+		// so treat them as JSON strings instead
+		field = &schema_j5pb.Field{
+			Type: &schema_j5pb.Field_String_{
+				String_: &schema_j5pb.StringField{},
+			},
+		}
+		hint := "JSON encoded"
+		if nestedKey != "" {
+			hint += fmt.Sprintf(", or set by nested parameters as `%s=value`", nestedKey)
+		}
+		param.Description = strings.TrimSpace(param.Description + "\n\n" + hint + ".")
+	}
+
+	schema, err := convertSchema(field)
+	if err != nil {
+		return param, err
+	}
+	param.Schema = schema
+	return param, nil
+}
+
 type PathSet []*PathItem
 
 func (ps PathSet) MarshalJSON() ([]byte, error) {
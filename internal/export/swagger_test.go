@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/pentops/j5/gen/j5/client/v1/client_j5pb"
 	"github.com/pentops/j5/gen/j5/schema/v1/schema_j5pb"
 	"github.com/tidwall/gjson"
 )
@@ -47,3 +48,114 @@ func TestPathFormat(t *testing.T) {
 		t.Fatalf("expected %q, got %q", "/foo/{bar}/baz/{qux}", formattedPath)
 	}
 }
+
+func TestRateLimitExtension(t *testing.T) {
+	dd := &Document{}
+	err := dd.addMethod(&client_j5pb.Service{Name: "FooService"}, &client_j5pb.Method{
+		Method: &schema_j5pb.Method{
+			Name:         "GetFoo",
+			FullGrpcName: "/foo.v1.FooService/GetFoo",
+			HttpMethod:   schema_j5pb.HTTPMethod_GET,
+			HttpPath:     "/foo",
+			RateLimit: &schema_j5pb.RateLimit{
+				RequestsPerSecond: 2.5,
+				MaxConcurrent:     4,
+			},
+		},
+		Request: &client_j5pb.Method_Request{},
+	}, "foo.v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	jsonVal, err := json.Marshal(dd)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rateLimit := gjson.GetBytes(jsonVal, "paths./foo.get.x-rate-limit")
+	if val := rateLimit.Get("requestsPerSecond").Float(); val != 2.5 {
+		t.Errorf("expected requestsPerSecond 2.5, got %v", val)
+	}
+	if val := rateLimit.Get("maxConcurrent").Int(); val != 4 {
+		t.Errorf("expected maxConcurrent 4, got %v", val)
+	}
+	if rateLimit.Get("burst").Exists() {
+		t.Errorf("expected burst to be omitted")
+	}
+}
+
+func TestQueryParameter(t *testing.T) {
+	stringField := &schema_j5pb.Field{
+		Type: &schema_j5pb.Field_String_{String_: &schema_j5pb.StringField{}},
+	}
+	objectField := &schema_j5pb.Field{
+		Type: &schema_j5pb.Field_Object{Object: &schema_j5pb.ObjectField{
+			Schema: &schema_j5pb.ObjectField_Ref{Ref: &schema_j5pb.Ref{Package: "foo.v1", Schema: "Bar"}},
+		}},
+	}
+
+	for _, tc := range []struct {
+		name        string
+		field       *schema_j5pb.Field
+		wantType    string
+		wantStyle   string
+		wantExplode bool
+		wantDesc    string
+	}{{
+		name:     "scalar",
+		field:    stringField,
+		wantType: "string",
+	}, {
+		name: "arrayOfScalars",
+		field: &schema_j5pb.Field{
+			Type: &schema_j5pb.Field_Array{Array: &schema_j5pb.ArrayField{Items: stringField}},
+		},
+		wantType:    "array",
+		wantStyle:   "form",
+		wantExplode: true,
+	}, {
+		name:     "object",
+		field:    objectField,
+		wantType: "string",
+		wantDesc: "JSON encoded, or set by nested parameters as `object.property=value`.",
+	}, {
+		name: "arrayOfObjects",
+		field: &schema_j5pb.Field{
+			Type: &schema_j5pb.Field_Array{Array: &schema_j5pb.ArrayField{Items: objectField}},
+		},
+		wantType: "string",
+		wantDesc: "JSON encoded, or set by nested parameters as `arrayOfObjects[0].property=value`.",
+	}, {
+		name: "map",
+		field: &schema_j5pb.Field{
+			Type: &schema_j5pb.Field_Map{Map: &schema_j5pb.MapField{ItemSchema: stringField}},
+		},
+		wantType: "string",
+		wantDesc: "JSON encoded, or set by nested parameters as `map[key]=value`.",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			param, err := queryParameter(&schema_j5pb.ObjectProperty{
+				Name:   tc.name,
+				Schema: tc.field,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			jsonVal, err := json.Marshal(param)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if val := gjson.GetBytes(jsonVal, "schema.type").String(); val != tc.wantType {
+				t.Errorf("expected type %q, got %q", tc.wantType, val)
+			}
+			if param.Style != tc.wantStyle || param.Explode != tc.wantExplode {
+				t.Errorf("expected style %q explode %v, got %q %v", tc.wantStyle, tc.wantExplode, param.Style, param.Explode)
+			}
+			if param.Description != tc.wantDesc {
+				t.Errorf("expected description %q, got %q", tc.wantDesc, param.Description)
+			}
+		})
+	}
+}
@@ -119,6 +119,8 @@ type Service struct {
 	Name        string
 	Methods     []*Method
 	defaultAuth *auth_j5pb.MethodAuthType
+
+	defaultRateLimit *schema_j5pb.RateLimit
 }
 
 func (ss *Service) ToJ5Proto() (*client_j5pb.Service, error) {
@@ -189,6 +191,7 @@ type Method struct {
 	RawRequest   bool
 	Auth         *auth_j5pb.MethodAuthType
 	MethodType   *schema_j5pb.MethodType
+	RateLimit    *schema_j5pb.RateLimit
 
 	Service *Service
 }
@@ -202,6 +205,7 @@ func (mm *Method) ToJ5Proto() (*client_j5pb.Method, error) {
 			HttpPath:     mm.HTTPPath,
 			Auth:         mm.Auth,
 			MethodType:   mm.MethodType,
+			RateLimit:    mm.RateLimit,
 		},
 	}
 	if mm.Request != nil {
@@ -220,10 +220,11 @@ func schemaDescForEntity(schema *j5schema.ObjectSchema) string {
 
 func (sb *sourceBuilder) serviceFromSource(pkg *subPackage, src *schema_j5pb.Service) (*Service, error) {
 	service := &Service{
-		Package:     pkg.Package,
-		Name:        src.Name,
-		Methods:     make([]*Method, len(src.Methods)),
-		defaultAuth: src.DefaultAuth,
+		Package:          pkg.Package,
+		Name:             src.Name,
+		Methods:          make([]*Method, len(src.Methods)),
+		defaultAuth:      src.DefaultAuth,
+		defaultRateLimit: src.DefaultRateLimit,
 	}
 
 	for idx, src := range src.Methods {
@@ -254,6 +255,12 @@ func (sb *sourceBuilder) methodFromSource(pkg *subPackage, service *Service, src
 		method.Auth = service.defaultAuth
 	}
 
+	if src.RateLimit != nil {
+		method.RateLimit = src.RateLimit
+	} else {
+		method.RateLimit = service.defaultRateLimit
+	}
+
 	if src.ResponseSchema == "HttpBody" {
 		method.RawResponse = true
 	} else {
@@ -11,6 +11,7 @@ import (
 	"github.com/pentops/j5/gen/j5/messaging/v1/messaging_j5pb"
 	"github.com/pentops/j5/internal/bcl/errpos"
 	"github.com/pentops/j5/internal/j5s/sourcewalk"
+	"github.com/pentops/j5/lib/j5schema"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/descriptorpb"
 )
@@ -19,6 +20,9 @@ type conversionVisitor struct {
 	root          *rootContext
 	file          *fileContext
 	parentContext parentContext
+
+	// instance is set while converting the object expanded from a template.
+	instance *instanceContext
 }
 
 func (ww *conversionVisitor) _clone() *conversionVisitor {
@@ -26,6 +30,7 @@ func (ww *conversionVisitor) _clone() *conversionVisitor {
 		root:          ww.root,
 		file:          ww.file,
 		parentContext: ww.parentContext,
+		instance:      ww.instance,
 	}
 }
 
@@ -37,6 +42,9 @@ func (rr *conversionVisitor) addErrorf(node sourcewalk.SourceNode, format string
 func (rr *conversionVisitor) addError(node sourcewalk.SourceNode, err error) {
 	loc := node.GetPos()
 	wrapped := errpos.AddPosition(err, loc)
+	if rr.instance != nil {
+		wrapped = rr.instance.wrapError(wrapped)
+	}
 	rr.root.errors = append(rr.root.errors, wrapped)
 }
 
@@ -97,6 +105,14 @@ func (ww *conversionVisitor) visitFileNode(file *sourcewalk.FileNode) error {
 			subWalk := ww.subPackageFile("service")
 			return subWalk.visitServiceFileNode(sn)
 		},
+		Template: func(tn *sourcewalk.TemplateNode) error {
+			// Templates are converted where they are instantiated.
+			return nil
+		},
+		Instance: func(in *sourcewalk.InstanceNode) error {
+			ww.visitInstanceNode(in)
+			return nil
+		},
 	})
 }
 
@@ -191,7 +207,15 @@ func (ww *conversionVisitor) visitObjectNode(node *sourcewalk.ObjectNode) {
 		proto.SetExtension(message.descriptor.Options, bcl_j5pb.E_Block, node.BCLBlock)
 	}
 
-	objectType := &ext_j5pb.ObjectMessageOptions{}
+	objectType := &ext_j5pb.ObjectMessageOptions{
+		Rules: node.Rules,
+	}
+
+	for _, rule := range node.Rules {
+		if _, err := j5schema.CompileObjectRule(rule); err != nil {
+			ww.addError(node.Source, err)
+		}
+	}
 
 	fqn := ww.file.fullyQualifiedName(node)
 
@@ -264,6 +288,13 @@ func (ww *conversionVisitor) visitOneofNode(node *sourcewalk.OneofNode) {
 
 	message := newMessageContext(schema.Name, ww.parentContext)
 
+	if !node.SourceAnonymous {
+		err := ww.parentContext.addLocalType(schema.Name, oneofTypeRef(node))
+		if err != nil {
+			ww.addError(node.Source, err)
+		}
+	}
+
 	message.descriptor.OneofDecl = []*descriptorpb.OneofDescriptorProto{{
 		Name: gl.Ptr("type"),
 	}}
@@ -291,13 +322,15 @@ func (ww *conversionVisitor) visitOneofNode(node *sourcewalk.OneofNode) {
 		proto.SetExtension(message.descriptor.Options, bcl_j5pb.E_Block, node.Schema.Bcl)
 	}
 
+	inMessageWalker := ww.inMessage(message)
+
 	err := node.RangeProperties(&sourcewalk.PropertyCallbacks{
-		SchemaVisitor: walkerSchemaVisitor(ww.inMessage(message)),
+		SchemaVisitor: walkerSchemaVisitor(inMessageWalker),
 		Property: func(node *sourcewalk.PropertyNode) error {
 			schema := node.Schema
 			//schema.ProtoField = []int32{node.Number}
 
-			propertyDesc, err := buildProperty(ww, node)
+			propertyDesc, err := buildProperty(inMessageWalker, node)
 			if err != nil {
 				ww.addError(node.Source, err)
 				return nil
@@ -378,6 +411,13 @@ func (ww *conversionVisitor) visitPolymorphNode(node *sourcewalk.PolymorphNode)
 }
 
 func (ww *conversionVisitor) visitEnumNode(node *sourcewalk.EnumNode) {
+	if !node.SourceAnonymous {
+		err := ww.parentContext.addLocalType(node.Schema.Name, enumTypeRef(node))
+		if err != nil {
+			ww.addError(node.Source, err)
+		}
+	}
+
 	desc := &descriptorpb.EnumDescriptorProto{
 		Name: gl.Ptr(node.Schema.Name),
 		Value: []*descriptorpb.EnumValueDescriptorProto{{
@@ -243,3 +243,39 @@ func (im importMap) expand(ref *schema_j5pb.Ref) *expandedRef {
 	}
 
 }
+
+// FileImports resolves the package names used in refs of a source file.
+type FileImports struct {
+	imports *importMap
+}
+
+func NewFileImports(file *sourcedef_j5pb.SourceFile) (*FileImports, error) {
+	imports, err := j5Imports(file)
+	if err != nil {
+		return nil, err
+	}
+	return &FileImports{imports: imports}, nil
+}
+
+// Expand returns the ref with the full package name, resolving import aliases,
+// or nil when the package is not imported.
+func (fi *FileImports) Expand(ref *schema_j5pb.Ref) *schema_j5pb.Ref {
+	expanded := fi.imports.expand(ref)
+	if expanded == nil {
+		return nil
+	}
+	return expanded.ref
+}
+
+// Aliases maps the full name of each imported package to the name used for it
+// in refs, the shortest when an import has more than one.
+func (fi *FileImports) Aliases() map[string]string {
+	aliases := map[string]string{}
+	for alias, def := range fi.imports.vals {
+		existing, ok := aliases[def.fullPath]
+		if !ok || len(alias) < len(existing) {
+			aliases[def.fullPath] = alias
+		}
+	}
+	return aliases
+}
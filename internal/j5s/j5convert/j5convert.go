@@ -23,6 +23,7 @@ func ConvertJ5File(deps TypeResolver, source *sourcedef_j5pb.SourceFile) ([]*des
 	file := newFileContext(source.Path+".proto", rr)
 	fs := newFileSet(file.fdp.GetPackage(), file)
 	root := newRootContext(fs)
+	root.errors = lintSource(source)
 
 	walker := &conversionVisitor{
 		root:          root,
@@ -0,0 +1,207 @@
+package j5convert
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/pentops/j5/gen/j5/bcl/v1/bcl_j5pb"
+	"github.com/pentops/j5/gen/j5/sourcedef/v1/sourcedef_j5pb"
+	"github.com/pentops/j5/internal/bcl/errpos"
+)
+
+// lintSource checks parts of the source which the conversion doesn't read,
+// or reads from elsewhere: the package declaration, which is derived from the
+// file path, and the statuses of transitions.
+func lintSource(file *sourcedef_j5pb.SourceFile) errpos.Errors {
+	var errs errpos.Errors
+
+	if err := lintPackage(file); err != nil {
+		errs = errs.Append(err)
+	}
+
+	elementLocs := childLocation(file.SourceLocations, "elements")
+	for idx, element := range file.Elements {
+		entity := element.GetEntity()
+		if entity == nil {
+			continue
+		}
+		entityLoc := childLocation(elementLocs, strconv.Itoa(idx), "entity")
+		for _, err := range lintTransitions(entity, entityLoc) {
+			errs = errs.Append(err)
+		}
+	}
+
+	return errs
+}
+
+func lintPackage(file *sourcedef_j5pb.SourceFile) error {
+	if file.Package == nil {
+		return nil
+	}
+	expected := PackageFromFilename(file.Path)
+	if file.Package.Name == expected {
+		return nil
+	}
+
+	err := fmt.Errorf("package %q should be %q to match the directory", file.Package.Name, expected)
+	nameLoc := childLocation(file.SourceLocations, "package", "name")
+	if nameLoc == nil {
+		return err
+	}
+	pos := locationPosition(nameLoc)
+	return errpos.AddFix(errpos.AddPosition(err, pos), &errpos.Fix{
+		Title: fmt.Sprintf("Change package to %s", expected),
+		Edits: []errpos.Edit{errpos.ReplaceEdit(pos, expected)},
+	})
+}
+
+// lintTransitions checks that the from and to values of event transitions are
+// statuses of the entity, or the 'any' and 'keep' wildcards.
+func lintTransitions(entity *sourcedef_j5pb.Entity, entityLoc *bcl_j5pb.SourceLocation) []error {
+	statuses := make([]string, 0, len(entity.Status))
+	for _, status := range entity.Status {
+		statuses = append(statuses, status.Name)
+	}
+	statusPrefix := strcase.ToScreamingSnake(entity.Name) + "_STATUS_"
+
+	var errs []error
+	check := func(value string, wildcard string, loc *bcl_j5pb.SourceLocation) {
+		if value == wildcard || value == "" {
+			return
+		}
+		for _, status := range statuses {
+			if value == status {
+				return
+			}
+		}
+
+		err := fmt.Errorf("transition status %q is not a status of entity %s", value, entity.Name)
+		if loc == nil {
+			errs = append(errs, err)
+			return
+		}
+		pos := locationPosition(loc)
+		posErr := errpos.AddPosition(err, pos)
+		suggestion, ok := closestStatus(strings.TrimPrefix(value, statusPrefix), statuses)
+		if !ok {
+			errs = append(errs, posErr)
+			return
+		}
+		errs = append(errs, errpos.AddFix(posErr, &errpos.Fix{
+			Title: fmt.Sprintf("Change to %s", suggestion),
+			Edits: []errpos.Edit{errpos.ReplaceEdit(pos, strconv.Quote(suggestion))},
+		}))
+	}
+
+	eventLocs := childLocation(entityLoc, "events")
+	for eventIdx, event := range entity.Events {
+		transitionLocs := childLocation(eventLocs, strconv.Itoa(eventIdx), "transitions")
+		for idx, transition := range event.Transitions {
+			transitionLoc := childLocation(transitionLocs, strconv.Itoa(idx))
+			check(transition.From, "any", childLocation(transitionLoc, "from"))
+			check(transition.To, "keep", childLocation(transitionLoc, "to"))
+		}
+	}
+	return errs
+}
+
+// closestStatus finds the status which the value was likely meant to be,
+// ignoring case and allowing for a typo.
+func closestStatus(value string, statuses []string) (string, bool) {
+	best := ""
+	bestDistance := 3 // more than two edits is not a typo
+	unique := false
+	for _, status := range statuses {
+		if strings.EqualFold(value, status) {
+			return status, true
+		}
+		distance := editDistance(strings.ToUpper(value), status)
+		if distance < bestDistance {
+			best = status
+			bestDistance = distance
+			unique = true
+		} else if distance == bestDistance {
+			unique = false
+		}
+	}
+	return best, unique
+}
+
+// editDistance is the Levenshtein distance between the strings.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		row := make([]int, len(b)+1)
+		row[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			row[j] = min(prev[j]+1, row[j-1]+1, prev[j-1]+cost)
+		}
+		prev = row
+	}
+	return prev[len(b)]
+}
+
+func childLocation(loc *bcl_j5pb.SourceLocation, path ...string) *bcl_j5pb.SourceLocation {
+	for _, key := range path {
+		if loc == nil {
+			return nil
+		}
+		loc = loc.Children[key]
+	}
+	return loc
+}
+
+func locationPosition(loc *bcl_j5pb.SourceLocation) errpos.Position {
+	return errpos.Position{
+		Start: errpos.Point{
+			Line:   int(loc.StartLine),
+			Column: int(loc.StartColumn),
+		},
+		End: errpos.Point{
+			Line:   int(loc.EndLine),
+			Column: int(loc.EndColumn),
+		},
+	}
+}
+
+// importFix inserts an import of the package after the last import, or after
+// the package declaration when there are none.
+func importFix(file *sourcedef_j5pb.SourceFile, pkg string) *errpos.Fix {
+	parts := strings.Split(pkg, ".")
+	if len(parts) < 2 || !reVersion.MatchString(parts[len(parts)-1]) {
+		// an alias or a package without a version, there is no way to know
+		// the package to import
+		return nil
+	}
+
+	title := fmt.Sprintf("Import %s", pkg)
+	importLocs := childLocation(file.SourceLocations, "imports")
+	if importLocs != nil && len(importLocs.Children) > 0 {
+		lastLine := int32(0)
+		for _, loc := range importLocs.Children {
+			lastLine = max(lastLine, loc.EndLine)
+		}
+		return &errpos.Fix{
+			Title: title,
+			Edits: []errpos.Edit{errpos.InsertEdit(errpos.Point{Line: int(lastLine) + 1}, fmt.Sprintf("import %s\n", pkg))},
+		}
+	}
+
+	packageLoc := childLocation(file.SourceLocations, "package")
+	if packageLoc == nil {
+		return nil
+	}
+	return &errpos.Fix{
+		Title: title,
+		Edits: []errpos.Edit{errpos.InsertEdit(errpos.Point{Line: int(packageLoc.EndLine) + 1}, fmt.Sprintf("\nimport %s\n", pkg))},
+	}
+}
@@ -0,0 +1,167 @@
+package j5convert
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pentops/j5/gen/j5/sourcedef/v1/sourcedef_j5pb"
+	"github.com/pentops/j5/internal/bcl/errpos"
+	"github.com/pentops/j5/internal/j5s/j5parse"
+	"github.com/pentops/j5/internal/j5s/protobuild/errset"
+)
+
+func TestLintFixes(t *testing.T) {
+	deps := &testDeps{
+		pkg: "test.v1",
+		types: map[string]*TypeRef{
+			"bar.v1.Bar": {
+				Package: "bar.v1",
+				Name:    "Bar",
+				File:    "bar/v1/bar.j5s.proto",
+				Object:  &ObjectRef{},
+			},
+		},
+	}
+
+	summary := func(parsed *sourcedef_j5pb.SourceFile) error {
+		_, err := SourceSummary(parsed, errset.NewCollector())
+		return err
+	}
+
+	convert := func(parsed *sourcedef_j5pb.SourceFile) error {
+		_, err := ConvertJ5File(deps, parsed)
+		return err
+	}
+
+	lint := func(parsed *sourcedef_j5pb.SourceFile) error {
+		if errs := lintSource(parsed); len(errs) > 0 {
+			return errs
+		}
+		return nil
+	}
+
+	run := func(t *testing.T, check func(*sourcedef_j5pb.SourceFile) error, input string, wantErrs []string, want string) {
+		t.Helper()
+		filename := "test/v1/foo.j5s"
+		input = strings.Join(strings.Split(input, "\n")[1:], "\n")
+		want = strings.Join(strings.Split(want, "\n")[1:], "\n")
+
+		parsed, err := j5parse.ParseFile(filename, input)
+		if err != nil {
+			t.Fatalf("parse: %v", err)
+		}
+
+		err = check(parsed)
+
+		errs, ok := errpos.AsErrors(err)
+		if !ok {
+			t.Fatalf("expected errpos errors, got %v", err)
+		}
+		if len(errs) != len(wantErrs) {
+			for _, err := range errs {
+				t.Log(err.Error())
+			}
+			t.Fatalf("expected %d errors, got %d", len(wantErrs), len(errs))
+		}
+		for idx, want := range wantErrs {
+			if !strings.Contains(errs[idx].Err.Error(), want) {
+				t.Errorf("error %d: expected %q, got %q", idx, want, errs[idx].Err.Error())
+			}
+		}
+
+		errs.AsErrorsWithSource(filename, input)
+		fixed, err := errpos.ApplyFixes(input, errs.Fixes(filename))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fixed != want {
+			t.Errorf("GOT:\n%s\nWANT:\n%s", fixed, want)
+		}
+	}
+
+	t.Run("package", func(t *testing.T) {
+		run(t, convert, `
+package test.v2
+
+object Foo {
+	field id string
+}
+`, []string{`package "test.v2" should be "test.v1"`}, `
+package test.v1
+
+object Foo {
+	field id string
+}
+`)
+	})
+
+	t.Run("import", func(t *testing.T) {
+		run(t, summary, `
+package test.v1
+
+object Foo {
+	field bar object:bar.v1.Bar
+}
+`, []string{`package "bar.v1" not imported`}, `
+package test.v1
+
+import bar.v1
+
+object Foo {
+	field bar object:bar.v1.Bar
+}
+`)
+	})
+
+	t.Run("transition", func(t *testing.T) {
+		run(t, lint, `
+package test.v1
+
+entity Foo {
+	key fooId key:id62 {
+		primary = true
+	}
+
+	status ACTIVE
+	status ARCHIVED
+
+	event Archive {
+		transition {
+			from = "active"
+			to = "ARCHIVD"
+		}
+		transition {
+			from = "any"
+			to = "GONE"
+		}
+	}
+}
+`, []string{
+			`transition status "active" is not a status`,
+			`transition status "ARCHIVD" is not a status`,
+			`transition status "GONE" is not a status`,
+		}, `
+package test.v1
+
+entity Foo {
+	key fooId key:id62 {
+		primary = true
+	}
+
+	status ACTIVE
+	status ARCHIVED
+
+	event Archive {
+		transition {
+			from = "ACTIVE"
+			to = "ARCHIVED"
+		}
+		transition {
+			from = "any"
+			to = "GONE"
+		}
+	}
+}
+`)
+	})
+}
@@ -40,6 +40,7 @@ type TypeRef struct {
 	Oneof        *OneofRef
 	Polymorph    *PolymorphRef
 	StringFormat *schema_j5pb.StringFormat
+	Template     *TemplateRef
 }
 
 func (typeRef TypeRef) protoTypeName() *string {
@@ -58,6 +59,8 @@ func (typeRef TypeRef) typeName() string {
 		return "oneof"
 	} else if typeRef.Polymorph != nil {
 		return "polymorph"
+	} else if typeRef.Template != nil {
+		return "template"
 	}
 	return "unknown"
 }
@@ -139,6 +142,9 @@ func SourceSummary(sourceFile *sourcedef_j5pb.SourceFile, ec ErrCollector) (*Fil
 			err := fmt.Errorf("package %q not imported (for schema %s)", refSrc.Package, refSrc.Schema)
 			err = errpos.AddContext(err, strings.Join(refSrc.Source.Path, "."))
 			err = errpos.AddPosition(err, refSrc.Source.GetPos())
+			if fix := importFix(sourceFile, refSrc.Package); fix != nil {
+				err = errpos.AddFix(err, fix)
+			}
 			return nil, err
 		}
 
@@ -148,6 +154,10 @@ func SourceSummary(sourceFile *sourcedef_j5pb.SourceFile, ec ErrCollector) (*Fil
 	for _, export := range cc.exports {
 		export.Package = sourceFile.Package.Name
 		export.File = importPath
+		if export.Template != nil {
+			export.Template.SourceFile = sourceFile.Path
+			export.Template.imports = importMap
+		}
 		fs.Exports[export.Name] = export
 	}
 
@@ -268,6 +278,29 @@ func (cc *summaryWalker) collectFileRefs(sourceFile *sourcedef_j5pb.SourceFile)
 			cc.includeSubFile("topic")
 			return nil
 		},
+		Template: func(node *sourcewalk.TemplateNode) error {
+			cc.addExport(&TypeRef{
+				Name:     node.Name,
+				Position: gl.Ptr(node.Source.GetPos()),
+				Template: &TemplateRef{Node: node},
+			})
+			for _, ref := range node.Refs() {
+				cc.addRef(ref)
+			}
+			return nil
+		},
+		Instance: func(node *sourcewalk.InstanceNode) error {
+			cc.addExport(&TypeRef{
+				Name:     node.Name(),
+				Position: gl.Ptr(node.Source.GetPos()),
+				Object:   &ObjectRef{},
+			})
+			cc.addRef(node.Template)
+			for _, arg := range node.Args {
+				cc.addRef(arg)
+			}
+			return nil
+		},
 		TypeStub: func(node *sourcewalk.TypeStubNode) error {
 			if node.StringFormat != nil {
 				cc.addExport(&TypeRef{
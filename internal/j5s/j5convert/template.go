@@ -0,0 +1,113 @@
+package j5convert
+
+import (
+	"fmt"
+
+	"github.com/pentops/golib/gl"
+	"github.com/pentops/j5/gen/j5/schema/v1/schema_j5pb"
+	"github.com/pentops/j5/internal/bcl/errpos"
+	"github.com/pentops/j5/internal/j5s/sourcewalk"
+)
+
+// TemplateRef is the summary of an object template. Templates don't produce
+// a message, each instance is converted to a message in the instance's file.
+type TemplateRef struct {
+	Node *sourcewalk.TemplateNode
+
+	// SourceFile is the j5s file of the template, for error positions.
+	SourceFile string
+
+	// imports of the template's file, to resolve the refs of the template
+	// when instantiated in another file.
+	imports *importMap
+}
+
+// instanceContext places errors in an expanded template at the instance, with
+// the position in the template added to the message.
+type instanceContext struct {
+	source       sourcewalk.SourceNode
+	templateName string
+
+	// templateFile is set when the template is in another file.
+	templateFile string
+}
+
+func (ic *instanceContext) wrapError(err *errpos.Err) *errpos.Err {
+	at := ""
+	if err.Pos != nil {
+		pos := *err.Pos
+		if pos.Filename == nil && ic.templateFile != "" {
+			pos.Filename = &ic.templateFile
+		}
+		at = " at " + pos.String()
+	}
+
+	wrapped := &errpos.Err{
+		Pos: gl.Ptr(ic.source.GetPos()),
+		Ctx: err.Ctx,
+		Err: fmt.Errorf("%w (in template %s%s)", err.Err, ic.templateName, at),
+	}
+	if ic.templateFile == "" {
+		// Fixes edit the template, which is only valid in the same file.
+		wrapped.Fix = err.Fix
+	}
+	return wrapped
+}
+
+func (ww *conversionVisitor) visitInstanceNode(node *sourcewalk.InstanceNode) {
+	// Not resolved with ww.resolveType, the template file is not imported.
+	typeRef, err := ww.parentContext.resolveType(node.Template.Ref)
+	if err != nil {
+		ww.addError(node.Template.Source, err)
+		return
+	}
+	template := typeRef.Template
+	if template == nil {
+		ww.addErrorf(node.Template.Source, "%s is not a template, found %s", typeRef.j5FullName(), typeRef.typeName())
+		return
+	}
+
+	argsOK := true
+	for _, arg := range node.Args {
+		if _, err := ww.parentContext.resolveType(arg.Ref); err != nil {
+			ww.addError(arg.Source, err)
+			argsOK = false
+		}
+	}
+	if !argsOK {
+		return
+	}
+
+	var mapRef func(*schema_j5pb.Ref) *schema_j5pb.Ref
+	ic := &instanceContext{
+		source:       node.Source,
+		templateName: template.Node.Name,
+	}
+	if typeRef.File != ww.root.mainFile.fdp.GetName() {
+		ic.templateFile = template.SourceFile
+		mapRef = template.qualifyRef
+	}
+
+	obj, err := template.Node.Expand(node, node.ArgRefs(), mapRef)
+	if err != nil {
+		ww.addError(node.Source, err)
+		return
+	}
+
+	walk := ww._clone()
+	walk.instance = ic
+	walk.visitObjectNode(obj)
+}
+
+// qualifyRef expands a ref in the template with the imports of the template
+// file, so that it resolves the same way from the file of the instance.
+func (tr *TemplateRef) qualifyRef(ref *schema_j5pb.Ref) *schema_j5pb.Ref {
+	if tr.imports == nil {
+		return nil
+	}
+	expanded := tr.imports.expand(ref)
+	if expanded == nil {
+		return nil
+	}
+	return expanded.ref
+}
@@ -0,0 +1,166 @@
+package j5convert
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pentops/j5/internal/bcl/errpos"
+	"github.com/pentops/j5/internal/j5s/j5parse"
+	"github.com/pentops/j5/internal/j5s/protobuild/errset"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+func convertTemplateFile(t *testing.T, input string) ([]*descriptorpb.FileDescriptorProto, error) {
+	t.Helper()
+	parsed, err := j5parse.ParseFile("test/v1/foo.j5s", strings.TrimSpace(input))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	summary, err := SourceSummary(parsed, errset.NewCollector())
+	if err != nil {
+		t.Fatalf("summary: %v", err)
+	}
+	deps := &testDeps{
+		pkg:   "test.v1",
+		types: map[string]*TypeRef{},
+	}
+	for name, export := range summary.Exports {
+		deps.types["test.v1."+name] = export
+	}
+	return ConvertJ5File(deps, parsed)
+}
+
+func TestTemplateInstance(t *testing.T) {
+	files, err := convertTemplateFile(t, `
+package test.v1
+
+template Pair {
+  | A pair
+  param Left
+  param Right
+  field left object:Left
+  field right object:Right
+}
+
+object Foo {
+  field name string
+}
+
+object Bar {
+  field name string
+}
+
+instance Pair {
+  | Foo and Bar
+  args = ["Foo", "Bar"]
+}
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var pair *descriptorpb.DescriptorProto
+	for _, msg := range files[0].MessageType {
+		if msg.GetName() == "PairFooBar" {
+			pair = msg
+		}
+		if msg.GetName() == "Pair" {
+			t.Errorf("template should not be converted to a message")
+		}
+	}
+	if pair == nil {
+		t.Fatal("expected message PairFooBar")
+	}
+	if len(pair.Field) != 2 {
+		t.Fatalf("expected 2 fields, got %d", len(pair.Field))
+	}
+	if got := pair.Field[0].GetTypeName(); got != ".test.v1.Foo" {
+		t.Errorf("left: got %s, want .test.v1.Foo", got)
+	}
+	if got := pair.Field[1].GetTypeName(); got != ".test.v1.Bar" {
+		t.Errorf("right: got %s, want .test.v1.Bar", got)
+	}
+}
+
+func TestTemplateInstanceErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		input     string
+		wantLine  int
+		wantError string
+	}{{
+		name: "error in template",
+		input: `
+package test.v1
+
+template Wrapper {
+  param Item
+  field item object:Item
+  field missing object:Missing
+}
+
+object Foo {
+  field name string
+}
+
+instance Wrapper {
+  args = ["Foo"]
+}
+`,
+		wantLine:  12,
+		wantError: "in template Wrapper at 6:",
+	}, {
+		name: "arg count",
+		input: `
+package test.v1
+
+template Wrapper {
+  param Item
+  field item object:Item
+}
+
+object Foo {
+  field name string
+}
+
+instance Wrapper {
+  args = ["Foo", "Foo"]
+}
+`,
+		wantLine:  11,
+		wantError: "takes 1 args, got 2",
+	}, {
+		name: "not a template",
+		input: `
+package test.v1
+
+object Foo {
+  field name string
+}
+
+instance Foo {
+  args = ["Foo"]
+}
+`,
+		wantLine:  6,
+		wantError: "not a template",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := convertTemplateFile(t, tc.input)
+			err = unwrapSingleError(t, err)
+			t.Log(err.Error())
+
+			pos := errpos.GetErrorPosition(err)
+			if pos == nil {
+				t.Fatalf("expected position, got %v", err)
+			}
+			if pos.Start.Line != tc.wantLine {
+				t.Errorf("got line %d, want %d", pos.Start.Line, tc.wantLine)
+			}
+			if !strings.Contains(err.Error(), tc.wantError) {
+				t.Errorf("got error %q, want %q", err.Error(), tc.wantError)
+			}
+		})
+	}
+}
@@ -0,0 +1,449 @@
+package j5import
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/pentops/j5/gen/j5/ext/v1/ext_j5pb"
+	"github.com/pentops/j5/gen/j5/list/v1/list_j5pb"
+	"github.com/pentops/j5/gen/j5/messaging/v1/messaging_j5pb"
+	"github.com/pentops/j5/gen/j5/schema/v1/schema_j5pb"
+	"github.com/pentops/j5/gen/j5/sourcedef/v1/sourcedef_j5pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// entityParts are the descriptors which j5convert generates for an entity.
+type entityParts struct {
+	name string // as in the j5s file, e.g. Foo
+
+	// the file the entity is written to, set when the first part is found
+	file *fileBuilder
+
+	keys      protoreflect.MessageDescriptor
+	data      protoreflect.MessageDescriptor
+	state     protoreflect.MessageDescriptor
+	event     protoreflect.MessageDescriptor
+	eventType protoreflect.MessageDescriptor
+	status    protoreflect.EnumDescriptor
+
+	query     protoreflect.ServiceDescriptor
+	commands  []protoreflect.ServiceDescriptor
+	publish   protoreflect.ServiceDescriptor
+	summaries []protoreflect.ServiceDescriptor
+}
+
+func (ent *entityParts) messages() []protoreflect.MessageDescriptor {
+	return []protoreflect.MessageDescriptor{ent.keys, ent.data, ent.state, ent.event, ent.eventType}
+}
+
+func (ent *entityParts) fullName(pkg string) string {
+	return pkg + "." + ent.name
+}
+
+// findEntities finds the state messages of the root package, with the
+// other messages and the services j5convert generates for the entity. All
+// parts are consumed, the entity is written in place of the first message.
+func (ii *importer) findEntities(files []protoreflect.FileDescriptor) error {
+	for _, file := range files {
+		if subPackageOrder(file) != 0 {
+			continue
+		}
+		messages := file.Messages()
+		for idx := range messages.Len() {
+			msg := messages.Get(idx)
+			root, err := ii.rootSchema(msg)
+			if err != nil {
+				return err
+			}
+			obj := root.ToJ5Root().GetObject()
+			if obj == nil || obj.Entity == nil || obj.Entity.Part != schema_j5pb.EntityPart_STATE {
+				continue
+			}
+			ent, err := ii.entityMessages(msg)
+			if err != nil {
+				return fmt.Errorf("entity state %s: %w", msg.FullName(), err)
+			}
+			ii.entities = append(ii.entities, ent)
+		}
+	}
+
+	for _, file := range files {
+		services := file.Services()
+		for idx := range services.Len() {
+			if err := ii.entityService(services.Get(idx)); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, ent := range ii.entities {
+		for _, msg := range ent.messages() {
+			ii.consumed[msg.FullName()] = true
+		}
+		ii.consumed[ent.status.FullName()] = true
+	}
+	return nil
+}
+
+func (ii *importer) entityMessages(state protoreflect.MessageDescriptor) (*entityParts, error) {
+	name := strings.TrimSuffix(string(state.Name()), "State")
+	ent := &entityParts{
+		name:  name,
+		state: state,
+	}
+
+	messages := state.ParentFile().Messages()
+	for _, part := range []struct {
+		suffix string
+		dest   *protoreflect.MessageDescriptor
+	}{
+		{"Keys", &ent.keys},
+		{"Data", &ent.data},
+		{"Event", &ent.event},
+		{"EventType", &ent.eventType},
+	} {
+		msg := messages.ByName(protoreflect.Name(name + part.suffix))
+		if msg == nil {
+			return nil, fmt.Errorf("missing message %s%s", name, part.suffix)
+		}
+		*part.dest = msg
+	}
+
+	ent.status = state.ParentFile().Enums().ByName(protoreflect.Name(name + "Status"))
+	if ent.status == nil {
+		return nil, fmt.Errorf("missing enum %sStatus", name)
+	}
+	return ent, nil
+}
+
+// entityService assigns query, command and topic services to their entity.
+func (ii *importer) entityService(service protoreflect.ServiceDescriptor) error {
+	if opts, ok := proto.GetExtension(service.Options(), ext_j5pb.E_Service).(*ext_j5pb.ServiceOptions); ok && opts != nil {
+		if query := opts.GetStateQuery(); query != nil {
+			ent := ii.entityBySnake(query.Entity)
+			if ent == nil {
+				return fmt.Errorf("query service %s: unknown entity %s", service.FullName(), query.Entity)
+			}
+			ent.query = service
+			ii.consumed[service.FullName()] = true
+		} else if command := opts.GetStateCommand(); command != nil {
+			ent := ii.entityBySnake(command.Entity)
+			if ent == nil {
+				return fmt.Errorf("command service %s: unknown entity %s", service.FullName(), command.Entity)
+			}
+			ent.commands = append(ent.commands, service)
+			ii.consumed[service.FullName()] = true
+		}
+		return nil
+	}
+
+	if config, ok := proto.GetExtension(service.Options(), messaging_j5pb.E_Service).(*messaging_j5pb.ServiceConfig); ok && config != nil {
+		var entityName string
+		if event := config.GetEvent(); event != nil {
+			entityName = event.EntityName
+		} else if upsert := config.GetUpsert(); upsert != nil {
+			entityName = upsert.EntityName
+		}
+		for _, ent := range ii.entities {
+			if ent.fullName(ii.pkgName) != entityName {
+				continue
+			}
+			if config.GetEvent() != nil && string(service.Name()) == ent.name+"PublishTopic" {
+				ent.publish = service
+				ii.consumed[service.FullName()] = true
+			} else if config.GetUpsert() != nil && strings.HasPrefix(string(service.Name()), ent.name) {
+				ent.summaries = append(ent.summaries, service)
+				ii.consumed[service.FullName()] = true
+			}
+		}
+	}
+	return nil
+}
+
+func (ii *importer) entityBySnake(name string) *entityParts {
+	for _, ent := range ii.entities {
+		if strcase.ToSnake(ent.name) == name {
+			return ent
+		}
+	}
+	return nil
+}
+
+// entityPart returns the entity which the message was generated for.
+func (ii *importer) entityPart(msg protoreflect.MessageDescriptor) *entityParts {
+	for _, ent := range ii.entities {
+		for _, part := range ent.messages() {
+			if part.FullName() == msg.FullName() {
+				return ent
+			}
+		}
+	}
+	return nil
+}
+
+// entity rebuilds the j5s entity from the generated parts. Transitions are
+// not stored in the descriptors, so the entity has none.
+func (ii *importer) entity(sc scope, ent *entityParts) (*sourcedef_j5pb.RootElement, error) {
+	entity := &sourcedef_j5pb.Entity{
+		Name: ent.name,
+	}
+
+	keys, err := ii.anonymousObject(sc, ent.keys)
+	if err != nil {
+		return nil, fmt.Errorf("keys: %w", err)
+	}
+	for _, prop := range keys {
+		key := &sourcedef_j5pb.EntityKey{
+			Def: prop,
+			Key: prop.EntityKey,
+		}
+		prop.EntityKey = nil
+		if proto.Equal(key.Key, &schema_j5pb.EntityKey{}) {
+			key.Key = nil
+		}
+		if keySchema := prop.Schema.GetKey(); keySchema != nil && proto.Equal(keySchema.ListRules, defaultKeyListRules) {
+			keySchema.ListRules = nil
+		}
+		entity.Keys = append(entity.Keys, key)
+	}
+
+	entity.Data, err = ii.anonymousObject(sc, ent.data)
+	if err != nil {
+		return nil, fmt.Errorf("data: %w", err)
+	}
+
+	status, err := ii.enum(ent.status)
+	if err != nil {
+		return nil, fmt.Errorf("status: %w", err)
+	}
+	if status.Prefix != "" {
+		return nil, fmt.Errorf("status enum %s has prefix %s", ent.status.FullName(), status.Prefix)
+	}
+	entity.Status = status.Options
+
+	if err := ii.entityEvents(sc, ent, entity); err != nil {
+		return nil, err
+	}
+
+	if err := ii.entityQuery(sc, ent, entity); err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+
+	for _, service := range ent.commands {
+		command, err := ii.entityCommand(sc, ent, entity, service)
+		if err != nil {
+			return nil, fmt.Errorf("command %s: %w", service.Name(), err)
+		}
+		entity.Commands = append(entity.Commands, command)
+	}
+
+	for _, service := range ent.summaries {
+		summary, err := ii.entitySummary(sc, ent, service)
+		if err != nil {
+			return nil, fmt.Errorf("summary %s: %w", service.Name(), err)
+		}
+		entity.Summaries = append(entity.Summaries, summary)
+	}
+
+	return &sourcedef_j5pb.RootElement{
+		Type: &sourcedef_j5pb.RootElement_Entity{Entity: entity},
+	}, nil
+}
+
+var defaultKeyListRules = &list_j5pb.KeyRules{
+	Filtering: &list_j5pb.FilteringConstraint{
+		Filterable: true,
+	},
+}
+
+func (ii *importer) entityEvents(sc scope, ent *entityParts, entity *sourcedef_j5pb.Entity) error {
+	eventScope := sc.in(ent.eventType)
+	fields := ent.eventType.Fields()
+	for idx := range fields.Len() {
+		field := fields.Get(idx)
+		msg := field.Message()
+		if msg == nil || msg.Parent().FullName() != ent.eventType.FullName() {
+			return fmt.Errorf("event field %s is not a nested message", field.FullName())
+		}
+
+		nested, err := ii.nestedSchema(eventScope, msg)
+		if err != nil {
+			return fmt.Errorf("event %s: %w", msg.Name(), err)
+		}
+		obj := nested.GetObject()
+		if obj == nil {
+			return fmt.Errorf("event %s is not an object", msg.FullName())
+		}
+		entity.Events = append(entity.Events, &sourcedef_j5pb.Event{
+			Def:     obj.Def,
+			Schemas: obj.Schemas,
+		})
+	}
+	return nil
+}
+
+func (ii *importer) entityQuery(sc scope, ent *entityParts, entity *sourcedef_j5pb.Entity) error {
+	query := &sourcedef_j5pb.EntityQuery{}
+
+	statusField := ent.state.Fields().ByName("status")
+	if statusField == nil {
+		return fmt.Errorf("state %s has no status", ent.state.FullName())
+	}
+	stateRoot, err := ii.rootSchema(ent.state)
+	if err != nil {
+		return err
+	}
+	for _, prop := range stateRoot.ToJ5Root().GetObject().Properties {
+		if prop.Name != "status" {
+			continue
+		}
+		prefix := strcase.ToScreamingSnake(ent.name) + "_STATUS_"
+		for _, filter := range prop.Schema.GetEnum().GetListRules().GetFiltering().GetDefaultFilters() {
+			query.DefaultStatusFilter = append(query.DefaultStatusFilter, strings.TrimPrefix(filter, prefix))
+		}
+	}
+
+	if ent.query != nil {
+		if opts, ok := proto.GetExtension(ent.query.Options(), ext_j5pb.E_Service).(*ext_j5pb.ServiceOptions); ok {
+			query.Auth = opts.GetDefaultAuth()
+		}
+
+		methods := ent.query.Methods()
+		get := methods.ByName(protoreflect.Name(ent.name + "Get"))
+		if get == nil {
+			return fmt.Errorf("query service %s has no Get method", ent.query.FullName())
+		}
+		query.EventsInGet = get.Output().Fields().ByName("events") != nil
+
+		if list := methods.ByName(protoreflect.Name(ent.name + "List")); list != nil {
+			query.ListRequest = listRequest(list)
+		}
+		if events := methods.ByName(protoreflect.Name(ent.name + "Events")); events != nil {
+			query.EventsListRequest = listRequest(events)
+		}
+
+		_, httpPath, err := httpRule(get)
+		if err != nil {
+			return err
+		}
+		idx := strings.LastIndex(httpPath, "/q/")
+		if idx < 0 {
+			if !strings.HasSuffix(httpPath, "/q") {
+				return fmt.Errorf("get path %s is not in a /q base", httpPath)
+			}
+			idx = len(httpPath) - 2
+		}
+		baseURLPath, err := ii.j5sPath(get.Input(), strings.TrimPrefix(httpPath[:idx], "/"))
+		if err != nil {
+			return err
+		}
+		if baseURLPath != ii.defaultBaseURLPath(ent) {
+			entity.BaseUrlPath = baseURLPath
+		}
+	}
+
+	if !proto.Equal(query, &sourcedef_j5pb.EntityQuery{}) {
+		entity.Query = query
+	}
+	return nil
+}
+
+func (ii *importer) defaultBaseURLPath(ent *entityParts) string {
+	parts := strings.Split(ii.pkgName, ".")
+	parts = append(parts, strcase.ToSnake(ent.name))
+	return strings.Join(parts, "/")
+}
+
+func (ii *importer) entityBaseURLPath(ent *entityParts, entity *sourcedef_j5pb.Entity) string {
+	if entity.BaseUrlPath != "" {
+		return entity.BaseUrlPath
+	}
+	return ii.defaultBaseURLPath(ent)
+}
+
+func (ii *importer) entityCommand(sc scope, ent *entityParts, entity *sourcedef_j5pb.Entity, service protoreflect.ServiceDescriptor) (*sourcedef_j5pb.Service, error) {
+	name, ok := strings.CutSuffix(string(service.Name()), "Service")
+	if !ok {
+		return nil, fmt.Errorf("service name does not end in Service")
+	}
+	command := &sourcedef_j5pb.Service{
+		Description: descriptorDescription(service),
+	}
+	// j5convert appends Command to the name when it is missing
+	if name = strings.TrimSuffix(name, "Command"); name != ent.name {
+		command.Name = &name
+	}
+
+	if opts, ok := proto.GetExtension(service.Options(), ext_j5pb.E_Service).(*ext_j5pb.ServiceOptions); ok && opts.GetDefaultAuth() != nil {
+		command.Options = &ext_j5pb.ServiceOptions{
+			DefaultAuth: opts.DefaultAuth,
+		}
+	}
+
+	base := "/" + ii.entityBaseURLPath(ent, entity) + "/"
+	basePath := ""
+	methods := service.Methods()
+	for idx := range methods.Len() {
+		desc := methods.Get(idx)
+		method, err := ii.method(sc, desc)
+		if err != nil {
+			return nil, fmt.Errorf("method %s: %w", desc.Name(), err)
+		}
+
+		rest, ok := strings.CutPrefix(method.HttpPath, base)
+		if !ok {
+			return nil, fmt.Errorf("method %s path %s is not under %s", desc.Name(), method.HttpPath, base)
+		}
+		methodBase, methodPath, _ := strings.Cut(rest, "/")
+		if idx == 0 {
+			basePath = methodBase
+		} else if methodBase != basePath {
+			return nil, fmt.Errorf("methods have different base paths %s and %s", basePath, methodBase)
+		}
+		method.HttpPath = methodPath
+		if methodPath != "" {
+			method.HttpPath = "/" + methodPath
+		}
+
+		if isDefaultCommandResponse(ent, method) {
+			method.Response = nil
+		}
+		command.Methods = append(command.Methods, method)
+	}
+	if basePath != "c" {
+		command.BasePath = &basePath
+	}
+	return command, nil
+}
+
+// isDefaultCommandResponse is true for the response j5convert adds to
+// commands without one, holding the new state.
+func isDefaultCommandResponse(ent *entityParts, method *sourcedef_j5pb.APIMethod) bool {
+	if method.Response == nil || method.Paged || len(method.Response.Properties) != 1 {
+		return false
+	}
+	prop := method.Response.Properties[0]
+	ref := prop.Schema.GetObject().GetRef()
+	return prop.Required &&
+		prop.Name == strcase.ToLowerCamel(ent.name) &&
+		prop.Description == "" &&
+		ref != nil && ref.Package == "" && ref.Schema == ent.name+"State"
+}
+
+func (ii *importer) entitySummary(sc scope, ent *entityParts, service protoreflect.ServiceDescriptor) (*sourcedef_j5pb.EntitySummary, error) {
+	topicName := strings.TrimSuffix(string(service.Name()), "Topic")
+	methods := service.Methods()
+	if methods.Len() != 1 {
+		return nil, fmt.Errorf("summary topic has %d methods", methods.Len())
+	}
+	fields, err := ii.topicFields(sc, methods.Get(0).Input(), "upsert")
+	if err != nil {
+		return nil, err
+	}
+	return &sourcedef_j5pb.EntitySummary{
+		Name:   strings.TrimPrefix(topicName, ent.name),
+		Fields: fields,
+	}, nil
+}
@@ -0,0 +1,452 @@
+// Package j5import converts proto files which follow the J5 rules into j5s
+// source files, the reverse of j5convert.
+package j5import
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/pentops/j5/gen/j5/schema/v1/schema_j5pb"
+	"github.com/pentops/j5/gen/j5/sourcedef/v1/sourcedef_j5pb"
+	"github.com/pentops/j5/internal/j5s/j5convert"
+	"github.com/pentops/j5/lib/j5schema"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// ImportPackage converts the files of a package, including the files of its
+// service and topic sub-packages, into j5s source files. Files are grouped by
+// base name, so foo/v1/foo.proto and foo/v1/service/foo.proto both become
+// foo/v1/foo.j5s.
+//
+// Entities are recognised by the psm options of their state message, and
+// replace the messages, services and topics which j5convert would generate
+// for them.
+func ImportPackage(pkgName string, files []protoreflect.FileDescriptor) ([]*sourcedef_j5pb.SourceFile, error) {
+	reg := &protoregistry.Files{}
+	for _, file := range files {
+		if err := reg.RegisterFile(file); err != nil {
+			return nil, fmt.Errorf("register %s: %w", file.Path(), err)
+		}
+	}
+
+	schemas, err := j5schema.SchemaSetFromFiles(reg, func(protoreflect.FileDescriptor) bool { return true })
+	if err != nil {
+		return nil, err
+	}
+
+	ii := &importer{
+		pkgName:     pkgName,
+		schemas:     schemas,
+		descriptors: map[string]protoreflect.Descriptor{},
+		consumed:    map[protoreflect.FullName]bool{},
+		files:       map[string]*fileBuilder{},
+	}
+
+	seen := map[string]bool{}
+	for _, file := range files {
+		ii.indexFile(file, seen)
+	}
+
+	sorted := make([]protoreflect.FileDescriptor, len(files))
+	copy(sorted, files)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		// root package files first, so entity parts are found before the
+		// services which use them.
+		return subPackageOrder(sorted[i]) < subPackageOrder(sorted[j])
+	})
+
+	if err := ii.findEntities(sorted); err != nil {
+		return nil, err
+	}
+
+	for _, file := range sorted {
+		if err := ii.importFile(file); err != nil {
+			return nil, fmt.Errorf("%s: %w", file.Path(), err)
+		}
+	}
+
+	out := make([]*sourcedef_j5pb.SourceFile, 0, len(ii.fileOrder))
+	for _, name := range ii.fileOrder {
+		out = append(out, ii.files[name].build())
+	}
+	return out, nil
+}
+
+type importer struct {
+	pkgName string
+	schemas *j5schema.SchemaSet
+
+	// descriptors by the name j5schema uses, package.Parent_Nested
+	descriptors map[string]protoreflect.Descriptor
+
+	// messages and services which were converted as part of another element
+	consumed map[protoreflect.FullName]bool
+
+	entities []*entityParts
+
+	files     map[string]*fileBuilder
+	fileOrder []string
+}
+
+func subPackageOrder(file protoreflect.FileDescriptor) int {
+	_, sub, _ := j5convert.SplitPackageFromFilename(file.Path())
+	switch sub {
+	case "":
+		return 0
+	case "service":
+		return 1
+	default:
+		return 2
+	}
+}
+
+// indexFile records the messages and enums of the file and all of its
+// imports by their j5schema name, to map refs back to proto names.
+func (ii *importer) indexFile(file protoreflect.FileDescriptor, seen map[string]bool) {
+	if seen[file.Path()] {
+		return
+	}
+	seen[file.Path()] = true
+
+	var walkMessages func(messages protoreflect.MessageDescriptors, enums protoreflect.EnumDescriptors)
+	walkMessages = func(messages protoreflect.MessageDescriptors, enums protoreflect.EnumDescriptors) {
+		for idx := range enums.Len() {
+			ii.addDescriptor(enums.Get(idx))
+		}
+		for idx := range messages.Len() {
+			msg := messages.Get(idx)
+			ii.addDescriptor(msg)
+			walkMessages(msg.Messages(), msg.Enums())
+		}
+	}
+	walkMessages(file.Messages(), file.Enums())
+
+	imports := file.Imports()
+	for idx := range imports.Len() {
+		ii.indexFile(imports.Get(idx).FileDescriptor, seen)
+	}
+}
+
+func (ii *importer) addDescriptor(desc protoreflect.Descriptor) {
+	pkg, name := schemaName(desc)
+	ii.descriptors[pkg+"."+name] = desc
+}
+
+// fileFor returns the builder for the j5s file which elements of the proto
+// file are written to.
+func (ii *importer) fileFor(file protoreflect.FileDescriptor) (*fileBuilder, error) {
+	pkg, _, err := j5convert.SplitPackageFromFilename(file.Path())
+	if err != nil {
+		return nil, err
+	}
+	if pkg != ii.pkgName {
+		return nil, fmt.Errorf("file %s is not in package %s", file.Path(), ii.pkgName)
+	}
+
+	base := path.Base(file.Path())
+	for _, suffix := range []string{".p.j5s.proto", ".j5s.proto", ".proto"} {
+		if strings.HasSuffix(base, suffix) {
+			base = strings.TrimSuffix(base, suffix)
+			break
+		}
+	}
+	filename := path.Join(strings.ReplaceAll(pkg, ".", "/"), base+".j5s")
+
+	fb, ok := ii.files[filename]
+	if !ok {
+		fb = &fileBuilder{
+			file: &sourcedef_j5pb.SourceFile{
+				Path:    filename,
+				Package: &sourcedef_j5pb.Package{Name: pkg},
+			},
+			imports: map[string]bool{},
+		}
+		ii.files[filename] = fb
+		ii.fileOrder = append(ii.fileOrder, filename)
+	}
+	return fb, nil
+}
+
+type fileBuilder struct {
+	file    *sourcedef_j5pb.SourceFile
+	imports map[string]bool
+}
+
+func (fb *fileBuilder) add(element *sourcedef_j5pb.RootElement) {
+	fb.file.Elements = append(fb.file.Elements, element)
+}
+
+func (fb *fileBuilder) build() *sourcedef_j5pb.SourceFile {
+	imports := make([]string, 0, len(fb.imports))
+	for pkg := range fb.imports {
+		imports = append(imports, pkg)
+	}
+	sort.Strings(imports)
+	fb.file.Imports = nil
+	for _, pkg := range imports {
+		fb.file.Imports = append(fb.file.Imports, &sourcedef_j5pb.Import{Path: pkg})
+	}
+	return fb.file
+}
+
+func (ii *importer) importFile(file protoreflect.FileDescriptor) error {
+	fb, err := ii.fileFor(file)
+	if err != nil {
+		return err
+	}
+	sc := scope{file: fb}
+
+	messages := file.Messages()
+	for idx := range messages.Len() {
+		msg := messages.Get(idx)
+		if ent := ii.entityPart(msg); ent != nil {
+			if ent.file == nil {
+				ent.file = fb
+				element, err := ii.entity(sc, ent)
+				if err != nil {
+					return fmt.Errorf("entity %s: %w", ent.name, err)
+				}
+				fb.add(element)
+			}
+			continue
+		}
+		if ii.consumed[msg.FullName()] {
+			continue
+		}
+		element, err := ii.rootMessage(sc, msg)
+		if err != nil {
+			return fmt.Errorf("message %s: %w", msg.FullName(), err)
+		}
+		if element != nil {
+			fb.add(element)
+		}
+	}
+
+	enums := file.Enums()
+	for idx := range enums.Len() {
+		enum := enums.Get(idx)
+		if ii.consumed[enum.FullName()] {
+			continue
+		}
+		schema, err := ii.enum(enum)
+		if err != nil {
+			return fmt.Errorf("enum %s: %w", enum.FullName(), err)
+		}
+		fb.add(&sourcedef_j5pb.RootElement{
+			Type: &sourcedef_j5pb.RootElement_Enum{Enum: schema},
+		})
+	}
+
+	services := file.Services()
+	for idx := range services.Len() {
+		service := services.Get(idx)
+		if ii.consumed[service.FullName()] {
+			continue
+		}
+		element, err := ii.service(sc, service)
+		if err != nil {
+			return fmt.Errorf("service %s: %w", service.FullName(), err)
+		}
+		if element != nil {
+			fb.add(element)
+		}
+	}
+
+	return nil
+}
+
+// rootMessage converts a message which is not part of an entity, a service
+// or a topic.
+func (ii *importer) rootMessage(sc scope, msg protoreflect.MessageDescriptor) (*sourcedef_j5pb.RootElement, error) {
+	if ii.isMethodMessage(msg) {
+		return nil, nil
+	}
+
+	root, err := ii.rootSchema(msg)
+	if err != nil {
+		return nil, err
+	}
+	if poly := root.ToJ5Root().GetPolymorph(); poly != nil {
+		poly.Name = string(msg.Name())
+		return &sourcedef_j5pb.RootElement{
+			Type: &sourcedef_j5pb.RootElement_Polymorph{
+				Polymorph: &sourcedef_j5pb.Polymorph{Def: poly},
+			},
+		}, nil
+	}
+
+	nested, err := ii.nestedSchema(sc, msg)
+	if err != nil {
+		return nil, err
+	}
+	switch st := nested.Type.(type) {
+	case *sourcedef_j5pb.NestedSchema_Object:
+		return &sourcedef_j5pb.RootElement{
+			Type: &sourcedef_j5pb.RootElement_Object{Object: st.Object},
+		}, nil
+	case *sourcedef_j5pb.NestedSchema_Oneof:
+		return &sourcedef_j5pb.RootElement{
+			Type: &sourcedef_j5pb.RootElement_Oneof{Oneof: st.Oneof},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unexpected nested type %T", st)
+	}
+}
+
+// isMethodMessage returns true when the message is the input or output of a
+// method of a service in the same file, which are converted with the method.
+func (ii *importer) isMethodMessage(msg protoreflect.MessageDescriptor) bool {
+	services := msg.ParentFile().Services()
+	for idx := range services.Len() {
+		methods := services.Get(idx).Methods()
+		for mi := range methods.Len() {
+			method := methods.Get(mi)
+			if method.Input().FullName() == msg.FullName() || method.Output().FullName() == msg.FullName() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// schemaName returns the package and the name j5schema uses for a message
+// or enum, with nested names joined by underscores.
+func schemaName(desc protoreflect.Descriptor) (string, string) {
+	parts := []string{string(desc.Name())}
+	for parent := desc.Parent(); ; parent = parent.Parent() {
+		if file, ok := parent.(protoreflect.FileDescriptor); ok {
+			slices.Reverse(parts)
+			return string(file.Package()), strings.Join(parts, "_")
+		}
+		parts = append(parts, string(parent.Name()))
+	}
+}
+
+func (ii *importer) rootSchema(desc protoreflect.Descriptor) (j5schema.RootSchema, error) {
+	pkg, name := schemaName(desc)
+	return ii.schemas.SchemaByName(pkg, name)
+}
+
+func (ii *importer) enum(enum protoreflect.EnumDescriptor) (*schema_j5pb.Enum, error) {
+	root, err := ii.rootSchema(enum)
+	if err != nil {
+		return nil, err
+	}
+	schema := root.ToJ5Root().GetEnum()
+	if schema == nil {
+		return nil, fmt.Errorf("%s is not an enum", enum.FullName())
+	}
+
+	values := enum.Values()
+	for idx := range values.Len() {
+		if values.Get(idx).Number() != protoreflect.EnumNumber(idx) {
+			return nil, fmt.Errorf("value %s has number %d, j5s numbers values in order from 0", values.Get(idx).Name(), values.Get(idx).Number())
+		}
+	}
+
+	schema.Name = string(enum.Name())
+	if schema.Prefix == strcase.ToScreamingSnake(schema.Name)+"_" {
+		schema.Prefix = ""
+	}
+
+	options := make([]*schema_j5pb.Enum_Option, 0, len(schema.Options))
+	for _, option := range schema.Options {
+		if option.Number == 0 {
+			// j5convert always adds the UNSPECIFIED value
+			continue
+		}
+		option.Number = 0
+		options = append(options, option)
+	}
+	schema.Options = options
+	return schema, nil
+}
+
+// scope is the context refs are written in, the file for imports and the
+// enclosing messages for nested names.
+type scope struct {
+	file     *fileBuilder
+	messages []protoreflect.MessageDescriptor
+}
+
+func (sc scope) in(msg protoreflect.MessageDescriptor) scope {
+	messages := make([]protoreflect.MessageDescriptor, len(sc.messages), len(sc.messages)+1)
+	copy(messages, sc.messages)
+	return scope{
+		file:     sc.file,
+		messages: append(messages, msg),
+	}
+}
+
+// ref converts a j5schema ref to the way it is written in the j5s file.
+// Types of other packages are imported and written with the full package
+// name. Nested types can only be referred to from within their parent.
+func (ii *importer) ref(sc scope, ref *schema_j5pb.Ref) (*schema_j5pb.Ref, error) {
+	desc, ok := ii.descriptors[ref.Package+"."+ref.Schema]
+	if !ok {
+		return nil, fmt.Errorf("unknown type %s.%s", ref.Package, ref.Schema)
+	}
+
+	pkg := string(desc.ParentFile().Package())
+	if _, isFile := desc.Parent().(protoreflect.FileDescriptor); isFile {
+		if pkg == ii.pkgName {
+			return &schema_j5pb.Ref{Schema: string(desc.Name())}, nil
+		}
+		if !implicitTypes[string(desc.FullName())] {
+			sc.file.imports[pkg] = true
+		}
+		return &schema_j5pb.Ref{Package: pkg, Schema: string(desc.Name())}, nil
+	}
+
+	// j5s resolves bare names from the innermost message outwards.
+	for idx := len(sc.messages) - 1; idx >= 0; idx-- {
+		msg := sc.messages[idx]
+		var found protoreflect.Descriptor
+		if child := msg.Messages().ByName(desc.Name()); child != nil {
+			found = child
+		} else if child := msg.Enums().ByName(desc.Name()); child != nil {
+			found = child
+		}
+		if found == nil {
+			continue
+		}
+		if found.FullName() != desc.FullName() {
+			break
+		}
+		return &schema_j5pb.Ref{Schema: string(desc.Name())}, nil
+	}
+	return nil, fmt.Errorf("nested type %s can only be referenced from within %s in j5s", desc.FullName(), desc.Parent().FullName())
+}
+
+// implicitTypes can be used in j5s files without an import, they are the
+// types j5convert adds to entities, list methods and topics.
+var implicitTypes = map[string]bool{
+	"j5.state.v1.StateMetadata":        true,
+	"j5.state.v1.EventMetadata":        true,
+	"j5.state.v1.EventPublishMetadata": true,
+	"j5.list.v1.PageRequest":           true,
+	"j5.list.v1.PageResponse":          true,
+	"j5.list.v1.QueryRequest":          true,
+	"j5.messaging.v1.UpsertMetadata":   true,
+	"j5.messaging.v1.RequestMetadata":  true,
+}
+
+func descriptorDescription(desc protoreflect.Descriptor) string {
+	loc := desc.ParentFile().SourceLocations().ByDescriptor(desc)
+	lines := []string{}
+	for _, comment := range []string{loc.LeadingComments, loc.TrailingComments} {
+		for _, line := range strings.Split(comment, "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,460 @@
+package j5import
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pentops/j5/gen/j5/source/v1/source_j5pb"
+	"github.com/pentops/j5/internal/j5s/j5parse"
+	"github.com/pentops/j5/internal/j5s/protobuild"
+	"github.com/pentops/j5/internal/j5s/protobuild/psrc"
+	"github.com/pentops/j5/lib/j5schema"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+type testFiles struct {
+	localFiles    map[string][]byte
+	localPackages []string
+}
+
+func newTestFiles() *testFiles {
+	return &testFiles{
+		localFiles: map[string][]byte{},
+	}
+}
+
+func (tf *testFiles) ListPackages() []string {
+	return tf.localPackages
+}
+
+func (tf *testFiles) ListSourceFiles(ctx context.Context, prefix string) ([]string, error) {
+	var files []string
+	for k := range tf.localFiles {
+		if strings.HasPrefix(k, prefix) {
+			files = append(files, k)
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+func (tf *testFiles) GetLocalFile(ctx context.Context, filename string) ([]byte, error) {
+	if desc, ok := tf.localFiles[filename]; ok {
+		return desc, nil
+	}
+	return nil, fmt.Errorf("file not found: %s", filename)
+}
+
+func (tf *testFiles) ProseFiles(pkgName string) ([]*source_j5pb.ProseFile, error) {
+	return []*source_j5pb.ProseFile{}, nil
+}
+
+func (tf *testFiles) add(pkg string, filename string, body string) {
+	tf.localFiles[filename] = []byte(body)
+	for _, existing := range tf.localPackages {
+		if existing == pkg {
+			return
+		}
+	}
+	tf.localPackages = append(tf.localPackages, pkg)
+}
+
+func compile(t *testing.T, tf *testFiles, pkg string) []protoreflect.FileDescriptor {
+	t.Helper()
+	resolver, err := protobuild.NewSourceResolver(tf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ps, err := protobuild.NewPackageSet(psrc.NewBuiltinResolver(), resolver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	built, err := ps.CompilePackage(t.Context(), pkg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := make([]protoreflect.FileDescriptor, 0, len(built.Proto))
+	for _, file := range built.Proto {
+		files = append(files, file.Linked)
+	}
+	return files
+}
+
+const depSrc = `package dep.v1
+
+object Dep {
+	field depId key:uuid
+}
+`
+
+const fooSrc = `package test.v1
+
+import dep.v1
+
+entity Foo {
+	query {
+		eventsInGet = true
+		defaultStatusFilter = ["ACTIVE"]
+		auth.jwtBearer
+	}
+
+	key fooId ! key:uuid {
+		primary = true
+	}
+
+	key ownerId ! key:uuid
+
+	status ACTIVE
+	status INACTIVE {
+		| No longer in use
+	}
+
+	data name string {
+		listRules.searching.searchable = true
+	}
+
+	data dep object:dep.v1.Dep
+	data tags array:string {
+		rules.minItems = 1
+	}
+	data labels map:string
+	data type enum {
+		enum Type {
+			option A
+			option B
+		}
+	}
+
+	event Created {
+		| Comment on Created
+		field name string
+		field inner object:Inner
+
+		object Inner {
+			field x string
+		}
+	}
+
+	event Archived {
+		field reason string
+	}
+
+	command {
+		options.defaultAuth.jwtBearer
+
+		method FooCreate {
+			| Creates a Foo
+			httpMethod = "POST"
+			httpPath = "/create"
+
+			request {
+				field name ! string
+				field patch object {
+					field name string
+				}
+			}
+		}
+
+		method FooCheck {
+			httpMethod = "GET"
+			httpPath = "/:fooId/check"
+
+			request {
+				field fooId ! key:uuid
+			}
+
+			response {
+				field ok bool
+			}
+		}
+	}
+
+	command {
+		name = "FooAdmin"
+		basePath = "admin"
+
+		method FooReset {
+			httpMethod = "POST"
+			httpPath = "/:fooId/reset"
+
+			request {
+				field fooId ! key:uuid
+			}
+		}
+	}
+
+	summary Summary {
+		field name string
+	}
+}
+
+object Bar {
+	| Bar is a bar
+	field barId ! key:uuid
+	field count ? integer:INT64 {
+		rules.minimum = 1
+	}
+	field ratio float:FLOAT64
+	field kind enum:Kind
+	field nested object:Nested
+	field choice oneof:Choice
+	field when timestamp
+	field day date
+	field amount decimal
+	field raw bytes
+	field code key:custom {
+		format.custom.pattern = "^[A-Z]+$"
+	}
+
+	rule {
+		expression = "this.count > 0"
+		message = "count must be positive"
+	}
+
+	object Nested {
+		| Nested in Bar
+		field a string
+		field deeper object:Deeper
+
+		object Deeper {
+			field b string
+		}
+	}
+
+	field shape oneof:Shape
+	field tone enum:Tone
+
+	schemas.oneof Shape {
+		option circle object:Circle
+
+		object Circle {
+			field radius integer:INT64
+		}
+	}
+
+	schemas.enum Tone {
+		option LOUD
+	}
+}
+
+enum Kind {
+	| Kinds of bar
+	option ONE {
+		| The first
+		info.label = "One"
+	}
+	option TWO
+}
+
+enum Shade {
+	prefix = "SH_"
+	option DARK
+}
+
+oneof Choice {
+	| A choice
+	option left object:Bar
+	option right string
+}
+
+service Download {
+	method DownloadRaw {
+		| Downloads the raw data
+		httpMethod = "GET"
+		httpPath = "/test/v1/bar/:barId/raw"
+		httpResponse = true
+
+		request {
+			field barId ! key:uuid
+		}
+	}
+
+	method BarList {
+		httpMethod = "GET"
+		httpPath = "/test/v1/bar"
+		paged = true
+		query = true
+		auth.none
+
+		response {
+			field bars array:object:Bar
+		}
+	}
+}
+
+topic Notify publish {
+	message Ping {
+		field note string
+	}
+
+	message Pong {
+		field note string
+	}
+}
+
+topic Single publish {
+	message {
+		field x string
+	}
+}
+
+topic Ask reqres {
+	request {
+		field q string
+	}
+
+	reply {
+		field a string
+	}
+}
+`
+
+const legacySrc = `syntax = "proto3";
+
+package test.v1;
+
+import "test/v1/foo.j5s.proto";
+
+// Legacy is written by hand.
+message Legacy {
+  // The name of the legacy thing
+  string name = 1;
+  int64 count = 2;
+  Bar bar = 3;
+}
+`
+
+func TestRoundTrip(t *testing.T) {
+	original := newTestFiles()
+	original.add("dep.v1", "dep/v1/dep.j5s", depSrc)
+	original.add("test.v1", "test/v1/foo.j5s", fooSrc)
+	original.add("test.v1", "test/v1/legacy.proto", legacySrc)
+	originalFiles := compile(t, original, "test.v1")
+
+	imported, err := ImportPackage("test.v1", originalFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	roundTrip := newTestFiles()
+	roundTrip.add("dep.v1", "dep/v1/dep.j5s", depSrc)
+	printed := map[string]string{}
+	for _, file := range imported {
+		src, err := Print(file)
+		if err != nil {
+			t.Fatalf("print %s: %s", file.Path, err)
+		}
+		t.Logf("%s:\n%s", file.Path, src)
+		printed[file.Path] = src
+
+		if _, err := j5parse.ParseFile(file.Path, src); err != nil {
+			t.Fatalf("parse %s: %s", file.Path, err)
+		}
+		roundTrip.add("test.v1", file.Path, src)
+	}
+
+	legacy, ok := printed["test/v1/legacy.j5s"]
+	if !ok {
+		t.Fatal("expected test/v1/legacy.j5s")
+	}
+	for _, want := range []string{
+		"| Legacy is written by hand.",
+		"| The name of the legacy thing",
+		"field count integer:INT64",
+	} {
+		if !strings.Contains(legacy, want) {
+			t.Errorf("legacy.j5s should contain %q", want)
+		}
+	}
+	if strings.Contains(printed["test/v1/foo.j5s"], "object FooState") {
+		t.Error("entity parts should not be written as objects")
+	}
+
+	roundTripFiles := compile(t, roundTrip, "test.v1")
+
+	// Messages from j5s sources are generated the same way again.
+	want := descriptorSet(originalFiles, "test/v1/legacy.proto")
+	got := descriptorSet(roundTripFiles, "test/v1/legacy.j5s.proto")
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("descriptors differ (-want +got):\n%s", diff)
+	}
+
+	// All messages have the same J5 schema, including the descriptions from
+	// the comments of the hand-written file.
+	wantSchemas := schemaSet(t, originalFiles)
+	gotSchemas := schemaSet(t, roundTripFiles)
+	if diff := cmp.Diff(wantSchemas, gotSchemas, protocmp.Transform()); diff != "" {
+		t.Errorf("schemas differ (-want +got):\n%s", diff)
+	}
+}
+
+// descriptorSet returns the messages, enums and services of the files by
+// full name, without source info.
+func descriptorSet(files []protoreflect.FileDescriptor, skip string) map[string]any {
+	out := map[string]any{}
+	for _, file := range files {
+		if file.Path() == skip {
+			continue
+		}
+		fileProto := protodesc.ToFileDescriptorProto(file)
+		for _, msg := range fileProto.MessageType {
+			out[fileProto.GetPackage()+"."+msg.GetName()] = msg
+		}
+		for _, enum := range fileProto.EnumType {
+			out[fileProto.GetPackage()+"."+enum.GetName()] = enum
+		}
+		for _, service := range fileProto.Service {
+			out[fileProto.GetPackage()+"."+service.GetName()] = service
+		}
+	}
+	return out
+}
+
+func schemaSet(t *testing.T, files []protoreflect.FileDescriptor) map[string]any {
+	t.Helper()
+	reg := &protoregistry.Files{}
+	for _, file := range files {
+		if err := reg.RegisterFile(file); err != nil {
+			t.Fatal(err)
+		}
+	}
+	ss, err := j5schema.SchemaSetFromFiles(reg, func(protoreflect.FileDescriptor) bool { return true })
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := map[string]any{}
+	var walk func(messages protoreflect.MessageDescriptors, enums protoreflect.EnumDescriptors)
+	add := func(desc protoreflect.Descriptor) {
+		pkg, name := schemaName(desc)
+		schema, err := ss.SchemaByName(pkg, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out[pkg+"."+name] = schema.ToJ5Root()
+	}
+	walk = func(messages protoreflect.MessageDescriptors, enums protoreflect.EnumDescriptors) {
+		for idx := range enums.Len() {
+			add(enums.Get(idx))
+		}
+		for idx := range messages.Len() {
+			msg := messages.Get(idx)
+			if msg.IsMapEntry() {
+				continue
+			}
+			add(msg)
+			walk(msg.Messages(), msg.Enums())
+		}
+	}
+	for _, file := range files {
+		walk(file.Messages(), file.Enums())
+	}
+	return out
+}
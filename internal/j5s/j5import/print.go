@@ -0,0 +1,743 @@
+package j5import
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/pentops/j5/gen/j5/ext/v1/ext_j5pb"
+	"github.com/pentops/j5/gen/j5/schema/v1/schema_j5pb"
+	"github.com/pentops/j5/gen/j5/sourcedef/v1/sourcedef_j5pb"
+	"github.com/pentops/j5/internal/bcl"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Print writes the source file as formatted j5s.
+func Print(file *sourcedef_j5pb.SourceFile) (string, error) {
+	pp := &printer{}
+	pp.line("package %s", file.Package.Name)
+
+	if len(file.Imports) > 0 {
+		pp.blank()
+		for _, imp := range file.Imports {
+			if imp.Alias != "" {
+				pp.line("import %s:%s", imp.Path, imp.Alias)
+			} else {
+				pp.line("import %s", imp.Path)
+			}
+		}
+	}
+
+	for _, element := range file.Elements {
+		pp.blank()
+		if err := pp.rootElement(element); err != nil {
+			return "", err
+		}
+	}
+
+	return bcl.Fmt(file.Path, pp.String())
+}
+
+type printer struct {
+	lines  []string
+	indent int
+}
+
+func (pp *printer) String() string {
+	return strings.Join(pp.lines, "\n") + "\n"
+}
+
+func (pp *printer) line(format string, args ...any) {
+	pp.lines = append(pp.lines, strings.Repeat("\t", pp.indent)+fmt.Sprintf(format, args...))
+}
+
+func (pp *printer) blank() {
+	if len(pp.lines) == 0 {
+		return
+	}
+	if last := pp.lines[len(pp.lines)-1]; last != "" && !strings.HasSuffix(last, "{") {
+		pp.lines = append(pp.lines, "")
+	}
+}
+
+// block writes the header and the body in braces, or the header alone when
+// the body is empty.
+func (pp *printer) block(header string, body func() error) error {
+	start := len(pp.lines)
+	pp.line("%s {", header)
+	pp.indent++
+	if err := body(); err != nil {
+		return err
+	}
+	pp.indent--
+	if len(pp.lines) == start+1 {
+		pp.lines[start] = strings.TrimSuffix(pp.lines[start], " {")
+		return nil
+	}
+	pp.line("}")
+	return nil
+}
+
+func (pp *printer) description(description string) {
+	if description == "" {
+		return
+	}
+	for _, line := range strings.Split(description, "\n") {
+		pp.line("| %s", line)
+	}
+}
+
+func (pp *printer) rootElement(element *sourcedef_j5pb.RootElement) error {
+	switch et := element.Type.(type) {
+	case *sourcedef_j5pb.RootElement_Object:
+		return pp.object(et.Object)
+	case *sourcedef_j5pb.RootElement_Oneof:
+		return pp.oneof("oneof", et.Oneof)
+	case *sourcedef_j5pb.RootElement_Enum:
+		return pp.enum("enum", et.Enum)
+	case *sourcedef_j5pb.RootElement_Polymorph:
+		return pp.polymorph(et.Polymorph)
+	case *sourcedef_j5pb.RootElement_Entity:
+		return pp.entity(et.Entity)
+	case *sourcedef_j5pb.RootElement_Service:
+		return pp.service("service "+et.Service.GetName(), et.Service)
+	case *sourcedef_j5pb.RootElement_Topic:
+		return pp.topic(et.Topic)
+	default:
+		return fmt.Errorf("unsupported element %T", et)
+	}
+}
+
+func (pp *printer) nestedSchemas(schemas []*sourcedef_j5pb.NestedSchema) error {
+	for _, nested := range schemas {
+		pp.blank()
+		var err error
+		switch nt := nested.Type.(type) {
+		case *sourcedef_j5pb.NestedSchema_Object:
+			err = pp.object(nt.Object)
+		case *sourcedef_j5pb.NestedSchema_Oneof:
+			// objects, oneofs and events only have an alias for nested
+			// objects
+			err = pp.oneof("schemas.oneof", nt.Oneof)
+		case *sourcedef_j5pb.NestedSchema_Enum:
+			err = pp.enum("schemas.enum", nt.Enum)
+		default:
+			err = fmt.Errorf("unsupported nested schema %T", nt)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (pp *printer) object(obj *sourcedef_j5pb.Object) error {
+	def := obj.Def
+	return pp.block("object "+def.Name, func() error {
+		pp.description(def.Description)
+		if err := pp.attributes(def.ProtoReflect(), "name", "description", "properties", "rules"); err != nil {
+			return err
+		}
+		for _, prop := range def.Properties {
+			if err := pp.property("field", prop); err != nil {
+				return err
+			}
+		}
+		for _, rule := range def.Rules {
+			if err := pp.block("rule", func() error {
+				return pp.attributes(rule.ProtoReflect())
+			}); err != nil {
+				return err
+			}
+		}
+		return pp.nestedSchemas(obj.Schemas)
+	})
+}
+
+func (pp *printer) oneof(keyword string, oneof *sourcedef_j5pb.Oneof) error {
+	def := oneof.Def
+	return pp.block(keyword+" "+def.Name, func() error {
+		pp.description(def.Description)
+		if err := pp.attributes(def.ProtoReflect(), "name", "description", "properties"); err != nil {
+			return err
+		}
+		for _, prop := range def.Properties {
+			if err := pp.property("option", prop); err != nil {
+				return err
+			}
+		}
+		return pp.nestedSchemas(oneof.Schemas)
+	})
+}
+
+func (pp *printer) enum(keyword string, enum *schema_j5pb.Enum) error {
+	return pp.block(keyword+" "+enum.Name, func() error {
+		pp.description(enum.Description)
+		if err := pp.attributes(enum.ProtoReflect(), "name", "description", "options"); err != nil {
+			return err
+		}
+		return pp.enumOptions("option", enum.Options)
+	})
+}
+
+func (pp *printer) enumOptions(keyword string, options []*schema_j5pb.Enum_Option) error {
+	for _, option := range options {
+		if err := pp.block(keyword+" "+option.Name, func() error {
+			pp.description(option.Description)
+			return pp.attributes(option.ProtoReflect(), "name", "number", "description")
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (pp *printer) polymorph(poly *sourcedef_j5pb.Polymorph) error {
+	def := poly.Def
+	return pp.block("polymorph "+def.Name, func() error {
+		pp.description(def.Description)
+		if err := pp.attributes(def.ProtoReflect(), "name", "description"); err != nil {
+			return err
+		}
+		return pp.attributes(poly.ProtoReflect(), "def")
+	})
+}
+
+func (pp *printer) entity(entity *sourcedef_j5pb.Entity) error {
+	return pp.block("entity "+entity.Name, func() error {
+		pp.description(entity.Description)
+		if entity.BaseUrlPath != "" {
+			pp.line("baseUrlPath = %s", strconv.Quote(entity.BaseUrlPath))
+		}
+		if entity.Query != nil {
+			pp.blank()
+			if err := pp.block("query", func() error {
+				return pp.attributes(entity.Query.ProtoReflect())
+			}); err != nil {
+				return err
+			}
+		}
+
+		pp.blank()
+		for _, key := range entity.Keys {
+			if err := pp.entityKey(key); err != nil {
+				return err
+			}
+		}
+
+		pp.blank()
+		if err := pp.enumOptions("status", entity.Status); err != nil {
+			return err
+		}
+
+		pp.blank()
+		for _, prop := range entity.Data {
+			if err := pp.property("data", prop); err != nil {
+				return err
+			}
+		}
+
+		for _, event := range entity.Events {
+			pp.blank()
+			if err := pp.event(event); err != nil {
+				return err
+			}
+		}
+
+		if err := pp.nestedSchemas(entity.Schemas); err != nil {
+			return err
+		}
+
+		for _, command := range entity.Commands {
+			pp.blank()
+			if err := pp.service("command", command); err != nil {
+				return err
+			}
+		}
+
+		for _, summary := range entity.Summaries {
+			pp.blank()
+			if err := pp.block("summary "+summary.Name, func() error {
+				pp.description(summary.Description)
+				return pp.properties("field", summary.Fields)
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (pp *printer) entityKey(key *sourcedef_j5pb.EntityKey) error {
+	header, err := propertyHeader("key", key.Def)
+	if err != nil {
+		return err
+	}
+	return pp.block(header, func() error {
+		pp.description(key.Def.Description)
+		if key.Key != nil {
+			if err := pp.attributes(key.Key.ProtoReflect()); err != nil {
+				return err
+			}
+		}
+		return pp.fieldBody(key.Def.Schema)
+	})
+}
+
+func (pp *printer) event(event *sourcedef_j5pb.Event) error {
+	def := event.Def
+	return pp.block("event "+def.Name, func() error {
+		pp.description(def.Description)
+		if err := pp.properties("field", def.Properties); err != nil {
+			return err
+		}
+		return pp.nestedSchemas(event.Schemas)
+	})
+}
+
+func (pp *printer) service(header string, service *sourcedef_j5pb.Service) error {
+	return pp.block(header, func() error {
+		pp.description(service.Description)
+		if header == "command" && service.Name != nil {
+			pp.line("name = %s", strconv.Quote(*service.Name))
+		}
+		if err := pp.attributes(service.ProtoReflect(), "name", "description", "methods"); err != nil {
+			return err
+		}
+		for _, method := range service.Methods {
+			pp.blank()
+			if err := pp.method(method); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (pp *printer) method(method *sourcedef_j5pb.APIMethod) error {
+	return pp.block("method "+method.Name, func() error {
+		pp.description(method.Description)
+		if err := pp.attributes(method.ProtoReflect(), "name", "description", "request", "response"); err != nil {
+			return err
+		}
+		if method.Request != nil {
+			pp.blank()
+			if err := pp.block("request", func() error {
+				return pp.properties("field", method.Request.Properties)
+			}); err != nil {
+				return err
+			}
+		}
+		if method.Response != nil {
+			pp.blank()
+			if err := pp.block("response", func() error {
+				return pp.properties("field", method.Response.Properties)
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (pp *printer) topic(topic *sourcedef_j5pb.Topic) error {
+	switch tt := topic.Type.Type.(type) {
+	case *sourcedef_j5pb.TopicType_Publish_:
+		return pp.block("topic "+topic.Name+" publish", func() error {
+			pp.description(topic.Description)
+			return pp.topicMethods("message", tt.Publish.Messages)
+		})
+	case *sourcedef_j5pb.TopicType_Reqres:
+		return pp.block("topic "+topic.Name+" reqres", func() error {
+			pp.description(topic.Description)
+			if err := pp.topicMethods("request", tt.Reqres.Request); err != nil {
+				return err
+			}
+			return pp.topicMethods("reply", tt.Reqres.Reply)
+		})
+	case *sourcedef_j5pb.TopicType_Upsert_:
+		return pp.block("topic "+topic.Name+" upsert", func() error {
+			pp.description(topic.Description)
+			if tt.Upsert.EntityName != "" {
+				pp.line("entityName = %s", strconv.Quote(tt.Upsert.EntityName))
+			}
+			return pp.topicMethods("message", []*sourcedef_j5pb.TopicMethod{tt.Upsert.Message})
+		})
+	case *sourcedef_j5pb.TopicType_Event_:
+		return pp.block("topic "+topic.Name+" event", func() error {
+			pp.description(topic.Description)
+			if tt.Event.EntityName != "" {
+				pp.line("entityName = %s", strconv.Quote(tt.Event.EntityName))
+			}
+			return pp.topicMethods("message", []*sourcedef_j5pb.TopicMethod{tt.Event.Message})
+		})
+	default:
+		return fmt.Errorf("unsupported topic type %T", tt)
+	}
+}
+
+func (pp *printer) topicMethods(keyword string, methods []*sourcedef_j5pb.TopicMethod) error {
+	for _, method := range methods {
+		header := keyword
+		if method.Name != nil {
+			header += " " + *method.Name
+		}
+		pp.blank()
+		if err := pp.block(header, func() error {
+			pp.description(method.Description)
+			return pp.properties("field", method.Fields)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (pp *printer) properties(keyword string, props []*schema_j5pb.ObjectProperty) error {
+	for _, prop := range props {
+		if err := pp.property(keyword, prop); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (pp *printer) property(keyword string, prop *schema_j5pb.ObjectProperty) error {
+	header, err := propertyHeader(keyword, prop)
+	if err != nil {
+		return err
+	}
+	return pp.block(header, func() error {
+		pp.description(prop.Description)
+		if err := pp.attributes(prop.ProtoReflect(), "schema", "name", "required", "explicitlyOptional", "description"); err != nil {
+			return err
+		}
+		return pp.fieldBody(prop.Schema)
+	})
+}
+
+func propertyHeader(keyword string, prop *schema_j5pb.ObjectProperty) (string, error) {
+	typeName, err := fieldType(prop.Schema)
+	if err != nil {
+		return "", fmt.Errorf("property %s: %w", prop.Name, err)
+	}
+	header := keyword + " " + prop.Name
+	if prop.Required {
+		header += " !"
+	}
+	if prop.ExplicitlyOptional {
+		header += " ?"
+	}
+	return header + " " + typeName, nil
+}
+
+// fieldType returns the type of the field as written in the property header,
+// with the qualifiers.
+func fieldType(field *schema_j5pb.Field) (string, error) {
+	switch ft := field.Type.(type) {
+	case *schema_j5pb.Field_Object:
+		if ref := ft.Object.GetRef(); ref != nil {
+			return "object:" + refString(ref), nil
+		}
+		return "object", nil
+	case *schema_j5pb.Field_Oneof:
+		if ref := ft.Oneof.GetRef(); ref != nil {
+			return "oneof:" + refString(ref), nil
+		}
+		return "oneof", nil
+	case *schema_j5pb.Field_Enum:
+		if ref := ft.Enum.GetRef(); ref != nil {
+			return "enum:" + refString(ref), nil
+		}
+		return "enum", nil
+	case *schema_j5pb.Field_Polymorph:
+		if ref := ft.Polymorph.GetRef(); ref != nil {
+			return "polymorph:" + refString(ref), nil
+		}
+		return "", fmt.Errorf("inline polymorphs are not supported in j5s")
+	case *schema_j5pb.Field_Array:
+		items, err := fieldType(ft.Array.Items)
+		if err != nil {
+			return "", err
+		}
+		if isInline(ft.Array.Items) {
+			return "", fmt.Errorf("inline array items are not supported in j5s")
+		}
+		return "array:" + items, nil
+	case *schema_j5pb.Field_Map:
+		items, err := fieldType(ft.Map.ItemSchema)
+		if err != nil {
+			return "", err
+		}
+		if isInline(ft.Map.ItemSchema) {
+			return "", fmt.Errorf("inline map items are not supported in j5s")
+		}
+		return "map:" + items, nil
+	case *schema_j5pb.Field_String_:
+		if ft.String_.Format != nil {
+			return "string:" + *ft.String_.Format, nil
+		}
+		return "string", nil
+	case *schema_j5pb.Field_Integer:
+		return "integer:" + strings.TrimPrefix(ft.Integer.Format.String(), "FORMAT_"), nil
+	case *schema_j5pb.Field_Float:
+		if ft.Float.Format == schema_j5pb.FloatField_FORMAT_UNSPECIFIED {
+			return "float", nil
+		}
+		return "float:" + strings.TrimPrefix(ft.Float.Format.String(), "FORMAT_"), nil
+	case *schema_j5pb.Field_Key:
+		switch format := ft.Key.Format.GetType().(type) {
+		case nil:
+			return "key", nil
+		case *schema_j5pb.KeyFormat_Named_:
+			return "key:named:" + refString(format.Named.Ref), nil
+		default:
+			return "key:" + oneofFieldName(ft.Key.Format.ProtoReflect()), nil
+		}
+	default:
+		return oneofFieldName(field.ProtoReflect()), nil
+	}
+}
+
+func isInline(field *schema_j5pb.Field) bool {
+	switch ft := field.Type.(type) {
+	case *schema_j5pb.Field_Object:
+		return ft.Object.GetObject() != nil
+	case *schema_j5pb.Field_Oneof:
+		return ft.Oneof.GetOneof() != nil
+	case *schema_j5pb.Field_Enum:
+		return ft.Enum.GetEnum() != nil
+	}
+	return false
+}
+
+func refString(ref *schema_j5pb.Ref) string {
+	if ref.Package == "" {
+		return ref.Schema
+	}
+	return ref.Package + "." + ref.Schema
+}
+
+// oneofFieldName returns the JSON name of the field set in the message's
+// only oneof.
+func oneofFieldName(msg protoreflect.Message) string {
+	oneof := msg.Descriptor().Oneofs().Get(0)
+	field := msg.WhichOneof(oneof)
+	if field == nil {
+		return ""
+	}
+	return field.JSONName()
+}
+
+// fieldBody writes the attributes of the field type, which are not part of
+// the header, and the properties of inline objects and oneofs.
+func (pp *printer) fieldBody(field *schema_j5pb.Field) error {
+	switch ft := field.Type.(type) {
+	case *schema_j5pb.Field_Object:
+		if err := pp.attributes(ft.Object.ProtoReflect(), "ref", "object"); err != nil {
+			return err
+		}
+		if obj := ft.Object.GetObject(); obj != nil {
+			return pp.properties("field", obj.Properties)
+		}
+		return nil
+	case *schema_j5pb.Field_Oneof:
+		if err := pp.attributes(ft.Oneof.ProtoReflect(), "ref", "oneof"); err != nil {
+			return err
+		}
+		if oneof := ft.Oneof.GetOneof(); oneof != nil {
+			return pp.properties("option", oneof.Properties)
+		}
+		return nil
+	case *schema_j5pb.Field_Enum:
+		if err := pp.attributes(ft.Enum.ProtoReflect(), "ref", "enum"); err != nil {
+			return err
+		}
+		if enum := ft.Enum.GetEnum(); enum != nil {
+			return pp.enum("enum", enum)
+		}
+		return nil
+	case *schema_j5pb.Field_Polymorph:
+		return pp.attributes(ft.Polymorph.ProtoReflect(), "ref")
+	case *schema_j5pb.Field_Array:
+		if err := pp.attributes(ft.Array.ProtoReflect(), "items"); err != nil {
+			return err
+		}
+		return pp.nested("items."+oneofFieldName(ft.Array.Items.ProtoReflect()), func() error {
+			return pp.fieldBody(ft.Array.Items)
+		})
+	case *schema_j5pb.Field_Map:
+		if err := pp.attributes(ft.Map.ProtoReflect(), "itemSchema", "keySchema"); err != nil {
+			return err
+		}
+		return pp.nested("itemSchema."+oneofFieldName(ft.Map.ItemSchema.ProtoReflect()), func() error {
+			return pp.fieldBody(ft.Map.ItemSchema)
+		})
+	case *schema_j5pb.Field_String_:
+		return pp.attributes(ft.String_.ProtoReflect(), "format")
+	case *schema_j5pb.Field_Integer:
+		return pp.attributes(ft.Integer.ProtoReflect(), "format")
+	case *schema_j5pb.Field_Float:
+		return pp.attributes(ft.Float.ProtoReflect(), "format")
+	case *schema_j5pb.Field_Key:
+		if err := pp.attributes(ft.Key.ProtoReflect(), "format"); err != nil {
+			return err
+		}
+		if custom := ft.Key.Format.GetCustom(); custom != nil {
+			return pp.nested("format.custom", func() error {
+				return pp.attributes(custom.ProtoReflect())
+			})
+		}
+		return nil
+	default:
+		msg := field.ProtoReflect()
+		fd := msg.WhichOneof(msg.Descriptor().Oneofs().Get(0))
+		if fd == nil {
+			return fmt.Errorf("field has no type")
+		}
+		return pp.attributes(msg.Get(fd).Message())
+	}
+}
+
+// nested writes the lines of the body under the dotted prefix, as a single
+// dotted line when there is one, or a block.
+func (pp *printer) nested(prefix string, body func() error) error {
+	start := len(pp.lines)
+	if err := body(); err != nil {
+		return err
+	}
+	written := pp.lines[start:]
+	switch len(written) {
+	case 0:
+		return nil
+	case 1:
+		pp.lines[start] = strings.Repeat("\t", pp.indent) + prefix + "." + strings.TrimLeft(written[0], "\t")
+		return nil
+	}
+
+	lines := make([]string, 0, len(written)+2)
+	lines = append(lines, strings.Repeat("\t", pp.indent)+prefix+" {")
+	for _, line := range written {
+		if line == "" {
+			lines = append(lines, line)
+			continue
+		}
+		lines = append(lines, "\t"+line)
+	}
+	lines = append(lines, strings.Repeat("\t", pp.indent)+"}")
+	pp.lines = append(pp.lines[:start], lines...)
+	return nil
+}
+
+// attributes writes the set fields of the message which are not skipped, by
+// JSON name, in field order.
+func (pp *printer) attributes(msg protoreflect.Message, skip ...string) error {
+	fields := msg.Descriptor().Fields()
+	for idx := range fields.Len() {
+		field := fields.Get(idx)
+		name := field.JSONName()
+		if !msg.Has(field) || contains(skip, name) {
+			continue
+		}
+		value := msg.Get(field)
+
+		switch {
+		case field.IsMap():
+			if field.MapKey().Kind() != protoreflect.StringKind || field.MapValue().Kind() != protoreflect.StringKind {
+				return fmt.Errorf("unsupported map %s", field.FullName())
+			}
+			keys := []string{}
+			value.Map().Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+				keys = append(keys, key.String())
+				return true
+			})
+			sort.Strings(keys)
+			for _, key := range keys {
+				pp.line("%s.%s = %s", name, key, strconv.Quote(value.Map().Get(protoreflect.ValueOfString(key).MapKey()).String()))
+			}
+
+		case field.IsList() && field.Kind() == protoreflect.MessageKind:
+			blockName := name
+			if opts, ok := proto.GetExtension(field.Options(), ext_j5pb.E_Field).(*ext_j5pb.FieldOptions); ok {
+				if single := opts.GetArray().GetSingleForm(); single != "" {
+					blockName = single
+				}
+			}
+			list := value.List()
+			for idx := range list.Len() {
+				if err := pp.block(blockName, func() error {
+					return pp.attributes(list.Get(idx).Message())
+				}); err != nil {
+					return err
+				}
+			}
+
+		case field.IsList():
+			list := value.List()
+			values := make([]string, 0, list.Len())
+			for idx := range list.Len() {
+				values = append(values, scalarString(field, list.Get(idx)))
+			}
+			pp.line("%s = [%s]", name, strings.Join(values, ", "))
+
+		case field.Kind() == protoreflect.MessageKind:
+			start := len(pp.lines)
+			if err := pp.nested(name, func() error {
+				return pp.attributes(value.Message())
+			}); err != nil {
+				return err
+			}
+			if len(pp.lines) == start && field.ContainingOneof() != nil {
+				// empty messages select the option, e.g. auth.none
+				pp.line("%s", name)
+			}
+
+		default:
+			pp.line("%s = %s", name, scalarString(field, value))
+		}
+	}
+	return nil
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
+func scalarString(field protoreflect.FieldDescriptor, value protoreflect.Value) string {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		return strconv.Quote(value.String())
+	case protoreflect.EnumKind:
+		return strconv.Quote(enumValueName(field.Enum(), value.Enum()))
+	case protoreflect.BoolKind:
+		return strconv.FormatBool(value.Bool())
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return strconv.FormatFloat(value.Float(), 'g', -1, 64)
+	default:
+		return value.String()
+	}
+}
+
+// enumValueName returns the name of the value without the prefix of the
+// enum, as j5 writes enum values.
+func enumValueName(enum protoreflect.EnumDescriptor, number protoreflect.EnumNumber) string {
+	value := enum.Values().ByNumber(number)
+	if value == nil {
+		return strconv.Itoa(int(number))
+	}
+	name := string(value.Name())
+	if zero := enum.Values().ByNumber(0); zero != nil {
+		if prefix, ok := strings.CutSuffix(string(zero.Name()), "UNSPECIFIED"); ok {
+			return strings.TrimPrefix(name, prefix)
+		}
+	}
+	return strings.TrimPrefix(name, strcase.ToScreamingSnake(string(enum.Name()))+"_")
+}
@@ -0,0 +1,382 @@
+package j5import
+
+import (
+	"fmt"
+
+	"github.com/iancoleman/strcase"
+	"github.com/pentops/j5/gen/j5/schema/v1/schema_j5pb"
+	"github.com/pentops/j5/gen/j5/sourcedef/v1/sourcedef_j5pb"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// nestedSchema converts an object or oneof message, with its nested types.
+func (ii *importer) nestedSchema(sc scope, msg protoreflect.MessageDescriptor) (*sourcedef_j5pb.NestedSchema, error) {
+	root, err := ii.rootSchema(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	inner := sc.in(msg)
+	switch rt := root.ToJ5Root().Type.(type) {
+	case *schema_j5pb.RootSchema_Object:
+		def := rt.Object
+		def.Name = string(msg.Name())
+		def.Properties, err = ii.properties(inner, msg, def.Properties, false)
+		if err != nil {
+			return nil, err
+		}
+		nested, err := ii.nestedSchemas(inner, msg)
+		if err != nil {
+			return nil, err
+		}
+		return &sourcedef_j5pb.NestedSchema{
+			Type: &sourcedef_j5pb.NestedSchema_Object{
+				Object: &sourcedef_j5pb.Object{Def: def, Schemas: nested},
+			},
+		}, nil
+
+	case *schema_j5pb.RootSchema_Oneof:
+		def := rt.Oneof
+		def.Name = string(msg.Name())
+		def.Properties, err = ii.properties(inner, msg, def.Properties, false)
+		if err != nil {
+			return nil, err
+		}
+		nested, err := ii.nestedSchemas(inner, msg)
+		if err != nil {
+			return nil, err
+		}
+		return &sourcedef_j5pb.NestedSchema{
+			Type: &sourcedef_j5pb.NestedSchema_Oneof{
+				Oneof: &sourcedef_j5pb.Oneof{Def: def, Schemas: nested},
+			},
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("message %s is a %T, which j5s can't declare", msg.FullName(), rt)
+	}
+}
+
+// nestedSchemas converts the nested messages and enums which were not
+// written inline by a property.
+func (ii *importer) nestedSchemas(sc scope, msg protoreflect.MessageDescriptor) ([]*sourcedef_j5pb.NestedSchema, error) {
+	out := []*sourcedef_j5pb.NestedSchema{}
+	messages := msg.Messages()
+	for idx := range messages.Len() {
+		nested := messages.Get(idx)
+		if nested.IsMapEntry() || ii.consumed[nested.FullName()] {
+			continue
+		}
+		schema, err := ii.nestedSchema(sc, nested)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, schema)
+	}
+
+	enums := msg.Enums()
+	for idx := range enums.Len() {
+		if ii.consumed[enums.Get(idx).FullName()] {
+			continue
+		}
+		enum, err := ii.enum(enums.Get(idx))
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, &sourcedef_j5pb.NestedSchema{
+			Type: &sourcedef_j5pb.NestedSchema_Enum{Enum: enum},
+		})
+	}
+	return out, nil
+}
+
+// properties converts the properties of the message for the j5s file. When
+// inline is set, the message has no block for nested types, as for request
+// and response objects, so nested objects and oneofs are written in the
+// property.
+func (ii *importer) properties(sc scope, msg protoreflect.MessageDescriptor, props []*schema_j5pb.ObjectProperty, inline bool) ([]*schema_j5pb.ObjectProperty, error) {
+	fields := msg.Fields()
+	if len(props) != fields.Len() {
+		return nil, fmt.Errorf("message %s has %d fields but %d properties", msg.FullName(), fields.Len(), len(props))
+	}
+
+	oneofs := msg.Oneofs()
+	for idx := range oneofs.Len() {
+		oneof := oneofs.Get(idx)
+		if !oneof.IsSynthetic() && oneof.Name() != "type" {
+			return nil, fmt.Errorf("message %s has oneof %s, use a oneof message", msg.FullName(), oneof.Name())
+		}
+	}
+
+	for idx, prop := range props {
+		field := fields.Get(idx)
+		// j5convert numbers fields in order, changing the numbers would break
+		// the wire format.
+		if field.Number() != protoreflect.FieldNumber(idx+1) {
+			return nil, fmt.Errorf("field %s has number %d, j5s numbers fields in order from 1", field.FullName(), field.Number())
+		}
+		if string(field.Name()) != strcase.ToSnake(prop.Name) {
+			return nil, fmt.Errorf("field %s would be named %s in j5s", field.FullName(), strcase.ToSnake(prop.Name))
+		}
+
+		schema, err := ii.field(sc, prop.Name, prop.Schema, inline)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.FullName(), err)
+		}
+		prop.Schema = schema
+	}
+	return props, nil
+}
+
+// field rewrites the refs of a field schema for the j5s file.
+func (ii *importer) field(sc scope, propName string, field *schema_j5pb.Field, inline bool) (*schema_j5pb.Field, error) {
+	switch ft := field.Type.(type) {
+	case *schema_j5pb.Field_Object:
+		ref := ft.Object.GetRef()
+		if ref == nil {
+			return field, nil
+		}
+		if inline {
+			if obj, ok, err := ii.inlineObject(sc, propName, ref); err != nil {
+				return nil, err
+			} else if ok {
+				ft.Object.Schema = &schema_j5pb.ObjectField_Object{Object: obj}
+				return field, nil
+			}
+		}
+		converted, err := ii.ref(sc, ref)
+		if err != nil {
+			return nil, err
+		}
+		ft.Object.Schema = &schema_j5pb.ObjectField_Ref{Ref: converted}
+
+	case *schema_j5pb.Field_Oneof:
+		ref := ft.Oneof.GetRef()
+		if ref == nil {
+			return field, nil
+		}
+		if inline {
+			if oneof, ok, err := ii.inlineOneof(sc, propName, ref); err != nil {
+				return nil, err
+			} else if ok {
+				ft.Oneof.Schema = &schema_j5pb.OneofField_Oneof{Oneof: oneof}
+				return field, nil
+			}
+		}
+		converted, err := ii.ref(sc, ref)
+		if err != nil {
+			return nil, err
+		}
+		ft.Oneof.Schema = &schema_j5pb.OneofField_Ref{Ref: converted}
+
+	case *schema_j5pb.Field_Enum:
+		ref := ft.Enum.GetRef()
+		if ref == nil {
+			return field, nil
+		}
+		if inline {
+			if enum, ok, err := ii.inlineEnum(sc, ref); err != nil {
+				return nil, err
+			} else if ok {
+				ft.Enum.Schema = &schema_j5pb.EnumField_Enum{Enum: enum}
+				return field, nil
+			}
+		}
+		converted, err := ii.ref(sc, ref)
+		if err != nil {
+			return nil, err
+		}
+		ft.Enum.Schema = &schema_j5pb.EnumField_Ref{Ref: converted}
+
+	case *schema_j5pb.Field_Polymorph:
+		ref := ft.Polymorph.GetRef()
+		if ref == nil {
+			return field, nil
+		}
+		converted, err := ii.ref(sc, ref)
+		if err != nil {
+			return nil, err
+		}
+		ft.Polymorph.Schema = &schema_j5pb.PolymorphField_Ref{Ref: converted}
+
+	case *schema_j5pb.Field_Array:
+		items, err := ii.field(sc, propName, ft.Array.Items, inline)
+		if err != nil {
+			return nil, err
+		}
+		ft.Array.Items = items
+
+	case *schema_j5pb.Field_Map:
+		items, err := ii.field(sc, propName, ft.Map.ItemSchema, inline)
+		if err != nil {
+			return nil, err
+		}
+		ft.Map.ItemSchema = items
+		if ft.Map.KeySchema.GetString_() == nil {
+			// string keys are the default
+			ft.Map.KeySchema = nil
+		}
+
+	case *schema_j5pb.Field_Key:
+		// j5schema adds the deprecated entity fields from entityKey and
+		// ext.foreign
+		ft.Key.Entity = nil
+		if named := ft.Key.Format.GetNamed(); named != nil && named.Ref != nil {
+			converted, err := ii.ref(sc, named.Ref)
+			if err != nil {
+				return nil, err
+			}
+			named.Ref = converted
+		}
+	}
+	return field, nil
+}
+
+// nestedChild returns the message of the ref when it is nested directly in
+// the innermost message of the scope, with the default name for the property.
+func (ii *importer) nestedChild(sc scope, propName string, ref *schema_j5pb.Ref) (protoreflect.MessageDescriptor, bool) {
+	if len(sc.messages) == 0 {
+		return nil, false
+	}
+	desc, ok := ii.descriptors[ref.Package+"."+ref.Schema]
+	if !ok {
+		return nil, false
+	}
+	msg, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, false
+	}
+	parent := sc.messages[len(sc.messages)-1]
+	if msg.Parent().FullName() != parent.FullName() || string(msg.Name()) != strcase.ToCamel(propName) {
+		return nil, false
+	}
+	return msg, true
+}
+
+func (ii *importer) inlineObject(sc scope, propName string, ref *schema_j5pb.Ref) (*schema_j5pb.Object, bool, error) {
+	msg, ok := ii.nestedChild(sc, propName, ref)
+	if !ok {
+		return nil, false, nil
+	}
+	schema, err := ii.rootSchema(msg)
+	if err != nil {
+		return nil, false, err
+	}
+	obj := schema.ToJ5Root().GetObject()
+	if obj == nil {
+		return nil, false, nil
+	}
+	if descriptorDescription(msg) != "" {
+		return nil, false, fmt.Errorf("inline message %s has a description", msg.FullName())
+	}
+	obj.Properties, err = ii.properties(sc.in(msg), msg, obj.Properties, true)
+	if err != nil {
+		return nil, false, err
+	}
+	if err := ii.checkConsumed(msg); err != nil {
+		return nil, false, err
+	}
+	ii.consumed[msg.FullName()] = true
+	return &schema_j5pb.Object{
+		Properties: obj.Properties,
+	}, true, nil
+}
+
+func (ii *importer) inlineOneof(sc scope, propName string, ref *schema_j5pb.Ref) (*schema_j5pb.Oneof, bool, error) {
+	msg, ok := ii.nestedChild(sc, propName, ref)
+	if !ok {
+		return nil, false, nil
+	}
+	schema, err := ii.rootSchema(msg)
+	if err != nil {
+		return nil, false, err
+	}
+	oneof := schema.ToJ5Root().GetOneof()
+	if oneof == nil {
+		return nil, false, nil
+	}
+	if descriptorDescription(msg) != "" {
+		return nil, false, fmt.Errorf("inline message %s has a description", msg.FullName())
+	}
+	oneof.Properties, err = ii.properties(sc.in(msg), msg, oneof.Properties, true)
+	if err != nil {
+		return nil, false, err
+	}
+	if err := ii.checkConsumed(msg); err != nil {
+		return nil, false, err
+	}
+	ii.consumed[msg.FullName()] = true
+	return &schema_j5pb.Oneof{
+		Properties: oneof.Properties,
+	}, true, nil
+}
+
+// inlineEnum returns the enum of the ref when it is nested directly in the
+// innermost message of the scope, which is where j5convert puts the enums
+// declared in a property.
+func (ii *importer) inlineEnum(sc scope, ref *schema_j5pb.Ref) (*schema_j5pb.Enum, bool, error) {
+	if len(sc.messages) == 0 {
+		return nil, false, nil
+	}
+	desc, ok := ii.descriptors[ref.Package+"."+ref.Schema]
+	if !ok {
+		return nil, false, nil
+	}
+	enumDesc, ok := desc.(protoreflect.EnumDescriptor)
+	if !ok || enumDesc.Parent().FullName() != sc.messages[len(sc.messages)-1].FullName() {
+		return nil, false, nil
+	}
+	if ii.consumed[enumDesc.FullName()] {
+		// a second property using the same enum refers to it by name
+		return nil, false, nil
+	}
+	enum, err := ii.enum(enumDesc)
+	if err != nil {
+		return nil, false, err
+	}
+	ii.consumed[enumDesc.FullName()] = true
+	return enum, true, nil
+}
+
+// checkConsumed rejects messages written inline when nested types were not
+// written by one of the properties, as there is nowhere else to put them.
+func (ii *importer) checkConsumed(msg protoreflect.MessageDescriptor) error {
+	nested := msg.Messages()
+	for idx := range nested.Len() {
+		child := nested.Get(idx)
+		if !child.IsMapEntry() && !ii.consumed[child.FullName()] {
+			return fmt.Errorf("nested message %s is not used by a property of the same name", child.FullName())
+		}
+	}
+	enums := msg.Enums()
+	for idx := range enums.Len() {
+		if !ii.consumed[enums.Get(idx).FullName()] {
+			return fmt.Errorf("nested enum %s is not used by a property", enums.Get(idx).FullName())
+		}
+	}
+	return nil
+}
+
+// anonymousObject converts a message which j5convert generates from a list
+// of properties, e.g. a request, the nested types of which are written
+// inline.
+func (ii *importer) anonymousObject(sc scope, msg protoreflect.MessageDescriptor) ([]*schema_j5pb.ObjectProperty, error) {
+	root, err := ii.rootSchema(msg)
+	if err != nil {
+		return nil, err
+	}
+	obj := root.ToJ5Root().GetObject()
+	if obj == nil {
+		return nil, fmt.Errorf("message %s is not an object", msg.FullName())
+	}
+
+	props, err := ii.properties(sc.in(msg), msg, obj.Properties, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ii.checkConsumed(msg); err != nil {
+		return nil, err
+	}
+	return props, nil
+}
@@ -0,0 +1,358 @@
+package j5import
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/pentops/j5/gen/j5/ext/v1/ext_j5pb"
+	"github.com/pentops/j5/gen/j5/list/v1/list_j5pb"
+	"github.com/pentops/j5/gen/j5/messaging/v1/messaging_j5pb"
+	"github.com/pentops/j5/gen/j5/schema/v1/schema_j5pb"
+	"github.com/pentops/j5/gen/j5/sourcedef/v1/sourcedef_j5pb"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const httpBody = "google.api.HttpBody"
+
+// service converts an API service or a topic. Returns nil for the reply side
+// of a request-reply topic, which is converted with the request side.
+func (ii *importer) service(sc scope, service protoreflect.ServiceDescriptor) (*sourcedef_j5pb.RootElement, error) {
+	if config, ok := proto.GetExtension(service.Options(), messaging_j5pb.E_Service).(*messaging_j5pb.ServiceConfig); ok && config != nil {
+		if config.GetReply() != nil {
+			name := strings.TrimSuffix(string(service.Name()), "ReplyTopic")
+			if service.ParentFile().Services().ByName(protoreflect.Name(name+"RequestTopic")) == nil {
+				return nil, fmt.Errorf("reply topic has no %sRequestTopic", name)
+			}
+			return nil, nil
+		}
+		topic, err := ii.topic(sc, service, config)
+		if err != nil {
+			return nil, err
+		}
+		return &sourcedef_j5pb.RootElement{
+			Type: &sourcedef_j5pb.RootElement_Topic{Topic: topic},
+		}, nil
+	}
+
+	name, ok := strings.CutSuffix(string(service.Name()), "Service")
+	if !ok {
+		return nil, fmt.Errorf("service name does not end in Service")
+	}
+	out := &sourcedef_j5pb.Service{
+		Name:        &name,
+		Description: descriptorDescription(service),
+	}
+	if opts, ok := proto.GetExtension(service.Options(), ext_j5pb.E_Service).(*ext_j5pb.ServiceOptions); ok && opts != nil {
+		out.Options = opts
+	}
+
+	methods := service.Methods()
+	for idx := range methods.Len() {
+		desc := methods.Get(idx)
+		method, err := ii.method(sc, desc)
+		if err != nil {
+			return nil, fmt.Errorf("method %s: %w", desc.Name(), err)
+		}
+		out.Methods = append(out.Methods, method)
+	}
+
+	return &sourcedef_j5pb.RootElement{
+		Type: &sourcedef_j5pb.RootElement_Service{Service: out},
+	}, nil
+}
+
+// method converts a service method, with the full path of the method as the
+// http path.
+func (ii *importer) method(sc scope, desc protoreflect.MethodDescriptor) (*sourcedef_j5pb.APIMethod, error) {
+	method := &sourcedef_j5pb.APIMethod{
+		Name:        string(desc.Name()),
+		Description: descriptorDescription(desc),
+	}
+
+	httpMethod, httpPath, err := httpRule(desc)
+	if err != nil {
+		return nil, err
+	}
+	method.HttpMethod = httpMethod
+	method.HttpPath, err = ii.j5sPath(desc.Input(), httpPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var request []*schema_j5pb.ObjectProperty
+	if desc.Input().FullName() == httpBody {
+		method.HttpRequest = true
+	} else {
+		if string(desc.Input().Name()) != method.Name+"Request" {
+			return nil, fmt.Errorf("request message %s should be named %sRequest", desc.Input().FullName(), method.Name)
+		}
+		request, err = ii.anonymousObject(sc, desc.Input())
+		if err != nil {
+			return nil, fmt.Errorf("request: %w", err)
+		}
+	}
+
+	var response []*schema_j5pb.ObjectProperty
+	if desc.Output().FullName() == httpBody {
+		method.HttpResponse = true
+	} else {
+		if string(desc.Output().Name()) != method.Name+"Response" {
+			return nil, fmt.Errorf("response message %s should be named %sResponse", desc.Output().FullName(), method.Name)
+		}
+		response, err = ii.anonymousObject(sc, desc.Output())
+		if err != nil {
+			return nil, fmt.Errorf("response: %w", err)
+		}
+	}
+
+	if last := len(request) - 1; last >= 0 && isListRef(request[last], "query", "QueryRequest") {
+		method.Query = true
+		request = request[:last]
+	}
+	if last := len(request) - 1; last >= 0 && isListRef(request[last], "page", "PageRequest") {
+		if resLast := len(response) - 1; resLast >= 0 && isListRef(response[resLast], "page", "PageResponse") {
+			method.Paged = true
+			request = request[:last]
+			response = response[:resLast]
+		}
+	}
+
+	if len(request) > 0 {
+		method.Request = &sourcedef_j5pb.AnonymousObject{Properties: request}
+	}
+	if !method.HttpResponse {
+		method.Response = &sourcedef_j5pb.AnonymousObject{Properties: response}
+	}
+
+	if opts, ok := proto.GetExtension(desc.Options(), ext_j5pb.E_Method).(*ext_j5pb.MethodOptions); ok && opts != nil {
+		opts = proto.Clone(opts).(*ext_j5pb.MethodOptions)
+		method.Auth = opts.Auth
+		opts.Auth = nil
+		if !proto.Equal(opts, &ext_j5pb.MethodOptions{}) {
+			method.Options = opts
+		}
+	}
+	method.ListRequest = listRequest(desc)
+
+	return method, nil
+}
+
+// isListRef matches the paging and query fields j5convert appends to list
+// methods.
+func isListRef(prop *schema_j5pb.ObjectProperty, name string, schema string) bool {
+	ref := prop.Schema.GetObject().GetRef()
+	return prop.Name == name &&
+		!prop.Required &&
+		prop.Description == "" &&
+		ref != nil && ref.Package == "j5.list.v1" && ref.Schema == schema
+}
+
+// listRequest returns the list options of the request message of the method.
+func listRequest(desc protoreflect.MethodDescriptor) *list_j5pb.ListRequestMessage {
+	listRequest, ok := proto.GetExtension(desc.Input().Options(), list_j5pb.E_ListRequest).(*list_j5pb.ListRequestMessage)
+	if !ok || listRequest == nil {
+		return nil
+	}
+	return listRequest
+}
+
+func httpRule(desc protoreflect.MethodDescriptor) (schema_j5pb.HTTPMethod, string, error) {
+	rule, ok := proto.GetExtension(desc.Options(), annotations.E_Http).(*annotations.HttpRule)
+	if !ok || rule == nil {
+		return 0, "", fmt.Errorf("method %s has no http annotation", desc.FullName())
+	}
+	switch pattern := rule.Pattern.(type) {
+	case *annotations.HttpRule_Get:
+		return schema_j5pb.HTTPMethod_GET, pattern.Get, nil
+	case *annotations.HttpRule_Post:
+		return schema_j5pb.HTTPMethod_POST, pattern.Post, nil
+	case *annotations.HttpRule_Put:
+		return schema_j5pb.HTTPMethod_PUT, pattern.Put, nil
+	case *annotations.HttpRule_Patch:
+		return schema_j5pb.HTTPMethod_PATCH, pattern.Patch, nil
+	case *annotations.HttpRule_Delete:
+		return schema_j5pb.HTTPMethod_DELETE, pattern.Delete, nil
+	default:
+		return 0, "", fmt.Errorf("method %s has unsupported http pattern %T", desc.FullName(), pattern)
+	}
+}
+
+// j5sPath converts path parameters from the proto {field_name} form to the
+// j5s :jsonName form.
+func (ii *importer) j5sPath(input protoreflect.MessageDescriptor, httpPath string) (string, error) {
+	parts := strings.Split(httpPath, "/")
+	for idx, part := range parts {
+		if !strings.HasPrefix(part, "{") {
+			continue
+		}
+		fieldName := strings.TrimSuffix(strings.TrimPrefix(part, "{"), "}")
+		field := input.Fields().ByName(protoreflect.Name(fieldName))
+		if field == nil {
+			return "", fmt.Errorf("path parameter %s is not a field of %s", fieldName, input.FullName())
+		}
+		parts[idx] = ":" + strcase.ToLowerCamel(fieldName)
+	}
+	return strings.Join(parts, "/"), nil
+}
+
+func (ii *importer) topic(sc scope, service protoreflect.ServiceDescriptor, config *messaging_j5pb.ServiceConfig) (*sourcedef_j5pb.Topic, error) {
+	serviceName := string(service.Name())
+	switch {
+	case config.GetPublish() != nil:
+		name := strings.TrimSuffix(serviceName, "Topic")
+		if err := checkTopicName(config, name); err != nil {
+			return nil, err
+		}
+		messages, err := ii.topicMethods(sc, service, name, "")
+		if err != nil {
+			return nil, err
+		}
+		return &sourcedef_j5pb.Topic{
+			Name: name,
+			Type: &sourcedef_j5pb.TopicType{
+				Type: &sourcedef_j5pb.TopicType_Publish_{
+					Publish: &sourcedef_j5pb.TopicType_Publish{Messages: messages},
+				},
+			},
+		}, nil
+
+	case config.GetEvent() != nil:
+		name := strings.TrimSuffix(serviceName, "Topic")
+		if err := checkTopicName(config, name); err != nil {
+			return nil, err
+		}
+		message, err := ii.singleTopicMethod(sc, service, name, "")
+		if err != nil {
+			return nil, err
+		}
+		return &sourcedef_j5pb.Topic{
+			Name: name,
+			Type: &sourcedef_j5pb.TopicType{
+				Type: &sourcedef_j5pb.TopicType_Event_{
+					Event: &sourcedef_j5pb.TopicType_Event{
+						EntityName: config.GetEvent().EntityName,
+						Message:    message,
+					},
+				},
+			},
+		}, nil
+
+	case config.GetUpsert() != nil:
+		name := strings.TrimSuffix(serviceName, "Topic")
+		if err := checkTopicName(config, name); err != nil {
+			return nil, err
+		}
+		message, err := ii.singleTopicMethod(sc, service, name, "upsert")
+		if err != nil {
+			return nil, err
+		}
+		return &sourcedef_j5pb.Topic{
+			Name: name,
+			Type: &sourcedef_j5pb.TopicType{
+				Type: &sourcedef_j5pb.TopicType_Upsert_{
+					Upsert: &sourcedef_j5pb.TopicType_Upsert{
+						EntityName: config.GetUpsert().EntityName,
+						Message:    message,
+					},
+				},
+			},
+		}, nil
+
+	case config.GetRequest() != nil:
+		name, ok := strings.CutSuffix(serviceName, "RequestTopic")
+		if !ok {
+			return nil, fmt.Errorf("request topic name does not end in RequestTopic")
+		}
+		if err := checkTopicName(config, name); err != nil {
+			return nil, err
+		}
+		request, err := ii.topicMethods(sc, service, name+"Request", "request")
+		if err != nil {
+			return nil, err
+		}
+		replyService := service.ParentFile().Services().ByName(protoreflect.Name(name + "ReplyTopic"))
+		if replyService == nil {
+			return nil, fmt.Errorf("no reply topic %sReplyTopic", name)
+		}
+		reply, err := ii.topicMethods(sc, replyService, name+"Reply", "request")
+		if err != nil {
+			return nil, err
+		}
+		return &sourcedef_j5pb.Topic{
+			Name: name,
+			Type: &sourcedef_j5pb.TopicType{
+				Type: &sourcedef_j5pb.TopicType_Reqres{
+					Reqres: &sourcedef_j5pb.TopicType_ReqRes{
+						Request: request,
+						Reply:   reply,
+					},
+				},
+			},
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported topic role %T", config.Role)
+	}
+}
+
+func checkTopicName(config *messaging_j5pb.ServiceConfig, name string) error {
+	if config.GetTopicName() != strcase.ToSnake(name) {
+		return fmt.Errorf("topic name %q would be %q in j5s", config.GetTopicName(), strcase.ToSnake(name))
+	}
+	return nil
+}
+
+func (ii *importer) singleTopicMethod(sc scope, service protoreflect.ServiceDescriptor, name string, prepended string) (*sourcedef_j5pb.TopicMethod, error) {
+	methods, err := ii.topicMethods(sc, service, name, prepended)
+	if err != nil {
+		return nil, err
+	}
+	if len(methods) != 1 {
+		return nil, fmt.Errorf("topic has %d methods, expected one", len(methods))
+	}
+	return methods[0], nil
+}
+
+// topicMethods converts the methods of a topic service. The name is the
+// default method name when there is a single method, and prepended is the
+// name of the field j5convert adds to the start of each message.
+func (ii *importer) topicMethods(sc scope, service protoreflect.ServiceDescriptor, name string, prepended string) ([]*sourcedef_j5pb.TopicMethod, error) {
+	methods := service.Methods()
+	out := make([]*sourcedef_j5pb.TopicMethod, 0, methods.Len())
+	for idx := range methods.Len() {
+		desc := methods.Get(idx)
+		methodName := string(desc.Name())
+		if string(desc.Input().Name()) != methodName+"Message" {
+			return nil, fmt.Errorf("message %s should be named %sMessage", desc.Input().FullName(), methodName)
+		}
+		fields, err := ii.topicFields(sc, desc.Input(), prepended)
+		if err != nil {
+			return nil, fmt.Errorf("method %s: %w", methodName, err)
+		}
+		method := &sourcedef_j5pb.TopicMethod{
+			Fields: fields,
+		}
+		if methods.Len() > 1 || methodName != name {
+			method.Name = &methodName
+		}
+		out = append(out, method)
+	}
+	return out, nil
+}
+
+func (ii *importer) topicFields(sc scope, msg protoreflect.MessageDescriptor, prepended string) ([]*schema_j5pb.ObjectProperty, error) {
+	props, err := ii.anonymousObject(sc, msg)
+	if err != nil {
+		return nil, err
+	}
+	if prepended == "" {
+		return props, nil
+	}
+	if len(props) == 0 || props[0].Name != prepended {
+		return nil, fmt.Errorf("message %s should start with %s", msg.FullName(), prepended)
+	}
+	return props[1:], nil
+}
@@ -0,0 +1,233 @@
+package j5nav
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/pentops/j5/gen/j5/sourcedef/v1/sourcedef_j5pb"
+	"github.com/pentops/j5/internal/bcl"
+	"github.com/pentops/j5/internal/bcl/errpos"
+	"github.com/pentops/j5/internal/j5s/j5convert"
+	"github.com/pentops/j5/internal/j5s/protobuild"
+)
+
+type CompletionKind int
+
+const (
+	CompletionKeyword CompletionKind = iota
+	CompletionProperty
+	CompletionValue
+	CompletionType
+	CompletionStatus
+)
+
+// Completion is a name which is valid at a point in a j5s file.
+type Completion struct {
+	Label         string
+	Kind          CompletionKind
+	Detail        string
+	Documentation string
+}
+
+// AddDependency indexes the exports of a package which is not local, so that
+// its types can be completed.
+func (ix *Index) AddDependency(pkgName string, exports map[string]*j5convert.TypeRef) {
+	if _, ok := ix.packages[pkgName]; ok {
+		return
+	}
+	ix.packages[pkgName] = &j5convert.PackageSummary{
+		Exports: exports,
+	}
+}
+
+// LoadImports adds the exports of the packages imported by the file which are
+// not already indexed. Packages which fail to load are skipped, and returned
+// as a joined error.
+func (ix *Index) LoadImports(ctx context.Context, ps *protobuild.PackageSet, file *sourcedef_j5pb.SourceFile) error {
+	errs := []error{}
+	for _, imp := range file.Imports {
+		imports, err := j5convert.NewFileImports(&sourcedef_j5pb.SourceFile{
+			Package: file.Package,
+			Imports: []*sourcedef_j5pb.Import{imp},
+		})
+		if err != nil {
+			// incomplete import while editing
+			continue
+		}
+		for pkgName := range imports.Aliases() {
+			if _, ok := ix.packages[pkgName]; ok {
+				continue
+			}
+			exports, err := ps.DependencyExports(ctx, pkgName)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("exports of %s: %w", pkgName, err))
+				continue
+			}
+			ix.AddDependency(pkgName, exports)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Complete converts the schema completions of the bcl walk, and adds type
+// names for refs and status names for transitions, which the schema can't
+// list.
+func (ix *Index) Complete(file *sourcedef_j5pb.SourceFile, found *bcl.Completions) []Completion {
+	completions := make([]Completion, 0, len(found.Options))
+	for _, opt := range found.Options {
+		completion := Completion{
+			Label:         opt.Name,
+			Detail:        opt.Detail,
+			Documentation: opt.Description,
+		}
+		if completion.Documentation == "" {
+			completion.Documentation = keywordDoc(found.Block, opt.Name)
+		}
+		switch opt.Kind {
+		case bcl.CompletionBlock:
+			completion.Kind = CompletionKeyword
+		case bcl.CompletionAttribute:
+			completion.Kind = CompletionProperty
+		default:
+			completion.Kind = CompletionValue
+		}
+		completions = append(completions, completion)
+	}
+
+	switch found.Block {
+	case "j5.sourcedef.v1.Transition":
+		switch found.Attribute {
+		case "from":
+			completions = append(completions, Completion{
+				Label:         "any",
+				Kind:          CompletionKeyword,
+				Documentation: "Transition from every status",
+			})
+			completions = append(completions, statusCompletions(file, found.Point)...)
+		case "to":
+			completions = append(completions, Completion{
+				Label:         "keep",
+				Kind:          CompletionKeyword,
+				Documentation: "Keep the status of the entity",
+			})
+			completions = append(completions, statusCompletions(file, found.Point)...)
+		}
+
+	case "j5.sourcedef.v1.EntityQuery":
+		if found.Attribute == "defaultStatusFilter" {
+			completions = append(completions, statusCompletions(file, found.Point)...)
+		}
+	}
+
+	if found.Schema == refSchema {
+		if kind, ok := refKinds[found.Block]; ok {
+			completions = append(completions, ix.typeCompletions(file, kind)...)
+		}
+	}
+
+	return completions
+}
+
+const refSchema = "object(j5.schema.v1.Ref)"
+
+// refKinds is the kind of type referenced by the ref field of each field
+// schema.
+var refKinds = map[string]string{
+	"j5.schema.v1.ObjectField":    "object",
+	"j5.schema.v1.OneofField":     "oneof",
+	"j5.schema.v1.EnumField":      "enum",
+	"j5.schema.v1.PolymorphField": "polymorph",
+}
+
+func typeRefKind(ref *j5convert.TypeRef) string {
+	switch {
+	case ref.Object != nil:
+		return "object"
+	case ref.Oneof != nil:
+		return "oneof"
+	case ref.Enum != nil:
+		return "enum"
+	case ref.Polymorph != nil:
+		return "polymorph"
+	default:
+		return ""
+	}
+}
+
+// typeCompletions lists the types of the kind in the file's package and the
+// packages it imports, named as they would be written in the file.
+func (ix *Index) typeCompletions(file *sourcedef_j5pb.SourceFile, kind string) []Completion {
+	qualifiers := map[string]string{}
+	if file.Package != nil {
+		qualifiers[file.Package.Name] = ""
+	}
+	if imports, err := j5convert.NewFileImports(file); err == nil {
+		for pkgName, alias := range imports.Aliases() {
+			if _, ok := qualifiers[pkgName]; !ok {
+				qualifiers[pkgName] = alias + "."
+			}
+		}
+	}
+
+	completions := []Completion{}
+	for pkgName, qualifier := range qualifiers {
+		pkg, ok := ix.packages[pkgName]
+		if !ok {
+			continue
+		}
+		for name, export := range pkg.Exports {
+			if typeRefKind(export) != kind {
+				continue
+			}
+			completion := Completion{
+				Label:  qualifier + name,
+				Kind:   CompletionType,
+				Detail: fmt.Sprintf("%s %s.%s", kind, pkgName, name),
+			}
+			if def, ok := ix.Definition(pkgName, name); ok {
+				completion.Documentation = def.Description
+			}
+			completions = append(completions, completion)
+		}
+	}
+
+	sort.Slice(completions, func(i, j int) bool {
+		return completions[i].Label < completions[j].Label
+	})
+	return completions
+}
+
+// statusCompletions lists the statuses of the entity at the point. Source
+// locations of elements cover only the header, so the entity is the last
+// element which starts before the point.
+func statusCompletions(file *sourcedef_j5pb.SourceFile, pt errpos.Point) []Completion {
+	locs := file.SourceLocations.GetChildren()["elements"]
+	var entity *sourcedef_j5pb.Entity
+	for idx, element := range file.Elements {
+		loc := locs.GetChildren()[fmt.Sprint(idx)]
+		if loc == nil {
+			continue
+		}
+		start := errpos.Point{Line: int(loc.StartLine), Column: int(loc.StartColumn)}
+		if pointBefore(pt, start) {
+			break
+		}
+		entity = element.GetEntity()
+	}
+	if entity == nil {
+		return nil
+	}
+
+	completions := make([]Completion, 0, len(entity.Status))
+	for _, status := range entity.Status {
+		completions = append(completions, Completion{
+			Label:         status.Name,
+			Kind:          CompletionStatus,
+			Detail:        fmt.Sprintf("status of %s", entity.Name),
+			Documentation: status.Description,
+		})
+	}
+	return completions
+}
@@ -0,0 +1,85 @@
+package j5nav
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pentops/j5/internal/j5s/j5parse"
+	"github.com/stretchr/testify/assert"
+)
+
+// testComplete completes the source at the $ marker, with the index of the
+// other files.
+func testComplete(t *testing.T, ix *Index, src string) map[string]Completion {
+	t.Helper()
+	pt := pointOf(t, src, "$", 0)
+	src = strings.Replace(src, "$", "", 1)
+
+	parser, err := j5parse.NewParser()
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, found, err := parser.Complete("foo/v1/edit.j5s", src, pt)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	byLabel := map[string]Completion{}
+	for _, completion := range ix.Complete(file, found) {
+		byLabel[completion.Label] = completion
+	}
+	return byLabel
+}
+
+func TestComplete(t *testing.T) {
+	ix := testIndex(t, map[string]string{
+		"foo/v1/foo.j5s": fooSrc,
+		"bar/v1/bar.j5s": barSrc,
+	})
+
+	t.Run("Object Body", func(t *testing.T) {
+		got := testComplete(t, ix, "package foo.v1\n\nobject Edit {\n\t$\n}\n")
+		assert.Contains(t, got, "field")
+		assert.Equal(t, CompletionKeyword, got["field"].Kind)
+		assert.NotEmpty(t, got["field"].Documentation)
+		assert.NotContains(t, got, "name", "set by the block header")
+		assert.NotContains(t, got, "properties", "reached by the field alias")
+	})
+
+	t.Run("Entity Body", func(t *testing.T) {
+		got := testComplete(t, ix, "package foo.v1\n\nentity Edit {\n\t$\n}\n")
+		for _, keyword := range []string{"key", "data", "status", "event", "query"} {
+			assert.Contains(t, got, keyword)
+		}
+	})
+
+	t.Run("Key Format", func(t *testing.T) {
+		got := testComplete(t, ix, "package foo.v1\n\nobject Edit {\n\tfield id key:$\n}\n")
+		assert.Contains(t, got, "id62")
+		assert.Contains(t, got, "uuid")
+		assert.NotEmpty(t, got["id62"].Documentation)
+	})
+
+	t.Run("Types", func(t *testing.T) {
+		src := "package foo.v1\n\nimport bar.v1:baz\n\nobject Edit {\n\tfield a object:$\n}\n"
+		got := testComplete(t, ix, src)
+		assert.Contains(t, got, "Foo")
+		assert.Contains(t, got, "Foo.Nested")
+		assert.Contains(t, got, "baz.Bar")
+		assert.Equal(t, "Bar description", got["baz.Bar"].Documentation)
+		assert.NotContains(t, got, "Status", "enum is not an object")
+
+		got = testComplete(t, ix, "package foo.v1\n\nobject Edit {\n\tfield a enum:$\n}\n")
+		assert.Contains(t, got, "Status")
+		assert.NotContains(t, got, "Foo")
+	})
+
+	t.Run("Transition Statuses", func(t *testing.T) {
+		src := "package foo.v1\n\nentity Edit {\n\tstatus ACTIVE {\n\t\t| Active description\n\t}\n\tstatus ARCHIVED\n\n\tevent Archived {\n\t\ttransition {\n\t\t\tfrom = $\n\t\t}\n\t}\n}\n"
+		got := testComplete(t, ix, src)
+		assert.Contains(t, got, "any")
+		assert.Contains(t, got, "ARCHIVED")
+		assert.Equal(t, CompletionStatus, got["ACTIVE"].Kind)
+		assert.Equal(t, "Active description", got["ACTIVE"].Documentation)
+	})
+}
@@ -0,0 +1,86 @@
+package j5nav
+
+// keywordDocs documents the common names of the j5s schema, by block schema
+// and name. The generated descriptors carry no comments, so the schema
+// descriptions are usually empty.
+var keywordDocs = map[string]map[string]string{
+	"j5.sourcedef.v1.SourceFile": {
+		"package":      "The package of the file, which must match the directory",
+		"import":       "Imports a package, `import foo.v1` or with an alias `import foo.v1:bar`",
+		"object":       "An object schema, with named properties",
+		"oneof":        "A schema where exactly one of the properties is set",
+		"enum":         "A set of named options",
+		"polymorph":    "A schema which holds any of the listed object types",
+		"entity":       "A state machine entity, with keys, data, statuses and events",
+		"service":      "A set of API methods under a base path",
+		"topic":        "A set of messages published and subscribed by services",
+		"stringFormat": "A named pattern for string fields",
+		"path":         "The base path for the services in the file",
+	},
+	"j5.sourcedef.v1.Object": {
+		"field":           "A property of the object, `field name type`",
+		"object":          "An object nested in the scope of this object",
+		"oneof":           "A oneof nested in the scope of this object",
+		"enum":            "An enum nested in the scope of this object",
+		"entity":          "Marks the object as part of an entity",
+		"polymorphMember": "Adds the object to a polymorph",
+		"rule":            "A CEL rule over the properties of the object",
+	},
+	"j5.sourcedef.v1.Oneof": {
+		"option": "An option of the oneof, `option name type`",
+	},
+	"j5.sourcedef.v1.Entity": {
+		"key":     "A key of the entity, the primary key when marked with `primary = true`",
+		"data":    "A property of the entity's state data",
+		"status":  "A status of the entity's state machine",
+		"event":   "An event which transitions the entity",
+		"query":   "Options for the generated query service",
+		"command": "A command service for the entity",
+		"summary": "A summary of the entity, published with each event",
+		"object":  "An object nested in the scope of the entity",
+		"oneof":   "A oneof nested in the scope of the entity",
+		"enum":    "An enum nested in the scope of the entity",
+	},
+	"j5.sourcedef.v1.Event": {
+		"field":      "A property of the event",
+		"transition": "A status transition, `from` a status `to` a status",
+	},
+	"j5.sourcedef.v1.Transition": {
+		"from": "The status before the event, or `any`",
+		"to":   "The status after the event, or `keep`",
+	},
+	"j5.sourcedef.v1.EntityQuery": {
+		"eventsInGet":         "Include events in the query API's GET response",
+		"defaultStatusFilter": "Statuses listed when the request sets no filter",
+		"auth":                "The authorization of the query methods",
+	},
+	"j5.schema.v1.Field": {
+		"any":       "Any message type, encoded with its type name",
+		"array":     "A repeated field, `array:string`",
+		"bool":      "A boolean",
+		"bytes":     "Binary data, base64 encoded in JSON",
+		"date":      "A calendar date without a time",
+		"decimal":   "A decimal number, encoded as a string",
+		"enum":      "A reference to an enum, `enum:Status`",
+		"float":     "A floating point number, `float:FLOAT64`",
+		"integer":   "An integer number, `integer:INT64`",
+		"key":       "A key for an entity or external system, `key:id62`",
+		"map":       "A map with string keys, `map:string`",
+		"object":    "A reference to an object, `object:Foo`",
+		"oneof":     "A reference to a oneof, `oneof:Foo`",
+		"polymorph": "A reference to a polymorph, `polymorph:Foo`",
+		"string":    "A string",
+		"timestamp": "A point in time",
+	},
+	"j5.schema.v1.KeyFormat": {
+		"id62":     "A 22 character base62 ID",
+		"uuid":     "A UUID in the canonical string form",
+		"informal": "Any string, not validated",
+		"custom":   "A string matching a custom pattern",
+		"named":    "The format of a named key type, `named:foo.v1.FooID`",
+	},
+}
+
+func keywordDoc(block, name string) string {
+	return keywordDocs[block][name]
+}
@@ -0,0 +1,329 @@
+// Package j5nav indexes the definitions and references of types in j5s source
+// files, for editor navigation.
+package j5nav
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/bufbuild/protocompile/parser"
+	"github.com/pentops/j5/gen/j5/bcl/v1/bcl_j5pb"
+	"github.com/pentops/j5/gen/j5/sourcedef/v1/sourcedef_j5pb"
+	"github.com/pentops/j5/internal/bcl/errpos"
+	"github.com/pentops/j5/internal/j5s/j5convert"
+	"github.com/pentops/j5/internal/j5s/protobuild"
+)
+
+// Span is a range in a source file. Lines and columns are zero based, and the
+// end is inclusive, as in bcl_j5pb.SourceLocation.
+type Span struct {
+	Filename string
+	Start    errpos.Point
+	End      errpos.Point
+}
+
+func (s Span) Contains(pt errpos.Point) bool {
+	return !pointBefore(pt, s.Start) && !pointBefore(s.End, pt)
+}
+
+func (s Span) String() string {
+	return fmt.Sprintf("%s:%s", s.Filename, s.Start)
+}
+
+func pointBefore(a, b errpos.Point) bool {
+	return a.Line < b.Line || (a.Line == b.Line && a.Column < b.Column)
+}
+
+func spanOf(filename string, loc *bcl_j5pb.SourceLocation) Span {
+	return Span{
+		Filename: filename,
+		Start:    errpos.Point{Line: int(loc.StartLine), Column: int(loc.StartColumn)},
+		End:      errpos.Point{Line: int(loc.EndLine), Column: int(loc.EndColumn)},
+	}
+}
+
+// Definition is a type declared in a source file.
+type Definition struct {
+	Package     string
+	Name        string // the name in the package, e.g. Foo.Bar for nested types
+	Kind        string // object, oneof, enum or polymorph
+	Description string
+
+	// Span is the name of the type, or the declaring block when the name is
+	// implicit, e.g. types generated by an entity.
+	Span Span
+}
+
+func (def *Definition) FullName() string {
+	return def.Package + "." + def.Name
+}
+
+// Reference is the use of a type by name.
+type Reference struct {
+	Package string // the full package name, after resolving imports
+	Name    string
+	Span    Span
+}
+
+// Property is a field of an object or oneof, for hover information.
+type Property struct {
+	Name        string
+	Description string
+	Type        string // e.g. array:object:foo.v1.Bar
+	Span        Span
+}
+
+// Symbol is the element at a point in a file.
+type Symbol struct {
+	// Definition is the type declared or referenced at the point, nil when a
+	// reference can't be resolved.
+	Definition *Definition
+
+	// Reference is set when the point is on a reference to a type.
+	Reference *Reference
+
+	// Property is set when the point is on the name of a property.
+	Property *Property
+}
+
+// Index holds the definitions and references of a set of source files,
+// usually the local packages of a bundle.
+type Index struct {
+	files     map[string]*fileIndex
+	packages  map[string]*j5convert.PackageSummary
+	protoRefs map[string][]protoRef
+}
+
+func NewIndex() *Index {
+	return &Index{
+		files:     map[string]*fileIndex{},
+		packages:  map[string]*j5convert.PackageSummary{},
+		protoRefs: map[string][]protoRef{},
+	}
+}
+
+// Clone copies the index, so that files can be added to the copy without
+// changing the original.
+func (ix *Index) Clone() *Index {
+	clone := &Index{
+		files:     maps.Clone(ix.files),
+		packages:  make(map[string]*j5convert.PackageSummary, len(ix.packages)),
+		protoRefs: maps.Clone(ix.protoRefs),
+	}
+	for name, pkg := range ix.packages {
+		clone.packages[name] = &j5convert.PackageSummary{
+			Files:   slices.Clone(pkg.Files),
+			Exports: maps.Clone(pkg.Exports),
+		}
+	}
+	return clone
+}
+
+// LoadPackages indexes the source files of the local packages of the package
+// set. Proto files are indexed only for their references to types.
+func LoadPackages(ctx context.Context, ps *protobuild.PackageSet) (*Index, error) {
+	ix := NewIndex()
+	for _, pkgName := range ps.ListLocalPackages() {
+		files, err := ps.LocalSourceFiles(ctx, pkgName)
+		if err != nil {
+			return nil, fmt.Errorf("source files for %s: %w", pkgName, err)
+		}
+		for _, file := range files {
+			if file.ProtoFile != nil {
+				if err := ix.AddProtoFile(*file.ProtoFile); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			if file.J5File == nil {
+				continue
+			}
+			if err := ix.addFile(file.J5File, file.Summary); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return ix, nil
+}
+
+// AddFile indexes a parsed source file, replacing any previous version of the
+// file with the same path.
+func (ix *Index) AddFile(file *sourcedef_j5pb.SourceFile) error {
+	summary, err := j5convert.SourceSummary(file, ignoreWarnings{})
+	if err != nil {
+		return err
+	}
+	return ix.addFile(file, summary)
+}
+
+// AddProtoFile indexes the type references of a parsed proto source file.
+func (ix *Index) AddProtoFile(result parser.Result) error {
+	refs, err := indexProtoFile(result)
+	if err != nil {
+		return fmt.Errorf("index %s: %w", result.AST().Name(), err)
+	}
+	ix.protoRefs[result.AST().Name()] = refs
+	return nil
+}
+
+type ignoreWarnings struct{}
+
+func (ignoreWarnings) WarnPos(*errpos.Position, error) {}
+
+func (ix *Index) addFile(file *sourcedef_j5pb.SourceFile, summary *j5convert.FileSummary) error {
+	indexed, err := indexFile(file)
+	if err != nil {
+		return fmt.Errorf("index %s: %w", file.Path, err)
+	}
+
+	if previous, ok := ix.files[file.Path]; ok {
+		pkg := ix.packages[previous.summary.Package]
+		for idx, fileSummary := range pkg.Files {
+			if fileSummary.SourceFilename == file.Path {
+				pkg.Files = append(pkg.Files[:idx], pkg.Files[idx+1:]...)
+				break
+			}
+		}
+		for name := range previous.summary.Exports {
+			delete(pkg.Exports, name)
+		}
+	}
+
+	indexed.summary = summary
+	ix.files[file.Path] = indexed
+
+	pkg, ok := ix.packages[summary.Package]
+	if !ok {
+		pkg = &j5convert.PackageSummary{
+			Exports: map[string]*j5convert.TypeRef{},
+		}
+		ix.packages[summary.Package] = pkg
+	}
+	pkg.Files = append(pkg.Files, summary)
+	for name, export := range summary.Exports {
+		pkg.Exports[name] = export
+	}
+	return nil
+}
+
+// Definition returns the declaration of a type in the indexed files.
+func (ix *Index) Definition(pkgName, name string) (*Definition, bool) {
+	pkg, ok := ix.packages[pkgName]
+	if !ok {
+		return nil, false
+	}
+	if _, ok := pkg.Exports[name]; !ok {
+		return nil, false
+	}
+	for _, fileSummary := range pkg.Files {
+		if _, ok := fileSummary.Exports[name]; !ok {
+			continue
+		}
+		file, ok := ix.files[fileSummary.SourceFilename]
+		if !ok {
+			continue
+		}
+		if def, ok := file.definitions[name]; ok {
+			return def, true
+		}
+	}
+	return nil, false
+}
+
+// References returns every reference to the type in the indexed files,
+// including proto files, ordered by file and position.
+func (ix *Index) References(pkgName, name string) []*Reference {
+	refs := []*Reference{}
+	for _, file := range ix.files {
+		for _, ref := range file.refs {
+			if ref.Package == pkgName && ref.Name == name {
+				refs = append(refs, ref)
+			}
+		}
+	}
+	for _, protoRefs := range ix.protoRefs {
+		for _, protoRef := range protoRefs {
+			ref, ok := ix.resolveProtoRef(protoRef)
+			if ok && ref.Package == pkgName && ref.Name == name {
+				refs = append(refs, ref)
+			}
+		}
+	}
+	sort.Slice(refs, func(i, j int) bool {
+		a, b := refs[i].Span, refs[j].Span
+		if a.Filename != b.Filename {
+			return a.Filename < b.Filename
+		}
+		return pointBefore(a.Start, b.Start)
+	})
+	return refs
+}
+
+// At returns the symbol at the point in the file. References and property
+// names take precedence over the declarations which contain them.
+func (ix *Index) At(filename string, pt errpos.Point) (*Symbol, bool) {
+	file, ok := ix.files[filename]
+	if !ok {
+		return nil, false
+	}
+
+	for _, ref := range file.refs {
+		if ref.Span.Contains(pt) {
+			def, _ := ix.Definition(ref.Package, ref.Name)
+			return &Symbol{
+				Reference:  ref,
+				Definition: def,
+			}, true
+		}
+	}
+
+	for _, prop := range file.properties {
+		if prop.Span.Contains(pt) {
+			return &Symbol{
+				Property: prop,
+			}, true
+		}
+	}
+
+	for name, def := range file.definitions {
+		if !file.virtual[name] && def.Span.Contains(pt) {
+			return &Symbol{
+				Definition: def,
+			}, true
+		}
+	}
+
+	return nil, false
+}
+
+// Hover returns markdown describing the symbol.
+func (sym *Symbol) Hover() string {
+	lines := []string{}
+	if sym.Property != nil {
+		lines = append(lines, fmt.Sprintf("```j5s\nfield %s %s\n```", sym.Property.Name, sym.Property.Type))
+		if sym.Property.Description != "" {
+			lines = append(lines, sym.Property.Description)
+		}
+		return strings.Join(lines, "\n\n")
+	}
+
+	if sym.Definition != nil {
+		def := sym.Definition
+		lines = append(lines, fmt.Sprintf("```j5s\n%s %s\n```", def.Kind, def.FullName()))
+		if def.Description != "" {
+			lines = append(lines, def.Description)
+		}
+		return strings.Join(lines, "\n\n")
+	}
+
+	if sym.Reference != nil {
+		ref := sym.Reference
+		lines = append(lines, fmt.Sprintf("```j5s\n%s.%s\n```", ref.Package, ref.Name))
+		lines = append(lines, "Not defined in a local package")
+	}
+	return strings.Join(lines, "\n\n")
+}
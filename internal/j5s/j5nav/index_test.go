@@ -0,0 +1,169 @@
+package j5nav
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pentops/j5/internal/bcl/errpos"
+	"github.com/pentops/j5/internal/j5s/j5parse"
+	"github.com/stretchr/testify/assert"
+)
+
+// pointOf returns the point of the first occurrence of marker in the source,
+// offset by the given number of characters.
+func pointOf(t *testing.T, src string, marker string, offset int) errpos.Point {
+	t.Helper()
+	idx := strings.Index(src, marker)
+	if idx < 0 {
+		t.Fatalf("marker %q not found", marker)
+	}
+	idx += offset
+	line := strings.Count(src[:idx], "\n")
+	col := idx - (strings.LastIndex(src[:idx], "\n") + 1)
+	return errpos.Point{Line: line, Column: col}
+}
+
+func testIndex(t *testing.T, files map[string]string) *Index {
+	t.Helper()
+	ix := NewIndex()
+	for filename, src := range files {
+		file, err := j5parse.ParseFile(filename, src)
+		if err != nil {
+			t.Fatalf("parse %s: %s", filename, err)
+		}
+		if err := ix.AddFile(file); err != nil {
+			t.Fatalf("index %s: %s", filename, err)
+		}
+	}
+	return ix
+}
+
+const fooSrc = `package foo.v1
+
+import bar.v1:baz
+
+object Foo {
+	| Foo description
+	field a object:baz.Bar
+	field b array:enum:Status {
+		| B description
+	}
+
+	object Nested {
+		field c string
+	}
+
+	field d object:Nested
+}
+
+enum Status {
+	option ACTIVE
+}
+`
+
+const barSrc = `package bar.v1
+
+object Bar {
+	| Bar description
+	field id string
+}
+
+object Other {
+	field bar object:Bar
+}
+`
+
+func TestNavigation(t *testing.T) {
+	ix := testIndex(t, map[string]string{
+		"foo/v1/foo.j5s": fooSrc,
+		"bar/v1/bar.j5s": barSrc,
+	})
+
+	t.Run("Reference Across Packages", func(t *testing.T) {
+		sym, ok := ix.At("foo/v1/foo.j5s", pointOf(t, fooSrc, "baz.Bar", 5))
+		if !ok {
+			t.Fatal("no symbol")
+		}
+		if sym.Reference == nil || sym.Definition == nil {
+			t.Fatalf("expected a resolved reference, got %+v", sym)
+		}
+		assert.Equal(t, "bar.v1.Bar", sym.Definition.FullName())
+		assert.Equal(t, "bar/v1/bar.j5s", sym.Definition.Span.Filename)
+		assert.Equal(t, pointOf(t, barSrc, "Bar {", 0), sym.Definition.Span.Start)
+		assert.Contains(t, sym.Hover(), "object bar.v1.Bar")
+		assert.Contains(t, sym.Hover(), "Bar description")
+	})
+
+	t.Run("Nested Scope", func(t *testing.T) {
+		sym, ok := ix.At("foo/v1/foo.j5s", pointOf(t, fooSrc, "object:Nested", 8))
+		if !ok || sym.Definition == nil {
+			t.Fatal("no definition")
+		}
+		assert.Equal(t, "Foo.Nested", sym.Definition.Name)
+	})
+
+	t.Run("References", func(t *testing.T) {
+		sym, ok := ix.At("bar/v1/bar.j5s", pointOf(t, barSrc, "Bar {", 1))
+		if !ok || sym.Definition == nil {
+			t.Fatal("no definition")
+		}
+		refs := ix.References(sym.Definition.Package, sym.Definition.Name)
+		files := []string{}
+		for _, ref := range refs {
+			files = append(files, ref.Span.String())
+		}
+		assert.Equal(t, []string{
+			"bar/v1/bar.j5s:9:19",
+			"foo/v1/foo.j5s:7:17",
+		}, files)
+	})
+
+	t.Run("Property Hover", func(t *testing.T) {
+		sym, ok := ix.At("foo/v1/foo.j5s", pointOf(t, fooSrc, "b array", 0))
+		if !ok || sym.Property == nil {
+			t.Fatal("no property")
+		}
+		assert.Equal(t, "array:enum:foo.v1.Status", sym.Property.Type)
+		assert.Contains(t, sym.Hover(), "B description")
+	})
+
+	t.Run("Replace File", func(t *testing.T) {
+		ix := testIndex(t, map[string]string{
+			"foo/v1/foo.j5s": fooSrc,
+			"bar/v1/bar.j5s": barSrc,
+		})
+		file, err := j5parse.ParseFile("bar/v1/bar.j5s", "package bar.v1\n\nobject Other {\n\tfield id string\n}\n")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := ix.AddFile(file); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := ix.Definition("bar.v1", "Bar"); ok {
+			t.Error("expected Bar to be removed")
+		}
+		assert.Len(t, ix.References("bar.v1", "Bar"), 1)
+	})
+
+	t.Run("Clone", func(t *testing.T) {
+		ix := testIndex(t, map[string]string{
+			"foo/v1/foo.j5s": fooSrc,
+			"bar/v1/bar.j5s": barSrc,
+		})
+		clone := ix.Clone()
+		file, err := j5parse.ParseFile("bar/v1/bar.j5s", "package bar.v1\n\nobject Other {\n\tfield id string\n}\n")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := clone.AddFile(file); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := clone.Definition("bar.v1", "Bar"); ok {
+			t.Error("expected Bar to be removed from the clone")
+		}
+		if _, ok := ix.Definition("bar.v1", "Bar"); !ok {
+			t.Error("expected Bar to remain in the original")
+		}
+		assert.Len(t, ix.References("bar.v1", "Bar"), 2)
+	})
+}
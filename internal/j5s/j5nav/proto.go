@@ -0,0 +1,151 @@
+package j5nav
+
+import (
+	"strings"
+
+	"github.com/bufbuild/protocompile/ast"
+	"github.com/bufbuild/protocompile/parser"
+	"github.com/pentops/j5/internal/bcl/errpos"
+)
+
+// protoRef is a type name in a proto source file, resolved once all files are
+// indexed, as proto names are relative to the scope they are written in.
+type protoRef struct {
+	ident  string
+	scopes []string // innermost first
+	span   Span
+}
+
+// indexProtoFile collects the type names used by fields and methods of a
+// local proto file, which may refer to types declared in j5s files, e.g.
+// through an import of foo/v1/foo.j5s.proto.
+func indexProtoFile(result parser.Result) ([]protoRef, error) {
+	root := result.AST()
+	filename := root.Name()
+
+	var pkgName string
+	messages := []string{}
+	refs := []protoRef{}
+
+	addRef := func(node ast.IdentValueNode) {
+		if node == nil {
+			return
+		}
+		ident := string(node.AsIdentifier())
+		info := root.NodeInfo(node)
+		start, end := info.Start(), info.End()
+
+		scope := pkgName
+		if len(messages) > 0 {
+			scope = strings.Join(append([]string{pkgName}, messages...), ".")
+		}
+		refs = append(refs, protoRef{
+			ident:  ident,
+			scopes: protoScopes(scope),
+			span: Span{
+				Filename: filename,
+				Start:    errpos.Point{Line: start.Line - 1, Column: start.Col - 1},
+				End:      errpos.Point{Line: end.Line - 1, Column: end.Col - 2},
+			},
+		})
+	}
+
+	visitor := &ast.SimpleVisitor{
+		DoVisitPackageNode: func(node *ast.PackageNode) error {
+			pkgName = string(node.Name.AsIdentifier())
+			return nil
+		},
+		DoVisitFieldNode: func(node *ast.FieldNode) error {
+			addRef(node.FldType)
+			return nil
+		},
+		DoVisitMapTypeNode: func(node *ast.MapTypeNode) error {
+			addRef(node.ValueType)
+			return nil
+		},
+		DoVisitRPCTypeNode: func(node *ast.RPCTypeNode) error {
+			addRef(node.MessageType)
+			return nil
+		},
+	}
+
+	err := ast.Walk(root, visitor, ast.WithBefore(func(node ast.Node) error {
+		if msg, ok := node.(*ast.MessageNode); ok {
+			messages = append(messages, string(msg.Name.AsIdentifier()))
+		}
+		return nil
+	}), ast.WithAfter(func(node ast.Node) error {
+		if _, ok := node.(*ast.MessageNode); ok {
+			messages = messages[:len(messages)-1]
+		}
+		return nil
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return refs, nil
+}
+
+// protoScopes lists the scopes a name is searched in, from the innermost to
+// the root, e.g. foo.v1.Msg, foo.v1, foo, "".
+func protoScopes(scope string) []string {
+	scopes := []string{}
+	for scope != "" {
+		scopes = append(scopes, scope)
+		idx := strings.LastIndex(scope, ".")
+		if idx < 0 {
+			break
+		}
+		scope = scope[:idx]
+	}
+	return append(scopes, "")
+}
+
+// resolveProtoRef finds the indexed type the name refers to, searching the
+// scopes of the name as protoc does.
+func (ix *Index) resolveProtoRef(ref protoRef) (*Reference, bool) {
+	candidates := []string{}
+	if strings.HasPrefix(ref.ident, ".") {
+		candidates = append(candidates, ref.ident[1:])
+	} else {
+		for _, scope := range ref.scopes {
+			if scope == "" {
+				candidates = append(candidates, ref.ident)
+			} else {
+				candidates = append(candidates, scope+"."+ref.ident)
+			}
+		}
+	}
+
+	for _, fullName := range candidates {
+		pkgName, name, ok := ix.splitFullName(fullName)
+		if !ok {
+			continue
+		}
+		return &Reference{
+			Package: pkgName,
+			Name:    name,
+			Span:    ref.span,
+		}, true
+	}
+	return nil, false
+}
+
+// splitFullName splits a full type name into an indexed package and the name
+// of an export of that package.
+func (ix *Index) splitFullName(fullName string) (string, string, bool) {
+	for idx := strings.Index(fullName, "."); idx > 0; {
+		pkgName, name := fullName[:idx], fullName[idx+1:]
+		if pkg, ok := ix.packages[pkgName]; ok {
+			if _, ok := pkg.Exports[name]; ok {
+				return pkgName, name, true
+			}
+		}
+		next := strings.Index(fullName[idx+1:], ".")
+		if next < 0 {
+			break
+		}
+		idx += next + 1
+	}
+	return "", "", false
+}
@@ -0,0 +1,423 @@
+package j5nav
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/pentops/j5/internal/bcl/errpos"
+)
+
+// Edit replaces the text of a span, a single line in all edits from Rename.
+type Edit struct {
+	Span    Span
+	NewText string
+}
+
+// RenameTarget is a declared symbol which can be renamed.
+type RenameTarget struct {
+	Kind    string // object, oneof, enum, polymorph, entity or value
+	Package string
+
+	// Name is the name in the package, e.g. Foo.Bar for nested types. For
+	// values the name of the enum comes first, e.g. FooStatus.ACTIVE.
+	Name string
+
+	// Span is the name in the declaration.
+	Span Span
+
+	// entity declares the statuses of a value target.
+	entity *entityIndex
+}
+
+func (rt *RenameTarget) FullName() string {
+	return rt.Package + "." + rt.Name
+}
+
+// SourceReader reads the content of an indexed file, for edits in values
+// which have no location of their own.
+type SourceReader func(filename string) (string, error)
+
+var (
+	typeNamePattern  = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+	valueNamePattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+)
+
+// RenameTargetAt returns the declaration at or referenced at the point.
+func (ix *Index) RenameTargetAt(filename string, pt errpos.Point) (*RenameTarget, error) {
+	file, ok := ix.files[filename]
+	if !ok {
+		return nil, fmt.Errorf("file %s not indexed", filename)
+	}
+	pkgName := file.summary.Package
+
+	for _, ent := range file.entities {
+		if ent.Span.Contains(pt) {
+			return &RenameTarget{
+				Kind:    "entity",
+				Package: pkgName,
+				Name:    ent.Name,
+				Span:    ent.Span,
+			}, nil
+		}
+	}
+
+	for enumName, values := range file.enumValues {
+		for _, value := range values {
+			if value.Span.Contains(pt) {
+				return ix.valueTarget(file, pkgName, enumName, value), nil
+			}
+		}
+	}
+
+	for _, ent := range file.entities {
+		for _, transition := range ent.Transitions {
+			if !transition.Span.Contains(pt) {
+				continue
+			}
+			enumName := strcase.ToCamel(ent.Name) + "Status"
+			for _, value := range file.enumValues[enumName] {
+				if value.Name == transition.Name {
+					return ix.valueTarget(file, pkgName, enumName, value), nil
+				}
+			}
+		}
+	}
+
+	sym, ok := ix.At(filename, pt)
+	if !ok || sym.Definition == nil {
+		return nil, fmt.Errorf("no symbol to rename at %s", pt)
+	}
+	return ix.typeTarget(sym.Definition)
+}
+
+// FindRenameTarget returns the declaration of a full name, e.g. foo.v1.Foo
+// for a type or entity, or foo.v1.FooStatus.ACTIVE for a value.
+func (ix *Index) FindRenameTarget(fullName string) (*RenameTarget, error) {
+	for _, file := range ix.files {
+		pkgName := file.summary.Package
+		name, ok := strings.CutPrefix(fullName, pkgName+".")
+		if !ok {
+			continue
+		}
+
+		if ent, ok := file.entities[name]; ok {
+			return &RenameTarget{
+				Kind:    "entity",
+				Package: pkgName,
+				Name:    ent.Name,
+				Span:    ent.Span,
+			}, nil
+		}
+
+		if def, ok := file.definitions[name]; ok {
+			return ix.typeTarget(def)
+		}
+
+		idx := strings.LastIndex(name, ".")
+		if idx < 0 {
+			continue
+		}
+		enumName, valueName := name[:idx], name[idx+1:]
+		for _, value := range file.enumValues[enumName] {
+			if value.Name == valueName {
+				return ix.valueTarget(file, pkgName, enumName, value), nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("symbol %s not found in local packages", fullName)
+}
+
+func (ix *Index) typeTarget(def *Definition) (*RenameTarget, error) {
+	file, ok := ix.files[def.Span.Filename]
+	if !ok {
+		return nil, fmt.Errorf("file %s not indexed", def.Span.Filename)
+	}
+	if file.virtual[def.Name] {
+		for _, ent := range file.entities {
+			if ent.Header.Contains(def.Span.Start) {
+				return nil, fmt.Errorf("%s is generated by entity %s, rename the entity", def.FullName(), ent.Name)
+			}
+		}
+		return nil, fmt.Errorf("%s is generated, and can't be renamed", def.FullName())
+	}
+	return &RenameTarget{
+		Kind:    def.Kind,
+		Package: def.Package,
+		Name:    def.Name,
+		Span:    def.Span,
+	}, nil
+}
+
+func (ix *Index) valueTarget(file *fileIndex, pkgName string, enumName string, value *namedSpan) *RenameTarget {
+	target := &RenameTarget{
+		Kind:    "value",
+		Package: pkgName,
+		Name:    enumName + "." + value.Name,
+		Span:    value.Span,
+	}
+	for _, ent := range file.entities {
+		if strcase.ToCamel(ent.Name)+"Status" == enumName {
+			target.entity = ent
+		}
+	}
+	return target
+}
+
+// Rename returns the edits which rename the target to the new name, which
+// replaces the last part of the target's name. References are rewritten in
+// every indexed file, j5s and proto, keeping the package or import alias they
+// are written with.
+func (ix *Index) Rename(target *RenameTarget, newName string, read SourceReader) ([]Edit, error) {
+	var edits []Edit
+	var err error
+	switch target.Kind {
+	case "entity":
+		edits, err = ix.renameEntity(target, newName)
+	case "value":
+		edits, err = ix.renameValue(target, newName, read)
+	default:
+		edits, err = ix.renameType(target, newName)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(edits, func(i, j int) bool {
+		a, b := edits[i].Span, edits[j].Span
+		if a.Filename != b.Filename {
+			return a.Filename < b.Filename
+		}
+		return pointBefore(a.Start, b.Start)
+	})
+	return edits, nil
+}
+
+// segmentRename renames one segment of the names of types in a package, and
+// of the types nested in them.
+type segmentRename struct {
+	pkgName string
+	parent  string // the names before the segment, with the trailing dot
+	old     string
+	new     string
+}
+
+func (ix *Index) renameType(target *RenameTarget, newName string) ([]Edit, error) {
+	if !typeNamePattern.MatchString(newName) {
+		return nil, fmt.Errorf("invalid type name %q", newName)
+	}
+
+	parent := ""
+	oldName := target.Name
+	if idx := strings.LastIndex(target.Name, "."); idx >= 0 {
+		parent, oldName = target.Name[:idx+1], target.Name[idx+1:]
+	}
+	if _, ok := ix.Definition(target.Package, parent+newName); ok {
+		return nil, fmt.Errorf("%s.%s%s is already defined", target.Package, parent, newName)
+	}
+
+	edits := []Edit{{
+		Span:    target.Span,
+		NewText: newName,
+	}}
+	return append(edits, ix.segmentEdits(segmentRename{
+		pkgName: target.Package,
+		parent:  parent,
+		old:     oldName,
+		new:     newName,
+	})...), nil
+}
+
+func (ix *Index) renameEntity(target *RenameTarget, newName string) ([]Edit, error) {
+	if !typeNamePattern.MatchString(newName) {
+		return nil, fmt.Errorf("invalid entity name %q", newName)
+	}
+
+	oldPrefix := strcase.ToCamel(target.Name)
+	newPrefix := strcase.ToCamel(newName)
+
+	var ent *entityIndex
+	var file *fileIndex
+	for _, search := range ix.files {
+		if search.summary.Package != target.Package {
+			continue
+		}
+		if found, ok := search.entities[target.Name]; ok && found.Span == target.Span {
+			ent, file = found, search
+		}
+	}
+	if ent == nil {
+		return nil, fmt.Errorf("entity %s not indexed", target.FullName())
+	}
+
+	edits := []Edit{{
+		Span:    target.Span,
+		NewText: newName,
+	}}
+
+	// The types generated by the entity are named with its prefix, and are
+	// declared within the entity block.
+	for name, def := range file.definitions {
+		if strings.Contains(name, ".") || !strings.HasPrefix(name, oldPrefix) || !ent.Header.Contains(def.Span.Start) {
+			continue
+		}
+		renamed := newPrefix + strings.TrimPrefix(name, oldPrefix)
+		if _, ok := ix.Definition(target.Package, renamed); ok {
+			return nil, fmt.Errorf("%s.%s is already defined", target.Package, renamed)
+		}
+		edits = append(edits, ix.segmentEdits(segmentRename{
+			pkgName: target.Package,
+			old:     name,
+			new:     renamed,
+		})...)
+	}
+	return edits, nil
+}
+
+// segmentEdits rewrites the segment in references to the renamed types, and
+// types nested in them. References are written relative to their scope, so
+// the segment is only written when the reference is long enough to hold it.
+func (ix *Index) segmentEdits(rename segmentRename) []Edit {
+	renamed := rename.parent + rename.old
+	pkg, ok := ix.packages[rename.pkgName]
+	if !ok {
+		return nil
+	}
+
+	edits := []Edit{}
+	for name := range pkg.Exports {
+		if name != renamed && !strings.HasPrefix(name, renamed+".") {
+			continue
+		}
+		suffix := strings.TrimPrefix(name, renamed)
+		for _, ref := range ix.References(rename.pkgName, name) {
+			span := ref.Span
+			if span.Start.Line != span.End.Line {
+				continue
+			}
+			written := span.End.Column - span.Start.Column + 1
+			if written < len(rename.old)+len(suffix) {
+				continue
+			}
+			end := span.End.Column - len(suffix)
+			edits = append(edits, Edit{
+				Span: Span{
+					Filename: span.Filename,
+					Start:    errpos.Point{Line: span.Start.Line, Column: end - len(rename.old) + 1},
+					End:      errpos.Point{Line: span.Start.Line, Column: end},
+				},
+				NewText: rename.new,
+			})
+		}
+	}
+	return edits
+}
+
+func (ix *Index) renameValue(target *RenameTarget, newName string, read SourceReader) ([]Edit, error) {
+	if !valueNamePattern.MatchString(newName) {
+		return nil, fmt.Errorf("invalid value name %q", newName)
+	}
+	idx := strings.LastIndex(target.Name, ".")
+	enumName, oldName := target.Name[:idx], target.Name[idx+1:]
+
+	file, ok := ix.files[target.Span.Filename]
+	if !ok {
+		return nil, fmt.Errorf("file %s not indexed", target.Span.Filename)
+	}
+	for _, value := range file.enumValues[enumName] {
+		if value.Name == newName {
+			return nil, fmt.Errorf("%s.%s.%s is already defined", target.Package, enumName, newName)
+		}
+	}
+
+	edits := []Edit{{
+		Span:    target.Span,
+		NewText: newName,
+	}}
+
+	ent := target.entity
+	if ent == nil {
+		return edits, nil
+	}
+
+	for _, transition := range ent.Transitions {
+		if transition.Name == oldName {
+			edits = append(edits, Edit{
+				Span:    transition.Span,
+				NewText: newName,
+			})
+		}
+	}
+
+	if ent.StatusFilter != nil {
+		filterEdits, err := arrayValueEdits(*ent.StatusFilter, oldName, newName, read)
+		if err != nil {
+			return nil, err
+		}
+		edits = append(edits, filterEdits...)
+	}
+	return edits, nil
+}
+
+// arrayValueEdits replaces the values of an array, quoted or bare, which the
+// source locations don't cover individually.
+func arrayValueEdits(span Span, oldName, newName string, read SourceReader) ([]Edit, error) {
+	if read == nil {
+		return nil, fmt.Errorf("no source to rename values in %s", span)
+	}
+	content, err := read(span.Filename)
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(content, "\n")
+
+	valuePattern := regexp.MustCompile(`\b` + regexp.QuoteMeta(oldName) + `\b`)
+	edits := []Edit{}
+	for lineNum := span.Start.Line; lineNum <= span.End.Line && lineNum < len(lines); lineNum++ {
+		line := lines[lineNum]
+		from, to := 0, len(line)
+		if lineNum == span.Start.Line {
+			from = min(span.Start.Column, len(line))
+		}
+		if lineNum == span.End.Line {
+			to = min(span.End.Column+1, len(line))
+		}
+		for _, match := range valuePattern.FindAllStringIndex(line[from:to], -1) {
+			edits = append(edits, Edit{
+				Span: Span{
+					Filename: span.Filename,
+					Start:    errpos.Point{Line: lineNum, Column: from + match[0]},
+					End:      errpos.Point{Line: lineNum, Column: from + match[1] - 1},
+				},
+				NewText: newName,
+			})
+		}
+	}
+	return edits, nil
+}
+
+// ApplyEdits applies the edits of a single file to its content. Edits must
+// not overlap, and each must be within one line.
+func ApplyEdits(content string, edits []Edit) (string, error) {
+	lines := strings.Split(content, "\n")
+	sorted := make([]Edit, len(edits))
+	copy(sorted, edits)
+	sort.Slice(sorted, func(i, j int) bool {
+		return pointBefore(sorted[j].Span.Start, sorted[i].Span.Start)
+	})
+
+	for _, edit := range sorted {
+		span := edit.Span
+		if span.Start.Line != span.End.Line || span.Start.Line >= len(lines) {
+			return "", fmt.Errorf("edit at %s is not within a line", span)
+		}
+		line := lines[span.Start.Line]
+		if span.End.Column >= len(line) || span.Start.Column > span.End.Column {
+			return "", fmt.Errorf("edit at %s is outside of the line", span)
+		}
+		lines[span.Start.Line] = line[:span.Start.Column] + edit.NewText + line[span.End.Column+1:]
+	}
+	return strings.Join(lines, "\n"), nil
+}
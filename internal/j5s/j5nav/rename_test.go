@@ -0,0 +1,197 @@
+package j5nav
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/bufbuild/protocompile/parser"
+	"github.com/bufbuild/protocompile/reporter"
+	"github.com/stretchr/testify/assert"
+)
+
+const renameEntitySrc = `package foo.v1
+
+entity Thing {
+	query {
+		defaultStatusFilter = ["ACTIVE", "INACTIVE"]
+	}
+
+	key thingId ! key:uuid {
+		primary = true
+	}
+
+	status ACTIVE
+	status INACTIVE
+
+	event Archived {
+		transition {
+			from = "ACTIVE"
+			to = "INACTIVE"
+		}
+	}
+}
+
+object UsesThing {
+	field state object:ThingState
+}
+`
+
+const renameProtoSrc = `syntax = "proto3";
+
+package foo.v1;
+
+import "foo/v1/foo.j5s.proto";
+
+message Wrapper {
+  Foo foo = 1;
+  foo.v1.Foo.Nested nested = 2;
+  map<string, Status> statuses = 3;
+}
+`
+
+func testRenameIndex(t *testing.T, files map[string]string) *Index {
+	t.Helper()
+	j5sFiles := map[string]string{}
+	for filename, src := range files {
+		if strings.HasSuffix(filename, ".j5s") {
+			j5sFiles[filename] = src
+		}
+	}
+	ix := testIndex(t, j5sFiles)
+	for filename, src := range files {
+		if !strings.HasSuffix(filename, ".proto") {
+			continue
+		}
+		node, err := parser.Parse(filename, strings.NewReader(src), reporter.NewHandler(nil))
+		if err != nil {
+			t.Fatalf("parse %s: %s", filename, err)
+		}
+		result, err := parser.ResultFromAST(node, true, reporter.NewHandler(nil))
+		if err != nil {
+			t.Fatalf("result %s: %s", filename, err)
+		}
+		if err := ix.AddProtoFile(result); err != nil {
+			t.Fatalf("index %s: %s", filename, err)
+		}
+	}
+	return ix
+}
+
+// applyRename renames the target and returns the edited files.
+func applyRename(t *testing.T, ix *Index, files map[string]string, target *RenameTarget, newName string) map[string]string {
+	t.Helper()
+	edits, err := ix.Rename(target, newName, func(filename string) (string, error) {
+		return files[filename], nil
+	})
+	if err != nil {
+		t.Fatalf("rename: %s", err)
+	}
+
+	byFile := map[string][]Edit{}
+	for _, edit := range edits {
+		byFile[edit.Span.Filename] = append(byFile[edit.Span.Filename], edit)
+	}
+
+	out := map[string]string{}
+	for filename, src := range files {
+		edited, err := ApplyEdits(src, byFile[filename])
+		if err != nil {
+			t.Fatalf("apply %s: %s", filename, err)
+		}
+		out[filename] = edited
+	}
+	return out
+}
+
+func TestRenameType(t *testing.T) {
+	files := map[string]string{
+		"foo/v1/foo.j5s":    fooSrc,
+		"bar/v1/bar.j5s":    barSrc,
+		"foo/v1/wrap.proto": renameProtoSrc,
+	}
+	ix := testRenameIndex(t, files)
+
+	target, err := ix.FindRenameTarget("bar.v1.Bar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := applyRename(t, ix, files, target, "Bat")
+	assert.Contains(t, out["bar/v1/bar.j5s"], "object Bat {")
+	assert.Contains(t, out["bar/v1/bar.j5s"], "field bar object:Bat")
+	// the import alias is kept
+	assert.Contains(t, out["foo/v1/foo.j5s"], "field a object:baz.Bat")
+
+	target, err = ix.RenameTargetAt("foo/v1/foo.j5s", pointOf(t, fooSrc, "object Foo", 7))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "foo.v1.Foo", target.FullName())
+	out = applyRename(t, ix, files, target, "Fooz")
+	assert.Contains(t, out["foo/v1/foo.j5s"], "object Fooz {")
+	// relative references to nested types don't include the renamed name
+	assert.Contains(t, out["foo/v1/foo.j5s"], "field d object:Nested")
+	assert.Contains(t, out["foo/v1/wrap.proto"], "  Fooz foo = 1;")
+	assert.Contains(t, out["foo/v1/wrap.proto"], "  foo.v1.Fooz.Nested nested = 2;")
+
+	target, err = ix.FindRenameTarget("foo.v1.Foo.Nested")
+	if err != nil {
+		t.Fatal(err)
+	}
+	out = applyRename(t, ix, files, target, "Inner")
+	assert.Contains(t, out["foo/v1/foo.j5s"], "object Inner {")
+	assert.Contains(t, out["foo/v1/foo.j5s"], "field d object:Inner")
+	assert.Contains(t, out["foo/v1/wrap.proto"], "  foo.v1.Foo.Inner nested = 2;")
+
+	_, err = ix.Rename(target, "lower", nil)
+	assert.Error(t, err)
+
+	target, err = ix.FindRenameTarget("bar.v1.Bar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ix.Rename(target, "Other", nil)
+	assert.ErrorContains(t, err, "already defined")
+}
+
+func TestRenameEntity(t *testing.T) {
+	files := map[string]string{
+		"foo/v1/thing.j5s": renameEntitySrc,
+	}
+	ix := testRenameIndex(t, files)
+
+	_, err := ix.FindRenameTarget("foo.v1.ThingState")
+	assert.ErrorContains(t, err, "rename the entity")
+
+	target, err := ix.RenameTargetAt("foo/v1/thing.j5s", pointOf(t, renameEntitySrc, "entity Thing", 8))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "entity", target.Kind)
+
+	out := applyRename(t, ix, files, target, "Widget")
+	assert.Contains(t, out["foo/v1/thing.j5s"], "entity Widget {")
+	assert.Contains(t, out["foo/v1/thing.j5s"], "field state object:WidgetState")
+}
+
+func TestRenameStatus(t *testing.T) {
+	files := map[string]string{
+		"foo/v1/thing.j5s": renameEntitySrc,
+	}
+	ix := testRenameIndex(t, files)
+
+	target, err := ix.RenameTargetAt("foo/v1/thing.j5s", pointOf(t, renameEntitySrc, `from = "ACTIVE"`, 9))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "foo.v1.ThingStatus.ACTIVE", target.FullName())
+
+	out := applyRename(t, ix, files, target, "LIVE")
+	edited := out["foo/v1/thing.j5s"]
+	assert.Contains(t, edited, "status LIVE\n")
+	assert.Contains(t, edited, `defaultStatusFilter = ["LIVE", "INACTIVE"]`)
+	assert.Contains(t, edited, `from = "LIVE"`)
+	assert.Contains(t, edited, `to = "INACTIVE"`)
+
+	_, err = ix.Rename(target, "INACTIVE", nil)
+	assert.ErrorContains(t, err, "already defined")
+}
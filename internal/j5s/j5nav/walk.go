@@ -0,0 +1,349 @@
+package j5nav
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/pentops/j5/gen/j5/bcl/v1/bcl_j5pb"
+	"github.com/pentops/j5/gen/j5/schema/v1/schema_j5pb"
+	"github.com/pentops/j5/gen/j5/sourcedef/v1/sourcedef_j5pb"
+	"github.com/pentops/j5/internal/bcl/errpos"
+	"github.com/pentops/j5/internal/j5s/j5convert"
+	"github.com/pentops/j5/internal/j5s/sourcewalk"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type fileIndex struct {
+	summary     *j5convert.FileSummary
+	definitions map[string]*Definition
+	refs        []*Reference
+	properties  []*Property
+
+	// virtual definitions are generated, e.g. by entities, and share the
+	// location of the element which generates them.
+	virtual map[string]bool
+
+	// enumValues are the declared options of each enum, by the name of the
+	// enum in the package, including entity statuses.
+	enumValues map[string][]*namedSpan
+
+	entities map[string]*entityIndex
+}
+
+// namedSpan is a name written in the file, e.g. an enum option.
+type namedSpan struct {
+	Name string
+	Span Span
+}
+
+// entityIndex holds the parts of an entity which refer to it by name, or to
+// its statuses.
+type entityIndex struct {
+	Name string
+	Span Span
+
+	// Header spans the entity block, up to the next root element, as source
+	// locations cover only the block header.
+	Header Span
+
+	// Transitions are the from and to statuses of events.
+	Transitions []*namedSpan
+
+	// StatusFilter is the defaultStatusFilter array of the query, which has
+	// no location for each value.
+	StatusFilter *Span
+}
+
+// scopedRef is a reference before resolution, refs without a package may
+// refer to types nested in the scope.
+type scopedRef struct {
+	ref   *Reference
+	scope string
+}
+
+type fileWalker struct {
+	file    *sourcedef_j5pb.SourceFile
+	imports *j5convert.FileImports
+	index   *fileIndex
+	refs    []scopedRef
+
+	// byNode finds the reference of a field's ref.
+	byNode map[*sourcewalk.RefNode]*Reference
+
+	// fieldTypes are resolved after the walk, as they may include refs.
+	fieldTypes map[*Property]sourcewalk.FieldNode
+}
+
+func indexFile(file *sourcedef_j5pb.SourceFile) (*fileIndex, error) {
+	imports, err := j5convert.NewFileImports(file)
+	if err != nil {
+		return nil, err
+	}
+
+	ww := &fileWalker{
+		file:    file,
+		imports: imports,
+		index: &fileIndex{
+			definitions: map[string]*Definition{},
+			virtual:     map[string]bool{},
+			enumValues:  map[string][]*namedSpan{},
+			entities:    map[string]*entityIndex{},
+		},
+		byNode:     map[*sourcewalk.RefNode]*Reference{},
+		fieldTypes: map[*Property]sourcewalk.FieldNode{},
+	}
+
+	root := sourcewalk.NewRoot(file)
+	err = root.RangeRootElements(&sourcewalk.DefaultVisitor{
+		Object: func(node *sourcewalk.ObjectNode) error {
+			ww.addDefinition(node.NameInPackage(), "object", node.Description, node.Source)
+			for _, member := range node.PolymorphMember {
+				ww.addRef(member, "")
+			}
+			return nil
+		},
+		Oneof: func(node *sourcewalk.OneofNode) error {
+			ww.addDefinition(node.NameInPackage(), "oneof", node.Schema.Description, node.Source)
+			return nil
+		},
+		Enum: func(node *sourcewalk.EnumNode) error {
+			ww.addDefinition(node.NameInPackage(), "enum", node.Schema.Description, node.Source)
+			if !node.Source.IsVirtual() {
+				ww.addEnumValues(node.NameInPackage(), node.Source.Source.Children["options"], node.Schema.Options)
+			}
+			return nil
+		},
+		Polymorph: func(node *sourcewalk.PolymorphNode) error {
+			ww.addDefinition(node.NameInPackage(), "polymorph", node.Description, node.Source)
+			for _, include := range node.Includes {
+				ww.addRef(include, "")
+			}
+			return nil
+		},
+		Property: func(node *sourcewalk.PropertyNode) error {
+			scope := strings.TrimSuffix(node.NameInPackage(), "."+node.Schema.Name)
+			ww.addProperty(node)
+			for field := &node.Field; field != nil; field = field.Items {
+				if field.Ref != nil && !field.Ref.Inline {
+					ww.addRef(field.Ref, scope)
+				}
+			}
+			return nil
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ww.addEntities()
+
+	for _, scoped := range ww.refs {
+		ww.resolveScope(scoped)
+		ww.index.refs = append(ww.index.refs, scoped.ref)
+	}
+
+	for prop, field := range ww.fieldTypes {
+		prop.Type = ww.fieldType(field)
+	}
+
+	return ww.index, nil
+}
+
+func (ww *fileWalker) addEnumValues(enumName string, locs *bcl_j5pb.SourceLocation, options []*schema_j5pb.Enum_Option) {
+	for idx, option := range options {
+		loc := locs.GetChildren()[strconv.Itoa(idx)]
+		if loc == nil {
+			continue
+		}
+		ww.index.enumValues[enumName] = append(ww.index.enumValues[enumName], &namedSpan{
+			Name: option.Name,
+			Span: spanOf(ww.file.Path, nameLocation(loc)),
+		})
+	}
+}
+
+// addEntities indexes the root entities of the file, which are walked as the
+// elements they generate.
+func (ww *fileWalker) addEntities() {
+	locs := ww.file.SourceLocations.GetChildren()["elements"]
+	for idx, element := range ww.file.Elements {
+		entity := element.GetEntity()
+		if entity == nil {
+			continue
+		}
+		loc := locs.GetChildren()[strconv.Itoa(idx)].GetChildren()["entity"]
+		if loc == nil {
+			continue
+		}
+
+		header := spanOf(ww.file.Path, loc)
+		header.End = errpos.Point{Line: math.MaxInt32}
+		if next := locs.GetChildren()[strconv.Itoa(idx+1)]; next != nil {
+			header.End = errpos.Point{Line: int(next.StartLine), Column: int(next.StartColumn) - 1}
+		}
+
+		ent := &entityIndex{
+			Name:   entity.Name,
+			Span:   spanOf(ww.file.Path, nameLocation(loc)),
+			Header: header,
+		}
+		ww.index.entities[entity.Name] = ent
+
+		statusEnum := strcase.ToCamel(entity.Name) + "Status"
+		ww.addEnumValues(statusEnum, loc.Children["status"], entity.Status)
+
+		eventLocs := loc.Children["events"]
+		for eventIdx, event := range entity.Events {
+			transitionLocs := eventLocs.GetChildren()[strconv.Itoa(eventIdx)].GetChildren()["transitions"]
+			for transitionIdx, transition := range event.Transitions {
+				transitionLoc := transitionLocs.GetChildren()[strconv.Itoa(transitionIdx)]
+				if from := transitionLoc.GetChildren()["from"]; from != nil {
+					ent.Transitions = append(ent.Transitions, &namedSpan{
+						Name: transition.From,
+						Span: valueSpan(spanOf(ww.file.Path, from), transition.From),
+					})
+				}
+				if to := transitionLoc.GetChildren()["to"]; to != nil {
+					ent.Transitions = append(ent.Transitions, &namedSpan{
+						Name: transition.To,
+						Span: valueSpan(spanOf(ww.file.Path, to), transition.To),
+					})
+				}
+			}
+		}
+
+		if filter := loc.Children["query"].GetChildren()["defaultStatusFilter"]; filter != nil {
+			span := spanOf(ww.file.Path, filter)
+			ent.StatusFilter = &span
+		}
+	}
+}
+
+// valueSpan narrows the span of a quoted value to the value itself.
+func valueSpan(span Span, value string) Span {
+	if span.Start.Line == span.End.Line && span.End.Column-span.Start.Column+1 == len(value)+2 {
+		span.Start.Column++
+		span.End.Column--
+	}
+	return span
+}
+
+// nameLocation returns the location of the name of a type or property, falling
+// back to the whole element.
+func nameLocation(loc *bcl_j5pb.SourceLocation) *bcl_j5pb.SourceLocation {
+	if name, ok := loc.Children["name"]; ok {
+		return name
+	}
+	if def, ok := loc.Children["def"]; ok {
+		if name, ok := def.Children["name"]; ok {
+			return name
+		}
+	}
+	return loc
+}
+
+func (ww *fileWalker) addDefinition(name string, kind string, description string, source sourcewalk.SourceNode) {
+	ww.index.definitions[name] = &Definition{
+		Package:     ww.file.Package.Name,
+		Name:        name,
+		Kind:        kind,
+		Description: description,
+		Span:        spanOf(ww.file.Path, nameLocation(source.Source)),
+	}
+	if source.IsVirtual() {
+		ww.index.virtual[name] = true
+	}
+}
+
+func (ww *fileWalker) addRef(node *sourcewalk.RefNode, scope string) {
+	if node.Source.IsVirtual() {
+		// generated, not written in the file
+		return
+	}
+	expanded := ww.imports.Expand(node.Ref)
+	if expanded == nil {
+		// not imported, which the linter reports
+		return
+	}
+	ref := &Reference{
+		Package: expanded.Package,
+		Name:    expanded.Schema,
+		Span:    spanOf(ww.file.Path, node.Source.Source),
+	}
+	ww.byNode[node] = ref
+	ww.refs = append(ww.refs, scopedRef{
+		ref:   ref,
+		scope: scope,
+	})
+}
+
+// resolveScope resolves refs in the file's own package to types nested in
+// the scope of the property, e.g. Bar within object Foo refers to Foo.Bar when
+// it exists.
+func (ww *fileWalker) resolveScope(scoped scopedRef) {
+	ref := scoped.ref
+	if ref.Package != ww.file.Package.Name || scoped.scope == "" {
+		return
+	}
+	scope := strings.Split(scoped.scope, ".")
+	for idx := len(scope); idx > 0; idx-- {
+		candidate := strings.Join(scope[:idx], ".") + "." + ref.Name
+		if _, ok := ww.index.definitions[candidate]; ok {
+			ref.Name = candidate
+			return
+		}
+	}
+}
+
+func (ww *fileWalker) addProperty(node *sourcewalk.PropertyNode) {
+	if node.Source.IsVirtual() {
+		return
+	}
+	prop := &Property{
+		Name:        node.Schema.Name,
+		Description: node.Schema.Description,
+		Span:        spanOf(ww.file.Path, nameLocation(node.Source.Source)),
+	}
+	ww.index.properties = append(ww.index.properties, prop)
+	ww.fieldTypes[prop] = node.Field
+}
+
+// fieldType describes the field in the j5s type syntax, with refs resolved to
+// their full name.
+func (ww *fileWalker) fieldType(field sourcewalk.FieldNode) string {
+	switch field.Schema.(type) {
+	case *schema_j5pb.Field_Array:
+		return "array:" + ww.fieldType(*field.Items)
+	case *schema_j5pb.Field_Map:
+		return "map:" + ww.fieldType(*field.Items)
+	}
+
+	name := scalarName(field.Schema)
+	if field.Ref == nil {
+		return name
+	}
+
+	if resolved, ok := ww.byNode[field.Ref]; ok {
+		return name + ":" + resolved.Package + "." + resolved.Name
+	}
+	ref := field.Ref.Ref
+	if field.Ref.Inline {
+		return name + ":" + ww.file.Package.Name + "." + ref.Schema
+	}
+	if ref.Package == "" {
+		return name + ":" + ref.Schema
+	}
+	return name + ":" + ref.Package + "." + ref.Schema
+}
+
+// scalarName returns the j5s name of the field type, e.g. string or object.
+func scalarName(fieldType schema_j5pb.IsField_Type) string {
+	var name string
+	(&schema_j5pb.Field{Type: fieldType}).ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		name = string(fd.Name())
+		return false
+	})
+	return name
+}
@@ -0,0 +1,142 @@
+package j5parse
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pentops/j5/internal/bcl"
+)
+
+func TestOutline(t *testing.T) {
+	input := strings.Join([]string{
+		"package foo.v1",
+		"",
+		"entity Foo {",
+		"	| Foo is a thing",
+		"	key fooId key:id62 {",
+		"		primary = true",
+		"	}",
+		"	data name string",
+		"	status ACTIVE",
+		"	event Created {",
+		"		field label ? string",
+		"	}",
+		"}",
+		"",
+		"service Foo {",
+		"	method GetFoo {",
+		"		httpPath = \"/foo\"",
+		"	}",
+		"}",
+	}, "\n")
+
+	p, err := bcl.NewParser()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	outline, err := p.Outline("foo/v1/foo.j5s", input, FileStub("foo/v1/foo.j5s"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type want struct {
+		schema, name, detail string
+		children             []want
+	}
+
+	var check func(t *testing.T, got []*bcl.Symbol, want []want)
+	check = func(t *testing.T, got []*bcl.Symbol, want []want) {
+		t.Helper()
+		if len(got) != len(want) {
+			t.Fatalf("expected %d symbols, got %d", len(want), len(got))
+		}
+		for idx, w := range want {
+			g := got[idx]
+			if g.Schema != w.schema || g.Name != w.name || g.Detail != w.detail {
+				t.Errorf("symbol %d: want %s %q %q, got %s %q %q", idx, w.schema, w.name, w.detail, g.Schema, g.Name, g.Detail)
+			}
+			check(t, g.Children, w.children)
+		}
+	}
+
+	check(t, outline.Symbols, []want{
+		{schema: "j5.sourcedef.v1.Package", name: "foo.v1"},
+		{schema: "j5.sourcedef.v1.Entity", name: "Foo", children: []want{
+			{schema: "j5.sourcedef.v1.EntityKey", name: "fooId", detail: "key:id62"},
+			{schema: "j5.schema.v1.ObjectProperty", name: "name", detail: "string"},
+			{schema: "j5.schema.v1.Enum_Option", name: "ACTIVE"},
+			{schema: "j5.sourcedef.v1.Event", name: "Created", children: []want{
+				{schema: "j5.schema.v1.ObjectProperty", name: "label", detail: "string"},
+			}},
+		}},
+		{schema: "j5.sourcedef.v1.Service", name: "Foo", children: []want{
+			{schema: "j5.sourcedef.v1.APIMethod", name: "GetFoo"},
+		}},
+	})
+
+	entity := outline.Symbols[1]
+	if entity.Position.Start.Line != 2 || entity.Position.End.Line != 12 {
+		t.Errorf("entity should span lines 3 to 13, got %s to %s", entity.Position.Start, entity.Position.End)
+	}
+
+	lines := strings.Split(input, "\n")
+	tokens := map[string]bcl.TokenKind{}
+	for _, tok := range outline.Tokens {
+		line := lines[tok.Position.Start.Line]
+		tokens[line[tok.Position.Start.Column:tok.Position.End.Column+1]] = tok.Kind
+	}
+
+	for text, kind := range map[string]bcl.TokenKind{
+		"entity":           bcl.TokenKeyword,
+		"Foo":              bcl.TokenName,
+		"| Foo is a thing": bcl.TokenDescription,
+		"fooId":            bcl.TokenName,
+		"id62":             bcl.TokenTypeRef,
+		"primary":          bcl.TokenProperty,
+		"string":           bcl.TokenTypeRef,
+		"httpPath":         bcl.TokenProperty,
+	} {
+		got, ok := tokens[text]
+		if !ok {
+			t.Errorf("no token for %q", text)
+			continue
+		}
+		if got != kind {
+			t.Errorf("token %q: want kind %d, got %d", text, kind, got)
+		}
+	}
+}
+
+func TestOutlineWithErrors(t *testing.T) {
+	input := strings.Join([]string{
+		"package foo.v1",
+		"",
+		"bogus Foo {",
+		"}",
+		"",
+		"object Bar {",
+		"	field id string",
+		"}",
+	}, "\n")
+
+	p, err := bcl.NewParser()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	outline, err := p.Outline("foo/v1/foo.j5s", input, FileStub("foo/v1/foo.j5s"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(outline.Symbols) != 3 {
+		t.Fatalf("expected 3 symbols, got %d", len(outline.Symbols))
+	}
+	if got := outline.Symbols[1].Schema; got != "" {
+		t.Errorf("expected no schema for the unknown block, got %q", got)
+	}
+	if got := outline.Symbols[2].Schema; got != "j5.sourcedef.v1.Object" {
+		t.Errorf("expected the walk to continue after the error, got %q", got)
+	}
+}
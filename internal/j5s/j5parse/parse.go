@@ -7,6 +7,7 @@ import (
 	"github.com/pentops/j5/gen/j5/bcl/v1/bcl_j5pb"
 	"github.com/pentops/j5/gen/j5/sourcedef/v1/sourcedef_j5pb"
 	"github.com/pentops/j5/internal/bcl"
+	"github.com/pentops/j5/internal/bcl/errpos"
 )
 
 func ParseFile(filename string, data string) (*sourcedef_j5pb.SourceFile, error) {
@@ -57,3 +58,16 @@ func (p *Parser) ParseFile(filename string, data string) (*sourcedef_j5pb.Source
 	return file, nil
 
 }
+
+// Complete parses as much of the file as possible, and returns the names
+// which are valid at the point, for editor completion. The returned file holds
+// the parts of the source which could be walked.
+func (p *Parser) Complete(filename string, data string, pt errpos.Point) (*sourcedef_j5pb.SourceFile, *bcl.Completions, error) {
+	file := p.fileStub(filename)
+	completions, err := p.bcl.Complete(filename, data, file.ProtoReflect(), pt)
+	if err != nil {
+		return nil, nil, err
+	}
+	file.SourceLocations = completions.SourceLocations
+	return file, completions, nil
+}
@@ -86,6 +86,22 @@ func (tf *testFiles) tAddJ5SFile(filename string, body ...string) {
 func ObjectReflect(t testing.TB, file string) protoreflect.MessageDescriptor {
 	pkgName := "pkg" + strings.ToLower(strings.ReplaceAll(uuid.New().String(), "-", ""))
 
+	fd := FileReflect(t, pkgName, file)
+
+	messages := fd.Messages()
+	if messages.Len() == 0 {
+		t.Fatal("FATAL: No messages found in file descriptor")
+	} else if messages.Len() > 1 {
+		t.Fatalf("FATAL: Expected exactly one message, got %d", messages.Len())
+	}
+	desc := messages.Get(0)
+
+	return desc
+}
+
+// FileReflect compiles a single j5s file in package {pkgName}.v1, for tests
+// which need to refer to types by their full name.
+func FileReflect(t testing.TB, pkgName string, file string) protoreflect.FileDescriptor {
 	locals := newTestFiles()
 	locals.tAddJ5SFile(pkgName+"/v1/file.j5s", file)
 
@@ -113,15 +129,7 @@ func ObjectReflect(t testing.TB, file string) protoreflect.MessageDescriptor {
 		t.Fatal(fmt.Errorf("FATAL: Failed to create file descriptor: %w", err))
 	}
 
-	messages := fd.Messages()
-	if messages.Len() == 0 {
-		t.Fatal("FATAL: No messages found in file descriptor")
-	} else if messages.Len() > 1 {
-		t.Fatalf("FATAL: Expected exactly one message, got %d", messages.Len())
-	}
-	desc := messages.Get(0)
-
-	return desc
+	return fd
 }
 
 func DynamicObject(t testing.TB, file string) j5reflect.Object {
@@ -21,6 +21,9 @@ func (ps *PackageSet) LintFile(ctx context.Context, filename string, parsed *sou
 
 	pkg, err := ps.localPackageIO(ctx, pkgName)
 	if err != nil {
+		if ews, ok := errpos.AsErrorsWithSource(err); ok {
+			return ews.Errors, nil
+		}
 		if ep, ok := errpos.AsErrors(err); ok {
 			return ep, nil
 		}
@@ -37,7 +40,7 @@ func (ps *PackageSet) LintFile(ctx context.Context, filename string, parsed *sou
 	}
 
 	errs := errset.NewCollector()
-	_, err = j5convert.SourceSummary(parsed, errs)
+	summary, err := j5convert.SourceSummary(parsed, errs)
 	if err != nil {
 		if ep, ok := errpos.AsErrors(err); ok {
 			return ep, nil
@@ -53,5 +56,128 @@ func (ps *PackageSet) LintFile(ctx context.Context, filename string, parsed *sou
 		return nil, fmt.Errorf("convertJ5File: %w", err)
 	}
 
-	return nil, nil
+	if ps.lintRules == nil {
+		return nil, nil
+	}
+
+	// The rules check the parsed file, which may not be saved yet, in place of
+	// the file read with the package. The read file is shared with the parse
+	// cache, so it is replaced with a copy rather than modified.
+	for idx, file := range pkg.SourceFiles {
+		if file.Summary.SourceFilename == filename {
+			edited := *file
+			edited.Summary = summary
+			edited.J5File = parsed
+			pkg.SourceFiles[idx] = &edited
+		}
+	}
+
+	ruleErrors, err := ps.checkLintRules(ctx, pkg)
+	if err != nil {
+		ep, ok := errpos.AsErrors(err)
+		if !ok {
+			return nil, fmt.Errorf("lint rules for %s: %w", pkgName, err)
+		}
+		ruleErrors = ep
+	}
+	ruleErrors = ruleErrors.FilterToFile(filename)
+	if len(ruleErrors) == 0 {
+		return nil, nil
+	}
+	return ruleErrors, nil
+}
+
+// PackageLint is the result of linting every source file of a local package.
+type PackageLint struct {
+	// Imports are the packages which the package depends on, local or not.
+	Imports []string
+
+	// Errors are positioned in the files of the package, by the filename
+	// relative to the bundle.
+	Errors errpos.Errors
+}
+
+// LintPackage converts each j5s file of the package, collecting the errors of
+// all files. Errors in dependencies are not included, as they are linted with
+// their own package.
+func (ps *PackageSet) LintPackage(ctx context.Context, pkgName string) (*PackageLint, error) {
+	if !ps.sourceResolver.IsLocalPackage(pkgName) {
+		return nil, fmt.Errorf("package %s is not a local package", pkgName)
+	}
+
+	pkg, err := ps.localPackageIO(ctx, pkgName)
+	if err != nil {
+		if ews, ok := errpos.AsErrorsWithSource(err); ok {
+			return &PackageLint{Errors: ews.Errors}, nil
+		}
+		if ep, ok := errpos.AsErrors(err); ok {
+			return &PackageLint{Errors: ep}, nil
+		}
+		return nil, fmt.Errorf("loadLocalPackage %s: %w", pkgName, err)
+	}
+
+	lint := &PackageLint{
+		Imports: pkg.imports(),
+	}
+
+	ownFiles := map[string]struct{}{}
+	for _, file := range pkg.SourceFiles {
+		ownFiles[file.Summary.SourceFilename] = struct{}{}
+	}
+
+	err = ps.resolveDependencies(ctx, newResolveBaton(), pkg)
+	if err != nil {
+		ep, ok := errpos.AsErrors(err)
+		if !ok {
+			return nil, fmt.Errorf("resolveDependencies for %s: %w", pkgName, err)
+		}
+		lint.Errors = ownErrors(ep, ownFiles)
+		return lint, nil
+	}
+
+	for _, file := range pkg.SourceFiles {
+		if file.J5File == nil {
+			continue
+		}
+		_, err := j5convert.ConvertJ5File(pkg, file.J5File)
+		if err == nil {
+			continue
+		}
+		ep, ok := errpos.AsErrors(err)
+		if !ok {
+			return nil, fmt.Errorf("convertJ5File %s: %w", file.Summary.SourceFilename, err)
+		}
+		ews := ep.AsErrorsWithSource(file.Summary.SourceFilename, "")
+		lint.Errors = append(lint.Errors, ews.Errors...)
+	}
+
+	if len(lint.Errors) > 0 || ps.lintRules == nil {
+		return lint, nil
+	}
+
+	ruleErrors, err := ps.checkLintRules(ctx, pkg)
+	if err != nil {
+		ep, ok := errpos.AsErrors(err)
+		if !ok {
+			return nil, fmt.Errorf("lint rules for %s: %w", pkgName, err)
+		}
+		ruleErrors = ownErrors(ep, ownFiles)
+	}
+	lint.Errors = append(lint.Errors, ruleErrors...)
+
+	return lint, nil
+}
+
+// ownErrors filters the errors to those positioned in the files.
+func ownErrors(errs errpos.Errors, files map[string]struct{}) errpos.Errors {
+	own := make(errpos.Errors, 0, len(errs))
+	for _, err := range errs {
+		if err.Pos == nil || err.Pos.Filename == nil {
+			continue
+		}
+		if _, ok := files[*err.Pos.Filename]; ok {
+			own = append(own, err)
+		}
+	}
+	return own
 }
@@ -0,0 +1,248 @@
+package protobuild
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pentops/j5/gen/j5/source/v1/source_j5pb"
+	"github.com/pentops/j5/internal/apilint"
+	"github.com/pentops/j5/internal/bcl/errpos"
+	"github.com/pentops/j5/internal/j5s/sourcewalk"
+	"github.com/pentops/j5/internal/structure"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// SetLintRules sets the rules which LintPackage and LintFile check against the
+// API of a package which converts without errors. Nil disables the rules.
+func (ps *PackageSet) SetLintRules(rules *apilint.RuleSet) {
+	ps.lintRules = rules
+}
+
+// CheckLintRules checks the lint rules against a package already built by
+// BuildPackages or CompilePackage. Without rules there are no errors.
+func (ps *PackageSet) CheckLintRules(ctx context.Context, pkgName string) (errpos.Errors, error) {
+	if ps.lintRules == nil {
+		return nil, nil
+	}
+	pkg, ok := ps.Packages[pkgName]
+	if !ok || pkg.Built == nil {
+		return nil, fmt.Errorf("package %s is not built", pkgName)
+	}
+	return ps.checkLintRules(ctx, pkg)
+}
+
+// checkLintRules links the package, builds its API and checks the lint rules.
+// Violations are positioned at the j5s source of the element, violations in
+// generated properties are skipped as they can't be fixed in the source.
+func (ps *PackageSet) checkLintRules(ctx context.Context, pkg *Package) (errpos.Errors, error) {
+	if pkg.Built == nil {
+		if err := ps.buildLocalPackage(ctx, pkg); err != nil {
+			return nil, err
+		}
+	}
+
+	img := &source_j5pb.SourceImage{
+		Packages: []*source_j5pb.Package{{
+			Name: pkg.Name,
+		}},
+	}
+	seen := map[string]struct{}{}
+	var addFile func(fd protoreflect.FileDescriptor)
+	addFile = func(fd protoreflect.FileDescriptor) {
+		if _, ok := seen[fd.Path()]; ok {
+			return
+		}
+		seen[fd.Path()] = struct{}{}
+		imports := fd.Imports()
+		for i := range imports.Len() {
+			addFile(imports.Get(i).FileDescriptor)
+		}
+		img.File = append(img.File, protodesc.ToFileDescriptorProto(fd))
+	}
+	for _, file := range pkg.Built.Proto {
+		addFile(file.Linked)
+	}
+
+	api, err := structure.APIFromImage(img)
+	if err != nil {
+		return nil, fmt.Errorf("building API for %s: %w", pkg.Name, err)
+	}
+
+	violations := ps.lintRules.Run(api)
+	if len(violations) == 0 {
+		return nil, nil
+	}
+
+	locations, err := locateSources(pkg)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := make(errpos.Errors, 0, len(violations))
+	for _, violation := range violations {
+		err := &errpos.Err{
+			Err:      violation,
+			Severity: violation.Severity,
+		}
+		src, ok := locations.find(violation.Location)
+		if !ok {
+			// Not from a j5s file, e.g. a proto file in the package.
+			err.Ctx = errpos.Context{violation.Location.String()}
+			errs = append(errs, err)
+			continue
+		}
+		if src.virtual && violation.Location.Property != "" {
+			continue
+		}
+		err.Pos = &src.pos
+		errs = append(errs, err)
+	}
+	return errs, nil
+}
+
+type sourceLocation struct {
+	pos     errpos.Position
+	virtual bool
+}
+
+type sourceLocations map[apilint.Location]sourceLocation
+
+// find returns the source of the location, or of the nearest parent for
+// elements which are not walked themselves, e.g. the properties of a template
+// instance.
+func (sl sourceLocations) find(loc apilint.Location) (sourceLocation, bool) {
+	if src, ok := sl[loc]; ok {
+		return src, true
+	}
+	if loc.Property != "" {
+		loc.Property = ""
+		return sl.find(loc)
+	}
+	if loc.Method != "" {
+		loc.Method = ""
+		return sl.find(loc)
+	}
+	return sourceLocation{}, false
+}
+
+// locateSources walks the j5s files of the package to find the source of each
+// element of the API, by the names the API uses.
+func locateSources(pkg *Package) (sourceLocations, error) {
+	locations := sourceLocations{}
+	for _, file := range pkg.SourceFiles {
+		if file.J5File == nil {
+			continue
+		}
+		filename := file.Summary.SourceFilename
+		pkgName := pkg.Name
+
+		add := func(loc apilint.Location, node sourcewalk.SourceNode) {
+			loc.Package = pkgName
+			if _, ok := locations[loc]; ok {
+				return
+			}
+			locations[loc] = sourceLocation{
+				pos:     namePosition(filename, node),
+				virtual: node.IsVirtual(),
+			}
+		}
+
+		root := sourcewalk.NewRoot(file.J5File)
+		err := root.RangeRootElements(&sourcewalk.DefaultVisitor{
+			ServiceFile: func(*sourcewalk.ServiceFileNode) error {
+				pkgName = pkg.Name + ".service"
+				return nil
+			},
+			ServiceFileExit: func(*sourcewalk.ServiceFileNode) error {
+				pkgName = pkg.Name
+				return nil
+			},
+			TopicFile: func(*sourcewalk.TopicFileNode) error {
+				pkgName = pkg.Name + ".topic"
+				return nil
+			},
+			TopicFileExit: func(*sourcewalk.TopicFileNode) error {
+				pkgName = pkg.Name
+				return nil
+			},
+			Object: func(node *sourcewalk.ObjectNode) error {
+				add(apilint.Location{Schema: schemaName(node.NameInPackage())}, node.Source)
+				return nil
+			},
+			Oneof: func(node *sourcewalk.OneofNode) error {
+				add(apilint.Location{Schema: schemaName(node.NameInPackage())}, node.Source)
+				return nil
+			},
+			Enum: func(node *sourcewalk.EnumNode) error {
+				add(apilint.Location{Schema: schemaName(node.NameInPackage())}, node.Source)
+				return nil
+			},
+			Polymorph: func(node *sourcewalk.PolymorphNode) error {
+				add(apilint.Location{Schema: schemaName(node.NameInPackage())}, node.Source)
+				return nil
+			},
+			Property: func(node *sourcewalk.PropertyNode) error {
+				parent := strings.TrimSuffix(node.NameInPackage(), "."+node.Schema.Name)
+				add(apilint.Location{
+					Schema:   schemaName(parent),
+					Property: node.Schema.Name,
+				}, node.Source)
+				return nil
+			},
+			Service: func(node *sourcewalk.ServiceNode) error {
+				add(apilint.Location{Service: node.Name}, node.Source)
+				for _, method := range node.Methods {
+					add(apilint.Location{
+						Service: node.Name,
+						Method:  method.Schema.Name,
+					}, method.Source)
+				}
+				return nil
+			},
+			Topic: func(node *sourcewalk.TopicNode) error {
+				add(apilint.Location{Topic: node.Name}, node.Source)
+				return nil
+			},
+			Instance: func(node *sourcewalk.InstanceNode) error {
+				add(apilint.Location{Schema: node.Name()}, node.Source)
+				return nil
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("walking %s: %w", filename, err)
+		}
+	}
+	return locations, nil
+}
+
+// schemaName converts the name of a nested schema in the source to the name
+// in the API, e.g. Foo.Bar is Foo_Bar.
+func schemaName(nameInPackage string) string {
+	return strings.ReplaceAll(nameInPackage, ".", "_")
+}
+
+// namePosition is the position of the name of the element in the source, or
+// the whole element when the name has no position of its own.
+func namePosition(filename string, node sourcewalk.SourceNode) errpos.Position {
+	loc := node.Source
+	if name, ok := loc.Children["name"]; ok {
+		loc = name
+	} else if def, ok := loc.Children["def"]; ok {
+		if name, ok := def.Children["name"]; ok {
+			loc = name
+		}
+	}
+	return errpos.Position{
+		Filename: &filename,
+		Start: errpos.Point{
+			Line:   int(loc.StartLine),
+			Column: int(loc.StartColumn),
+		},
+		End: errpos.Point{
+			Line:   int(loc.EndLine),
+			Column: int(loc.EndColumn),
+		},
+	}
+}
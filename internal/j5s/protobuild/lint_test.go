@@ -0,0 +1,249 @@
+package protobuild
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/pentops/j5/gen/j5/config/v1/config_j5pb"
+	"github.com/pentops/j5/internal/apilint"
+	"github.com/pentops/j5/internal/j5s/j5parse"
+	"github.com/pentops/j5/internal/j5s/protobuild/psrc"
+)
+
+func TestLintPackage(t *testing.T) {
+	ctx := context.Background()
+
+	tf := newTestFiles()
+	tf.tAddJ5SFile("bar/v1/bar.j5s", `
+		object Bar {
+		  field id string
+		}
+	`)
+	tf.tAddJ5SFile("foo/v1/foo.j5s", `
+		import bar.v1
+
+		object Foo {
+		  field bar object:bar.v1.Bar
+		}
+	`)
+	tf.tAddJ5SFile("foo/v1/baz.j5s", `
+		object Baz {
+		  field missing object:Missing
+		}
+	`)
+
+	cache := NewParseCache()
+	lint := func(t *testing.T, pkg string) *PackageLint {
+		t.Helper()
+		resolver, err := NewCachedSourceResolver(tf, cache)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ps, err := NewPackageSet(psrc.DescriptorFiles{}, resolver)
+		if err != nil {
+			t.Fatal(err)
+		}
+		lint, err := ps.LintPackage(ctx, pkg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return lint
+	}
+
+	got := lint(t, "foo.v1")
+	if !slices.Equal(got.Imports, []string{"bar.v1"}) {
+		t.Errorf("expected imports [bar.v1], got %v", got.Imports)
+	}
+	if len(got.Errors) != 1 {
+		t.Fatalf("expected 1 error, got %d: %v", len(got.Errors), got.Errors)
+	}
+	if filename := got.Errors[0].Pos.Filename; filename == nil || *filename != "foo/v1/baz.j5s" {
+		t.Errorf("expected the error in foo/v1/baz.j5s, got %v", got.Errors[0].Pos)
+	}
+
+	cached, ok := cache.get("foo/v1/foo.j5s", tf.localFiles["foo/v1/foo.j5s"])
+	if !ok {
+		t.Fatal("expected foo/v1/foo.j5s to be cached")
+	}
+
+	// a second lint reuses the parsed file, a change parses again.
+	lint(t, "foo.v1")
+	if again, _ := cache.get("foo/v1/foo.j5s", tf.localFiles["foo/v1/foo.j5s"]); again != cached {
+		t.Error("expected the unchanged file to be reused")
+	}
+
+	tf.localFiles["foo/v1/baz.j5s"] = []byte(strings.Join([]string{
+		"package foo.v1",
+		"object Baz {",
+		"  field id string",
+		"}",
+	}, "\n"))
+	got = lint(t, "foo.v1")
+	if len(got.Errors) != 0 {
+		t.Fatalf("expected no errors after the fix, got %v", got.Errors)
+	}
+}
+
+func TestLintFileCache(t *testing.T) {
+	ctx := context.Background()
+
+	tf := newTestFiles()
+	tf.tAddJ5SFile("foo/v1/foo.j5s", `
+		object Foo {
+		  field id string
+		}
+	`)
+
+	rules, err := apilint.NewRuleSet(&config_j5pb.LintConfig{
+		Rules: []*config_j5pb.LintRule{{
+			Name: "field-description",
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cache := NewParseCache()
+	packageSet := func(t *testing.T) *PackageSet {
+		t.Helper()
+		resolver, err := NewCachedSourceResolver(tf, cache)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ps, err := NewPackageSet(psrc.DescriptorFiles{}, resolver)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ps.SetLintRules(rules)
+		return ps
+	}
+
+	if _, err := packageSet(t).LintPackage(ctx, "foo.v1"); err != nil {
+		t.Fatal(err)
+	}
+	cached, ok := cache.get("foo/v1/foo.j5s", tf.localFiles["foo/v1/foo.j5s"])
+	if !ok {
+		t.Fatal("expected foo/v1/foo.j5s to be cached")
+	}
+	cachedJ5File := cached.J5File
+	cachedSummary := cached.Summary
+
+	// an unsaved edit is linted without replacing the cached file
+	parser, err := j5parse.NewParser()
+	if err != nil {
+		t.Fatal(err)
+	}
+	edited, err := parser.ParseFile("foo/v1/foo.j5s", strings.Join([]string{
+		"package foo.v1",
+		"object Foo {",
+		"  field id string",
+		"  field name string",
+		"}",
+	}, "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := packageSet(t).LintFile(ctx, "foo/v1/foo.j5s", edited); err != nil {
+		t.Fatal(err)
+	}
+
+	if cached.J5File != cachedJ5File || cached.Summary != cachedSummary {
+		t.Error("expected the cached file to be unchanged by LintFile")
+	}
+}
+
+func TestLintRules(t *testing.T) {
+	ctx := context.Background()
+
+	tf := newTestFiles()
+	tf.tAddJ5SFile("foo/v1/foo.j5s", `
+		entity Foo {
+			key fooId ! key:uuid {
+				primary = true
+			}
+			status ACTIVE
+			data name string
+			event Created {
+				field f string
+			}
+		}
+
+		object Bar {
+			field id key:uuid {
+				| The ID
+			}
+			field anything any
+		}
+
+		service Svc {
+			method Get {
+				httpMethod = "GET"
+				httpPath = "/bar"
+				response {
+					field bar object:Bar
+				}
+			}
+		}
+
+		topic Thing publish {
+			message Hello {
+				field bar object:Bar
+			}
+		}
+	`)
+
+	rules, err := apilint.NewRuleSet(&config_j5pb.LintConfig{
+		Rules: []*config_j5pb.LintRule{{
+			Name: "field-description",
+		}, {
+			Name:     "no-any-public",
+			Severity: config_j5pb.LintSeverity_LINT_SEVERITY_WARNING,
+		}, {
+			Name: "entity-query-auth",
+		}, {
+			Name:    "topic-naming",
+			Options: map[string]string{"pattern": "[A-Z][a-z]+EventTopic"},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resolver, err := NewSourceResolver(tf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ps, err := NewPackageSet(psrc.DescriptorFiles{}, resolver)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ps.SetLintRules(rules)
+
+	lint, err := ps.LintPackage(ctx, "foo.v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"foo/v1/foo.j5s:18:10 property anything of public schema Bar has no description [field-description] (error)",
+		"foo/v1/foo.j5s:8:9 property name of public schema FooData has no description [field-description] (error)",
+		"foo/v1/foo.j5s:10:11 property f of public schema FooEventType_Created has no description [field-description] (error)",
+		"foo/v1/foo.j5s:4:8 property fooId of public schema FooKeys has no description [field-description] (error)",
+		"foo/v1/foo.j5s:26:12 property bar of public schema GetResponse has no description [field-description] (error)",
+		"foo/v1/foo.j5s:18:10 property anything of public schema Bar is 'any' [no-any-public] (warning)",
+		"foo/v1/foo.j5s:3:3 entity foo has no query auth, required for FooGet, FooList, FooEvents [entity-query-auth] (error)",
+		"foo/v1/foo.j5s:3:3 topic FooPublishTopic does not match [A-Z][a-z]+EventTopic [topic-naming] (error)",
+		"foo/v1/foo.j5s:31:15 topic ThingTopic does not match [A-Z][a-z]+EventTopic [topic-naming] (error)",
+	}
+	got := make([]string, 0, len(lint.Errors))
+	for _, err := range lint.Errors {
+		got = append(got, fmt.Sprintf("%s (%s)", err.Error(), err.Severity))
+	}
+	assertEqualLines(t, want, got)
+	if len(got) != len(want) {
+		t.Fatalf("want %d errors, got %d", len(want), len(got))
+	}
+}
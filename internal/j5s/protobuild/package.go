@@ -3,6 +3,7 @@ package protobuild
 import (
 	"fmt"
 	"slices"
+	"sort"
 	"strings"
 
 	"github.com/pentops/j5/internal/j5s/j5convert"
@@ -73,6 +74,16 @@ func (pkg *Package) includeIO(summary *j5convert.FileSummary) {
 	}
 }
 
+// imports lists the packages the package depends on, in name order.
+func (pkg *Package) imports() []string {
+	imports := make([]string, 0, len(pkg.pkgDeps))
+	for dep := range pkg.pkgDeps {
+		imports = append(imports, dep)
+	}
+	sort.Strings(imports)
+	return imports
+}
+
 // ResolveType implements j5convert.TypeResolver interface.
 func (pkg *Package) ResolveType(pkgName string, name string) (*j5convert.TypeRef, error) {
 	if pkgName == pkg.Name {
@@ -8,8 +8,10 @@ import (
 
 	"github.com/bufbuild/protocompile/linker"
 	"github.com/pentops/j5/gen/j5/source/v1/source_j5pb"
+	"github.com/pentops/j5/internal/apilint"
 	"github.com/pentops/j5/internal/bcl/errpos"
 	"github.com/pentops/j5/internal/dag"
+	"github.com/pentops/j5/internal/j5s/j5convert"
 	"github.com/pentops/j5/internal/j5s/protobuild/psrc"
 	"github.com/pentops/log.go/log"
 )
@@ -30,6 +32,8 @@ type PackageSet struct {
 	// using the same symbols instance.
 	symbols *linker.Symbols
 
+	lintRules *apilint.RuleSet
+
 	Packages map[string]*Package
 }
 
@@ -56,6 +60,24 @@ func (ps *PackageSet) ListLocalPackages() []string {
 	return ps.sourceResolver.ListPackages()
 }
 
+// LocalSourceFiles reads and parses the source files of a local package.
+func (ps *PackageSet) LocalSourceFiles(ctx context.Context, pkgName string) ([]*SourceFile, error) {
+	return ps.sourceResolver.PackageSourceFiles(ctx, pkgName)
+}
+
+// DependencyExports returns the types exported by a package which is not
+// local, e.g. an import from the registry.
+func (ps *PackageSet) DependencyExports(ctx context.Context, pkgName string) (map[string]*j5convert.TypeRef, error) {
+	if ps.sourceResolver.IsLocalPackage(pkgName) {
+		return nil, fmt.Errorf("package %s is local", pkgName)
+	}
+	pkg, err := ps.loadPackage(ctx, newResolveBaton(), pkgName)
+	if err != nil {
+		return nil, err
+	}
+	return pkg.Exports, nil
+}
+
 func (ps *PackageSet) ListPackageFiles(pkgName string) ([]string, error) {
 	// TODO: This skips *local* package files.
 	return ps.dependencyResolver.ListPackageFiles(pkgName)
@@ -216,6 +216,75 @@ func TestImportNestedJ5ToJ5Other(t *testing.T) {
 	files.expectFile(t, "foo/v1/foo.j5s.proto")
 }
 
+func TestTemplateInstance(t *testing.T) {
+	tf := newTestFiles()
+
+	tf.tAddJ5SFile("foo/v1/foo.j5s", `
+		import bar.v1
+
+		object Foo {
+		  field fooId key:id62
+		}
+
+		instance bar.Paged {
+		  | Foos by page
+		  args = ["Foo"]
+		}
+
+		instance Local {
+		  args = ["Foo", "bar.Bar"]
+		}
+
+		template Local {
+		  param Item
+		  param Other
+		  field item object:Item
+		  field other object:Other
+		}
+	`)
+
+	tf.tAddJ5SFile("bar/v1/bar.j5s", `
+		template Paged {
+		  | A page of items
+		  param Item | The item type
+		  field items array:object:Item
+		  field page object:Page
+		}
+
+		object Page {
+		  field token string
+		}
+
+		object Bar {
+		  field name string
+		}
+	`)
+
+	files := testCompile(t, tf, nil, "foo.v1")
+	file := files.expectFile(t, "foo/v1/foo.j5s.proto")
+
+	paged := file.Messages().ByName("PagedFoo")
+	if paged == nil {
+		t.Fatal("expected message PagedFoo")
+	}
+	items := paged.Fields().ByName("items")
+	if items == nil || !items.IsList() || items.Message().FullName() != "foo.v1.Foo" {
+		t.Errorf("expected items to be a list of foo.v1.Foo, got %v", items)
+	}
+	page := paged.Fields().ByName("page")
+	if page == nil || page.Message().FullName() != "bar.v1.Page" {
+		t.Errorf("expected page to be bar.v1.Page, got %v", page)
+	}
+
+	local := file.Messages().ByName("LocalFooBar")
+	if local == nil {
+		t.Fatal("expected message LocalFooBar")
+	}
+	if other := local.Fields().ByName("other"); other == nil || other.Message().FullName() != "bar.v1.Bar" {
+		t.Errorf("expected other to be bar.v1.Bar, got %v", other)
+	}
+}
+
 func TestCircularPackageDependency(t *testing.T) {
 	ctx := context.Background()
 
@@ -0,0 +1,45 @@
+package protobuild
+
+import (
+	"crypto/sha256"
+	"sync"
+)
+
+// ParseCache holds parsed j5s source files by filename and content, so that
+// repeated builds of the same files, e.g. in the language server, only parse
+// files which changed. Proto files are not cached, the linker takes ownership
+// of their parse results.
+type ParseCache struct {
+	lock  sync.Mutex
+	files map[string]cachedFile
+}
+
+type cachedFile struct {
+	hash [sha256.Size]byte
+	file *SourceFile
+}
+
+func NewParseCache() *ParseCache {
+	return &ParseCache{
+		files: map[string]cachedFile{},
+	}
+}
+
+func (pc *ParseCache) get(filename string, data []byte) (*SourceFile, bool) {
+	pc.lock.Lock()
+	defer pc.lock.Unlock()
+	cached, ok := pc.files[filename]
+	if !ok || cached.hash != sha256.Sum256(data) {
+		return nil, false
+	}
+	return cached.file, true
+}
+
+func (pc *ParseCache) put(filename string, data []byte, file *SourceFile) {
+	pc.lock.Lock()
+	defer pc.lock.Unlock()
+	pc.files[filename] = cachedFile{
+		hash: sha256.Sum256(data),
+		file: file,
+	}
+}
@@ -72,9 +72,16 @@ type sourceResolver struct {
 	j5Parser          *j5parse.Parser
 	localPrefixes     []string
 	localPackageNames map[string]struct{}
+	cache             *ParseCache
 }
 
 func NewSourceResolver(localFiles LocalFileSource) (*sourceResolver, error) {
+	return NewCachedSourceResolver(localFiles, nil)
+}
+
+// NewCachedSourceResolver reuses parsed files from the cache when their
+// content is unchanged. A nil cache parses every file.
+func NewCachedSourceResolver(localFiles LocalFileSource, cache *ParseCache) (*sourceResolver, error) {
 	packages := localFiles.ListPackages()
 
 	localPackageNames := map[string]struct{}{}
@@ -96,6 +103,7 @@ func NewSourceResolver(localFiles LocalFileSource) (*sourceResolver, error) {
 		bundleFiles:       localFiles,
 		localPackageNames: localPackageNames,
 		localPrefixes:     localPrefixes,
+		cache:             cache,
 	}
 
 	return sr, nil
@@ -162,7 +170,19 @@ func (sr *sourceResolver) getFile(ctx context.Context, sourceFilename string) (*
 	}
 
 	if strings.HasSuffix(sourceFilename, ".j5s") {
-		return sr.parseJ5s(sourceFilename, data)
+		if sr.cache != nil {
+			if file, ok := sr.cache.get(sourceFilename, data); ok {
+				return file, nil
+			}
+		}
+		file, err := sr.parseJ5s(sourceFilename, data)
+		if err != nil {
+			return nil, err
+		}
+		if sr.cache != nil {
+			sr.cache.put(sourceFilename, data, file)
+		}
+		return file, nil
 	}
 
 	if strings.HasSuffix(sourceFilename, ".proto") {
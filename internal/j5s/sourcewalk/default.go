@@ -17,6 +17,8 @@ type DefaultVisitor struct {
 	Service         func(*ServiceNode) error
 	ServiceFileExit func(*ServiceFileNode) error
 	TypeStub        func(*TypeStubNode) error
+	Template        func(*TemplateNode) error
+	Instance        func(*InstanceNode) error
 }
 
 func (df *DefaultVisitor) VisitProperty(node *PropertyNode) error {
@@ -153,3 +155,17 @@ func (df *DefaultVisitor) VisitTypeStub(node *TypeStubNode) error {
 	}
 	return nil
 }
+
+func (df *DefaultVisitor) VisitTemplate(node *TemplateNode) error {
+	if df.Template != nil {
+		return df.Template(node)
+	}
+	return nil
+}
+
+func (df *DefaultVisitor) VisitInstance(node *InstanceNode) error {
+	if df.Instance != nil {
+		return df.Instance(node)
+	}
+	return nil
+}
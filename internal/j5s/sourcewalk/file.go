@@ -13,12 +13,16 @@ type FileVisitor interface {
 	VisitTopicFile(*TopicFileNode) error
 	VisitServiceFile(*ServiceFileNode) error
 	VisitTypeStub(*TypeStubNode) error
+	VisitTemplate(*TemplateNode) error
+	VisitInstance(*InstanceNode) error
 }
 
 type FileCallbacks struct {
 	SchemaCallbacks
 	TopicFile   func(*TopicFileNode) error
 	ServiceFile func(*ServiceFileNode) error
+	Template    func(*TemplateNode) error
+	Instance    func(*InstanceNode) error
 }
 
 func (fc FileCallbacks) VisitTopicFile(tfn *TopicFileNode) error {
@@ -29,6 +33,14 @@ func (fc FileCallbacks) VisitServiceFile(sfn *ServiceFileNode) error {
 	return fc.ServiceFile(sfn)
 }
 
+func (fc FileCallbacks) VisitTemplate(tn *TemplateNode) error {
+	return fc.Template(tn)
+}
+
+func (fc FileCallbacks) VisitInstance(in *InstanceNode) error {
+	return fc.Instance(in)
+}
+
 var _ FileVisitor = FileCallbacks{}
 
 type FileNode struct {
@@ -125,6 +137,26 @@ func (fn *FileNode) RangeRootElements(visitor FileVisitor) error {
 				return wrapErr(source, err)
 			}
 
+		case *sourcedef_j5pb.RootElement_Template:
+			source := source.child("template")
+			templateNode, err := newTemplateNode(source, element.Template)
+			if err != nil {
+				return wrapErr(source, err)
+			}
+			if err := visitor.VisitTemplate(templateNode); err != nil {
+				return wrapErr(source, err)
+			}
+
+		case *sourcedef_j5pb.RootElement_Instance:
+			source := source.child("instance")
+			instanceNode, err := newInstanceNode(source, element.Instance)
+			if err != nil {
+				return wrapErr(source, err)
+			}
+			if err := visitor.VisitInstance(instanceNode); err != nil {
+				return wrapErr(source, err)
+			}
+
 		default:
 			return walkerErrorf("(sourcewalk) unknown root element in FileNode %T", element)
 		}
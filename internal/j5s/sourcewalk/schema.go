@@ -113,6 +113,7 @@ type ObjectNode struct {
 	Entity          *schema_j5pb.EntityObject
 	PolymorphMember []*RefNode
 	BCLBlock        *bcl_j5pb.Block
+	Rules           []*schema_j5pb.ObjectRule
 
 	rootType
 	propertySet
@@ -160,6 +161,7 @@ func newObjectSchemaNode(source SourceNode, parent parentNode, schema *schema_j5
 		},
 		PolymorphMember: polymorphMembers,
 		BCLBlock:        schema.Bcl,
+		Rules:           schema.Rules,
 	}, nil
 }
 
@@ -303,9 +305,18 @@ type nestedNode struct {
 func mapNested(source SourceNode, parent parentNode, nested []*sourcedef_j5pb.NestedSchema) nestedSet {
 	out := make([]*nestedNode, 0, len(nested))
 	for idx, n := range nested {
+		var typeName string
+		switch n.Type.(type) {
+		case *sourcedef_j5pb.NestedSchema_Object:
+			typeName = "object"
+		case *sourcedef_j5pb.NestedSchema_Oneof:
+			typeName = "oneof"
+		case *sourcedef_j5pb.NestedSchema_Enum:
+			typeName = "enum"
+		}
 		out = append(out, &nestedNode{
 			schema: n.Type,
-			source: source.child("schemas", strconv.Itoa(idx)),
+			source: source.child("schemas", strconv.Itoa(idx), typeName),
 		})
 	}
 	return nestedSet{
@@ -32,6 +32,12 @@ func (sn SourceNode) PathString() string {
 	return strings.Join(sn.Path, ".")
 }
 
+// IsVirtual returns true when the node has no source of its own, e.g. elements
+// generated from an entity, in which case the location is the nearest parent.
+func (sn SourceNode) IsVirtual() bool {
+	return sn.virtual
+}
+
 const virtualPathNode = "-"
 
 func (sn SourceNode) child(path ...string) SourceNode {
@@ -0,0 +1,206 @@
+package sourcewalk
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pentops/j5/gen/j5/schema/v1/schema_j5pb"
+	"github.com/pentops/j5/gen/j5/sourcedef/v1/sourcedef_j5pb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protopath"
+	"google.golang.org/protobuf/reflect/protorange"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// TemplateNode is an object with type parameters. Templates are not converted
+// themselves, each InstanceNode expands the template into an object.
+type TemplateNode struct {
+	Source      SourceNode
+	Name        string
+	Description string
+	Params      []*TemplateParamNode
+
+	def *schema_j5pb.Object
+}
+
+type TemplateParamNode struct {
+	Source      SourceNode
+	Name        string
+	Description string
+}
+
+func newTemplateNode(source SourceNode, template *sourcedef_j5pb.ObjectTemplate) (*TemplateNode, error) {
+	if template.Def == nil || template.Def.Name == "" {
+		return nil, fmt.Errorf("template has no name")
+	}
+
+	params := make([]*TemplateParamNode, 0, len(template.Params))
+	seen := map[string]bool{}
+	for idx, param := range template.Params {
+		if seen[param.Name] {
+			return nil, fmt.Errorf("duplicate template param %q", param.Name)
+		}
+		seen[param.Name] = true
+		params = append(params, &TemplateParamNode{
+			Source:      source.child("params", strconv.Itoa(idx)),
+			Name:        param.Name,
+			Description: param.Description,
+		})
+	}
+
+	return &TemplateNode{
+		Source:      source,
+		Name:        template.Def.Name,
+		Description: template.Def.Description,
+		Params:      params,
+		def:         template.Def,
+	}, nil
+}
+
+func (tn *TemplateNode) isParam(ref *schema_j5pb.Ref) bool {
+	if ref.Package != "" {
+		return false
+	}
+	for _, param := range tn.Params {
+		if param.Name == ref.Schema {
+			return true
+		}
+	}
+	return false
+}
+
+// Refs returns the refs in the template body, excluding the params.
+func (tn *TemplateNode) Refs() []*RefNode {
+	refs := make([]*RefNode, 0)
+	rangeRefs(proto.Clone(tn.def).(*schema_j5pb.Object), func(ref *schema_j5pb.Ref) {
+		if tn.isParam(ref) {
+			return
+		}
+		refs = append(refs, &RefNode{
+			Ref:    ref,
+			Source: tn.Source.child("def"),
+		})
+	})
+	return refs
+}
+
+// Expand builds the object for an instance of the template. Each ref to a
+// param is replaced with the matching arg, the remaining refs are passed
+// through mapRef, which may be nil. Source locations of the object point to
+// the template.
+func (tn *TemplateNode) Expand(instance *InstanceNode, args []*schema_j5pb.Ref, mapRef func(*schema_j5pb.Ref) *schema_j5pb.Ref) (*ObjectNode, error) {
+	if len(args) != len(tn.Params) {
+		return nil, fmt.Errorf("template %s takes %d args, got %d", tn.Name, len(tn.Params), len(args))
+	}
+
+	argMap := make(map[string]*schema_j5pb.Ref, len(args))
+	for idx, param := range tn.Params {
+		argMap[param.Name] = args[idx]
+	}
+
+	obj := proto.Clone(tn.def).(*schema_j5pb.Object)
+	obj.Name = instance.Name()
+	if instance.Description != "" {
+		obj.Description = instance.Description
+	}
+
+	rangeRefs(obj, func(ref *schema_j5pb.Ref) {
+		var replacement *schema_j5pb.Ref
+		if tn.isParam(ref) {
+			replacement = argMap[ref.Schema]
+		} else if mapRef != nil {
+			replacement = mapRef(ref)
+		}
+		if replacement != nil {
+			ref.Package = replacement.Package
+			ref.Schema = replacement.Schema
+		}
+	})
+
+	return newObjectSchemaNode(tn.Source.child("def"), nil, obj)
+}
+
+// rangeRefs calls the callback for every type ref in the object, including
+// those in inline schemas. The callback may modify the ref in place.
+func rangeRefs(obj *schema_j5pb.Object, callback func(*schema_j5pb.Ref)) {
+	refName := (&schema_j5pb.Ref{}).ProtoReflect().Descriptor().FullName()
+	_ = protorange.Range(obj.ProtoReflect(), func(values protopath.Values) error {
+		msg, ok := values.Index(-1).Value.Interface().(protoreflect.Message)
+		if !ok || msg.Descriptor().FullName() != refName {
+			return nil
+		}
+		ref, ok := msg.Interface().(*schema_j5pb.Ref)
+		if !ok {
+			return nil
+		}
+		callback(ref)
+		return nil
+	})
+}
+
+// InstanceNode expands a template into an object, named by the template and
+// the args, e.g. Paged with Foo is PagedFoo.
+type InstanceNode struct {
+	Source      SourceNode
+	Template    *RefNode
+	Args        []*RefNode
+	Description string
+}
+
+func newInstanceNode(source SourceNode, instance *sourcedef_j5pb.TemplateInstance) (*InstanceNode, error) {
+	if instance.Template == "" {
+		return nil, fmt.Errorf("instance has no template")
+	}
+	args := make([]*RefNode, 0, len(instance.Args))
+	for idx, arg := range instance.Args {
+		if arg == "" {
+			return nil, fmt.Errorf("empty template arg %d", idx)
+		}
+		args = append(args, &RefNode{
+			Ref:    nameToRef(arg),
+			Source: source.child("args", strconv.Itoa(idx)),
+		})
+	}
+	return &InstanceNode{
+		Source: source,
+		Template: &RefNode{
+			Ref:    nameToRef(instance.Template),
+			Source: source.child("template"),
+		},
+		Args:        args,
+		Description: instance.Description,
+	}, nil
+}
+
+// Name is the name of the expanded object, the template name followed by the
+// schema name of each arg.
+func (in *InstanceNode) Name() string {
+	name := in.Template.Schema
+	for _, arg := range in.Args {
+		name += arg.Schema
+	}
+	return name
+}
+
+// ArgRefs returns the refs of the args, in order.
+func (in *InstanceNode) ArgRefs() []*schema_j5pb.Ref {
+	refs := make([]*schema_j5pb.Ref, 0, len(in.Args))
+	for _, arg := range in.Args {
+		refs = append(refs, arg.Ref)
+	}
+	return refs
+}
+
+// nameToRef splits a type name which may or may not have a package, unlike
+// typeNameToRef.
+func nameToRef(typeName string) *schema_j5pb.Ref {
+	idx := strings.LastIndex(typeName, ".")
+	if idx < 0 {
+		return &schema_j5pb.Ref{Schema: typeName}
+	}
+	return &schema_j5pb.Ref{
+		Package: typeName[:idx],
+		Schema:  typeName[idx+1:],
+	}
+}
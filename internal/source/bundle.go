@@ -11,6 +11,7 @@ import (
 	"github.com/pentops/j5/gen/j5/config/v1/config_j5pb"
 	"github.com/pentops/j5/gen/j5/ext/v1/ext_j5pb"
 	"github.com/pentops/j5/gen/j5/source/v1/source_j5pb"
+	"github.com/pentops/j5/internal/apilint"
 	"github.com/pentops/j5/internal/j5s/j5convert"
 	"github.com/pentops/j5/internal/j5s/protobuild"
 	"github.com/pentops/j5/internal/j5s/protobuild/protomod"
@@ -26,6 +27,10 @@ type Bundle interface {
 
 	SourceImage(ctx context.Context, resolver InputSource) (*source_j5pb.SourceImage, error)
 	Compiler(context.Context, InputSource) (*protobuild.PackageSet, error)
+
+	// CachedCompiler is a Compiler which reuses parsed source files from the
+	// cache.
+	CachedCompiler(context.Context, InputSource, *protobuild.ParseCache) (*protobuild.PackageSet, error)
 }
 
 type bundleSource struct {
@@ -35,6 +40,9 @@ type bundleSource struct {
 	refConfig  *config_j5pb.BundleReference
 	config     *config_j5pb.BundleConfigFile
 	dirInRepo  string
+
+	// lintRules from the repo config, nil when not configured.
+	lintRules *apilint.RuleSet
 }
 
 func (bs bundleSource) NameInRepo() *string {
@@ -90,6 +98,10 @@ func (b *bundleSource) SourceImage(ctx context.Context, resolver InputSource) (*
 }
 
 func (bundle *bundleSource) Compiler(ctx context.Context, resolver InputSource) (*protobuild.PackageSet, error) {
+	return bundle.CachedCompiler(ctx, resolver, nil)
+}
+
+func (bundle *bundleSource) CachedCompiler(ctx context.Context, resolver InputSource, cache *protobuild.ParseCache) (*protobuild.PackageSet, error) {
 	j5Config, err := bundle.J5Config()
 	if err != nil {
 		return nil, err
@@ -119,7 +131,7 @@ func (bundle *bundleSource) Compiler(ctx context.Context, resolver InputSource)
 		}
 	}
 
-	localFiles, err := bundle.FileSource()
+	localFiles, err := bundle.cachedFileSource(cache)
 	if err != nil {
 		return nil, fmt.Errorf("getting local file source: %w", err)
 	}
@@ -128,6 +140,7 @@ func (bundle *bundleSource) Compiler(ctx context.Context, resolver InputSource)
 	if err != nil {
 		return nil, fmt.Errorf("creating package set: %w", err)
 	}
+	compiler.SetLintRules(bundle.lintRules)
 
 	return compiler, nil
 }
@@ -196,6 +209,7 @@ func (bundle *bundleSource) readImageFromDir(ctx context.Context, resolver Input
 	if err != nil {
 		return nil, fmt.Errorf("creating package set: %w", err)
 	}
+	compiler.SetLintRules(bundle.lintRules)
 
 	img := image.NewBuilder()
 
@@ -268,6 +282,10 @@ func (bundle *bundleSource) ListPackages() ([]string, error) {
 }
 
 func (bundle *bundleSource) FileSource() (protobuild.LocalSourceResolver, error) {
+	return bundle.cachedFileSource(nil)
+}
+
+func (bundle *bundleSource) cachedFileSource(cache *protobuild.ParseCache) (protobuild.LocalSourceResolver, error) {
 	packages, err := bundle.ListPackages()
 	if err != nil {
 		return nil, fmt.Errorf("listing packages: %w", err)
@@ -280,7 +298,7 @@ func (bundle *bundleSource) FileSource() (protobuild.LocalSourceResolver, error)
 		packages: packages,
 	}
 
-	sourceResolver, err := protobuild.NewSourceResolver(localFiles)
+	sourceResolver, err := protobuild.NewCachedSourceResolver(localFiles, cache)
 	if err != nil {
 		return nil, fmt.Errorf("newSourceResolver: %w", err)
 	}
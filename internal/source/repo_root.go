@@ -11,6 +11,7 @@ import (
 	"github.com/pentops/golib/gl"
 	"github.com/pentops/j5/gen/j5/config/v1/config_j5pb"
 	"github.com/pentops/j5/gen/j5/source/v1/source_j5pb"
+	"github.com/pentops/j5/internal/apilint"
 	"github.com/pentops/j5/internal/dag"
 	"github.com/pentops/j5/internal/j5s/protobuild/psrc"
 	"google.golang.org/protobuf/types/descriptorpb"
@@ -145,6 +146,14 @@ func (src *RepoRoot) newRepo(debugName string, repoRoot fs.FS) (*repo, error) {
 		lockFile = &config_j5pb.LockFile{}
 	}
 
+	var lintRules *apilint.RuleSet
+	if config.Lint != nil {
+		lintRules, err = apilint.NewRuleSet(config.Lint)
+		if err != nil {
+			return nil, fmt.Errorf("lint config: %w", err)
+		}
+	}
+
 	thisRepo := &repo{
 		config:   config,
 		repoRoot: repoRoot,
@@ -178,6 +187,7 @@ func (src *RepoRoot) newRepo(debugName string, repoRoot fs.FS) (*repo, error) {
 			dirInRepo:  refConfig.Dir,
 			refConfig:  refConfig,
 			config:     bundleConfig,
+			lintRules:  lintRules,
 		})
 	}
 
@@ -197,6 +207,7 @@ func (src *RepoRoot) newRepo(debugName string, repoRoot fs.FS) (*repo, error) {
 				Options:      config.Options,
 				Dependencies: config.Dependencies,
 			},
+			lintRules: lintRules,
 		})
 	}
 
@@ -417,3 +428,27 @@ func (src *RepoRoot) BundleSource(name string) (*bundleSource, error) {
 func (src *RepoRoot) SourceFile(ctx context.Context, filename string) ([]byte, error) {
 	return fs.ReadFile(src.thisRepo.repoRoot, filename)
 }
+
+// LockedDependents returns the inputs of this repo's lock file which pin the
+// published registry version of the bundle, i.e. other parts of the same repo
+// consume the bundle from the registry rather than locally. Dependents in
+// other repos record their locks in their own lock files, and are not
+// included.
+func (src *RepoRoot) LockedDependents(bundle Bundle) ([]*config_j5pb.InputLock, error) {
+	cfg, err := bundle.J5Config()
+	if err != nil {
+		return nil, err
+	}
+	if cfg.Registry == nil {
+		return nil, nil
+	}
+
+	fullName := fmt.Sprintf("registry/%s/%s", cfg.Registry.Owner, cfg.Registry.Name)
+	locked := []*config_j5pb.InputLock{}
+	for _, lock := range src.thisRepo.lockFile.Inputs {
+		if lock.Name == fullName {
+			locked = append(locked, lock)
+		}
+	}
+	return locked, nil
+}
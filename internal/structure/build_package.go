@@ -347,6 +347,7 @@ func buildService(src protoreflect.ServiceDescriptor) (*schema_j5pb.Service, err
 
 		service.DefaultAuth = serviceExt.DefaultAuth
 		service.Audience = serviceExt.Audience
+		service.DefaultRateLimit = serviceExt.DefaultRateLimit
 	}
 
 	for ii := range methods.Len() {
@@ -453,6 +454,7 @@ func buildMethod(service *schema_j5pb.Service, method protoreflect.MethodDescrip
 	ext := protosrc.GetExtension[*ext_j5pb.MethodOptions](method.Options(), ext_j5pb.E_Method)
 	if ext != nil {
 		builtMethod.Auth = ext.Auth
+		builtMethod.RateLimit = ext.RateLimit
 		if ext.StateQuery != nil {
 			serviceQuery := service.Type.GetStateEntityQuery()
 			if serviceQuery == nil {
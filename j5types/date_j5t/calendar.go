@@ -0,0 +1,173 @@
+package date_j5t
+
+import (
+	"time"
+)
+
+// AddDays returns the date n days after dd, or before when n is negative.
+func (dd *Date) AddDays(n int) *Date {
+	return dd.AddDate(0, 0, int32(n))
+}
+
+// AddMonths returns the same day n months after dd, or before when n is
+// negative. Unlike AddDate, days past the end of the target month are clamped
+// to its last day, so 2021-01-31 plus one month is 2021-02-28.
+func (dd *Date) AddMonths(n int) *Date {
+	first := NewDate(dd.Year, dd.Month, 1).AddDate(0, int32(n), 0)
+	day := min(dd.Day, int32(first.DaysInMonth()))
+	return NewDate(first.Year, first.Month, day)
+}
+
+// AddYears returns the same day n years after dd, clamping 29 February to 28
+// February in non leap years.
+func (dd *Date) AddYears(n int) *Date {
+	return dd.AddMonths(n * 12)
+}
+
+// DaysInMonth returns the number of days in the month of dd.
+func (dd *Date) DaysInMonth() int {
+	return time.Date(int(dd.Year), time.Month(dd.Month)+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// MonthStart returns the first day of the month of dd.
+func (dd *Date) MonthStart() *Date {
+	return NewDate(dd.Year, dd.Month, 1)
+}
+
+// MonthEnd returns the last day of the month of dd.
+func (dd *Date) MonthEnd() *Date {
+	return NewDate(dd.Year, dd.Month, int32(dd.DaysInMonth()))
+}
+
+func (dd *Date) IsMonthEnd() bool {
+	return int(dd.Day) == dd.DaysInMonth()
+}
+
+// Calendar decides which dates are business days. A calendar must have
+// business days, the business day functions search for one without limit.
+type Calendar interface {
+	IsBusinessDay(*Date) bool
+}
+
+// CalendarFunc implements Calendar with a function.
+type CalendarFunc func(*Date) bool
+
+func (cf CalendarFunc) IsBusinessDay(dd *Date) bool {
+	return cf(dd)
+}
+
+// HolidayCalendar is a Calendar of weekdays excluding holidays.
+type HolidayCalendar struct {
+	weekend  map[time.Weekday]bool
+	holidays map[dateKey]bool
+}
+
+// NewHolidayCalendar returns a calendar where Saturday, Sunday and the given
+// holidays are not business days.
+func NewHolidayCalendar(holidays ...*Date) *HolidayCalendar {
+	cal := &HolidayCalendar{
+		weekend: map[time.Weekday]bool{
+			time.Saturday: true,
+			time.Sunday:   true,
+		},
+		holidays: map[dateKey]bool{},
+	}
+	cal.AddHolidays(holidays...)
+	return cal
+}
+
+// SetWeekend replaces the default weekend of Saturday and Sunday.
+func (cal *HolidayCalendar) SetWeekend(days ...time.Weekday) {
+	cal.weekend = map[time.Weekday]bool{}
+	for _, day := range days {
+		cal.weekend[day] = true
+	}
+}
+
+func (cal *HolidayCalendar) AddHolidays(holidays ...*Date) {
+	for _, holiday := range holidays {
+		cal.holidays[keyOf(holiday)] = true
+	}
+}
+
+func (cal *HolidayCalendar) IsBusinessDay(dd *Date) bool {
+	return !cal.weekend[dd.Weekday()] && !cal.holidays[keyOf(dd)]
+}
+
+// dateKey is a comparable copy of a Date, to use as a map key.
+type dateKey struct {
+	year, month, day int32
+}
+
+func keyOf(dd *Date) dateKey {
+	return dateKey{year: dd.Year, month: dd.Month, day: dd.Day}
+}
+
+// Weekdays is a calendar where every day other than Saturday and Sunday is a
+// business day.
+var Weekdays Calendar = NewHolidayCalendar()
+
+// IsBusinessDay returns true if the date is a business day in the calendar.
+func (dd *Date) IsBusinessDay(cal Calendar) bool {
+	return cal.IsBusinessDay(dd)
+}
+
+// AddBusinessDays returns the date n business days after dd, or before when n
+// is negative. dd itself need not be a business day, with n of 0 it is
+// returned unchanged.
+func (dd *Date) AddBusinessDays(cal Calendar, n int) *Date {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+	current := dd
+	for n > 0 {
+		current = current.AddDays(step)
+		if cal.IsBusinessDay(current) {
+			n--
+		}
+	}
+	return current
+}
+
+// RollForward returns dd if it is a business day, otherwise the next business
+// day after it.
+func (dd *Date) RollForward(cal Calendar) *Date {
+	current := dd
+	for !cal.IsBusinessDay(current) {
+		current = current.AddDays(1)
+	}
+	return current
+}
+
+// RollBackward returns dd if it is a business day, otherwise the last business
+// day before it.
+func (dd *Date) RollBackward(cal Calendar) *Date {
+	current := dd
+	for !cal.IsBusinessDay(current) {
+		current = current.AddDays(-1)
+	}
+	return current
+}
+
+// BusinessDaysUntil counts the business days after dd up to and including
+// other, negative when other is before dd, so that
+// dd.AddBusinessDays(cal, dd.BusinessDaysUntil(cal, other)) is other when other
+// is a business day.
+func (dd *Date) BusinessDaysUntil(cal Calendar, other *Date) int {
+	if other.Compare(dd) < 0 {
+		return -other.BusinessDaysUntil(cal, dd)
+	}
+	count := 0
+	for current := dd.AddDays(1); current.Compare(other) <= 0; current = current.AddDays(1) {
+		if cal.IsBusinessDay(current) {
+			count++
+		}
+	}
+	return count
+}
+
+// LastBusinessDayOfMonth returns the last business day in the month of dd.
+func (dd *Date) LastBusinessDayOfMonth(cal Calendar) *Date {
+	return dd.MonthEnd().RollBackward(cal)
+}
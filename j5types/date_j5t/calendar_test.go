@@ -0,0 +1,111 @@
+package date_j5t
+
+import (
+	"testing"
+)
+
+func mustDate(t *testing.T, str string) *Date {
+	t.Helper()
+	dd, err := DateFromString(str)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dd
+}
+
+func TestAddMonths(t *testing.T) {
+	for _, tc := range []struct {
+		date   string
+		months int
+		want   string
+	}{
+		{"2021-01-31", 1, "2021-02-28"},
+		{"2020-01-31", 1, "2020-02-29"},
+		{"2021-03-31", -1, "2021-02-28"},
+		{"2021-01-15", 13, "2022-02-15"},
+		{"2021-01-15", -13, "2019-12-15"},
+	} {
+		got := mustDate(t, tc.date).AddMonths(tc.months)
+		if got.DateString() != tc.want {
+			t.Errorf("%s + %d months: expected %s, got %s", tc.date, tc.months, tc.want, got.DateString())
+		}
+	}
+
+	if got := mustDate(t, "2020-02-29").AddYears(1).DateString(); got != "2021-02-28" {
+		t.Errorf("AddYears: expected 2021-02-28, got %s", got)
+	}
+}
+
+func TestMonthEnd(t *testing.T) {
+	dd := mustDate(t, "2024-02-10")
+	if got := dd.MonthEnd().DateString(); got != "2024-02-29" {
+		t.Errorf("MonthEnd: expected 2024-02-29, got %s", got)
+	}
+	if got := dd.MonthStart().DateString(); got != "2024-02-01" {
+		t.Errorf("MonthStart: expected 2024-02-01, got %s", got)
+	}
+	if dd.IsMonthEnd() || !dd.MonthEnd().IsMonthEnd() {
+		t.Errorf("IsMonthEnd mismatch")
+	}
+}
+
+func TestBusinessDays(t *testing.T) {
+	// 2024-03-29 is a Friday holiday, 2024-04-01 a Monday holiday
+	cal := NewHolidayCalendar(mustDate(t, "2024-03-29"), mustDate(t, "2024-04-01"))
+	thursday := mustDate(t, "2024-03-28")
+
+	for _, tc := range []struct {
+		days int
+		want string
+	}{
+		{0, "2024-03-28"},
+		{1, "2024-04-02"},
+		{2, "2024-04-03"},
+		{-1, "2024-03-27"},
+	} {
+		got := thursday.AddBusinessDays(cal, tc.days)
+		if got.DateString() != tc.want {
+			t.Errorf("AddBusinessDays(%d): expected %s, got %s", tc.days, tc.want, got.DateString())
+		}
+		if n := thursday.BusinessDaysUntil(cal, got); n != tc.days {
+			t.Errorf("BusinessDaysUntil(%s): expected %d, got %d", tc.want, tc.days, n)
+		}
+	}
+
+	saturday := mustDate(t, "2024-03-30")
+	if got := saturday.RollForward(cal).DateString(); got != "2024-04-02" {
+		t.Errorf("RollForward: expected 2024-04-02, got %s", got)
+	}
+	if got := saturday.RollBackward(cal).DateString(); got != "2024-03-28" {
+		t.Errorf("RollBackward: expected 2024-03-28, got %s", got)
+	}
+	if got := saturday.LastBusinessDayOfMonth(cal).DateString(); got != "2024-03-28" {
+		t.Errorf("LastBusinessDayOfMonth: expected 2024-03-28, got %s", got)
+	}
+	if !saturday.IsBusinessDay(CalendarFunc(func(*Date) bool { return true })) {
+		t.Errorf("expected CalendarFunc to be used")
+	}
+	if Weekdays.IsBusinessDay(saturday) {
+		t.Errorf("expected Saturday not to be a weekday")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	a := mustDate(t, "2024-01-31")
+	b := mustDate(t, "2024-02-01")
+	if a.Compare(b) != -1 || b.Compare(a) != 1 || a.Compare(a) != 0 {
+		t.Errorf("Compare mismatch")
+	}
+	if !a.Between(a, b) || b.Between(mustDate(t, "2023-01-01"), a) {
+		t.Errorf("Between mismatch")
+	}
+	if got := mustDate(t, "2025-01-01").Clamp(a, b); got != b {
+		t.Errorf("Clamp: expected %s, got %s", b.DateString(), got.DateString())
+	}
+	if days := a.DaysUntil(mustDate(t, "2024-03-01")); days != 30 {
+		t.Errorf("DaysUntil: expected 30, got %d", days)
+	}
+	if days := b.DaysUntil(a); days != -1 {
+		t.Errorf("DaysUntil: expected -1, got %d", days)
+	}
+}
@@ -1,6 +1,7 @@
 package date_j5t
 
 import (
+	"cmp"
 	"database/sql/driver"
 	"fmt"
 	"strconv"
@@ -152,3 +153,42 @@ func (dd *Date) After(other *Date) bool {
 func (dd *Date) IsZero() bool {
 	return dd.Year == 0 && dd.Month == 0 && dd.Day == 0
 }
+
+// Compare returns -1 if dd is before other, 0 if they are equal and +1 if dd is
+// after other.
+func (dd *Date) Compare(other *Date) int {
+	switch {
+	case dd.Year != other.Year:
+		return cmp.Compare(dd.Year, other.Year)
+	case dd.Month != other.Month:
+		return cmp.Compare(dd.Month, other.Month)
+	default:
+		return cmp.Compare(dd.Day, other.Day)
+	}
+}
+
+// Between returns true if start <= dd <= end.
+func (dd *Date) Between(start, end *Date) bool {
+	return dd.Compare(start) >= 0 && dd.Compare(end) <= 0
+}
+
+// Clamp returns dd limited to the range start to end, inclusive.
+func (dd *Date) Clamp(start, end *Date) *Date {
+	if dd.Compare(start) < 0 {
+		return start
+	}
+	if dd.Compare(end) > 0 {
+		return end
+	}
+	return dd
+}
+
+// DaysUntil returns the number of days from dd to other, negative when other
+// is before dd.
+func (dd *Date) DaysUntil(other *Date) int {
+	return int(other.AsTime(time.UTC).Sub(dd.AsTime(time.UTC)).Hours() / 24)
+}
+
+func (dd *Date) Weekday() time.Weekday {
+	return dd.AsTime(time.UTC).Weekday()
+}
@@ -0,0 +1,99 @@
+package decimal_j5t
+
+// Cmp compares the values numerically, returning -1 if d < d2, 0 if they are
+// equal and +1 if d > d2. Trailing zeros are ignored, 1.50 equals 1.5.
+func (d *Decimal) Cmp(d2 *Decimal) int {
+	return d.Decimal().Cmp(d2.Decimal())
+}
+
+// Equal returns true if the values are numerically equal.
+func (d *Decimal) Equal(d2 *Decimal) bool {
+	return d.Cmp(d2) == 0
+}
+
+func (d *Decimal) LessThan(d2 *Decimal) bool {
+	return d.Cmp(d2) < 0
+}
+
+func (d *Decimal) LessThanOrEqual(d2 *Decimal) bool {
+	return d.Cmp(d2) <= 0
+}
+
+func (d *Decimal) GreaterThan(d2 *Decimal) bool {
+	return d.Cmp(d2) > 0
+}
+
+func (d *Decimal) GreaterThanOrEqual(d2 *Decimal) bool {
+	return d.Cmp(d2) >= 0
+}
+
+// Sign returns -1, 0 or +1.
+func (d *Decimal) Sign() int {
+	return d.Decimal().Sign()
+}
+
+func (d *Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+func (d *Decimal) IsPositive() bool {
+	return d.Sign() > 0
+}
+
+func (d *Decimal) IsNegative() bool {
+	return d.Sign() < 0
+}
+
+func (d *Decimal) Abs() *Decimal {
+	return FromShop(d.Decimal().Abs())
+}
+
+// Between returns true if min <= d <= max.
+func (d *Decimal) Between(min, max *Decimal) bool {
+	return d.Cmp(min) >= 0 && d.Cmp(max) <= 0
+}
+
+// Clamp returns d limited to the range min to max, inclusive.
+func (d *Decimal) Clamp(min, max *Decimal) *Decimal {
+	if d.Cmp(min) < 0 {
+		return min
+	}
+	if d.Cmp(max) > 0 {
+		return max
+	}
+	return d
+}
+
+// Min returns the smallest of the values.
+func Min(first *Decimal, rest ...*Decimal) *Decimal {
+	result := first
+	for _, d := range rest {
+		if d.Cmp(result) < 0 {
+			result = d
+		}
+	}
+	return result
+}
+
+// Max returns the largest of the values.
+func Max(first *Decimal, rest ...*Decimal) *Decimal {
+	result := first
+	for _, d := range rest {
+		if d.Cmp(result) > 0 {
+			result = d
+		}
+	}
+	return result
+}
+
+// Sum returns the total of the values, zero when there are none.
+func Sum(values ...*Decimal) *Decimal {
+	if len(values) == 0 {
+		return Zero()
+	}
+	total := values[0].Decimal()
+	for _, d := range values[1:] {
+		total = total.Add(d.Decimal())
+	}
+	return FromShop(total)
+}
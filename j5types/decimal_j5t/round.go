@@ -0,0 +1,184 @@
+package decimal_j5t
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/shopspring/decimal"
+)
+
+// RoundingMode decides which way a value between two multiples of the scale is
+// rounded.
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds to the nearest, ties away from zero.
+	RoundHalfUp RoundingMode = iota
+
+	// RoundHalfDown rounds to the nearest, ties towards zero.
+	RoundHalfDown
+
+	// RoundHalfEven rounds to the nearest, ties to the even neighbour
+	// (banker's rounding).
+	RoundHalfEven
+
+	// RoundUp rounds away from zero.
+	RoundUp
+
+	// RoundDown rounds towards zero (truncates).
+	RoundDown
+
+	// RoundCeiling rounds towards positive infinity.
+	RoundCeiling
+
+	// RoundFloor rounds towards negative infinity.
+	RoundFloor
+)
+
+func (mode RoundingMode) String() string {
+	switch mode {
+	case RoundHalfUp:
+		return "HALF_UP"
+	case RoundHalfDown:
+		return "HALF_DOWN"
+	case RoundHalfEven:
+		return "HALF_EVEN"
+	case RoundUp:
+		return "UP"
+	case RoundDown:
+		return "DOWN"
+	case RoundCeiling:
+		return "CEILING"
+	case RoundFloor:
+		return "FLOOR"
+	default:
+		return fmt.Sprintf("RoundingMode(%d)", int(mode))
+	}
+}
+
+// Round rounds to places digits after the decimal point. Negative places round
+// to the left of the point, e.g. -2 rounds to hundreds.
+func (d *Decimal) Round(places int32, mode RoundingMode) (*Decimal, error) {
+	sd, err := d.ToShop()
+	if err != nil {
+		return nil, fmt.Errorf("error converting %v to decimal", d.Value)
+	}
+	// Truncate ignores negative places
+	q := sd.Shift(places).Truncate(0).Shift(-places)
+	r := sd.Sub(q)
+	rounded, err := roundQuotient(q, r, decimal.New(1, -places), sd.Sign() < 0, places, mode)
+	if err != nil {
+		return nil, err
+	}
+	return FromShop(rounded), nil
+}
+
+// DivRound divides, rounding the exact quotient to places digits after the
+// decimal point. Unlike Div, the result doesn't depend on the intermediate
+// division precision.
+func (d *Decimal) DivRound(d2 *Decimal, places int32, mode RoundingMode) (*Decimal, error) {
+	s1, err := d.ToShop()
+	if err != nil {
+		return nil, fmt.Errorf("error converting %v to decimal", d.Value)
+	}
+
+	s2, err := d2.ToShop()
+	if err != nil {
+		return nil, fmt.Errorf("error converting %v to decimal", d2.Value)
+	}
+
+	if s2.IsZero() {
+		return nil, fmt.Errorf("error divide by zero")
+	}
+
+	// s1 = s2 * q + r, where q is truncated to places and |r| < |s2| * 10^-places
+	q, r := s1.QuoRem(s2, places)
+	unit := s2.Abs().Mul(decimal.New(1, -places))
+	negative := s1.Sign()*s2.Sign() < 0
+	rounded, err := roundQuotient(q, r, unit, negative, places, mode)
+	if err != nil {
+		return nil, err
+	}
+	return FromShop(rounded), nil
+}
+
+// roundQuotient rounds the truncated value q, given the remainder r and the
+// size of one step at places, in units of the remainder.
+func roundQuotient(q, r, unit decimal.Decimal, negative bool, places int32, mode RoundingMode) (decimal.Decimal, error) {
+	if r.IsZero() {
+		return q, nil
+	}
+
+	half := r.Abs().Mul(decimal.NewFromInt(2)).Cmp(unit)
+
+	var away bool
+	switch mode {
+	case RoundHalfUp:
+		away = half >= 0
+	case RoundHalfDown:
+		away = half > 0
+	case RoundHalfEven:
+		away = half > 0 || (half == 0 && q.Shift(places).BigInt().Bit(0) == 1)
+	case RoundUp:
+		away = true
+	case RoundDown:
+		away = false
+	case RoundCeiling:
+		away = !negative
+	case RoundFloor:
+		away = negative
+	default:
+		return decimal.Decimal{}, fmt.Errorf("unknown rounding mode %s", mode)
+	}
+
+	if !away {
+		return q, nil
+	}
+	step := decimal.New(1, -places)
+	if negative {
+		return q.Sub(step), nil
+	}
+	return q.Add(step), nil
+}
+
+var reCurrencyCode = regexp.MustCompile(`^[A-Z]{3}$`)
+
+// currencyScales lists the ISO 4217 currencies with other than two minor units.
+var currencyScales = map[string]int32{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0,
+	"KRW": 0, "PYG": 0, "RWF": 0, "UGX": 0, "VND": 0, "VUV": 0, "XAF": 0,
+	"XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+	"CLF": 4, "UYW": 4,
+}
+
+// CurrencyScale returns the number of minor unit digits of an ISO 4217
+// currency code, which is 2 unless the currency is known to differ.
+func CurrencyScale(code string) (int32, error) {
+	if !reCurrencyCode.MatchString(code) {
+		return 0, fmt.Errorf("invalid currency code %q", code)
+	}
+	if scale, ok := currencyScales[code]; ok {
+		return scale, nil
+	}
+	return 2, nil
+}
+
+// RoundCurrency rounds to the minor units of the currency, see CurrencyScale.
+func (d *Decimal) RoundCurrency(code string, mode RoundingMode) (*Decimal, error) {
+	scale, err := CurrencyScale(code)
+	if err != nil {
+		return nil, err
+	}
+	return d.Round(scale, mode)
+}
+
+// StringFixed formats with exactly places digits after the decimal point,
+// rounding with mode, e.g. for display of currency amounts.
+func (d *Decimal) StringFixed(places int32, mode RoundingMode) (string, error) {
+	rounded, err := d.Round(places, mode)
+	if err != nil {
+		return "", err
+	}
+	return rounded.Decimal().StringFixed(places), nil
+}
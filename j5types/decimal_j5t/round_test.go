@@ -0,0 +1,165 @@
+package decimal_j5t
+
+import (
+	"testing"
+)
+
+func TestRound(t *testing.T) {
+	for _, tc := range []struct {
+		value  string
+		places int32
+		want   map[RoundingMode]string
+	}{{
+		value:  "2.5",
+		places: 0,
+		want: map[RoundingMode]string{
+			RoundHalfUp:   "3",
+			RoundHalfDown: "2",
+			RoundHalfEven: "2",
+			RoundUp:       "3",
+			RoundDown:     "2",
+			RoundCeiling:  "3",
+			RoundFloor:    "2",
+		},
+	}, {
+		value:  "-2.5",
+		places: 0,
+		want: map[RoundingMode]string{
+			RoundHalfUp:   "-3",
+			RoundHalfDown: "-2",
+			RoundHalfEven: "-2",
+			RoundUp:       "-3",
+			RoundDown:     "-2",
+			RoundCeiling:  "-2",
+			RoundFloor:    "-3",
+		},
+	}, {
+		value:  "1.235",
+		places: 2,
+		want: map[RoundingMode]string{
+			RoundHalfUp:   "1.24",
+			RoundHalfDown: "1.23",
+			RoundHalfEven: "1.24",
+			RoundDown:     "1.23",
+		},
+	}, {
+		value:  "1.2351",
+		places: 2,
+		want: map[RoundingMode]string{
+			RoundHalfDown: "1.24",
+			RoundHalfEven: "1.24",
+		},
+	}, {
+		value:  "1.20",
+		places: 1,
+		want: map[RoundingMode]string{
+			RoundUp:    "1.2",
+			RoundFloor: "1.2",
+		},
+	}, {
+		value:  "1250",
+		places: -2,
+		want: map[RoundingMode]string{
+			RoundHalfEven: "1200",
+			RoundHalfUp:   "1300",
+		},
+	}} {
+		for mode, want := range tc.want {
+			got, err := FromString(tc.value).Round(tc.places, mode)
+			if err != nil {
+				t.Fatal(err)
+			}
+			AssertEqual(t, want, got, tc.value+" "+mode.String())
+		}
+	}
+}
+
+func TestRoundErrors(t *testing.T) {
+	if _, err := FromString("1.x").Round(2, RoundHalfUp); err == nil {
+		t.Errorf("expected an invalid decimal error")
+	}
+	if _, err := FromString("1.55").Round(1, RoundingMode(99)); err == nil {
+		t.Errorf("expected an unknown rounding mode error")
+	}
+	if _, err := FromString("1.x").StringFixed(2, RoundHalfUp); err == nil {
+		t.Errorf("expected an invalid decimal error")
+	}
+}
+
+func TestDivRound(t *testing.T) {
+	for _, tc := range []struct {
+		a, b   string
+		places int32
+		mode   RoundingMode
+		want   string
+	}{
+		{"10", "3", 2, RoundHalfUp, "3.33"},
+		{"20", "3", 2, RoundHalfUp, "6.67"},
+		{"20", "3", 2, RoundDown, "6.66"},
+		{"-20", "3", 2, RoundCeiling, "-6.66"},
+		{"-20", "3", 2, RoundFloor, "-6.67"},
+		{"1", "8", 2, RoundHalfEven, "0.12"},
+		{"3", "8", 2, RoundHalfEven, "0.38"},
+		{"1", "-8", 2, RoundHalfUp, "-0.13"},
+		// exactly half only when all digits are considered
+		{"1", "3", 0, RoundHalfUp, "0"},
+		{"5", "2", 0, RoundHalfDown, "2"},
+		{"5", "2", 0, RoundUp, "3"},
+	} {
+		got, err := FromString(tc.a).DivRound(FromString(tc.b), tc.places, tc.mode)
+		if err != nil {
+			t.Errorf("%s / %s: %v", tc.a, tc.b, err)
+			continue
+		}
+		AssertEqual(t, tc.want, got, tc.a+" / "+tc.b+" "+tc.mode.String())
+	}
+
+	if _, err := FromInt(1).DivRound(Zero(), 2, RoundHalfUp); err == nil {
+		t.Errorf("expected a divide by zero error")
+	}
+
+	if _, err := FromString("1.5").DivRound(FromInt(1), 0, RoundingMode(99)); err == nil {
+		t.Errorf("expected an unknown rounding mode error")
+	}
+}
+
+func TestRoundCurrency(t *testing.T) {
+	for code, want := range map[string]string{
+		"USD": "1234.57",
+		"JPY": "1235",
+		"KWD": "1234.568",
+		"CLF": "1234.5678",
+	} {
+		got, err := FromString("1234.5678").RoundCurrency(code, RoundHalfEven)
+		if err != nil {
+			t.Errorf("%s: %v", code, err)
+			continue
+		}
+		AssertEqual(t, want, got, code)
+	}
+
+	if _, err := FromInt(1).RoundCurrency("usd", RoundHalfUp); err == nil {
+		t.Errorf("expected an invalid currency error")
+	}
+
+	if got, err := FromString("1.5").StringFixed(2, RoundHalfUp); err != nil || got != "1.50" {
+		t.Errorf("expected 1.50, got %s, %v", got, err)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	if !FromString("1.50").Equal(FromString("1.5")) {
+		t.Errorf("expected 1.50 to equal 1.5")
+	}
+	if !FromInt(1).LessThan(FromString("1.01")) {
+		t.Errorf("expected 1 < 1.01")
+	}
+	if !FromInt(2).Between(FromInt(1), FromInt(2)) {
+		t.Errorf("expected 2 between 1 and 2")
+	}
+	AssertEqual(t, "10", FromInt(12).Clamp(Zero(), FromInt(10)), "clamp")
+	AssertEqual(t, "-3", Min(FromInt(1), FromInt(-3), FromInt(2)), "min")
+	AssertEqual(t, "2", Max(FromInt(1), FromInt(-3), FromInt(2)), "max")
+	AssertEqual(t, "0.6", Sum(FromString("0.1"), FromString("0.2"), FromString("0.3")), "sum")
+	AssertEqual(t, "0", Sum(), "empty sum")
+}
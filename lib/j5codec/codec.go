@@ -32,3 +32,17 @@ func WithProtoToAny() CodecOption {
 func WithIncludeEmpty() CodecOption {
 	return codec.WithIncludeEmpty()
 }
+
+// WithCanonical encodes JSON in a deterministic form for hashing and
+// signatures: object and map keys are sorted, and numbers, decimals, timestamps
+// and dates have a single representation. WithIncludeEmpty has no effect.
+func WithCanonical() CodecOption {
+	return codec.WithCanonical()
+}
+
+// ArrayElementFunc receives each element decoded by
+// Codec.DecodeJSONArrayStream.
+type ArrayElementFunc = codec.ArrayElementFunc
+
+// ArrayElements yields the elements encoded by Codec.EncodeJSONArrayStream.
+type ArrayElements = codec.ArrayElements
@@ -0,0 +1,27 @@
+package j5codec
+
+import (
+	"crypto/sha256"
+
+	"github.com/pentops/j5/internal/codec"
+	"google.golang.org/protobuf/proto"
+)
+
+var canonicalCodec = codec.NewCodec(codec.WithCanonical())
+
+// Hash returns the SHA-256 of the message's full name, a newline, and the
+// canonical J5 JSON of the message. Messages with the same J5 value have the
+// same hash, regardless of field order or how the value was constructed.
+func Hash(msg proto.Message) ([]byte, error) {
+	refl := msg.ProtoReflect()
+	jsonData, err := canonicalCodec.ProtoToJSON(refl)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(refl.Descriptor().FullName()))
+	hash.Write([]byte("\n"))
+	hash.Write(jsonData)
+	return hash.Sum(nil), nil
+}
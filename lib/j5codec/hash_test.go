@@ -0,0 +1,45 @@
+package j5codec
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/pentops/j5/internal/gen/test/schema/v1/schema_testpb"
+	"github.com/pentops/j5/j5types/decimal_j5t"
+)
+
+func TestHash(t *testing.T) {
+	hash := func(msg *schema_testpb.FullSchema) []byte {
+		t.Helper()
+		sum, err := Hash(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return sum
+	}
+
+	a := hash(&schema_testpb.FullSchema{
+		SString: "a",
+		Decimal: decimal_j5t.FromString("1.10"),
+		MapStringString: map[string]string{
+			"x": "1", "y": "2", "z": "3",
+		},
+	})
+	b := hash(&schema_testpb.FullSchema{
+		MapStringString: map[string]string{
+			"z": "3", "y": "2", "x": "1",
+		},
+		Decimal: decimal_j5t.FromString("1.1"),
+		SString: "a",
+	})
+	if !bytes.Equal(a, b) {
+		t.Errorf("expected equal hashes, got %x and %x", a, b)
+	}
+
+	c := hash(&schema_testpb.FullSchema{
+		SString: "b",
+	})
+	if bytes.Equal(a, c) {
+		t.Errorf("expected different hashes")
+	}
+}
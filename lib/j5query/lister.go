@@ -260,6 +260,13 @@ func (ll *Lister) SetQueryLogger(logger QueryLogger) {
 }
 
 func (ll *Lister) List(ctx context.Context, db Transactor, req, res j5reflect.Object) error {
+	return ll.ListMasked(ctx, db, req, res, nil)
+}
+
+// ListMasked is List, loading only the properties of each row selected by the
+// mask, which is relative to the response, e.g. parsed from the proxy's
+// FieldMaskHeader. A nil mask loads whole rows.
+func (ll *Lister) ListMasked(ctx context.Context, db Transactor, req, res j5reflect.Object, mask *j5schema.FieldMask) error {
 	if err := ll.validator.Validate(req); err != nil {
 		return fmt.Errorf("validating request %s: %w", req.SchemaName(), err)
 	}
@@ -269,7 +276,7 @@ func (ll *Lister) List(ctx context.Context, db Transactor, req, res j5reflect.Ob
 		return fmt.Errorf("get page size: %w", err)
 	}
 
-	selectQuery, err := ll.BuildQuery(ctx, req, res)
+	selectQuery, err := ll.buildQuery(ctx, req, res, mask)
 	if err != nil {
 		return fmt.Errorf("build query: %w", err)
 	}
@@ -436,6 +443,10 @@ func fieldAs[T any](obj j5reflect.Object, path ...string) (val T, ok bool, err e
 }
 
 func (ll *Lister) BuildQuery(ctx context.Context, req j5reflect.Object, res j5reflect.Object) (*Query, error) {
+	return ll.buildQuery(ctx, req, res, nil)
+}
+
+func (ll *Lister) buildQuery(ctx context.Context, req j5reflect.Object, res j5reflect.Object, mask *j5schema.FieldMask) (*Query, error) {
 	err := assertObjectsMatch(ll.method, req, res)
 	if err != nil {
 		return nil, err
@@ -451,7 +462,15 @@ func (ll *Lister) BuildQuery(ctx context.Context, req j5reflect.Object, res j5re
 		return nil, err
 	}
 
-	query.AddRootColumn()
+	rowMask, err := ll.rowMask(mask, query.sortFields)
+	if err != nil {
+		return nil, err
+	}
+	if rowMask == nil {
+		query.AddRootColumn()
+	} else {
+		query.Column(maskProjection(fmt.Sprintf("%s.%s", query.rootTableAlias, query.mainDataColumn), ll.arrayObject, rowMask))
+	}
 
 	if ll.requestFilter != nil {
 		filter, err := ll.requestFilter(req)
@@ -520,6 +539,63 @@ func (ll *Lister) BuildQuery(ctx context.Context, req j5reflect.Object, res j5re
 	return query, nil
 }
 
+// rowMask converts the response mask to a mask for each row, including the
+// sort fields for the page token. It returns nil to load whole rows.
+func (ll *ListReflectionSet) rowMask(mask *j5schema.FieldMask, sortFields []sortSpec) (*j5schema.FieldMask, error) {
+	if mask == nil {
+		return nil, nil
+	}
+	rowMask, ok := mask.Child(ll.arrayField.JSONName)
+	if ok && rowMask == nil {
+		return nil, nil
+	}
+
+	paths := []string{}
+	if ok {
+		paths = append(paths, rowMask.Paths()...)
+	}
+	for _, sortField := range sortFields {
+		paths = append(paths, sortField.Path.ClientPath())
+	}
+	rowMask, err := j5schema.ParseFieldMask(ll.arrayObject, paths...)
+	if err != nil {
+		return nil, fmt.Errorf("row field mask: %w", err)
+	}
+	return rowMask, nil
+}
+
+// maskProjection builds a jsonb expression with the properties of the object
+// at expr which are selected by the mask. Properties within arrays are loaded
+// whole, the codec trims them when encoding the response.
+func maskProjection(expr string, obj hasPropertySet, mask *j5schema.FieldMask) string {
+	args := []string{}
+	if _, ok := obj.(*j5schema.OneofSchema); ok {
+		args = append(args, "'!type'", fmt.Sprintf("%s->'!type'", expr))
+	}
+	for _, prop := range obj.ClientProperties() {
+		child, ok := mask.Child(prop.JSONName)
+		if !ok {
+			continue
+		}
+		propExpr := fmt.Sprintf("%s->'%s'", expr, prop.JSONName)
+		if child != nil {
+			var container hasPropertySet
+			switch ft := prop.Schema.(type) {
+			case *j5schema.ObjectField:
+				container = ft.ObjectSchema()
+			case *j5schema.OneofField:
+				container = ft.OneofSchema()
+			}
+			if container != nil {
+				// keep unset objects unset, rather than empty
+				propExpr = fmt.Sprintf("CASE WHEN %s IS NULL THEN NULL ELSE %s END", propExpr, maskProjection("("+propExpr+")", container, child))
+			}
+		}
+		args = append(args, fmt.Sprintf("'%s'", prop.JSONName), propExpr)
+	}
+	return fmt.Sprintf("jsonb_strip_nulls(jsonb_build_object(%s))", strings.Join(args, ", "))
+}
+
 func (ll *Lister) addPageFilter(token string, sortFields []sortSpec, tableAlias string) (sq.Sqlizer, error) {
 	lhsFields := make([]string, 0, len(sortFields))
 	rhsValues := make([]any, 0, len(sortFields))
@@ -230,6 +230,64 @@ func TestBuildListReflection(t *testing.T) {
 
 	})
 
+	runHappy("field mask projection", `
+		message FooListRequest {
+			j5.list.v1.PageRequest page = 1;
+			j5.list.v1.QueryRequest query = 2;
+			option (j5.list.v1.list_request) = {
+				sort_tiebreaker: ["id"]
+			};
+		}
+
+		message FooListResponse {
+			repeated Foo foos = 1;
+			j5.list.v1.PageResponse page = 2;
+		}
+
+		message Foo {
+			string id = 1;
+			Bar bar = 2;
+			repeated Bar bars = 3;
+			string name = 4;
+		}
+
+		message Bar {
+			string a = 1;
+			string b = 2;
+		}
+		`, nil, func(t *testing.T, lr *ListReflectionSet) {
+
+		response := &j5schema.ObjectSchema{
+			Properties: j5schema.PropertySet{lr.arrayField},
+		}
+		mask, err := j5schema.ParseFieldMask(response, "foos.bar.a", "foos.bars.b")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rowMask, err := lr.rowMask(mask, lr.tieBreakerFields)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, []string{"bar.a", "bars.b", "id"}, rowMask.Paths())
+
+		want := "jsonb_strip_nulls(jsonb_build_object(" +
+			"'id', ALIAS.data->'id', " +
+			"'bar', CASE WHEN ALIAS.data->'bar' IS NULL THEN NULL ELSE jsonb_strip_nulls(jsonb_build_object('a', (ALIAS.data->'bar')->'a')) END, " +
+			"'bars', ALIAS.data->'bars'))"
+		assert.Equal(t, want, maskProjection("ALIAS.data", lr.arrayObject, rowMask))
+
+		mask, err = j5schema.ParseFieldMask(response, "foos")
+		if err != nil {
+			t.Fatal(err)
+		}
+		rowMask, err = lr.rowMask(mask, lr.tieBreakerFields)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Nil(t, rowMask)
+	})
+
 	runHappy("override default page size by validation", `
 		message FooListRequest {
 			j5.list.v1.PageRequest page = 1;
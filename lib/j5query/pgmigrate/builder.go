@@ -86,6 +86,7 @@ const (
 	Timestamptz ColumnType = "timestamptz"
 	JSONB       ColumnType = "jsonb"
 	Int         ColumnType = "int"
+	Bytea       ColumnType = "bytea"
 )
 
 func (t *CreateTableBuilder) Build() (*Table, error) {
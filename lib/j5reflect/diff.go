@@ -0,0 +1,175 @@
+package j5reflect
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+type ChangeKind int
+
+const (
+	ChangeAdded ChangeKind = iota + 1
+	ChangeRemoved
+	ChangeChanged
+)
+
+func (ck ChangeKind) String() string {
+	switch ck {
+	case ChangeAdded:
+		return "added"
+	case ChangeRemoved:
+		return "removed"
+	case ChangeChanged:
+		return "changed"
+	default:
+		return fmt.Sprintf("Unknown(%d)", int(ck))
+	}
+}
+
+// Change is a single difference between two values. Old and New are the J5
+// JSON values, Old is nil when added and New is nil when removed.
+type Change struct {
+	Kind ChangeKind
+	Old  json.RawMessage
+	New  json.RawMessage
+
+	clientPath []string
+	pointer    []string
+}
+
+// JSONPath returns the path of the change in the same format as
+// j5validate.Error.JSONPath, "-" for the root.
+func (c Change) JSONPath() string {
+	if len(c.clientPath) == 0 {
+		return "-"
+	}
+	out := strings.Join(c.clientPath, ".")
+	return strings.ReplaceAll(out, ".[", "[")
+}
+
+// Pointer returns the path of the change as an RFC 6901 JSON Pointer.
+func (c Change) Pointer() string {
+	return formatPointer(c.pointer)
+}
+
+// Changes is the result of Diff, in schema order.
+type Changes []Change
+
+// String renders the changes as text, one per line, prefixed '+' for added,
+// '-' for removed and '~' for changed.
+func (cc Changes) String() string {
+	out := &strings.Builder{}
+	for _, change := range cc {
+		switch change.Kind {
+		case ChangeAdded:
+			fmt.Fprintf(out, "+ %s: %s\n", change.JSONPath(), change.New)
+		case ChangeRemoved:
+			fmt.Fprintf(out, "- %s: %s\n", change.JSONPath(), change.Old)
+		default:
+			fmt.Fprintf(out, "~ %s: %s -> %s\n", change.JSONPath(), change.Old, change.New)
+		}
+	}
+	return out.String()
+}
+
+type jsonChange struct {
+	Kind string          `json:"kind"`
+	Path string          `json:"path"`
+	Old  json.RawMessage `json:"old,omitempty"`
+	New  json.RawMessage `json:"new,omitempty"`
+}
+
+// MarshalJSON renders the changes as an array of objects with kind, path, old
+// and new.
+func (cc Changes) MarshalJSON() ([]byte, error) {
+	out := make([]jsonChange, 0, len(cc))
+	for _, change := range cc {
+		out = append(out, jsonChange{
+			Kind: change.Kind.String(),
+			Path: change.JSONPath(),
+			Old:  change.Old,
+			New:  change.New,
+		})
+	}
+	return json.Marshal(out)
+}
+
+// Diff walks both values by schema, returning the changes from a to b. Both
+// must have the same schema. The walk is the same as CreateJSONPatch, with the
+// old values and client paths kept.
+//
+// Objects and maps are compared property by property and key by key. When a
+// oneof changes type the whole oneof is changed, and Any and Polymorph values
+// are compared as a whole. Array elements are compared by index, so inserting
+// an element changes those after it.
+func Diff(a, b Root) (Changes, error) {
+	if a.SchemaName() != b.SchemaName() {
+		return nil, fmt.Errorf("can't diff %s with %s", a.SchemaName(), b.SchemaName())
+	}
+
+	pc := &patchCreator{}
+	switch aRoot := a.(type) {
+	case Object:
+		bRoot, ok := b.(Object)
+		if !ok {
+			return nil, fmt.Errorf("schema mismatch, %s is not an object", b.SchemaName())
+		}
+		if err := pc.diffSets(aRoot, bRoot, diffPath{}); err != nil {
+			return nil, err
+		}
+
+	case Oneof:
+		bRoot, ok := b.(Oneof)
+		if !ok {
+			return nil, fmt.Errorf("schema mismatch, %s is not a oneof", b.SchemaName())
+		}
+		if err := pc.diffRootOneofs(aRoot, bRoot); err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported root type %T", a)
+	}
+
+	return pc.changes, nil
+}
+
+func (pc *patchCreator) diffRootOneofs(from, to Oneof) error {
+	fromOption, fromOK, err := from.GetOne()
+	if err != nil {
+		return err
+	}
+	toOption, toOK, err := to.GetOne()
+	if err != nil {
+		return err
+	}
+	if fromOK && toOK && fromOption.NameInParent() == toOption.NameInParent() {
+		return pc.diffFields(fromOption, toOption, diffPath{}.property(fromOption.NameInParent()))
+	}
+
+	var fromVal, toVal any
+	if fromOK {
+		fromVal, err = jsonFromOneof(from)
+		if err != nil {
+			return err
+		}
+	}
+	if toOK {
+		toVal, err = jsonFromOneof(to)
+		if err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case !fromOK && !toOK:
+		return nil
+	case !fromOK:
+		return pc.add(ChangeAdded, diffPath{}, nil, toVal)
+	case !toOK:
+		return pc.add(ChangeRemoved, diffPath{}, fromVal, nil)
+	default:
+		return pc.add(ChangeChanged, diffPath{}, fromVal, toVal)
+	}
+}
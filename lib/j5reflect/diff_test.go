@@ -0,0 +1,117 @@
+package j5reflect
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pentops/j5/internal/gen/test/schema/v1/schema_testpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	a := &schema_testpb.FullSchema{
+		SString: "a",
+		SBar:    &schema_testpb.Bar{BarId: "1", BarField: "x"},
+		RString: []string{"a", "b"},
+		MapStringBar: map[string]*schema_testpb.Bar{
+			"k": {BarId: "1"},
+		},
+		WrappedOneof: &schema_testpb.WrappedOneof{
+			Type: &schema_testpb.WrappedOneof_WOneofString{WOneofString: "a"},
+		},
+	}
+	b := &schema_testpb.FullSchema{
+		SInt32:  5,
+		SBar:    &schema_testpb.Bar{BarId: "1", BarField: "y"},
+		RString: []string{"c"},
+		MapStringBar: map[string]*schema_testpb.Bar{
+			"k": {BarId: "2"},
+			"l": {BarId: "3"},
+		},
+		WrappedOneof: &schema_testpb.WrappedOneof{
+			Type: &schema_testpb.WrappedOneof_WOneofFloat{WOneofFloat: 1.5},
+		},
+	}
+
+	changes, err := Diff(patchTestObject(t, a), patchTestObject(t, b))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, ""+
+		"- sString: \"a\"\n"+
+		"~ rString[0]: \"a\" -> \"c\"\n"+
+		"- rString[1]: \"b\"\n"+
+		"+ sInt32: 5\n"+
+		"~ sBar.barField: \"x\" -> \"y\"\n"+
+		"~ mapStringBar.k.barId: \"1\" -> \"2\"\n"+
+		"+ mapStringBar.l: {\"barId\":\"3\"}\n"+
+		"~ wrappedOneof: {\"!type\":\"wOneofString\",\"wOneofString\":\"a\"} -> {\"!type\":\"wOneofFloat\",\"wOneofFloat\":1.5}\n",
+		changes.String())
+
+	assert.Equal(t, ChangeChanged, changes[1].Kind)
+	assert.Equal(t, "/rString/0", changes[1].Pointer())
+
+	jsonOut, err := json.Marshal(changes[:2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.JSONEq(t, `[
+		{"kind": "removed", "path": "sString", "old": "a"},
+		{"kind": "changed", "path": "rString[0]", "old": "a", "new": "c"}
+	]`, string(jsonOut))
+
+	changes, err = Diff(patchTestObject(t, a), patchTestObject(t, a))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, changes)
+}
+
+func TestDiffOneofRoot(t *testing.T) {
+	refl := New()
+	newRoot := func(msg *schema_testpb.WrappedOneof) Root {
+		root, err := refl.NewRoot(msg.ProtoReflect())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return root
+	}
+
+	changes, err := Diff(
+		newRoot(&schema_testpb.WrappedOneof{Type: &schema_testpb.WrappedOneof_WOneofBar{WOneofBar: &schema_testpb.Bar{BarId: "1"}}}),
+		newRoot(&schema_testpb.WrappedOneof{Type: &schema_testpb.WrappedOneof_WOneofBar{WOneofBar: &schema_testpb.Bar{BarId: "2"}}}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "~ wOneofBar.barId: \"1\" -> \"2\"\n", changes.String())
+
+	changes, err = Diff(
+		newRoot(&schema_testpb.WrappedOneof{Type: &schema_testpb.WrappedOneof_WOneofString{WOneofString: "a"}}),
+		newRoot(&schema_testpb.WrappedOneof{}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "- -: {\"!type\":\"wOneofString\",\"wOneofString\":\"a\"}\n", changes.String())
+	assert.Equal(t, ChangeRemoved, changes[0].Kind)
+
+	changes, err = Diff(
+		newRoot(&schema_testpb.WrappedOneof{}),
+		newRoot(&schema_testpb.WrappedOneof{Type: &schema_testpb.WrappedOneof_WOneofString{WOneofString: "a"}}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "+ -: {\"!type\":\"wOneofString\",\"wOneofString\":\"a\"}\n", changes.String())
+
+	changes, err = Diff(
+		newRoot(&schema_testpb.WrappedOneof{Type: &schema_testpb.WrappedOneof_WOneofString{WOneofString: "a"}}),
+		newRoot(&schema_testpb.WrappedOneof{Type: &schema_testpb.WrappedOneof_WOneofFloat{WOneofFloat: 1.5}}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "~ -: {\"!type\":\"wOneofString\",\"wOneofString\":\"a\"} -> {\"!type\":\"wOneofFloat\",\"wOneofFloat\":1.5}\n", changes.String())
+}
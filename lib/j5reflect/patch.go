@@ -0,0 +1,782 @@
+package j5reflect
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pentops/j5/lib/j5reflect/protoval"
+	"github.com/pentops/j5/lib/patherr"
+	"google.golang.org/protobuf/proto"
+)
+
+// PatchOperation is a single operation of an RFC 6902 JSON Patch.
+//
+// Path and From are RFC 6901 JSON Pointers into the J5 JSON encoding of the
+// object, as produced by the codec: object properties by their JSON name,
+// oneof options by name under the oneof, array elements by index and map
+// entries by key. A oneof's '!type' can be read, e.g. by 'test', but not set,
+// setting an option sets the type.
+//
+// Any and Polymorph values are replaced as a whole, paths can't point inside
+// them.
+type PatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+const (
+	PatchAdd     = "add"
+	PatchRemove  = "remove"
+	PatchReplace = "replace"
+	PatchMove    = "move"
+	PatchCopy    = "copy"
+	PatchTest    = "test"
+
+	// PatchMerge is the Op of errors from ApplyMergePatch
+	PatchMerge = "merge"
+)
+
+// PatchErrors is returned when a patch can't be applied, with an error for
+// each failed path.
+type PatchErrors []PatchError
+
+func (e PatchErrors) Error() string {
+	if len(e) == 0 {
+		return "no patch errors"
+	}
+	msg := "patch errors:\n"
+	for _, err := range e {
+		msg += fmt.Sprintf("- %s\n", err.Error())
+	}
+	return msg
+}
+
+type PatchError struct {
+	Op         string
+	clientPath []string
+	Message    string
+}
+
+// JSONPath returns the path of the error in the same format as
+// j5validate.Error.JSONPath
+func (e PatchError) JSONPath() string {
+	if len(e.clientPath) == 0 {
+		return "-"
+	}
+	out := strings.Join(e.clientPath, ".")
+	return strings.ReplaceAll(out, ".[", "[")
+}
+
+func (e PatchError) Error() string {
+	return fmt.Sprintf("%s %s: %s", e.Op, e.JSONPath(), e.Message)
+}
+
+func newPatchError(op string, clientPath []string, err error) PatchError {
+	path := slices.Clone(clientPath)
+	pe := &patherr.Error{}
+	if errors.As(err, &pe) {
+		path = append(path, pe.Path...)
+		err = pe.Err
+	}
+	return PatchError{
+		Op:         op,
+		clientPath: path,
+		Message:    err.Error(),
+	}
+}
+
+// ApplyJSONPatch applies an RFC 6902 JSON Patch document to the object. The
+// operations are applied in order, and if any fails the object is not
+// modified, and the error is PatchErrors with the failed operation.
+func ApplyJSONPatch(obj Object, patch []byte) error {
+	ops := []PatchOperation{}
+	if err := json.Unmarshal(patch, &ops); err != nil {
+		return fmt.Errorf("parsing JSON patch: %w", err)
+	}
+	return ApplyPatchOperations(obj, ops)
+}
+
+// ApplyPatchOperations is ApplyJSONPatch with the parsed operations.
+func ApplyPatchOperations(obj Object, ops []PatchOperation) error {
+	return patchAtomic(obj, func(work Object) error {
+		for _, op := range ops {
+			if err := applyPatchOperation(work, op); err != nil {
+				return PatchErrors{*err}
+			}
+		}
+		return nil
+	})
+}
+
+// ApplyMergePatch applies an RFC 7396 JSON Merge Patch document to the object.
+//
+// Objects, oneofs and maps are merged, null removes a property or map entry.
+// All other values, including arrays, Any and Polymorph values, are replaced.
+// A oneof option can only be set when no other option is set, or the other is
+// removed in the same patch.
+//
+// If any path fails the object is not modified, and the error is PatchErrors
+// with an error for each failed path.
+func ApplyMergePatch(obj Object, patch []byte) error {
+	val, err := decodeGenericJSON(patch)
+	if err != nil {
+		return fmt.Errorf("parsing merge patch: %w", err)
+	}
+	members, ok := val.(map[string]any)
+	if !ok {
+		return PatchErrors{{
+			Op:      PatchMerge,
+			Message: unexpectedJSONType(val, "object").Error(),
+		}}
+	}
+
+	return patchAtomic(obj, func(work Object) error {
+		errs := PatchErrors{}
+		mergeIntoSet(work, false, members, nil, &errs)
+		if len(errs) > 0 {
+			return errs
+		}
+		return nil
+	})
+}
+
+// patchAtomic applies the patch to a copy of the object's message, and only
+// copies the result back when it succeeds.
+func patchAtomic(obj Object, apply func(Object) error) error {
+	msg := obj.ProtoReflect()
+	if msg == nil {
+		return fmt.Errorf("object %s is not set", obj.SchemaName())
+	}
+
+	clone := proto.Clone(msg.Interface()).ProtoReflect()
+	work, err := buildObject(obj.ObjectSchema(), protoval.NewRootMessageValue(clone), clone.Descriptor())
+	if err != nil {
+		return err
+	}
+
+	if err := apply(work); err != nil {
+		return err
+	}
+
+	proto.Reset(msg.Interface())
+	proto.Merge(msg.Interface(), clone.Interface())
+	return nil
+}
+
+func applyPatchOperation(root Object, op PatchOperation) *PatchError {
+	fail := func(path []string, err error) *PatchError {
+		pe := newPatchError(op.Op, path, err)
+		return &pe
+	}
+
+	pathTokens, err := parsePointer(op.Path)
+	if err != nil {
+		return fail(nil, err)
+	}
+
+	var value any
+	switch op.Op {
+	case PatchAdd, PatchReplace, PatchTest:
+		if len(op.Value) == 0 {
+			return fail(nil, fmt.Errorf("missing value for %q at %q", op.Op, op.Path))
+		}
+		value, err = decodeGenericJSON(op.Value)
+		if err != nil {
+			return fail(nil, err)
+		}
+
+	case PatchMove, PatchCopy:
+		fromTokens, err := parsePointer(op.From)
+		if err != nil {
+			return fail(nil, err)
+		}
+		from, clientPath, err := resolvePatchTarget(root, fromTokens)
+		if err != nil {
+			return fail(clientPath, err)
+		}
+		var ok bool
+		value, ok, err = from.get()
+		if err != nil {
+			return fail(clientPath, err)
+		}
+		if !ok {
+			return fail(clientPath, fmt.Errorf("from %q not found", op.From))
+		}
+		if op.Op == PatchMove {
+			if len(fromTokens) < len(pathTokens) && slices.Equal(fromTokens, pathTokens[:len(fromTokens)]) {
+				return fail(clientPath, fmt.Errorf("can't move %q into itself", op.From))
+			}
+			if err := from.remove(); err != nil {
+				return fail(clientPath, err)
+			}
+		}
+
+	case PatchRemove:
+
+	default:
+		return fail(nil, fmt.Errorf("unknown patch op %q", op.Op))
+	}
+
+	target, clientPath, err := resolvePatchTarget(root, pathTokens)
+	if err != nil {
+		return fail(clientPath, err)
+	}
+
+	switch op.Op {
+	case PatchAdd, PatchMove, PatchCopy:
+		err = target.set(value, false)
+
+	case PatchReplace:
+		err = target.set(value, true)
+
+	case PatchRemove:
+		err = target.remove()
+
+	case PatchTest:
+		current, ok, getErr := target.get()
+		if getErr != nil {
+			err = getErr
+		} else if !ok {
+			err = fmt.Errorf("path not found")
+		} else if !jsonEqual(current, value) {
+			err = fmt.Errorf("value does not match")
+		}
+	}
+	if err != nil {
+		return fail(clientPath, err)
+	}
+	return nil
+}
+
+// parsePointer splits an RFC 6901 JSON Pointer into its reference tokens.
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if pointer[0] != '/' {
+		return nil, fmt.Errorf("invalid JSON pointer %q, must start with '/'", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for idx, token := range tokens {
+		token = strings.ReplaceAll(token, "~1", "/")
+		tokens[idx] = strings.ReplaceAll(token, "~0", "~")
+	}
+	return tokens, nil
+}
+
+func formatPointer(tokens []string) string {
+	out := &strings.Builder{}
+	for _, token := range tokens {
+		token = strings.ReplaceAll(token, "~", "~0")
+		out.WriteString("/")
+		out.WriteString(strings.ReplaceAll(token, "/", "~1"))
+	}
+	return out.String()
+}
+
+// patchTarget is the location of a patch path, in its parent.
+type patchTarget interface {
+	// get returns the JSON value, or false if it doesn't exist
+	get() (any, bool, error)
+
+	// set adds or replaces the value, when replace is true the value must
+	// already exist
+	set(value any, replace bool) error
+
+	remove() error
+}
+
+// resolvePatchTarget walks the path to the parent of the final token. The
+// returned client path is the path walked, for errors.
+func resolvePatchTarget(root Object, tokens []string) (patchTarget, []string, error) {
+	if len(tokens) == 0 {
+		return &rootTarget{obj: root}, nil, nil
+	}
+	return resolveInSet(root, false, tokens, nil)
+}
+
+func resolveInSet(set PropertySet, isOneof bool, tokens []string, clientPath []string) (patchTarget, []string, error) {
+	token := tokens[0]
+	clientPath = append(clientPath, token)
+
+	if isOneof && token == "!type" {
+		if len(tokens) > 1 {
+			return nil, clientPath, fmt.Errorf("!type has no children")
+		}
+		return &oneofTypeTarget{oneof: set}, clientPath, nil
+	}
+
+	genProp, err := set.GetProperty(token)
+	if err != nil {
+		return nil, clientPath, err
+	}
+	prop, ok := genProp.(*property)
+	if !ok {
+		return nil, clientPath, fmt.Errorf("unexpected property type %T", genProp)
+	}
+
+	if len(tokens) == 1 {
+		return &propertyTarget{
+			parent:  set,
+			prop:    prop,
+			isOneof: isOneof,
+		}, clientPath, nil
+	}
+
+	field, err := prop.Field()
+	if err != nil {
+		return nil, clientPath, err
+	}
+
+	// An unset object doesn't exist, but empty oneofs, arrays and maps are
+	// equivalent to unset ones in J5 JSON.
+	if _, isObject := field.AsObject(); isObject && !field.IsSet() {
+		return nil, clientPath, fmt.Errorf("path not found")
+	}
+	return resolveInField(field, tokens[1:], clientPath)
+}
+
+func resolveInField(field Field, tokens []string, clientPath []string) (patchTarget, []string, error) {
+	if obj, ok := field.AsObject(); ok {
+		return resolveInSet(obj, false, tokens, clientPath)
+	}
+
+	if oneof, ok := field.AsOneof(); ok {
+		return resolveInSet(oneof, true, tokens, clientPath)
+	}
+
+	if array, ok := field.AsArray(); ok {
+		token := tokens[0]
+		idx := -1
+		if token == "-" {
+			if len(tokens) > 1 {
+				return nil, clientPath, fmt.Errorf("'-' must be the last path token")
+			}
+			clientPath = append(clientPath, fmt.Sprintf("[%d]", array.Length()))
+		} else {
+			var err error
+			idx, err = parseArrayIndex(token)
+			if err != nil {
+				return nil, clientPath, err
+			}
+			clientPath = append(clientPath, fmt.Sprintf("[%d]", idx))
+		}
+
+		if len(tokens) == 1 {
+			return &arrayTarget{array: array, index: idx}, clientPath, nil
+		}
+
+		elem, ok, err := arrayElement(array, idx)
+		if err != nil {
+			return nil, clientPath, err
+		}
+		if !ok {
+			return nil, clientPath, fmt.Errorf("path not found")
+		}
+		return resolveInField(elem, tokens[1:], clientPath)
+	}
+
+	if mapField, ok := field.AsMap(); ok {
+		key := tokens[0]
+		clientPath = append(clientPath, key)
+		if len(tokens) == 1 {
+			return &mapTarget{mapField: mapField, key: key}, clientPath, nil
+		}
+
+		elem, ok, err := mapField.GetElement(key)
+		if err != nil {
+			return nil, clientPath, err
+		}
+		if !ok {
+			return nil, clientPath, fmt.Errorf("path not found")
+		}
+		return resolveInField(elem, tokens[1:], clientPath)
+	}
+
+	if _, ok := field.AsAny(); ok {
+		return nil, clientPath, fmt.Errorf("path crosses the boundary of an Any value, replace the whole value")
+	}
+
+	if _, ok := field.AsPolymorph(); ok {
+		return nil, clientPath, fmt.Errorf("path crosses the boundary of a Polymorph value, replace the whole value")
+	}
+
+	return nil, clientPath, fmt.Errorf("%s has no children", field.TypeName())
+}
+
+// parseArrayIndex accepts RFC 6901 array indexes, no leading zeros or signs.
+func parseArrayIndex(token string) (int, error) {
+	if token == "" || (len(token) > 1 && token[0] == '0') || strings.ContainsAny(token, "+-") {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	idx, err := strconv.Atoi(token)
+	if err != nil {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	return idx, nil
+}
+
+func arrayElement(array ArrayField, idx int) (Field, bool, error) {
+	var found Field
+	err := array.RangeValues(func(itemIdx int, item Field) error {
+		if itemIdx == idx {
+			found = item
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return found, found != nil, nil
+}
+
+type rootTarget struct {
+	obj Object
+}
+
+func (t *rootTarget) get() (any, bool, error) {
+	val, err := jsonFromPropertySet(t.obj)
+	return val, true, err
+}
+
+func (t *rootTarget) set(value any, replace bool) error {
+	members, ok := value.(map[string]any)
+	if !ok {
+		return unexpectedJSONType(value, "object")
+	}
+	err := t.obj.RangeProperties(func(prop Property) error {
+		return prop.(*property).clear()
+	})
+	if err != nil {
+		return err
+	}
+	return setPropertySetFromJSON(t.obj, members)
+}
+
+func (t *rootTarget) remove() error {
+	return fmt.Errorf("can't remove the root object")
+}
+
+type propertyTarget struct {
+	parent  PropertySet
+	prop    *property
+	isOneof bool
+}
+
+func (t *propertyTarget) get() (any, bool, error) {
+	field, err := t.prop.Field()
+	if err != nil {
+		return nil, false, err
+	}
+	if !field.IsSet() {
+		return nil, false, nil
+	}
+	val, err := jsonFromField(field)
+	return val, true, err
+}
+
+func (t *propertyTarget) set(value any, replace bool) error {
+	field, err := t.prop.Field()
+	if err != nil {
+		return err
+	}
+	if replace && !field.IsSet() {
+		return fmt.Errorf("path not found")
+	}
+
+	if t.isOneof {
+		if err := checkOneofOption(t.parent, t.prop.schema.JSONName); err != nil {
+			return err
+		}
+	}
+
+	if err := t.prop.clear(); err != nil {
+		return err
+	}
+	return setFieldFromJSON(field, value)
+}
+
+func (t *propertyTarget) remove() error {
+	if !t.prop.IsSet() {
+		return fmt.Errorf("path not found")
+	}
+	return t.prop.clear()
+}
+
+// checkOneofOption returns an error if an option other than name is set.
+func checkOneofOption(set PropertySet, name string) error {
+	oneof, ok := set.(Oneof)
+	if !ok {
+		return nil
+	}
+	current, ok, err := oneof.GetOne()
+	if err != nil {
+		return err
+	}
+	if ok && current.NameInParent() != name {
+		return fmt.Errorf("oneof already has %q set, remove it or replace the oneof", current.NameInParent())
+	}
+	return nil
+}
+
+type oneofTypeTarget struct {
+	oneof PropertySet
+}
+
+func (t *oneofTypeTarget) get() (any, bool, error) {
+	oneof, ok := t.oneof.(Oneof)
+	if !ok {
+		return nil, false, nil
+	}
+	current, ok, err := oneof.GetOne()
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	return current.NameInParent(), true, nil
+}
+
+func (t *oneofTypeTarget) set(value any, replace bool) error {
+	return fmt.Errorf("!type is set by the oneof value, replace the oneof instead")
+}
+
+func (t *oneofTypeTarget) remove() error {
+	return fmt.Errorf("!type is set by the oneof value, remove the oneof instead")
+}
+
+type arrayTarget struct {
+	array ArrayField
+	index int // -1 for the end of the array
+}
+
+func (t *arrayTarget) get() (any, bool, error) {
+	elem, ok, err := arrayElement(t.array, t.index)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	val, err := jsonFromField(elem)
+	return val, true, err
+}
+
+func (t *arrayTarget) set(value any, replace bool) error {
+	length := t.array.Length()
+	idx := t.index
+	if replace {
+		if idx < 0 || idx >= length {
+			return fmt.Errorf("path not found")
+		}
+		if err := t.array.RemoveElement(idx); err != nil {
+			return err
+		}
+		length--
+	} else if idx < 0 {
+		idx = length
+	} else if idx > length {
+		return fmt.Errorf("index %d out of range for array of length %d", idx, length)
+	}
+
+	newIdx, err := appendArrayFromJSON(t.array, value)
+	if err != nil {
+		return err
+	}
+	return t.array.MoveElement(newIdx, idx)
+}
+
+func (t *arrayTarget) remove() error {
+	if t.index < 0 || t.index >= t.array.Length() {
+		return fmt.Errorf("path not found")
+	}
+	return t.array.RemoveElement(t.index)
+}
+
+type mapTarget struct {
+	mapField MapField
+	key      string
+}
+
+func (t *mapTarget) get() (any, bool, error) {
+	elem, ok, err := t.mapField.GetElement(t.key)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+	val, err := jsonFromField(elem)
+	return val, true, err
+}
+
+func (t *mapTarget) set(value any, replace bool) error {
+	_, exists, err := t.mapField.GetElement(t.key)
+	if err != nil {
+		return err
+	}
+	if replace && !exists {
+		return fmt.Errorf("path not found")
+	}
+	if value == nil {
+		return fmt.Errorf("map values can't be null")
+	}
+	if err := t.mapField.DeleteElement(t.key); err != nil {
+		return err
+	}
+	return setMapElementFromJSON(t.mapField, t.key, value)
+}
+
+func (t *mapTarget) remove() error {
+	_, exists, err := t.mapField.GetElement(t.key)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("path not found")
+	}
+	return t.mapField.DeleteElement(t.key)
+}
+
+// mergeIntoSet merges the members of a merge patch into an object or oneof.
+func mergeIntoSet(set PropertySet, isOneof bool, members map[string]any, clientPath []string, errs *PatchErrors) {
+	fail := func(path []string, err error) {
+		*errs = append(*errs, newPatchError(PatchMerge, path, err))
+	}
+
+	// nulls first, so that a oneof option can be removed and another set in
+	// the same patch.
+	keys := sortedKeys(members)
+	slices.SortStableFunc(keys, func(a, b string) int {
+		aNull, bNull := members[a] == nil, members[b] == nil
+		if aNull == bNull {
+			return 0
+		}
+		if aNull {
+			return -1
+		}
+		return 1
+	})
+
+	var typeName *string
+	for _, key := range keys {
+		val := members[key]
+		path := append(slices.Clone(clientPath), key)
+
+		if isOneof && key == "!type" {
+			str, ok := val.(string)
+			if !ok {
+				fail(path, unexpectedJSONType(val, "string"))
+				continue
+			}
+			typeName = &str
+			continue
+		}
+
+		genProp, err := set.GetProperty(key)
+		if err != nil {
+			fail(path, err)
+			continue
+		}
+		prop := genProp.(*property)
+
+		if val == nil {
+			if err := prop.clear(); err != nil {
+				fail(path, err)
+			}
+			continue
+		}
+
+		if isOneof {
+			if err := checkOneofOption(set, key); err != nil {
+				fail(path, err)
+				continue
+			}
+		}
+
+		field, err := prop.Field()
+		if err != nil {
+			fail(path, err)
+			continue
+		}
+		mergeIntoField(field, val, prop.clear, path, errs)
+	}
+
+	if typeName != nil {
+		current := ""
+		if oneof, ok := set.(Oneof); ok {
+			field, ok, err := oneof.GetOne()
+			if err != nil {
+				fail(clientPath, err)
+				return
+			}
+			if ok {
+				current = field.NameInParent()
+			}
+		}
+		if *typeName != current {
+			fail(append(slices.Clone(clientPath), "!type"), fmt.Errorf("type %q does not match the value %q", *typeName, current))
+		}
+	}
+}
+
+// mergeIntoField merges objects, oneofs and maps, and replaces other values.
+func mergeIntoField(field Field, val any, clear func() error, clientPath []string, errs *PatchErrors) {
+	fail := func(path []string, err error) {
+		*errs = append(*errs, newPatchError(PatchMerge, path, err))
+	}
+
+	members, isObject := val.(map[string]any)
+	if isObject && field.IsSet() {
+		if obj, ok := field.AsObject(); ok {
+			mergeIntoSet(obj, false, members, clientPath, errs)
+			return
+		}
+		if oneof, ok := field.AsOneof(); ok {
+			mergeIntoSet(oneof, true, members, clientPath, errs)
+			return
+		}
+	}
+
+	if mapField, ok := field.AsMap(); ok && isObject {
+		for _, key := range sortedKeys(members) {
+			path := append(slices.Clone(clientPath), key)
+			elemVal := members[key]
+			deleteKey := func() error {
+				return mapField.DeleteElement(key)
+			}
+			if elemVal == nil {
+				if err := deleteKey(); err != nil {
+					fail(path, err)
+				}
+				continue
+			}
+
+			elem, exists, err := mapField.GetElement(key)
+			if err != nil {
+				fail(path, err)
+				continue
+			}
+			if exists {
+				if _, isContainer := elem.AsContainer(); isContainer {
+					mergeIntoField(elem, elemVal, deleteKey, path, errs)
+					continue
+				}
+				if err := deleteKey(); err != nil {
+					fail(path, err)
+					continue
+				}
+			}
+			if err := setMapElementFromJSON(mapField, key, elemVal); err != nil {
+				fail(path, err)
+			}
+		}
+		return
+	}
+
+	if err := clear(); err != nil {
+		fail(clientPath, err)
+		return
+	}
+	if err := setFieldFromJSON(field, val); err != nil {
+		fail(clientPath, err)
+	}
+}
@@ -0,0 +1,294 @@
+package j5reflect
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+)
+
+// CreateJSONPatch returns the JSON Patch operations which change from into to,
+// e.g. for audit logs. Both must have the same schema.
+//
+// Objects, maps and arrays are compared element by element, a oneof is
+// replaced when the option changes, and Any and Polymorph values are replaced
+// as a whole. Array elements are compared by index, so inserting an element
+// replaces those after it.
+func CreateJSONPatch(from, to Object) ([]PatchOperation, error) {
+	if from.SchemaName() != to.SchemaName() {
+		return nil, fmt.Errorf("can't patch %s to %s", from.SchemaName(), to.SchemaName())
+	}
+	pc := &patchCreator{}
+	if err := pc.diffSets(from, to, diffPath{}); err != nil {
+		return nil, err
+	}
+
+	ops := make([]PatchOperation, 0, len(pc.changes))
+	for _, change := range pc.changes {
+		op := PatchOperation{
+			Path: change.Pointer(),
+		}
+		switch change.Kind {
+		case ChangeAdded:
+			op.Op = PatchAdd
+			op.Value = change.New
+		case ChangeRemoved:
+			op.Op = PatchRemove
+		default:
+			op.Op = PatchReplace
+			op.Value = change.New
+		}
+		ops = append(ops, op)
+	}
+	return ops, nil
+}
+
+// diffPath is the location in both the client path and JSON pointer forms.
+type diffPath struct {
+	clientPath []string
+	pointer    []string
+}
+
+func (dp diffPath) property(name string) diffPath {
+	return diffPath{
+		clientPath: append(slices.Clone(dp.clientPath), name),
+		pointer:    append(slices.Clone(dp.pointer), name),
+	}
+}
+
+func (dp diffPath) index(idx int) diffPath {
+	return diffPath{
+		clientPath: append(slices.Clone(dp.clientPath), fmt.Sprintf("[%d]", idx)),
+		pointer:    append(slices.Clone(dp.pointer), strconv.Itoa(idx)),
+	}
+}
+
+// patchCreator walks two values by schema, recording the changes for both
+// CreateJSONPatch and Diff.
+type patchCreator struct {
+	changes Changes
+}
+
+func (pc *patchCreator) add(kind ChangeKind, path diffPath, oldVal, newVal any) error {
+	change := Change{
+		Kind:       kind,
+		clientPath: path.clientPath,
+		pointer:    path.pointer,
+	}
+	var err error
+	if kind != ChangeAdded {
+		change.Old, err = json.Marshal(oldVal)
+		if err != nil {
+			return err
+		}
+	}
+	if kind != ChangeRemoved {
+		change.New, err = json.Marshal(newVal)
+		if err != nil {
+			return err
+		}
+	}
+	pc.changes = append(pc.changes, change)
+	return nil
+}
+
+func (pc *patchCreator) addFields(kind ChangeKind, path diffPath, from, to Field) error {
+	var fromVal, toVal any
+	var err error
+	if from != nil {
+		fromVal, err = jsonFromField(from)
+		if err != nil {
+			return err
+		}
+	}
+	if to != nil {
+		toVal, err = jsonFromField(to)
+		if err != nil {
+			return err
+		}
+	}
+	return pc.add(kind, path, fromVal, toVal)
+}
+
+func (pc *patchCreator) diffSets(from, to PropertySet, path diffPath) error {
+	return from.RangeProperties(func(fromProp Property) error {
+		name := fromProp.Schema().JSONName
+		toProp, err := to.GetProperty(name)
+		if err != nil {
+			return err
+		}
+		fromField, err := fromProp.Field()
+		if err != nil {
+			return err
+		}
+		toField, err := toProp.Field()
+		if err != nil {
+			return err
+		}
+		return pc.diffFields(fromField, toField, path.property(name))
+	})
+}
+
+func (pc *patchCreator) diffFields(from, to Field, path diffPath) error {
+	fromSet, toSet := from.IsSet(), to.IsSet()
+	switch {
+	case !fromSet && !toSet:
+		return nil
+	case !fromSet:
+		return pc.addFields(ChangeAdded, path, nil, to)
+	case !toSet:
+		return pc.addFields(ChangeRemoved, path, from, nil)
+	}
+
+	schemaMismatch := func() error {
+		return fmt.Errorf("schema mismatch at %s", formatPointer(path.pointer))
+	}
+
+	if fromObj, ok := from.AsObject(); ok {
+		toObj, ok := to.AsObject()
+		if !ok {
+			return schemaMismatch()
+		}
+		return pc.diffSets(fromObj, toObj, path)
+	}
+
+	if fromOneof, ok := from.AsOneof(); ok {
+		toOneof, ok := to.AsOneof()
+		if !ok {
+			return schemaMismatch()
+		}
+		fromOption, _, err := fromOneof.GetOne()
+		if err != nil {
+			return err
+		}
+		toOption, _, err := toOneof.GetOne()
+		if err != nil {
+			return err
+		}
+		if fromOption.NameInParent() != toOption.NameInParent() {
+			return pc.addFields(ChangeChanged, path, from, to)
+		}
+		return pc.diffFields(fromOption, toOption, path.property(toOption.NameInParent()))
+	}
+
+	if fromMap, ok := from.AsMap(); ok {
+		toMap, ok := to.AsMap()
+		if !ok {
+			return schemaMismatch()
+		}
+		return pc.diffMaps(fromMap, toMap, path)
+	}
+
+	if fromArray, ok := from.AsArray(); ok {
+		toArray, ok := to.AsArray()
+		if !ok {
+			return schemaMismatch()
+		}
+		return pc.diffArrays(fromArray, toArray, path)
+	}
+
+	fromVal, err := jsonFromField(from)
+	if err != nil {
+		return err
+	}
+	toVal, err := jsonFromField(to)
+	if err != nil {
+		return err
+	}
+	if jsonEqual(fromVal, toVal) {
+		return nil
+	}
+	return pc.add(ChangeChanged, path, fromVal, toVal)
+}
+
+func (pc *patchCreator) diffMaps(from, to MapField, path diffPath) error {
+	fromElems, err := mapElements(from)
+	if err != nil {
+		return err
+	}
+	toElems, err := mapElements(to)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(fromElems)+len(toElems))
+	for key := range fromElems {
+		keys = append(keys, key)
+	}
+	for key := range toElems {
+		if _, ok := fromElems[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		elemPath := path.property(key)
+		fromElem, inFrom := fromElems[key]
+		toElem, inTo := toElems[key]
+		switch {
+		case !inTo:
+			err = pc.addFields(ChangeRemoved, elemPath, fromElem, nil)
+		case !inFrom:
+			err = pc.addFields(ChangeAdded, elemPath, nil, toElem)
+		default:
+			err = pc.diffFields(fromElem, toElem, elemPath)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (pc *patchCreator) diffArrays(from, to ArrayField, path diffPath) error {
+	fromElems, err := arrayElements(from)
+	if err != nil {
+		return err
+	}
+	toElems, err := arrayElements(to)
+	if err != nil {
+		return err
+	}
+
+	common := min(len(fromElems), len(toElems))
+	for idx := range common {
+		if err := pc.diffFields(fromElems[idx], toElems[idx], path.index(idx)); err != nil {
+			return err
+		}
+	}
+
+	for idx := common; idx < len(toElems); idx++ {
+		if err := pc.addFields(ChangeAdded, path.index(idx), nil, toElems[idx]); err != nil {
+			return err
+		}
+	}
+
+	// from the end, so that applying the changes in order doesn't shift the
+	// indexes
+	for idx := len(fromElems) - 1; idx >= common; idx-- {
+		if err := pc.addFields(ChangeRemoved, path.index(idx), fromElems[idx], nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func mapElements(mapField MapField) (map[string]Field, error) {
+	elems := map[string]Field{}
+	err := mapField.Range(func(key string, elem Field) error {
+		elems[key] = elem
+		return nil
+	})
+	return elems, err
+}
+
+func arrayElements(array ArrayField) ([]Field, error) {
+	elems := []Field{}
+	err := array.RangeValues(func(_ int, elem Field) error {
+		elems = append(elems, elem)
+		return nil
+	})
+	return elems, err
+}
@@ -0,0 +1,452 @@
+package j5reflect
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pentops/j5/internal/gen/test/schema/v1/schema_testpb"
+	"github.com/pentops/j5/j5types/any_j5t"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+func patchTestObject(t *testing.T, msg *schema_testpb.FullSchema) Object {
+	t.Helper()
+	obj, err := New().NewObject(msg.ProtoReflect())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return obj
+}
+
+func assertProtoEqual(t *testing.T, want, got proto.Message) {
+	t.Helper()
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected message (-want +got):\n%s", diff)
+	}
+}
+
+func assertPatchError(t *testing.T, err error, path string) PatchError {
+	t.Helper()
+	patchErrs := PatchErrors{}
+	if !errors.As(err, &patchErrs) {
+		t.Fatalf("expected PatchErrors, got %v", err)
+	}
+	for _, pe := range patchErrs {
+		if pe.JSONPath() == path {
+			return pe
+		}
+	}
+	t.Fatalf("expected error at %s, got %v", path, err)
+	return PatchError{}
+}
+
+func TestApplyJSONPatch(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		input *schema_testpb.FullSchema
+		patch string
+		want  *schema_testpb.FullSchema
+	}{{
+		name:  "add scalar",
+		input: &schema_testpb.FullSchema{},
+		patch: `[{"op": "add", "path": "/sString", "value": "hello"}]`,
+		want:  &schema_testpb.FullSchema{SString: "hello"},
+	}, {
+		name:  "replace nested",
+		input: &schema_testpb.FullSchema{SBar: &schema_testpb.Bar{BarId: "1", BarField: "a"}},
+		patch: `[{"op": "replace", "path": "/sBar/barField", "value": "b"}]`,
+		want:  &schema_testpb.FullSchema{SBar: &schema_testpb.Bar{BarId: "1", BarField: "b"}},
+	}, {
+		name:  "add object",
+		input: &schema_testpb.FullSchema{},
+		patch: `[{"op": "add", "path": "/sBar", "value": {"barId": "1"}}]`,
+		want:  &schema_testpb.FullSchema{SBar: &schema_testpb.Bar{BarId: "1"}},
+	}, {
+		name:  "remove",
+		input: &schema_testpb.FullSchema{SString: "hello", SInt64: 5},
+		patch: `[{"op": "remove", "path": "/sString"}]`,
+		want:  &schema_testpb.FullSchema{SInt64: 5},
+	}, {
+		name:  "int64 as string",
+		input: &schema_testpb.FullSchema{},
+		patch: `[{"op": "add", "path": "/sInt64", "value": "123"}]`,
+		want:  &schema_testpb.FullSchema{SInt64: 123},
+	}, {
+		name:  "array insert",
+		input: &schema_testpb.FullSchema{RString: []string{"a", "c"}},
+		patch: `[
+			{"op": "add", "path": "/rString/1", "value": "b"},
+			{"op": "add", "path": "/rString/-", "value": "d"},
+			{"op": "add", "path": "/rString/0", "value": "0"}
+		]`,
+		want: &schema_testpb.FullSchema{RString: []string{"0", "a", "b", "c", "d"}},
+	}, {
+		name: "array of objects",
+		input: &schema_testpb.FullSchema{RBars: []*schema_testpb.Bar{
+			{BarId: "1"}, {BarId: "2"}, {BarId: "3"},
+		}},
+		patch: `[
+			{"op": "remove", "path": "/rBars/0"},
+			{"op": "replace", "path": "/rBars/1", "value": {"barId": "4"}},
+			{"op": "add", "path": "/rBars/0/barField", "value": "x"}
+		]`,
+		want: &schema_testpb.FullSchema{RBars: []*schema_testpb.Bar{
+			{BarId: "2", BarField: "x"}, {BarId: "4"},
+		}},
+	}, {
+		name:  "map",
+		input: &schema_testpb.FullSchema{MapStringString: map[string]string{"a": "1", "b": "2"}},
+		patch: `[
+			{"op": "remove", "path": "/mapStringString/a"},
+			{"op": "replace", "path": "/mapStringString/b", "value": "3"},
+			{"op": "add", "path": "/mapStringString/c~1d", "value": "4"}
+		]`,
+		want: &schema_testpb.FullSchema{MapStringString: map[string]string{"b": "3", "c/d": "4"}},
+	}, {
+		name: "map of objects",
+		input: &schema_testpb.FullSchema{MapStringBar: map[string]*schema_testpb.Bar{
+			"a": {BarId: "1"},
+		}},
+		patch: `[{"op": "add", "path": "/mapStringBar/a/barField", "value": "x"}]`,
+		want: &schema_testpb.FullSchema{MapStringBar: map[string]*schema_testpb.Bar{
+			"a": {BarId: "1", BarField: "x"},
+		}},
+	}, {
+		name:  "move and copy",
+		input: &schema_testpb.FullSchema{SBar: &schema_testpb.Bar{BarId: "1"}},
+		patch: `[
+			{"op": "copy", "from": "/sBar", "path": "/rBars/-"},
+			{"op": "move", "from": "/sBar/barId", "path": "/sString"}
+		]`,
+		want: &schema_testpb.FullSchema{
+			SString: "1",
+			SBar:    &schema_testpb.Bar{},
+			RBars:   []*schema_testpb.Bar{{BarId: "1"}},
+		},
+	}, {
+		name: "test",
+		input: &schema_testpb.FullSchema{
+			SFloat: 1.5,
+			WrappedOneof: &schema_testpb.WrappedOneof{
+				Type: &schema_testpb.WrappedOneof_WOneofString{WOneofString: "a"},
+			},
+		},
+		patch: `[
+			{"op": "test", "path": "/sFloat", "value": 1.50},
+			{"op": "test", "path": "/wrappedOneof/!type", "value": "wOneofString"},
+			{"op": "replace", "path": "/wrappedOneof/wOneofString", "value": "b"}
+		]`,
+		want: &schema_testpb.FullSchema{
+			SFloat: 1.5,
+			WrappedOneof: &schema_testpb.WrappedOneof{
+				Type: &schema_testpb.WrappedOneof_WOneofString{WOneofString: "b"},
+			},
+		},
+	}, {
+		name: "switch oneof option",
+		input: &schema_testpb.FullSchema{
+			WrappedOneof: &schema_testpb.WrappedOneof{
+				Type: &schema_testpb.WrappedOneof_WOneofString{WOneofString: "a"},
+			},
+		},
+		patch: `[
+			{"op": "remove", "path": "/wrappedOneof/wOneofString"},
+			{"op": "add", "path": "/wrappedOneof/wOneofEnum", "value": "VALUE1"}
+		]`,
+		want: &schema_testpb.FullSchema{
+			WrappedOneof: &schema_testpb.WrappedOneof{
+				Type: &schema_testpb.WrappedOneof_WOneofEnum{WOneofEnum: schema_testpb.Enum_ENUM_VALUE1},
+			},
+		},
+	}, {
+		name: "replace oneof",
+		input: &schema_testpb.FullSchema{
+			WrappedOneof: &schema_testpb.WrappedOneof{
+				Type: &schema_testpb.WrappedOneof_WOneofString{WOneofString: "a"},
+			},
+		},
+		patch: `[{"op": "replace", "path": "/wrappedOneof", "value": {"!type": "wOneofBar", "wOneofBar": {"barId": "1"}}}]`,
+		want: &schema_testpb.FullSchema{
+			WrappedOneof: &schema_testpb.WrappedOneof{
+				Type: &schema_testpb.WrappedOneof_WOneofBar{WOneofBar: &schema_testpb.Bar{BarId: "1"}},
+			},
+		},
+	}, {
+		name:  "any",
+		input: &schema_testpb.FullSchema{},
+		patch: `[{"op": "add", "path": "/j5any", "value": {"!type": "test.schema.v1.Bar", "value": {"barId": "1"}}}]`,
+		want: &schema_testpb.FullSchema{
+			J5Any: &any_j5t.Any{
+				TypeName: "test.schema.v1.Bar",
+				J5Json:   []byte(`{"barId":"1"}`),
+			},
+		},
+	}, {
+		name:  "replace root",
+		input: &schema_testpb.FullSchema{SString: "a", SInt32: 1},
+		patch: `[{"op": "replace", "path": "", "value": {"sString": "b"}}]`,
+		want:  &schema_testpb.FullSchema{SString: "b"},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			obj := patchTestObject(t, tc.input)
+			if err := ApplyJSONPatch(obj, []byte(tc.patch)); err != nil {
+				t.Fatal(err)
+			}
+			assertProtoEqual(t, tc.want, tc.input)
+		})
+	}
+}
+
+func TestApplyJSONPatchErrors(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		input   *schema_testpb.FullSchema
+		patch   string
+		errPath string
+	}{{
+		name:    "unknown property",
+		input:   &schema_testpb.FullSchema{},
+		patch:   `[{"op": "add", "path": "/sBar/nope", "value": "x"}]`,
+		errPath: "sBar",
+	}, {
+		name:    "wrong type",
+		input:   &schema_testpb.FullSchema{},
+		patch:   `[{"op": "add", "path": "/sBar", "value": {"barId": 1}}]`,
+		errPath: "sBar.barId",
+	}, {
+		name:    "wrong type in array",
+		input:   &schema_testpb.FullSchema{},
+		patch:   `[{"op": "add", "path": "/rBars", "value": [{"barId": "1"}, {"barId": true}]}]`,
+		errPath: "rBars[1].barId",
+	}, {
+		name:    "replace missing",
+		input:   &schema_testpb.FullSchema{},
+		patch:   `[{"op": "replace", "path": "/sString", "value": "x"}]`,
+		errPath: "sString",
+	}, {
+		name:    "index out of range",
+		input:   &schema_testpb.FullSchema{RString: []string{"a"}},
+		patch:   `[{"op": "add", "path": "/rString/2", "value": "x"}]`,
+		errPath: "rString[2]",
+	}, {
+		name: "oneof exclusive",
+		input: &schema_testpb.FullSchema{
+			WrappedOneof: &schema_testpb.WrappedOneof{
+				Type: &schema_testpb.WrappedOneof_WOneofString{WOneofString: "a"},
+			},
+		},
+		patch:   `[{"op": "add", "path": "/wrappedOneof/wOneofFloat", "value": 1}]`,
+		errPath: "wrappedOneof.wOneofFloat",
+	}, {
+		name: "oneof type",
+		input: &schema_testpb.FullSchema{
+			WrappedOneof: &schema_testpb.WrappedOneof{
+				Type: &schema_testpb.WrappedOneof_WOneofString{WOneofString: "a"},
+			},
+		},
+		patch:   `[{"op": "replace", "path": "/wrappedOneof/!type", "value": "wOneofFloat"}]`,
+		errPath: "wrappedOneof.!type",
+	}, {
+		name: "inside any",
+		input: &schema_testpb.FullSchema{
+			J5Any: &any_j5t.Any{
+				TypeName: "test.schema.v1.Bar",
+				J5Json:   []byte(`{"barId":"1"}`),
+			},
+		},
+		patch:   `[{"op": "replace", "path": "/j5any/value/barId", "value": "2"}]`,
+		errPath: "j5any",
+	}, {
+		name:    "test fails",
+		input:   &schema_testpb.FullSchema{SString: "a"},
+		patch:   `[{"op": "test", "path": "/sString", "value": "b"}]`,
+		errPath: "sString",
+	}, {
+		name:    "atomic",
+		input:   &schema_testpb.FullSchema{SString: "a"},
+		patch:   `[{"op": "replace", "path": "/sString", "value": "b"}, {"op": "remove", "path": "/sBar"}]`,
+		errPath: "sBar",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			want := proto.Clone(tc.input)
+			obj := patchTestObject(t, tc.input)
+			err := ApplyJSONPatch(obj, []byte(tc.patch))
+			pe := assertPatchError(t, err, tc.errPath)
+			t.Log(pe.Error())
+			assertProtoEqual(t, want, tc.input)
+		})
+	}
+}
+
+func TestApplyMergePatch(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		input *schema_testpb.FullSchema
+		patch string
+		want  *schema_testpb.FullSchema
+	}{{
+		name: "merge objects",
+		input: &schema_testpb.FullSchema{
+			SString: "a",
+			SBar:    &schema_testpb.Bar{BarId: "1", BarField: "x"},
+		},
+		patch: `{"sString": null, "sBar": {"barField": "y"}, "sInt32": 5}`,
+		want: &schema_testpb.FullSchema{
+			SInt32: 5,
+			SBar:   &schema_testpb.Bar{BarId: "1", BarField: "y"},
+		},
+	}, {
+		name:  "replace arrays",
+		input: &schema_testpb.FullSchema{RString: []string{"a", "b"}},
+		patch: `{"rString": ["c"]}`,
+		want:  &schema_testpb.FullSchema{RString: []string{"c"}},
+	}, {
+		name: "merge maps",
+		input: &schema_testpb.FullSchema{
+			MapStringString: map[string]string{"a": "1", "b": "2"},
+			MapStringBar: map[string]*schema_testpb.Bar{
+				"a": {BarId: "1", BarField: "x"},
+			},
+		},
+		patch: `{"mapStringString": {"a": null, "c": "3"}, "mapStringBar": {"a": {"barField": "y"}, "b": {"barId": "2"}}}`,
+		want: &schema_testpb.FullSchema{
+			MapStringString: map[string]string{"b": "2", "c": "3"},
+			MapStringBar: map[string]*schema_testpb.Bar{
+				"a": {BarId: "1", BarField: "y"},
+				"b": {BarId: "2"},
+			},
+		},
+	}, {
+		name: "switch oneof option",
+		input: &schema_testpb.FullSchema{
+			WrappedOneof: &schema_testpb.WrappedOneof{
+				Type: &schema_testpb.WrappedOneof_WOneofString{WOneofString: "a"},
+			},
+		},
+		patch: `{"wrappedOneof": {"wOneofString": null, "wOneofBar": {"barId": "1"}, "!type": "wOneofBar"}}`,
+		want: &schema_testpb.FullSchema{
+			WrappedOneof: &schema_testpb.WrappedOneof{
+				Type: &schema_testpb.WrappedOneof_WOneofBar{WOneofBar: &schema_testpb.Bar{BarId: "1"}},
+			},
+		},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			obj := patchTestObject(t, tc.input)
+			if err := ApplyMergePatch(obj, []byte(tc.patch)); err != nil {
+				t.Fatal(err)
+			}
+			assertProtoEqual(t, tc.want, tc.input)
+		})
+	}
+}
+
+func TestApplyMergePatchErrors(t *testing.T) {
+	input := &schema_testpb.FullSchema{
+		SString: "a",
+		WrappedOneof: &schema_testpb.WrappedOneof{
+			Type: &schema_testpb.WrappedOneof_WOneofString{WOneofString: "a"},
+		},
+	}
+	want := proto.Clone(input)
+
+	obj := patchTestObject(t, input)
+	err := ApplyMergePatch(obj, []byte(`{
+		"sString": "b",
+		"sInt32": {},
+		"sBar": {"nope": 1},
+		"wrappedOneof": {"wOneofFloat": 1}
+	}`))
+
+	patchErrs := PatchErrors{}
+	if !errors.As(err, &patchErrs) {
+		t.Fatalf("expected PatchErrors, got %v", err)
+	}
+	paths := []string{}
+	for _, pe := range patchErrs {
+		paths = append(paths, pe.JSONPath())
+		assert.Equal(t, PatchMerge, pe.Op)
+	}
+	assert.ElementsMatch(t, []string{"sInt32", "sBar.nope", "wrappedOneof.wOneofFloat"}, paths)
+
+	assertProtoEqual(t, want, input)
+}
+
+func TestCreateJSONPatch(t *testing.T) {
+	from := &schema_testpb.FullSchema{
+		SString: "a",
+		SInt64:  1,
+		SBar:    &schema_testpb.Bar{BarId: "1", BarField: "x"},
+		RBars:   []*schema_testpb.Bar{{BarId: "1"}, {BarId: "2"}, {BarId: "3"}},
+		RString: []string{"a"},
+		MapStringString: map[string]string{
+			"a": "1",
+			"b": "2",
+		},
+		WrappedOneof: &schema_testpb.WrappedOneof{
+			Type: &schema_testpb.WrappedOneof_WOneofString{WOneofString: "a"},
+		},
+		Enum: schema_testpb.Enum_ENUM_VALUE1,
+	}
+	to := &schema_testpb.FullSchema{
+		SInt64:  2,
+		SBar:    &schema_testpb.Bar{BarId: "1", BarField: "y"},
+		RBars:   []*schema_testpb.Bar{{BarId: "1"}, {BarId: "4"}},
+		RString: []string{"a", "b", "c"},
+		MapStringString: map[string]string{
+			"b": "3",
+			"c": "4",
+		},
+		WrappedOneof: &schema_testpb.WrappedOneof{
+			Type: &schema_testpb.WrappedOneof_WOneofBar{WOneofBar: &schema_testpb.Bar{BarId: "1"}},
+		},
+		Enum: schema_testpb.Enum_ENUM_VALUE2,
+		J5Any: &any_j5t.Any{
+			TypeName: "test.schema.v1.Bar",
+			J5Json:   []byte(`{"barId":"1"}`),
+		},
+	}
+
+	ops, err := CreateJSONPatch(patchTestObject(t, from), patchTestObject(t, to))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := []string{}
+	for _, op := range ops {
+		got = append(got, op.Op+" "+op.Path+" "+string(op.Value))
+	}
+	assert.Equal(t, []string{
+		`remove /sString `,
+		`add /rString/1 "b"`,
+		`add /rString/2 "c"`,
+		`replace /sInt64 "2"`,
+		`replace /sBar/barField "y"`,
+		`replace /rBars/1/barId "4"`,
+		`remove /rBars/2 `,
+		`replace /enum "VALUE2"`,
+		`remove /mapStringString/a `,
+		`replace /mapStringString/b "3"`,
+		`add /mapStringString/c "4"`,
+		`add /j5any {"!type":"test.schema.v1.Bar","value":{"barId":"1"}}`,
+		`replace /wrappedOneof {"!type":"wOneofBar","wOneofBar":{"barId":"1"}}`,
+	}, got)
+
+	patch, err := json.Marshal(ops)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ApplyJSONPatch(patchTestObject(t, from), patch); err != nil {
+		t.Fatal(err)
+	}
+	assertProtoEqual(t, to, from)
+
+	ops, err = CreateJSONPatch(patchTestObject(t, from), patchTestObject(t, to))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Empty(t, ops)
+}
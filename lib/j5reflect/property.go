@@ -184,6 +184,35 @@ func (prop *property) buildField() error {
 	return nil
 }
 
+// clear removes the value of the property from the message.
+func (prop *property) clear() error {
+	// unset parent messages are not created, there is nothing to clear.
+	walkMessage := prop.propSet.value
+	if !walkMessage.IsSet() {
+		return nil
+	}
+	for _, walkField := range prop.protoPath[:len(prop.protoPath)-1] {
+		childVal, err := walkMessage.ChildField(walkField)
+		if err != nil {
+			return fmt.Errorf("error walking field %s: %w", walkField.FullName(), err)
+		}
+		childMessage, ok := childVal.AsMessage()
+		if !ok {
+			return fmt.Errorf("expected message for field %s", walkField.FullName())
+		}
+		if !childMessage.IsSet() {
+			return nil
+		}
+		walkMessage = childMessage
+	}
+
+	protoVal, err := walkMessage.ChildField(prop.protoPath[len(prop.protoPath)-1])
+	if err != nil {
+		return err
+	}
+	return protoVal.SetValue(protoreflect.Value{})
+}
+
 func schemaIsMutable(schema j5schema.FieldSchema) (bool, error) {
 	switch schema.(type) {
 	case *j5schema.ObjectField, *j5schema.OneofField, *j5schema.AnyField, *j5schema.PolymorphField:
@@ -20,6 +20,11 @@ type AnyField interface {
 	GetJ5Any() (*any_j5t.Any, error)
 	SetProtoAny(val *anypb.Any) error
 	GetProtoAny() (*anypb.Any, error)
+
+	// IsOpaque returns true when the field is backed by a concrete message,
+	// e.g. google.protobuf.Value in a Struct, rather than an Any with a type
+	// name, so the contents can not be read as an Any.
+	IsOpaque() bool
 }
 
 type MapOfAnyField interface {
@@ -65,6 +70,11 @@ func (field *anyField) AsAny() (AnyField, bool) {
 	return field, true
 }
 
+func (field *anyField) IsOpaque() bool {
+	_, ok := field.implType.(*exitAnyImpl)
+	return ok
+}
+
 func (field *anyField) SetJ5Any(val *any_j5t.Any) error {
 	return field.implType.setAny(val)
 }
@@ -257,6 +267,10 @@ type arrayOfAnyField struct {
 
 var _ ArrayOfAnyField = (*arrayOfAnyField)(nil)
 
+func (field *arrayOfAnyField) AsArray() (ArrayField, bool) {
+	return field, true
+}
+
 /*** Implement Map Of Any ***/
 
 type mapOfAnyField struct {
@@ -18,6 +18,14 @@ type ArrayField interface {
 	RangeValues(RangeArrayCallback) error
 	Length() int
 	Truncate(int)
+
+	// RemoveElement removes the element at idx, shifting the following
+	// elements down.
+	RemoveElement(idx int) error
+
+	// MoveElement moves the element at from to the index to, shifting the
+	// elements between.
+	MoveElement(from, to int) error
 }
 
 type MutableArrayField interface {
@@ -57,6 +65,42 @@ func (array *baseArrayField) Truncate(newLen int) {
 	array.value.Truncate(newLen)
 }
 
+func (array *baseArrayField) RemoveElement(idx int) error {
+	length := array.value.Len()
+	if idx < 0 || idx >= length {
+		return fmt.Errorf("index %d out of range for array of length %d", idx, length)
+	}
+	if err := array.MoveElement(idx, length-1); err != nil {
+		return err
+	}
+	array.value.Truncate(length - 1)
+	return nil
+}
+
+func (array *baseArrayField) MoveElement(from, to int) error {
+	length := array.value.Len()
+	if from < 0 || from >= length {
+		return fmt.Errorf("index %d out of range for array of length %d", from, length)
+	}
+	if to < 0 || to >= length {
+		return fmt.Errorf("index %d out of range for array of length %d", to, length)
+	}
+	val, ok := array.value.GetValue()
+	if !ok {
+		return fmt.Errorf("array is not set")
+	}
+	list := val.List()
+	moving := list.Get(from)
+	for idx := from; idx < to; idx++ {
+		list.Set(idx, list.Get(idx+1))
+	}
+	for idx := from; idx > to; idx-- {
+		list.Set(idx, list.Get(idx-1))
+	}
+	list.Set(to, moving)
+	return nil
+}
+
 func (array *baseArrayField) SetDefaultValue() error {
 	return nil
 }
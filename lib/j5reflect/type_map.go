@@ -5,6 +5,7 @@ import (
 
 	"github.com/pentops/j5/lib/j5reflect/protoval"
 	"github.com/pentops/j5/lib/j5schema"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 /*** Interface ***/
@@ -18,6 +19,7 @@ type MapField interface {
 	NewElement(key string) (Field, error)
 	GetOrCreateElement(key string) (Field, error)
 	GetElement(key string) (Field, bool, error)
+	DeleteElement(key string) error
 }
 
 type MutableMapField interface {
@@ -103,6 +105,15 @@ func (mapField *baseMapField) NewElement(key string) (Field, error) {
 	return mapField.wrapValue(key, value), nil
 }
 
+func (mapField *baseMapField) DeleteElement(key string) error {
+	val, ok := mapField.value.GetValue()
+	if !ok {
+		return nil
+	}
+	val.Map().Clear(protoreflect.ValueOfString(key).MapKey())
+	return nil
+}
+
 func newMessageMapField(context fieldContext, schema *j5schema.MapField, value protoval.MapValue, factory fieldFactory) (MutableMapField, error) {
 	base := baseMapField{
 		fieldContext: context,
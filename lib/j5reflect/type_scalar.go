@@ -58,8 +58,9 @@ func (sf *scalarField) IsSet() bool {
 		return false
 	}
 
-	// value isn't null, so include it
-	if sf.PropertySchema().ExplicitlyOptional {
+	// value isn't null, so include it. Array and map elements have no
+	// property, and are always set.
+	if prop := sf.PropertySchema(); prop == nil || prop.ExplicitlyOptional {
 		return true
 	}
 
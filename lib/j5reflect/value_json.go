@@ -0,0 +1,606 @@
+package j5reflect
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pentops/j5/j5types/any_j5t"
+	"github.com/pentops/j5/j5types/date_j5t"
+	"github.com/pentops/j5/j5types/decimal_j5t"
+	"github.com/pentops/j5/lib/patherr"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// The functions in this file convert between fields and generic JSON values,
+// as decoded by encoding/json with UseNumber: map[string]any, []any, string,
+// json.Number, bool and nil. The structure matches the J5 JSON codec, which
+// can't be imported here as it imports this package. The codec's tests check
+// that the two match for each of its fixtures.
+
+func decodeGenericJSON(data []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var val any
+	if err := dec.Decode(&val); err != nil {
+		return nil, err
+	}
+	if dec.More() {
+		return nil, fmt.Errorf("unexpected data after JSON value")
+	}
+	return val, nil
+}
+
+// jsonFromField returns the JSON value of the field, nil when it is not set.
+func jsonFromField(field Field) (any, error) {
+	if !field.IsSet() {
+		return nil, nil
+	}
+
+	if obj, ok := field.AsObject(); ok {
+		return jsonFromPropertySet(obj)
+	}
+
+	if oneof, ok := field.AsOneof(); ok {
+		return jsonFromOneof(oneof)
+	}
+
+	if anyField, ok := field.AsAny(); ok {
+		return jsonFromAny(anyField)
+	}
+
+	if poly, ok := field.AsPolymorph(); ok {
+		anyField, err := poly.Unwrap()
+		if err != nil {
+			return nil, err
+		}
+		return jsonFromAny(anyField)
+	}
+
+	if enum, ok := field.AsEnum(); ok {
+		val, err := enum.GetValue()
+		if err != nil {
+			return nil, err
+		}
+		return val.Name(), nil
+	}
+
+	if array, ok := field.AsArray(); ok {
+		out := []any{}
+		err := array.RangeValues(func(idx int, item Field) error {
+			val, err := jsonFromField(item)
+			if err != nil {
+				return patherr.Wrap(err, fmt.Sprintf("[%d]", idx))
+			}
+			out = append(out, val)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+
+	if mapField, ok := field.AsMap(); ok {
+		out := map[string]any{}
+		err := mapField.Range(func(key string, item Field) error {
+			val, err := jsonFromField(item)
+			if err != nil {
+				return patherr.Wrap(err, key)
+			}
+			out[key] = val
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return out, nil
+	}
+
+	if scalar, ok := field.AsScalar(); ok {
+		val, err := scalar.ToGoValue()
+		if err != nil {
+			return nil, err
+		}
+		return jsonFromScalar(val)
+	}
+
+	return nil, fmt.Errorf("unsupported field type %s", field.FullTypeName())
+}
+
+func jsonFromRoot(root Root) (any, error) {
+	switch root := root.(type) {
+	case Object:
+		return jsonFromPropertySet(root)
+	case Oneof:
+		return jsonFromOneof(root)
+	default:
+		return nil, fmt.Errorf("unsupported root type %T", root)
+	}
+}
+
+func jsonFromPropertySet(ps PropertySet) (map[string]any, error) {
+	out := map[string]any{}
+	err := ps.RangeValues(func(field Field) error {
+		val, err := jsonFromField(field)
+		if err != nil {
+			return patherr.Wrap(err, field.NameInParent())
+		}
+		out[field.NameInParent()] = val
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func jsonFromOneof(oneof Oneof) (map[string]any, error) {
+	field, ok, err := oneof.GetOne()
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return map[string]any{}, nil
+	}
+	val, err := jsonFromField(field)
+	if err != nil {
+		return nil, patherr.Wrap(err, field.NameInParent())
+	}
+	return map[string]any{
+		"!type":              field.NameInParent(),
+		field.NameInParent(): val,
+	}, nil
+}
+
+func jsonFromAny(field AnyField) (any, error) {
+	if field.IsOpaque() {
+		return nil, fmt.Errorf("opaque any type %s has no J5 JSON value", field.FullTypeName())
+	}
+	val, err := field.GetJ5Any()
+	if err != nil {
+		return nil, err
+	}
+	if val == nil {
+		return nil, nil
+	}
+
+	var inner any
+	if len(val.J5Json) > 0 {
+		inner, err = decodeGenericJSON(val.J5Json)
+		if err != nil {
+			return nil, fmt.Errorf("any type %q: %w", val.TypeName, err)
+		}
+	} else {
+		// Without a codec there is only the global registry to decode the
+		// proto bytes.
+		msg, err := newGlobalMessage(val.TypeName)
+		if err != nil {
+			return nil, err
+		}
+		if err := proto.Unmarshal(val.Proto, msg.Interface()); err != nil {
+			return nil, fmt.Errorf("any type %q: %w", val.TypeName, err)
+		}
+		root, err := Global.NewRoot(msg)
+		if err != nil {
+			return nil, err
+		}
+		inner, err = jsonFromRoot(root)
+		if err != nil {
+			return nil, patherr.Wrap(err, "value")
+		}
+	}
+
+	return map[string]any{
+		"!type": val.TypeName,
+		"value": inner,
+	}, nil
+}
+
+func newGlobalMessage(typeName string) (protoreflect.Message, error) {
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(typeName))
+	if err != nil {
+		return nil, fmt.Errorf("any type %q: %w", typeName, err)
+	}
+	return mt.New(), nil
+}
+
+// jsonFromScalar matches the codec, 64 bit integers are strings.
+func jsonFromScalar(val any) (any, error) {
+	switch vt := val.(type) {
+	case string, bool:
+		return vt, nil
+	case int32:
+		return json.Number(strconv.FormatInt(int64(vt), 10)), nil
+	case uint32:
+		return json.Number(strconv.FormatUint(uint64(vt), 10)), nil
+	case int64:
+		return strconv.FormatInt(vt, 10), nil
+	case uint64:
+		return strconv.FormatUint(vt, 10), nil
+	case float32:
+		return json.Number(strconv.FormatFloat(float64(vt), 'g', -1, 32)), nil
+	case float64:
+		return json.Number(strconv.FormatFloat(vt, 'g', -1, 64)), nil
+	case []byte:
+		return base64.StdEncoding.EncodeToString(vt), nil
+	case *date_j5t.Date:
+		return vt.DateString(), nil
+	case *decimal_j5t.Decimal:
+		return vt.Value, nil
+	case time.Time:
+		return vt.In(time.UTC).Format(time.RFC3339Nano), nil
+	case nil:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unsupported scalar type %T", vt)
+	}
+}
+
+// setFieldFromJSON sets an unset field from its JSON value. Null leaves the
+// field unset.
+func setFieldFromJSON(field Field, val any) error {
+	if val == nil {
+		return nil
+	}
+
+	if obj, ok := field.AsObject(); ok {
+		members, ok := val.(map[string]any)
+		if !ok {
+			return unexpectedJSONType(val, "object")
+		}
+		if err := obj.SetDefaultValue(); err != nil {
+			return err
+		}
+		return setPropertySetFromJSON(obj, members)
+	}
+
+	if oneof, ok := field.AsOneof(); ok {
+		members, ok := val.(map[string]any)
+		if !ok {
+			return unexpectedJSONType(val, "object")
+		}
+		if err := oneof.SetDefaultValue(); err != nil {
+			return err
+		}
+		return setOneofFromJSON(oneof, members)
+	}
+
+	if anyField, ok := field.AsAny(); ok {
+		return setAnyFromJSON(anyField, val)
+	}
+
+	if poly, ok := field.AsPolymorph(); ok {
+		anyField, err := poly.Unwrap()
+		if err != nil {
+			return err
+		}
+		return setAnyFromJSON(anyField, val)
+	}
+
+	if enum, ok := field.AsEnum(); ok {
+		str, ok := val.(string)
+		if !ok {
+			return unexpectedJSONType(val, "string")
+		}
+		if str == "" {
+			return nil
+		}
+		return enum.SetFromString(str)
+	}
+
+	if array, ok := field.AsArray(); ok {
+		items, ok := val.([]any)
+		if !ok {
+			return unexpectedJSONType(val, "array")
+		}
+		for idx, item := range items {
+			if _, err := appendArrayFromJSON(array, item); err != nil {
+				return patherr.Wrap(err, fmt.Sprintf("[%d]", idx))
+			}
+		}
+		return nil
+	}
+
+	if mapField, ok := field.AsMap(); ok {
+		members, ok := val.(map[string]any)
+		if !ok {
+			return unexpectedJSONType(val, "object")
+		}
+		for _, key := range sortedKeys(members) {
+			if err := setMapElementFromJSON(mapField, key, members[key]); err != nil {
+				return patherr.Wrap(err, key)
+			}
+		}
+		return nil
+	}
+
+	if scalar, ok := field.AsScalar(); ok {
+		switch val.(type) {
+		case map[string]any, []any:
+			return unexpectedJSONType(val, "scalar")
+		}
+		return scalar.SetGoValue(val)
+	}
+
+	return fmt.Errorf("unsupported field type %s", field.FullTypeName())
+}
+
+func setPropertySetFromJSON(ps PropertySet, members map[string]any) error {
+	for _, key := range sortedKeys(members) {
+		prop, err := ps.GetProperty(key)
+		if err != nil {
+			return patherr.Wrap(err, key)
+		}
+		field, err := prop.Field()
+		if err != nil {
+			return patherr.Wrap(err, key)
+		}
+		if err := setFieldFromJSON(field, members[key]); err != nil {
+			return patherr.Wrap(err, key)
+		}
+	}
+	return nil
+}
+
+// setOneofFromJSON allows at most one option, '!type' is optional, and must
+// match the option when given.
+func setOneofFromJSON(oneof Oneof, members map[string]any) error {
+	var typeName *string
+	var option string
+	for _, key := range sortedKeys(members) {
+		val := members[key]
+		if key == "!type" {
+			str, ok := val.(string)
+			if !ok {
+				return patherr.Wrap(unexpectedJSONType(val, "string"), key)
+			}
+			typeName = &str
+			continue
+		}
+		if val == nil {
+			continue
+		}
+		if option != "" {
+			return fmt.Errorf("multiple values set for oneof: %q and %q", option, key)
+		}
+		option = key
+	}
+
+	if typeName != nil && *typeName != option {
+		return patherr.Wrap(fmt.Errorf("type %q does not match the value %q", *typeName, option), "!type")
+	}
+
+	if option == "" {
+		return nil
+	}
+
+	prop, err := oneof.GetProperty(option)
+	if err != nil {
+		return patherr.Wrap(err, option)
+	}
+	field, err := prop.Field()
+	if err != nil {
+		return patherr.Wrap(err, option)
+	}
+	if err := setFieldFromJSON(field, members[option]); err != nil {
+		return patherr.Wrap(err, option)
+	}
+	return nil
+}
+
+func setAnyFromJSON(field AnyField, val any) error {
+	if val == nil {
+		return nil
+	}
+	if field.IsOpaque() {
+		return fmt.Errorf("opaque any type %s can't be set from J5 JSON", field.FullTypeName())
+	}
+
+	members, ok := val.(map[string]any)
+	if !ok {
+		return unexpectedJSONType(val, "object")
+	}
+
+	typeName, ok := members["!type"].(string)
+	if !ok || typeName == "" {
+		return patherr.Wrap(fmt.Errorf("no type found in Any"), "value")
+	}
+	inner, ok := members["value"]
+	if !ok {
+		return patherr.Wrap(fmt.Errorf("no value found in Any"), "value")
+	}
+	for key := range members {
+		if key != "!type" && key != "value" {
+			return patherr.Wrap(fmt.Errorf("unknown key in Any"), key)
+		}
+	}
+
+	j5Json, err := json.Marshal(inner)
+	if err != nil {
+		return err
+	}
+	anyVal := &any_j5t.Any{
+		TypeName: typeName,
+		J5Json:   j5Json,
+	}
+
+	if impl, ok := field.(*anyField); ok {
+		if _, isPB := impl.implType.(*pbAnyImpl); isPB {
+			// google.protobuf.Any can only hold proto bytes
+			anyVal.Proto, err = protoFromJSON(typeName, inner)
+			if err != nil {
+				return patherr.Wrap(err, "value")
+			}
+		}
+	}
+
+	return field.SetJ5Any(anyVal)
+}
+
+func protoFromJSON(typeName string, val any) ([]byte, error) {
+	msg, err := newGlobalMessage(typeName)
+	if err != nil {
+		return nil, err
+	}
+	root, err := Global.NewRoot(msg)
+	if err != nil {
+		return nil, err
+	}
+	members, ok := val.(map[string]any)
+	if !ok {
+		return nil, unexpectedJSONType(val, "object")
+	}
+	switch root := root.(type) {
+	case Object:
+		err = setPropertySetFromJSON(root, members)
+	case Oneof:
+		err = setOneofFromJSON(root, members)
+	default:
+		err = fmt.Errorf("unsupported root type %T", root)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return proto.MarshalOptions{Deterministic: true}.Marshal(msg.Interface())
+}
+
+// appendArrayFromJSON appends an element to the array, returning its index.
+func appendArrayFromJSON(array ArrayField, val any) (int, error) {
+	if val == nil {
+		return -1, fmt.Errorf("array elements can't be null")
+	}
+
+	if scalars, ok := array.AsArrayOfScalar(); ok {
+		switch val.(type) {
+		case map[string]any, []any:
+			return -1, unexpectedJSONType(val, "scalar")
+		}
+		return scalars.AppendGoValue(val)
+	}
+
+	mutable, ok := array.(MutableArrayField)
+	if !ok {
+		return -1, fmt.Errorf("unsupported array type %s", array.FullTypeName())
+	}
+	elem := mutable.NewElement()
+	if err := setFieldFromJSON(elem, val); err != nil {
+		return -1, err
+	}
+	return elem.IndexInParent(), nil
+}
+
+// setMapElementFromJSON sets the key, which must not exist in the map. Null is
+// ignored.
+func setMapElementFromJSON(mapField MapField, key string, val any) error {
+	if val == nil {
+		return nil
+	}
+
+	switch mt := mapField.(type) {
+	case MapOfScalarField:
+		switch val.(type) {
+		case map[string]any, []any:
+			return unexpectedJSONType(val, "scalar")
+		}
+		return mt.SetGoValue(key, val)
+
+	case MapOfEnumField:
+		str, ok := val.(string)
+		if !ok {
+			return unexpectedJSONType(val, "string")
+		}
+		return mt.SetEnum(key, str)
+	}
+
+	elem, err := mapField.NewElement(key)
+	if err != nil {
+		return err
+	}
+	return setFieldFromJSON(elem, val)
+}
+
+func unexpectedJSONType(val any, expected string) error {
+	return fmt.Errorf("expected %s, got %s", expected, jsonTypeName(val))
+}
+
+func jsonTypeName(val any) string {
+	switch val.(type) {
+	case nil:
+		return "null"
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	case string:
+		return "string"
+	case json.Number:
+		return "number"
+	case bool:
+		return "boolean"
+	default:
+		return fmt.Sprintf("%T", val)
+	}
+}
+
+func sortedKeys(members map[string]any) []string {
+	keys := make([]string, 0, len(members))
+	for key := range members {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// jsonEqual compares generic JSON values, numbers are equal when they have the
+// same value, regardless of formatting.
+func jsonEqual(a, b any) bool {
+	switch at := a.(type) {
+	case map[string]any:
+		bt, ok := b.(map[string]any)
+		if !ok || len(at) != len(bt) {
+			return false
+		}
+		for key, aVal := range at {
+			bVal, ok := bt[key]
+			if !ok || !jsonEqual(aVal, bVal) {
+				return false
+			}
+		}
+		return true
+
+	case []any:
+		bt, ok := b.([]any)
+		if !ok || len(at) != len(bt) {
+			return false
+		}
+		for idx := range at {
+			if !jsonEqual(at[idx], bt[idx]) {
+				return false
+			}
+		}
+		return true
+
+	case json.Number:
+		bt, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		if at == bt {
+			return true
+		}
+		aVal, aOK := new(big.Float).SetPrec(256).SetString(at.String())
+		bVal, bOK := new(big.Float).SetPrec(256).SetString(bt.String())
+		return aOK && bOK && aVal.Cmp(bVal) == 0
+
+	default:
+		return a == b
+	}
+}
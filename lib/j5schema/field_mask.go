@@ -0,0 +1,133 @@
+package j5schema
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// FieldMask selects properties of an object by client JSON path, e.g.
+// "keys.id". Selecting a property selects everything within it. Arrays are
+// transparent, so "entities.status" selects the status of each element of the
+// entities array. A nil mask selects everything.
+type FieldMask struct {
+	// children is keyed by JSON name, a nil value selects the whole property.
+	children map[string]*FieldMask
+}
+
+// ParseFieldMask validates the paths against the object schema, paths may pass
+// through objects, oneofs and arrays of either, but not maps.
+func ParseFieldMask(schema *ObjectSchema, paths ...string) (*FieldMask, error) {
+	mask := &FieldMask{
+		children: map[string]*FieldMask{},
+	}
+	for _, path := range paths {
+		if err := mask.addPath(schema, path); err != nil {
+			return nil, fmt.Errorf("field mask path %q: %w", path, err)
+		}
+	}
+	return mask, nil
+}
+
+type hasClientProperties interface {
+	ClientProperties() PropertySet
+	FullName() string
+}
+
+func (fm *FieldMask) addPath(schema *ObjectSchema, path string) error {
+	var parent hasClientProperties = schema
+	mask := fm
+	parts := strings.Split(path, ".")
+	for idx, name := range parts {
+		if name == "" {
+			return fmt.Errorf("empty path element")
+		}
+		if parent == nil {
+			return fmt.Errorf("%s has no properties to select", parts[idx-1])
+		}
+
+		prop := parent.ClientProperties().ByJSONName(name)
+		if prop == nil {
+			return fmt.Errorf("property %q not found in %s", name, parent.FullName())
+		}
+
+		if idx == len(parts)-1 {
+			// the whole property, replacing any narrower selection
+			mask.children[name] = nil
+			return nil
+		}
+
+		child, ok := mask.children[name]
+		if ok && child == nil {
+			return nil // already selected as a whole
+		}
+		if !ok {
+			child = &FieldMask{
+				children: map[string]*FieldMask{},
+			}
+			mask.children[name] = child
+		}
+		mask = child
+		parent = maskContainer(prop.Schema)
+	}
+	return nil
+}
+
+// maskContainer returns the properties a mask can select within the field, nil
+// when the field has none.
+func maskContainer(field FieldSchema) hasClientProperties {
+	switch ft := field.(type) {
+	case *ObjectField:
+		return ft.ObjectSchema()
+	case *OneofField:
+		return ft.OneofSchema()
+	case *ArrayField:
+		return maskContainer(ft.ItemSchema)
+	default:
+		return nil
+	}
+}
+
+// Child returns the selection within the named property, ok is false when the
+// property is not selected, and child is nil when it is selected as a whole.
+func (fm *FieldMask) Child(name string) (child *FieldMask, ok bool) {
+	if fm == nil {
+		return nil, true
+	}
+	child, ok = fm.children[name]
+	return child, ok
+}
+
+// Names returns the JSON names of the selected properties, sorted.
+func (fm *FieldMask) Names() []string {
+	if fm == nil {
+		return nil
+	}
+	names := make([]string, 0, len(fm.children))
+	for name := range fm.children {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Paths returns the minimal set of paths which select the same properties,
+// sorted.
+func (fm *FieldMask) Paths() []string {
+	paths := []string{}
+	for _, name := range fm.Names() {
+		child := fm.children[name]
+		if child == nil {
+			paths = append(paths, name)
+			continue
+		}
+		for _, childPath := range child.Paths() {
+			paths = append(paths, name+"."+childPath)
+		}
+	}
+	return paths
+}
+
+func (fm *FieldMask) String() string {
+	return strings.Join(fm.Paths(), ",")
+}
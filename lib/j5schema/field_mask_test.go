@@ -0,0 +1,61 @@
+package j5schema
+
+import (
+	"testing"
+
+	"github.com/pentops/j5/internal/gen/test/schema/v1/schema_testpb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseFieldMask(t *testing.T) {
+	schema := MustObjectSchema((&schema_testpb.FullSchema{}).ProtoReflect().Descriptor())
+
+	mask, err := ParseFieldMask(schema,
+		"sBar.barId",
+		"sBar.barField",
+		"rBars.barId",
+		"rBars",
+		"wrappedOneof.wOneofBar.barId",
+		"fieldFromFlattened",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{
+		"fieldFromFlattened",
+		"rBars",
+		"sBar.barField",
+		"sBar.barId",
+		"wrappedOneof.wOneofBar.barId",
+	}, mask.Paths())
+
+	child, ok := mask.Child("sBar")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"barField", "barId"}, child.Names())
+
+	child, ok = mask.Child("rBars")
+	assert.True(t, ok)
+	assert.Nil(t, child)
+
+	_, ok = mask.Child("sString")
+	assert.False(t, ok)
+
+	var everything *FieldMask
+	_, ok = everything.Child("sString")
+	assert.True(t, ok)
+
+	for _, path := range []string{
+		"",
+		"nope",
+		"sBar.nope",
+		"sString.x",
+		"mapStringBar.k",
+		"flattened",
+		"sBar..barId",
+	} {
+		_, err := ParseFieldMask(schema, path)
+		if err == nil {
+			t.Errorf("expected error for %q", path)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package j5schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	celast "github.com/google/cel-go/common/ast"
+	"github.com/pentops/j5/gen/j5/schema/v1/schema_j5pb"
+)
+
+// ObjectRule is a compiled CEL rule of an object, see schema_j5pb.ObjectRule.
+type ObjectRule struct {
+	Expression string
+	Message    string
+	Path       []string
+
+	program cel.Program
+
+	// properties are the properties the expression selects from the object,
+	// allProperties is set when it uses the object as a whole.
+	properties    map[string]struct{}
+	allProperties bool
+}
+
+// ObjectRuleVariable is the name of the CEL variable holding the object.
+const ObjectRuleVariable = "this"
+
+var objectRuleEnv = func() *cel.Env {
+	env, err := cel.NewEnv(
+		cel.Variable(ObjectRuleVariable, cel.MapType(cel.StringType, cel.DynType)),
+		decimalFunction,
+	)
+	if err != nil {
+		panic(fmt.Sprintf("building CEL environment: %s", err))
+	}
+	return env
+}()
+
+// compileObjectRules compiles the rules of an object, and checks that the
+// path of each rule starts at one of the object's properties. Deeper path
+// parts are not checked as the property schemas may not be linked yet.
+func compileObjectRules(rules []*schema_j5pb.ObjectRule, properties []*ObjectProperty) ([]*ObjectRule, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	compiled := make([]*ObjectRule, 0, len(rules))
+	for idx, rule := range rules {
+		built, err := CompileObjectRule(rule)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %w", idx, err)
+		}
+		if len(built.Path) > 0 && !hasProperty(properties, built.Path[0]) {
+			return nil, fmt.Errorf("rule %d: path %q: no property %q", idx, rule.Path, built.Path[0])
+		}
+		compiled = append(compiled, built)
+	}
+	return compiled, nil
+}
+
+func hasProperty(properties []*ObjectProperty, jsonName string) bool {
+	for _, prop := range properties {
+		if prop.JSONName == jsonName {
+			return true
+		}
+	}
+	return false
+}
+
+// CompileObjectRule parses and type checks the rule's expression.
+func CompileObjectRule(rule *schema_j5pb.ObjectRule) (*ObjectRule, error) {
+	ast, issues := objectRuleEnv.Compile(rule.Expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("compiling %q: %w", rule.Expression, issues.Err())
+	}
+
+	outputType := ast.OutputType()
+	if !outputType.IsExactType(cel.BoolType) && !outputType.IsExactType(cel.DynType) {
+		return nil, fmt.Errorf("expression %q must return a bool, got %s", rule.Expression, outputType)
+	}
+
+	program, err := objectRuleEnv.Program(ast)
+	if err != nil {
+		return nil, fmt.Errorf("building program for %q: %w", rule.Expression, err)
+	}
+
+	var path []string
+	if rule.Path != "" {
+		path = strings.Split(rule.Path, ".")
+		for _, part := range path {
+			if part == "" {
+				return nil, fmt.Errorf("invalid rule path %q", rule.Path)
+			}
+		}
+	}
+
+	built := &ObjectRule{
+		Expression: rule.Expression,
+		Message:    rule.Message,
+		Path:       path,
+		program:    program,
+		properties: map[string]struct{}{},
+	}
+	built.findProperties(ast)
+	return built, nil
+}
+
+// findProperties records the properties selected from the object variable,
+// e.g. this.foo and has(this.foo). Any other use, such as this['foo'] or
+// size(this), depends on every property.
+func (rule *ObjectRule) findProperties(ast *cel.Ast) {
+	root := celast.NavigateAST(ast.NativeRep())
+	idents := celast.MatchDescendants(root, celast.KindMatcher(celast.IdentKind))
+	for _, ident := range idents {
+		if ident.AsIdent() != ObjectRuleVariable {
+			continue
+		}
+		parent, ok := ident.Parent()
+		if ok && parent.Kind() == celast.SelectKind && parent.AsSelect().Operand().ID() == ident.ID() {
+			rule.properties[parent.AsSelect().FieldName()] = struct{}{}
+			continue
+		}
+		rule.allProperties = true
+	}
+}
+
+// UsesProperty returns true when the expression depends on the property,
+// by JSON name.
+func (rule *ObjectRule) UsesProperty(jsonName string) bool {
+	if rule.allProperties {
+		return true
+	}
+	_, ok := rule.properties[jsonName]
+	return ok
+}
+
+// Eval runs the rule against the object's properties, keyed by JSON name.
+func (rule *ObjectRule) Eval(this map[string]any) (bool, error) {
+	out, _, err := rule.program.Eval(map[string]any{
+		ObjectRuleVariable: this,
+	})
+	if err != nil {
+		return false, err
+	}
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("rule %q returned %T, expected bool", rule.Expression, out.Value())
+	}
+	return result, nil
+}
+
+func (rule *ObjectRule) ToJ5Proto() *schema_j5pb.ObjectRule {
+	return &schema_j5pb.ObjectRule{
+		Expression: rule.Expression,
+		Message:    rule.Message,
+		Path:       strings.Join(rule.Path, "."),
+	}
+}
+
+func objectRulesToJ5(rules []*ObjectRule) []*schema_j5pb.ObjectRule {
+	if len(rules) == 0 {
+		return nil
+	}
+	out := make([]*schema_j5pb.ObjectRule, len(rules))
+	for idx, rule := range rules {
+		out[idx] = rule.ToJ5Proto()
+	}
+	return out
+}
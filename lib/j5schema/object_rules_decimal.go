@@ -0,0 +1,108 @@
+package j5schema
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/common/types/traits"
+	"github.com/shopspring/decimal"
+)
+
+// RuleDecimal is the CEL value of a decimal property. Decimals compare exactly
+// with other decimals, and with int, uint and double values on the right hand
+// side, e.g. `this.amount > 0` or `this.amount <= decimal('0.05')`. A number
+// on the left, e.g. `0 < this.amount`, does not compare.
+type RuleDecimal struct {
+	decimal.Decimal
+}
+
+var ruleDecimalType = types.NewObjectType("j5.Decimal", traits.ComparerType)
+
+// decimalFunction parses a decimal string in a rule, e.g. decimal('1.05'). It
+// is declared as dyn so that the comparison operators accept it.
+var decimalFunction = cel.Function("decimal",
+	cel.Overload("decimal_string",
+		[]*cel.Type{cel.StringType}, cel.DynType,
+		cel.UnaryBinding(func(val ref.Val) ref.Val {
+			str, ok := val.(types.String)
+			if !ok {
+				return types.MaybeNoSuchOverloadErr(val)
+			}
+			parsed, err := decimal.NewFromString(string(str))
+			if err != nil {
+				return types.NewErr("invalid decimal %q", string(str))
+			}
+			return RuleDecimal{Decimal: parsed}
+		}),
+	),
+)
+
+func (d RuleDecimal) ConvertToNative(typeDesc reflect.Type) (any, error) {
+	switch typeDesc {
+	case reflect.TypeOf(decimal.Decimal{}):
+		return d.Decimal, nil
+	case reflect.TypeOf(""):
+		return d.String(), nil
+	}
+	return nil, fmt.Errorf("type conversion error from decimal to %s", typeDesc)
+}
+
+func (d RuleDecimal) ConvertToType(typeVal ref.Type) ref.Val {
+	switch typeVal {
+	case ruleDecimalType:
+		return d
+	case types.StringType:
+		return types.String(d.String())
+	case types.DoubleType:
+		return types.Double(d.InexactFloat64())
+	case types.TypeType:
+		return ruleDecimalType
+	}
+	return types.NewErr("type conversion error from decimal to %s", typeVal)
+}
+
+func (d RuleDecimal) Equal(other ref.Val) ref.Val {
+	otherDecimal, ok := toRuleDecimal(other)
+	if !ok {
+		return types.False
+	}
+	return types.Bool(d.Decimal.Equal(otherDecimal))
+}
+
+func (d RuleDecimal) Compare(other ref.Val) ref.Val {
+	otherDecimal, ok := toRuleDecimal(other)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(other)
+	}
+	return types.Int(d.Cmp(otherDecimal))
+}
+
+func (d RuleDecimal) Type() ref.Type {
+	return ruleDecimalType
+}
+
+func (d RuleDecimal) Value() any {
+	return d.Decimal
+}
+
+func toRuleDecimal(val ref.Val) (decimal.Decimal, bool) {
+	switch val := val.(type) {
+	case RuleDecimal:
+		return val.Decimal, true
+	case types.Int:
+		return decimal.NewFromInt(int64(val)), true
+	case types.Uint:
+		return decimal.NewFromUint64(uint64(val)), true
+	case types.Double:
+		f := float64(val)
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return decimal.Decimal{}, false
+		}
+		return decimal.NewFromFloat(f), true
+	}
+	return decimal.Decimal{}, false
+}
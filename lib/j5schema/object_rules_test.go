@@ -0,0 +1,123 @@
+package j5schema
+
+import (
+	"testing"
+
+	"github.com/pentops/j5/gen/j5/schema/v1/schema_j5pb"
+	"github.com/shopspring/decimal"
+)
+
+func TestCompileObjectRule(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		expression string
+		path       string
+		wantErr    bool
+	}{
+		{name: "bool", expression: "this.a > this.b"},
+		{name: "has", expression: "has(this.a)", path: "a.b"},
+		{name: "syntax", expression: "this.a >", wantErr: true},
+		{name: "not bool", expression: "'foo'", wantErr: true},
+		{name: "unknown variable", expression: "that.a", wantErr: true},
+		{name: "empty path part", expression: "true", path: "a..b", wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			rule, err := CompileObjectRule(&schema_j5pb.ObjectRule{
+				Expression: tc.expression,
+				Path:       tc.path,
+			})
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error for %q", tc.expression)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if rule.ToJ5Proto().Path != tc.path {
+				t.Errorf("expected path %q, got %q", tc.path, rule.ToJ5Proto().Path)
+			}
+		})
+	}
+}
+
+func TestCompileObjectRulePath(t *testing.T) {
+	properties := []*ObjectProperty{{JSONName: "a"}, {JSONName: "b"}}
+	for _, tc := range []struct {
+		path    string
+		wantErr bool
+	}{
+		{path: ""},
+		{path: "a"},
+		{path: "b.c"},
+		{path: "c", wantErr: true},
+		{path: "c.a", wantErr: true},
+	} {
+		_, err := compileObjectRules([]*schema_j5pb.ObjectRule{{
+			Expression: "true",
+			Path:       tc.path,
+		}}, properties)
+		if tc.wantErr && err == nil {
+			t.Errorf("expected error for path %q", tc.path)
+		} else if !tc.wantErr && err != nil {
+			t.Errorf("path %q: %s", tc.path, err)
+		}
+	}
+}
+
+func TestObjectRuleProperties(t *testing.T) {
+	for _, tc := range []struct {
+		expression string
+		uses       []string
+		notUses    []string
+	}{
+		{expression: "this.a > this.b", uses: []string{"a", "b"}, notUses: []string{"c"}},
+		{expression: "!has(this.a) || this.a.x == 1", uses: []string{"a"}, notUses: []string{"x"}},
+		{expression: "true", notUses: []string{"a"}},
+		{expression: "size(this) > 1", uses: []string{"a", "b"}},
+		{expression: "this['a'] == 1", uses: []string{"a", "b"}},
+	} {
+		rule, err := CompileObjectRule(&schema_j5pb.ObjectRule{Expression: tc.expression})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, name := range tc.uses {
+			if !rule.UsesProperty(name) {
+				t.Errorf("%q: expected to use %q", tc.expression, name)
+			}
+		}
+		for _, name := range tc.notUses {
+			if rule.UsesProperty(name) {
+				t.Errorf("%q: expected not to use %q", tc.expression, name)
+			}
+		}
+	}
+}
+
+func TestObjectRuleDecimal(t *testing.T) {
+	for _, tc := range []struct {
+		expression string
+		want       bool
+	}{
+		{expression: "this.d == decimal('0.30')", want: true},
+		{expression: "this.d > 0", want: true},
+		{expression: "this.d < 1u", want: true},
+		{expression: "this.d < 0.3", want: false},
+		{expression: "this.d >= decimal('0.30000000000000000001')", want: false},
+	} {
+		rule, err := CompileObjectRule(&schema_j5pb.ObjectRule{Expression: tc.expression})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := rule.Eval(map[string]any{
+			"d": RuleDecimal{Decimal: decimal.RequireFromString("0.3")},
+		})
+		if err != nil {
+			t.Fatalf("%q: %s", tc.expression, err)
+		}
+		if got != tc.want {
+			t.Errorf("%q: got %v, want %v", tc.expression, got, tc.want)
+		}
+	}
+}
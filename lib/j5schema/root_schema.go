@@ -177,6 +177,7 @@ type ObjectSchema struct {
 	Properties      PropertySet
 	BCL             *bcl_j5pb.Block
 	ListRequest     *ListRequestDefaults
+	Rules           []*ObjectRule
 }
 
 type ListRequestDefaults struct {
@@ -272,6 +273,7 @@ func (s *ObjectSchema) ToJ5Object() *schema_j5pb.Object {
 		Entity:          s.Entity,
 		PolymorphMember: s.PolymorphMember,
 		Bcl:             s.BCL,
+		Rules:           objectRulesToJ5(s.Rules),
 	}
 }
 
@@ -321,6 +323,7 @@ func (s *ObjectSchema) ToJ5ClientObject() *schema_j5pb.Object {
 		Properties:      properties,
 		Entity:          s.Entity,
 		PolymorphMember: s.PolymorphMember,
+		Rules:           objectRulesToJ5(s.Rules),
 	}
 }
 
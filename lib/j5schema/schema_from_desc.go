@@ -360,6 +360,12 @@ func (pkg *Package) objectSchemaFromDesc(sch *schema_j5pb.Object) (*ObjectSchema
 
 	}
 
+	rules, err := compileObjectRules(sch.Rules, object.Properties)
+	if err != nil {
+		return nil, fmt.Errorf("rules of %s: %w", sch.Name, err)
+	}
+	object.Rules = rules
+
 	return object, nil
 }
 
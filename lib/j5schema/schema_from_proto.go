@@ -234,7 +234,6 @@ func (ss *Package) buildObjectSchema(srcMsg protoreflect.MessageDescriptor, opts
 	objectSchema := &ObjectSchema{
 		rootSchema:      ss.schemaRootFromProto(srcMsg),
 		PolymorphMember: opts.PolymorphMember,
-		// TODO: Rules
 	}
 
 	properties, err := ss.messageProperties(objectSchema, srcMsg)
@@ -243,6 +242,12 @@ func (ss *Package) buildObjectSchema(srcMsg protoreflect.MessageDescriptor, opts
 	}
 	objectSchema.Properties = properties
 
+	rules, err := compileObjectRules(opts.Rules, properties)
+	if err != nil {
+		return nil, fmt.Errorf("rules of %s: %w", srcMsg.FullName(), err)
+	}
+	objectSchema.Rules = rules
+
 	for _, prop := range properties {
 		if err := prop.checkValid(); err != nil {
 			return nil, fmt.Errorf("property %q: %w", prop.JSONName, err)
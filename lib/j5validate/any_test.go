@@ -0,0 +1,232 @@
+package j5validate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pentops/j5/internal/j5s/j5test"
+	"github.com/pentops/j5/j5types/any_j5t"
+	"github.com/pentops/j5/lib/j5reflect"
+	"github.com/pentops/j5/lib/j5schema"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+type testResolver map[protoreflect.FullName]protoreflect.MessageType
+
+func (tr testResolver) FindMessageByName(name protoreflect.FullName) (protoreflect.MessageType, error) {
+	if mt, ok := tr[name]; ok {
+		return mt, nil
+	}
+	return nil, protoregistry.NotFound
+}
+
+func TestValidateAny(t *testing.T) {
+	fd := j5test.FileReflect(t, "anytest", `
+		object Inner {
+			field name ! string {
+				rules.maxLength = 3
+			}
+		}
+
+		object Other {
+			field id ? string
+		}
+
+		polymorph Shape {
+			members = ["anytest.v1.Inner"]
+		}
+
+		object Outer {
+			field payload ? any
+			field shape ? polymorph:Shape
+			field list array:any
+		}
+	`)
+
+	resolver := testResolver{}
+	for idx := range fd.Messages().Len() {
+		msg := fd.Messages().Get(idx)
+		resolver[msg.FullName()] = dynamicpb.NewMessageType(msg)
+	}
+	validator := NewValidator(WithResolver(resolver))
+	reflector := j5reflect.NewWithCache(j5schema.NewSchemaCache())
+
+	newOuter := func(t *testing.T) j5reflect.Object {
+		t.Helper()
+		root, err := reflector.NewRoot(dynamicpb.NewMessage(fd.Messages().ByName("Outer")))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return root.(j5reflect.Object)
+	}
+
+	setAny := func(t *testing.T, obj j5reflect.Object, path string, val *any_j5t.Any) {
+		t.Helper()
+		field, err := obj.GetOrCreateValue(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var anyField j5reflect.AnyField
+		if poly, ok := field.AsPolymorph(); ok {
+			anyField, err = poly.Unwrap()
+			if err != nil {
+				t.Fatal(err)
+			}
+		} else if af, ok := field.AsAny(); ok {
+			anyField = af
+		} else {
+			t.Fatalf("field %s is not an any or polymorph", path)
+		}
+		if err := anyField.SetJ5Any(val); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	assertErrors := func(t *testing.T, obj j5reflect.Object, wantPath string) {
+		t.Helper()
+		err := validator.Validate(obj)
+		if wantPath == "" {
+			if err != nil {
+				t.Fatalf("expected no validation error, got %s", err.Error())
+			}
+			return
+		}
+		errs, ok := err.(Errors)
+		if !ok {
+			t.Fatalf("expected Errors, got %T: %v", err, err)
+		}
+		if len(errs) != 1 {
+			t.Fatalf("expected exactly one error, got %s", errs.Error())
+		}
+		if got := errs[0].JSONPath(); got != wantPath {
+			t.Fatalf("expected error at %q, got %q (%s)", wantPath, got, errs[0].Message)
+		}
+	}
+
+	t.Run("Any JSON Valid", func(t *testing.T) {
+		obj := newOuter(t)
+		setAny(t, obj, "payload", &any_j5t.Any{
+			TypeName: "anytest.v1.Inner",
+			J5Json:   []byte(`{"name":"abc"}`),
+		})
+		assertErrors(t, obj, "")
+	})
+
+	t.Run("Any JSON Invalid", func(t *testing.T) {
+		obj := newOuter(t)
+		setAny(t, obj, "payload", &any_j5t.Any{
+			TypeName: "anytest.v1.Inner",
+			J5Json:   []byte(`{"name":"abcd"}`),
+		})
+		assertErrors(t, obj, "payload.value.name")
+	})
+
+	t.Run("Any Proto Invalid", func(t *testing.T) {
+		inner := dynamicpb.NewMessage(fd.Messages().ByName("Inner"))
+		inner.Set(inner.Descriptor().Fields().ByName("name"), protoreflect.ValueOfString("abcd"))
+		protoBytes, err := proto.Marshal(inner)
+		if err != nil {
+			t.Fatal(err)
+		}
+		obj := newOuter(t)
+		setAny(t, obj, "payload", &any_j5t.Any{
+			TypeName: "anytest.v1.Inner",
+			Proto:    protoBytes,
+		})
+		assertErrors(t, obj, "payload.value.name")
+	})
+
+	t.Run("Any Unknown Type", func(t *testing.T) {
+		obj := newOuter(t)
+		setAny(t, obj, "payload", &any_j5t.Any{
+			TypeName: "anytest.v1.Missing",
+			J5Json:   []byte(`{}`),
+		})
+		assertErrors(t, obj, "payload.!type")
+	})
+
+	t.Run("Any Bad JSON", func(t *testing.T) {
+		obj := newOuter(t)
+		setAny(t, obj, "payload", &any_j5t.Any{
+			TypeName: "anytest.v1.Inner",
+			J5Json:   []byte(`{"name":1}`),
+		})
+		assertErrors(t, obj, "payload.value")
+	})
+
+	t.Run("Array Of Any", func(t *testing.T) {
+		obj := newOuter(t)
+		list, err := obj.GetOrCreateValue("list")
+		if err != nil {
+			t.Fatal(err)
+		}
+		arr, ok := list.(j5reflect.MutableArrayField)
+		if !ok {
+			t.Fatalf("expected mutable array, got %T", list)
+		}
+		for _, name := range []string{"a", "abcd"} {
+			elem, ok := arr.NewElement().AsAny()
+			if !ok {
+				t.Fatal("expected any element")
+			}
+			if err := elem.SetJ5Any(&any_j5t.Any{
+				TypeName: "anytest.v1.Inner",
+				J5Json:   []byte(`{"name":"` + name + `"}`),
+			}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		assertErrors(t, obj, "list[1].value.name")
+	})
+
+	t.Run("Polymorph Valid", func(t *testing.T) {
+		obj := newOuter(t)
+		setAny(t, obj, "shape", &any_j5t.Any{
+			TypeName: "anytest.v1.Inner",
+			J5Json:   []byte(`{"name":"abc"}`),
+		})
+		assertErrors(t, obj, "")
+	})
+
+	t.Run("Polymorph Invalid Content", func(t *testing.T) {
+		obj := newOuter(t)
+		setAny(t, obj, "shape", &any_j5t.Any{
+			TypeName: "anytest.v1.Inner",
+			J5Json:   []byte(`{}`),
+		})
+		assertErrors(t, obj, "shape.value.name")
+	})
+
+	t.Run("Polymorph Not Member", func(t *testing.T) {
+		obj := newOuter(t)
+		setAny(t, obj, "shape", &any_j5t.Any{
+			TypeName: "anytest.v1.Other",
+			J5Json:   []byte(`{}`),
+		})
+		assertErrors(t, obj, "shape.!type")
+	})
+
+	t.Run("Nested Depth", func(t *testing.T) {
+		nested := func(levels int) *any_j5t.Any {
+			inner := `{}`
+			for range levels {
+				inner = `{"payload":{"!type":"anytest.v1.Outer","value":` + inner + `}}`
+			}
+			return &any_j5t.Any{
+				TypeName: "anytest.v1.Outer",
+				J5Json:   []byte(inner),
+			}
+		}
+
+		obj := newOuter(t)
+		setAny(t, obj, "payload", nested(maxAnyDepth-1))
+		assertErrors(t, obj, "")
+
+		obj = newOuter(t)
+		setAny(t, obj, "payload", nested(maxAnyDepth))
+		assertErrors(t, obj, strings.Repeat("payload.value.", maxAnyDepth)+"payload.value")
+	})
+}
@@ -0,0 +1,181 @@
+package j5validate
+
+import (
+	"fmt"
+
+	"github.com/pentops/j5/j5types/date_j5t"
+	"github.com/pentops/j5/j5types/decimal_j5t"
+	"github.com/pentops/j5/lib/j5reflect"
+	"github.com/pentops/j5/lib/j5schema"
+)
+
+// validateObjectRules evaluates the CEL rules of the object's schema. Errors
+// are reported at the path declared by the rule. Rules which use a property
+// with an error in propErrs are skipped, as the property error is reported
+// instead.
+func (v *Validator) validateObjectRules(obj j5reflect.Object, propErrs Errors) (Errors, error) {
+	rules := obj.ObjectSchema().Rules
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	invalid := map[string]struct{}{}
+	for _, propErr := range propErrs {
+		if len(propErr.clientPath) > 0 {
+			invalid[propErr.clientPath[0]] = struct{}{}
+		}
+	}
+
+	var this map[string]any
+	var errs Errors
+	for _, rule := range rules {
+		if usesInvalid(rule, invalid) {
+			continue
+		}
+
+		if this == nil {
+			var err error
+			this, err = celPropertySet(obj, invalid)
+			if err != nil {
+				return nil, fmt.Errorf("building CEL value for %s: %w", obj.SchemaName(), err)
+			}
+		}
+
+		message := rule.Message
+		if message == "" {
+			message = fmt.Sprintf("rule %q not satisfied", rule.Expression)
+		}
+
+		ok, err := rule.Eval(this)
+		if err != nil {
+			// Usually a property which is not set, e.g. when the expression
+			// doesn't check has(this.field).
+			message = fmt.Sprintf("%s: %s", message, err.Error())
+		} else if ok {
+			continue
+		}
+
+		errs = append(errs, Error{
+			clientPath: append([]string{}, rule.Path...),
+			Message:    message,
+		})
+	}
+	return errs, nil
+}
+
+func usesInvalid(rule *j5schema.ObjectRule, invalid map[string]struct{}) bool {
+	for name := range invalid {
+		if rule.UsesProperty(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// celPropertySet converts the set properties to a map keyed by JSON name,
+// leaving out the skipped properties.
+func celPropertySet(ps j5reflect.PropertySet, skip map[string]struct{}) (map[string]any, error) {
+	out := map[string]any{}
+	err := ps.RangeValues(func(field j5reflect.Field) error {
+		if _, ok := skip[field.NameInParent()]; ok {
+			return nil
+		}
+		val, ok, err := celValue(field)
+		if err != nil {
+			return err
+		}
+		if ok {
+			out[field.NameInParent()] = val
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// celValue converts a field to the native type CEL adapts it from. Decimals
+// are converted to j5schema.RuleDecimal to compare exactly. Any and Polymorph
+// values are not available to rules.
+func celValue(field j5reflect.Field) (any, bool, error) {
+	if obj, ok := field.AsObject(); ok {
+		val, err := celPropertySet(obj, nil)
+		return val, true, err
+	}
+
+	if oneof, ok := field.AsOneof(); ok {
+		val, err := celPropertySet(oneof, nil)
+		return val, true, err
+	}
+
+	if enum, ok := field.AsEnum(); ok {
+		val, err := enum.GetValue()
+		if err != nil {
+			return nil, false, err
+		}
+		return val.Name(), true, nil
+	}
+
+	if array, ok := field.AsArray(); ok {
+		out := []any{}
+		err := array.RangeValues(func(_ int, item j5reflect.Field) error {
+			val, ok, err := celValue(item)
+			if err != nil {
+				return err
+			}
+			if ok {
+				out = append(out, val)
+			}
+			return nil
+		})
+		return out, true, err
+	}
+
+	if mapField, ok := field.AsMap(); ok {
+		out := map[string]any{}
+		err := mapField.Range(func(key string, item j5reflect.Field) error {
+			val, ok, err := celValue(item)
+			if err != nil {
+				return err
+			}
+			if ok {
+				out[key] = val
+			}
+			return nil
+		})
+		return out, true, err
+	}
+
+	scalar, ok := field.AsScalar()
+	if !ok {
+		return nil, false, nil
+	}
+
+	val, err := scalar.ToGoValue()
+	if err != nil {
+		return nil, false, err
+	}
+
+	switch val := val.(type) {
+	case nil:
+		return nil, false, nil
+	case int32:
+		return int64(val), true, nil
+	case uint32:
+		return uint64(val), true, nil
+	case float32:
+		return float64(val), true, nil
+	case *date_j5t.Date:
+		// ISO dates compare correctly as strings
+		return val.DateString(), true, nil
+	case *decimal_j5t.Decimal:
+		shop, err := val.ToShop()
+		if err != nil {
+			return nil, false, err
+		}
+		return j5schema.RuleDecimal{Decimal: shop}, true, nil
+	default:
+		return val, true, nil
+	}
+}
@@ -0,0 +1,165 @@
+package j5validate
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestObjectRules(t *testing.T) {
+	t.Run("Compare Dates", func(t *testing.T) {
+		schema := newReflectCase(t, `
+		object Foo {
+			field startDate ! date
+			field endDate ? date
+
+			rule {
+				expression = "!has(this.endDate) || this.endDate > this.startDate"
+				message = "end date must be after start date"
+				path = "endDate"
+			}
+		}`)
+
+		schema.New().SetScalar("startDate", "2025-01-01").AssertValid()
+		schema.New().
+			SetScalar("startDate", "2025-01-01").
+			SetScalar("endDate", "2025-02-01").
+			AssertValid()
+		schema.New().
+			SetScalar("startDate", "2025-01-01").
+			SetScalar("endDate", "2024-12-01").
+			AssertInvalid("endDate", "end date must be after start date")
+
+		// Property errors are reported instead of rule errors
+		schema.New().SetScalar("endDate", "2024-12-01").AssertInvalid("startDate", "required")
+	})
+
+	t.Run("Conditional Required", func(t *testing.T) {
+		schema := newReflectCase(t, `
+		object Foo {
+			field status ! key
+
+			field reason ? string
+
+			rule {
+				expression = "this.status != 'closed' || has(this.reason)"
+				message = "reason is required when closed"
+				path = "reason"
+			}
+		}`)
+
+		schema.New().SetScalar("status", "open").AssertValid()
+		schema.New().SetScalar("status", "closed").AssertInvalid("reason", "reason is required")
+		schema.New().
+			SetScalar("status", "closed").
+			SetScalar("reason", "done").
+			AssertValid()
+	})
+
+	t.Run("Nested Object", func(t *testing.T) {
+		schema := newReflectCase(t, `
+		object Foo {
+			field range ! object {
+				field min ! integer:INT32
+				field max ! integer:INT32
+
+				rule {
+					expression = "this.min <= this.max"
+				}
+			}
+		}`)
+
+		schema.New().
+			SetScalar("range.min", int32(1)).
+			SetScalar("range.max", int32(2)).
+			AssertValid()
+		schema.New().
+			SetScalar("range.min", int32(3)).
+			SetScalar("range.max", int32(2)).
+			AssertInvalid("range", "this.min <= this.max")
+	})
+
+	t.Run("Missing Property", func(t *testing.T) {
+		schema := newReflectCase(t, `
+		object Foo {
+			field a ? string
+
+			rule {
+				expression = "this.a == 'x'"
+				message = "a must be x"
+			}
+		}`)
+
+		schema.New().AssertInvalid("-", "a must be x", "no such key")
+	})
+
+	t.Run("Decimals", func(t *testing.T) {
+		schema := newReflectCase(t, `
+		object Foo {
+			field min ! decimal
+			field max ! decimal
+
+			rule {
+				expression = "this.min <= this.max"
+				message = "min must not exceed max"
+				path = "max"
+			}
+
+			rule {
+				expression = "this.max > 0 && this.max <= decimal('100.5')"
+				message = "max out of range"
+				path = "max"
+			}
+		}`)
+
+		schema.New().
+			SetScalar("min", "0.3").
+			SetScalar("max", "100.5").
+			AssertValid()
+
+		// Equal as float64
+		schema.New().
+			SetScalar("min", "0.30000000000000000001").
+			SetScalar("max", "0.3").
+			AssertInvalid("max", "min must not exceed max")
+		schema.New().
+			SetScalar("min", "-1").
+			SetScalar("max", "100.50000000000000000001").
+			AssertInvalid("max", "max out of range")
+	})
+
+	t.Run("Independent Properties", func(t *testing.T) {
+		schema := newReflectCase(t, `
+		object Foo {
+			field a ! integer:INT32
+			field b ! integer:INT32
+			field c ! string
+
+			rule {
+				expression = "this.a < this.b"
+				message = "a must be less than b"
+			}
+		}`)
+
+		obj := schema.New().
+			SetScalar("a", int32(2)).
+			SetScalar("b", int32(1))
+
+		// c is not set, the rule only uses a and b so still runs
+		err := NewValidator().Validate(obj.Object)
+		errs, ok := err.(Errors)
+		if !ok {
+			t.Fatalf("expected Errors, got %v", err)
+		}
+		paths := make([]string, 0, len(errs))
+		for _, e := range errs {
+			paths = append(paths, e.JSONPath()+": "+e.Message)
+		}
+		want := []string{
+			"c: required field is not set",
+			"-: a must be less than b",
+		}
+		if !slices.Equal(paths, want) {
+			t.Errorf("got errors %q, want %q", paths, want)
+		}
+	})
+}
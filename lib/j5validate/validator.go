@@ -1,6 +1,7 @@
 package j5validate
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 	"slices"
@@ -11,22 +12,44 @@ import (
 	"github.com/pentops/j5/j5types/date_j5t"
 	"github.com/pentops/j5/j5types/decimal_j5t"
 	"github.com/pentops/j5/lib/id62"
+	"github.com/pentops/j5/lib/j5codec"
 	"github.com/pentops/j5/lib/j5reflect"
 	"github.com/pentops/j5/lib/j5schema"
 	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
 )
 
 var Global = NewValidator()
 
 type Validator struct {
+	resolver j5codec.MessageTypeResolver
+	codec    *j5codec.Codec
 }
 
-func NewValidator() *Validator {
-	return &Validator{}
+type ValidatorOption func(*Validator)
+
+// WithResolver sets the resolver used to find the types of Any and Polymorph
+// values, as in j5codec.WithResolver. Defaults to protoregistry.GlobalTypes.
+func WithResolver(resolver j5codec.MessageTypeResolver) ValidatorOption {
+	return func(v *Validator) {
+		v.resolver = resolver
+	}
+}
+
+func NewValidator(opts ...ValidatorOption) *Validator {
+	v := &Validator{
+		resolver: protoregistry.GlobalTypes,
+	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	v.codec = j5codec.NewCodec(j5codec.WithResolver(v.resolver))
+	return v
 }
 
 func (v *Validator) Validate(root j5reflect.Root) error {
-	e, err := v.validateRoot(root)
+	e, err := v.validateRoot(root, 0)
 	if err != nil {
 		return err
 	}
@@ -75,14 +98,23 @@ func (e Error) JSONPath() string {
 
 }
 
-func (v *Validator) validateRoot(root j5reflect.Root) (Errors, error) {
+// validateRoot validates the object or oneof. anyDepth is the number of Any
+// and Polymorph values it is nested in.
+func (v *Validator) validateRoot(root j5reflect.Root, anyDepth int) (Errors, error) {
 	switch elem := root.(type) {
 	case j5reflect.Object:
-		e, _, err := v.validatePropSet(elem)
-		return e, err
+		e, _, err := v.validatePropSet(elem, anyDepth)
+		if err != nil {
+			return nil, err
+		}
+		ruleErrs, err := v.validateObjectRules(elem, e)
+		if err != nil {
+			return nil, err
+		}
+		return append(e, ruleErrs...), nil
 
 	case j5reflect.Oneof:
-		e, _, err := v.validatePropSet(elem)
+		e, _, err := v.validatePropSet(elem, anyDepth)
 		return e, err
 
 	default:
@@ -90,7 +122,7 @@ func (v *Validator) validateRoot(root j5reflect.Root) (Errors, error) {
 	}
 }
 
-func (v *Validator) validatePropSet(ps j5reflect.PropertySet) (Errors, int, error) {
+func (v *Validator) validatePropSet(ps j5reflect.PropertySet, anyDepth int) (Errors, int, error) {
 	var errs Errors
 	count := 0
 	err := ps.RangeProperties(func(prop j5reflect.Property) error {
@@ -123,7 +155,7 @@ func (v *Validator) validatePropSet(ps j5reflect.PropertySet) (Errors, int, erro
 		}
 		count++
 
-		validationErr, err := v.validateField(field, schema.Schema)
+		validationErr, err := v.validateField(field, schema.Schema, anyDepth)
 		if err != nil {
 			return fmt.Errorf("error validating field %s: %w", schema.JSONName, err)
 		}
@@ -138,7 +170,7 @@ func (v *Validator) validatePropSet(ps j5reflect.PropertySet) (Errors, int, erro
 
 }
 
-func (v *Validator) validateField(field j5reflect.Field, schema j5schema.FieldSchema) (Errors, error) {
+func (v *Validator) validateField(field j5reflect.Field, schema j5schema.FieldSchema, anyDepth int) (Errors, error) {
 	switch st := schema.(type) {
 	case *j5schema.ObjectField:
 
@@ -147,10 +179,16 @@ func (v *Validator) validateField(field j5reflect.Field, schema j5schema.FieldSc
 			return nil, fmt.Errorf("expected object field, got %T", field)
 		}
 
-		errs, subCount, err := v.validatePropSet(obj)
+		errs, subCount, err := v.validatePropSet(obj, anyDepth)
+		if err != nil {
+			return nil, err
+		}
+
+		ruleErrs, err := v.validateObjectRules(obj, errs)
 		if err != nil {
 			return nil, err
 		}
+		errs = append(errs, ruleErrs...)
 
 		if st.Rules != nil {
 			if st.Rules.MinProperties != nil {
@@ -178,7 +216,7 @@ func (v *Validator) validateField(field j5reflect.Field, schema j5schema.FieldSc
 			return nil, fmt.Errorf("expected oneof field, got %T", field)
 		}
 
-		errs, subCount, err := v.validatePropSet(obj)
+		errs, subCount, err := v.validatePropSet(obj, anyDepth)
 		if err != nil {
 			return nil, err
 		}
@@ -192,12 +230,30 @@ func (v *Validator) validateField(field j5reflect.Field, schema j5schema.FieldSc
 		return errs, nil
 
 	case *j5schema.AnyField:
-		// OK, no rules for any, however sub-validation is not being run.
-		return nil, nil
+		anyField, ok := field.AsAny()
+		if !ok {
+			return nil, fmt.Errorf("expected any field, got %T", field)
+		}
+
+		return v.validateAny(anyField, nil, anyDepth)
 
 	case *j5schema.PolymorphField:
-		// OK, no rules for any, however sub-validation is not being run.
-		return nil, nil
+		polymorphField, ok := field.AsPolymorph()
+		if !ok {
+			return nil, fmt.Errorf("expected polymorph field, got %T", field)
+		}
+
+		polymorphSchema, ok := st.Ref.To.(*j5schema.PolymorphSchema)
+		if !ok {
+			return nil, fmt.Errorf("expected polymorph schema for %s, got %T", st.Ref.FullName(), st.Ref.To)
+		}
+
+		anyField, err := polymorphField.Unwrap()
+		if err != nil {
+			return nil, err
+		}
+
+		return v.validateAny(anyField, polymorphSchema.Members, anyDepth)
 
 	case *j5schema.ArrayField:
 
@@ -211,7 +267,7 @@ func (v *Validator) validateField(field j5reflect.Field, schema j5schema.FieldSc
 		count := 0
 		err := arrayField.RangeValues(func(idx int, item j5reflect.Field) error {
 			count++
-			subErr, err := v.validateField(item, st.ItemSchema)
+			subErr, err := v.validateField(item, st.ItemSchema, anyDepth)
 			if err != nil {
 				return err
 			}
@@ -252,7 +308,7 @@ func (v *Validator) validateField(field j5reflect.Field, schema j5schema.FieldSc
 		count := 0
 		err := mapField.Range(func(key string, item j5reflect.Field) error {
 			count++
-			subErr, err := v.validateField(item, st.ItemSchema)
+			subErr, err := v.validateField(item, st.ItemSchema, anyDepth)
 			if err != nil {
 				return fmt.Errorf("error validating map field %s: %w", key, err)
 			}
@@ -335,6 +391,73 @@ func (v *Validator) validateField(field j5reflect.Field, schema j5schema.FieldSc
 	}
 }
 
+// maxAnyDepth limits the nesting of Any and Polymorph values, each of which is
+// decoded again to be validated.
+const maxAnyDepth = 100
+
+// validateAny resolves the type of the value and validates it as a root. When
+// members is non-nil, the type must be one of the members.
+func (v *Validator) validateAny(field j5reflect.AnyField, members []string, anyDepth int) (Errors, error) {
+	if field.IsOpaque() {
+		return nil, nil
+	}
+
+	val, err := field.GetJ5Any()
+	if err != nil {
+		return nil, err
+	}
+	if val == nil {
+		return nil, nil
+	}
+
+	if members != nil && !slices.Contains(members, val.TypeName) {
+		return Errors{{
+			clientPath: []string{"!type"},
+			Message:    fmt.Sprintf("type %q is not one of the allowed types %v", val.TypeName, members),
+		}}, nil
+	}
+
+	if anyDepth >= maxAnyDepth {
+		return Errors{{
+			clientPath: []string{"value"},
+			Message:    fmt.Sprintf("exceeded max depth of %d nested any values", maxAnyDepth),
+		}}, nil
+	}
+
+	msgType, err := v.resolver.FindMessageByName(protoreflect.FullName(val.TypeName))
+	if err != nil {
+		if errors.Is(err, protoregistry.NotFound) {
+			return Errors{{
+				clientPath: []string{"!type"},
+				Message:    fmt.Sprintf("unknown type %q", val.TypeName),
+			}}, nil
+		}
+		return nil, fmt.Errorf("resolving any type %q: %w", val.TypeName, err)
+	}
+
+	msg := msgType.New()
+	if err := v.codec.DecodeAnyTo(val, msg.Interface()); err != nil {
+		return Errors{{
+			clientPath: []string{"value"},
+			Message:    fmt.Sprintf("invalid %s: %s", val.TypeName, err.Error()),
+		}}, nil
+	}
+
+	root, err := j5reflect.Global.NewRoot(msg)
+	if err != nil {
+		return nil, fmt.Errorf("reflecting any type %q: %w", val.TypeName, err)
+	}
+
+	subErrs, err := v.validateRoot(root, anyDepth+1)
+	if err != nil {
+		return nil, fmt.Errorf("validating any type %q: %w", val.TypeName, err)
+	}
+
+	errs := Errors{}
+	errs.mergeAt("value", subErrs)
+	return errs, nil
+}
+
 func validateScalar(gotValue any, schema *j5schema.ScalarSchema) (Errors, error) {
 	j5Schema := schema.ToJ5Field()
 	switch st := j5Schema.Type.(type) {
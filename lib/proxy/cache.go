@@ -0,0 +1,117 @@
+package proxy
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/pentops/j5/gen/j5/ext/v1/ext_j5pb"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const stateMetadataName protoreflect.FullName = "j5.state.v1.StateMetadata"
+
+func buildCacheControl(cc *ext_j5pb.CacheControl) string {
+	if cc == nil {
+		return ""
+	}
+	directives := []string{}
+	if cc.Private {
+		directives = append(directives, "private")
+	}
+	if cc.NoCache {
+		directives = append(directives, "no-cache")
+	}
+	if cc.NoStore {
+		directives = append(directives, "no-store")
+	}
+	if cc.MustRevalidate {
+		directives = append(directives, "must-revalidate")
+	}
+	if cc.MaxAge != nil {
+		directives = append(directives, fmt.Sprintf("max-age=%d", *cc.MaxAge))
+	}
+	return strings.Join(directives, ", ")
+}
+
+// stateMetadataPath finds a top level field in the response which holds a psm
+// state, returning the path from the response to the state's metadata, or nil
+// when the response does not wrap a single state.
+func stateMetadataPath(output protoreflect.MessageDescriptor) []protoreflect.FieldDescriptor {
+	fields := output.Fields()
+	for idx := range fields.Len() {
+		field := fields.Get(idx)
+		if field.Kind() != protoreflect.MessageKind || field.IsList() || field.IsMap() {
+			continue
+		}
+		metadata := field.Message().Fields().ByName("metadata")
+		if metadata == nil || metadata.Kind() != protoreflect.MessageKind || metadata.IsList() {
+			continue
+		}
+		if metadata.Message().FullName() != stateMetadataName {
+			continue
+		}
+		return []protoreflect.FieldDescriptor{field, metadata}
+	}
+	return nil
+}
+
+// responseETag builds an ETag for the response from a hash of the encoded
+// body. When the response wraps a state, the ETag is prefixed with the state's
+// sequence to make the version visible to clients, the hash still
+// distinguishes responses at the same sequence with different events, pages
+// or field masks.
+//
+// The ETag is only known once the method has been called, so a 304 saves
+// sending the body to the client, not the call to the backend.
+func (mm *grpcMethod) responseETag(output protoreflect.Message, body []byte) string {
+	sum := sha256.Sum256(body)
+	hash := base64.RawURLEncoding.EncodeToString(sum[:16])
+
+	if output != nil && len(mm.stateMetadata) == 2 {
+		state := output
+		for _, field := range mm.stateMetadata {
+			if !state.Has(field) {
+				state = nil
+				break
+			}
+			state = state.Get(field).Message()
+		}
+		if state != nil {
+			sequence := state.Get(state.Descriptor().Fields().ByName("last_sequence")).Uint()
+			return fmt.Sprintf(`W/"%s-%s-%s"`, mm.stateMetadata[0].Name(), strconv.FormatUint(sequence, 10), hash)
+		}
+	}
+
+	return `"` + hash + `"`
+}
+
+// etagMatches compares the If-None-Match header with an ETag using the weak
+// comparison from RFC 9110 13.1.2
+func etagMatches(ifNoneMatch string, etag string) bool {
+	etag = strings.TrimPrefix(etag, "W/")
+	for candidate := range strings.SplitSeq(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" {
+			return true
+		}
+		if strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// writeNotModified checks the request's If-None-Match header against the
+// ETag, returns true when a 304 has been written.
+func writeNotModified(w http.ResponseWriter, r *http.Request, etag string) bool {
+	ifNoneMatch := r.Header.Get("If-None-Match")
+	if ifNoneMatch == "" || !etagMatches(ifNoneMatch, etag) {
+		return false
+	}
+	w.WriteHeader(http.StatusNotModified)
+	return true
+}
@@ -0,0 +1,104 @@
+package proxy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pentops/j5/gen/j5/ext/v1/ext_j5pb"
+	"github.com/pentops/j5/gen/j5/state/v1/psm_j5pb"
+	"github.com/pentops/j5/internal/gen/test/foo/v1/foo_testpb"
+	"github.com/pentops/j5/internal/gen/test/foo/v1/foo_testspb"
+	codec "github.com/pentops/j5/lib/j5codec"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestETags(t *testing.T) {
+	services := foo_testspb.File_test_foo_v1_service_foo_p_j5s_proto.Services()
+
+	rr := NewRouter()
+	rr.SetGlobalAuth(AuthHeadersFunc(func(ctx context.Context, req *http.Request) (map[string]string, error) {
+		return map[string]string{}, nil
+	}))
+
+	invoker := &MockInvoker{
+		Codec: codec.NewCodec(),
+	}
+	for _, name := range []protoreflect.Name{"HandlerTestService", "FooQueryService"} {
+		if err := rr.RegisterGRPCService(context.Background(), services.ByName(name), invoker); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	get := func(path string, ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		rec := httptest.NewRecorder()
+		rr.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("Body Hash", func(t *testing.T) {
+		invoker.SetResponse(t, &foo_testspb.HandlerGetResponse{})
+		rec := get("/test/v1/foo/idVal", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		etag := rec.Header().Get("ETag")
+		assert.NotEmpty(t, etag)
+
+		rec = get("/test/v1/foo/idVal", etag)
+		assert.Equal(t, http.StatusNotModified, rec.Code)
+		assert.Empty(t, rec.Body.Bytes())
+		assert.Equal(t, etag, rec.Header().Get("ETag"))
+
+		rec = get("/test/v1/foo/idVal", `"other"`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("State Sequence", func(t *testing.T) {
+		invoker.SetResponse(t, &foo_testspb.FooGetResponse{
+			Foo: &foo_testpb.FooState{
+				Metadata: &psm_j5pb.StateMetadata{
+					LastSequence: 5,
+				},
+			},
+		})
+		rec := get("/test/foo/v1/foo/q/fooId", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		etag := rec.Header().Get("ETag")
+		assert.True(t, strings.HasPrefix(etag, `W/"foo-5-`), etag)
+
+		rec = get("/test/foo/v1/foo/q/fooId", `"abc", `+etag)
+		assert.Equal(t, http.StatusNotModified, rec.Code)
+
+		// Same sequence, different body, e.g. another field mask
+		invoker.SetResponse(t, &foo_testspb.FooGetResponse{
+			Foo: &foo_testpb.FooState{
+				Metadata: &psm_j5pb.StateMetadata{
+					LastSequence: 5,
+				},
+				Status: foo_testpb.FooStatus_FOO_STATUS_ACTIVE,
+			},
+		})
+		rec = get("/test/foo/v1/foo/q/fooId", etag)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.NotEqual(t, etag, rec.Header().Get("ETag"))
+	})
+}
+
+func TestBuildCacheControl(t *testing.T) {
+	assert.Equal(t, "", buildCacheControl(nil))
+	assert.Equal(t, "private, no-cache, max-age=60", buildCacheControl(&ext_j5pb.CacheControl{
+		Private: true,
+		NoCache: true,
+		MaxAge:  proto.Int32(60),
+	}))
+	assert.Equal(t, "no-store", buildCacheControl(&ext_j5pb.CacheControl{
+		NoStore: true,
+	}))
+}
@@ -0,0 +1,219 @@
+package proxy
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pentops/j5/gen/j5/auth/v1/auth_j5pb"
+)
+
+// CORSConfig configures Cross-Origin Resource Sharing for the gRPC methods
+// registered on a Router. Preflight (OPTIONS) responses are computed from the
+// HTTP methods registered for each path.
+type CORSConfig struct {
+	// AllowedOrigins are matched against the Origin request header. An entry
+	// may use '*' as a wildcard for a single subdomain label, e.g.
+	// 'https://*.example.com', or for the port, e.g. 'http://localhost:*'.
+	// A single '*' allows any origin, but never allows credentialed requests:
+	// origins matched only by '*' do not get Access-Control-Allow-Credentials.
+	AllowedOrigins []string
+
+	// AllowedHeaders are the request headers a browser may send. When empty,
+	// the headers requested by the preflight are reflected back.
+	AllowedHeaders []string
+
+	// ExposedHeaders are the response headers the browser may read.
+	ExposedHeaders []string
+
+	// CredentialedAuth is the set of method auth types which allow credentialed
+	// requests (cookies). When nil, only Cookie auth is credentialed.
+	CredentialedAuth map[auth_j5pb.MethodAuthTypeKey]bool
+
+	// MaxAge is how long the browser may cache a preflight response. Zero
+	// omits the header.
+	MaxAge time.Duration
+}
+
+type corsPolicy struct {
+	allowAny         bool
+	origins          []*regexp.Regexp
+	allowedHeaders   []string
+	exposedHeaders   string
+	credentialedAuth map[auth_j5pb.MethodAuthTypeKey]bool
+	maxAge           string
+}
+
+func newCORSPolicy(cfg CORSConfig) (*corsPolicy, error) {
+	policy := &corsPolicy{
+		credentialedAuth: cfg.CredentialedAuth,
+		exposedHeaders:   strings.Join(cfg.ExposedHeaders, ", "),
+	}
+	if policy.credentialedAuth == nil {
+		policy.credentialedAuth = map[auth_j5pb.MethodAuthTypeKey]bool{
+			auth_j5pb.MethodAuth_Type_Cookie: true,
+		}
+	}
+
+	for _, header := range cfg.AllowedHeaders {
+		policy.allowedHeaders = append(policy.allowedHeaders, http.CanonicalHeaderKey(header))
+	}
+
+	if cfg.MaxAge > 0 {
+		policy.maxAge = strconv.Itoa(int(cfg.MaxAge.Seconds()))
+	}
+
+	for _, origin := range cfg.AllowedOrigins {
+		if origin == "*" {
+			policy.allowAny = true
+			continue
+		}
+		if strings.Contains(origin, "**") {
+			return nil, fmt.Errorf("invalid origin pattern %q", origin)
+		}
+		parts := strings.Split(origin, "*")
+		pattern := regexp.QuoteMeta(parts[0])
+		for idx, part := range parts[1:] {
+			if strings.HasSuffix(parts[idx], ":") {
+				pattern += "[0-9]+"
+			} else {
+				pattern += "[A-Za-z0-9-]+"
+			}
+			pattern += regexp.QuoteMeta(part)
+		}
+		re, err := regexp.Compile("^" + pattern + "$")
+		if err != nil {
+			return nil, fmt.Errorf("invalid origin pattern %q: %w", origin, err)
+		}
+		policy.origins = append(policy.origins, re)
+	}
+
+	return policy, nil
+}
+
+// matchOrigin returns whether the origin is allowed, and whether it was
+// listed explicitly, which is required for credentialed requests.
+func (cp *corsPolicy) matchOrigin(origin string) (allowed bool, listed bool) {
+	if origin == "" {
+		return false, false
+	}
+	for _, re := range cp.origins {
+		if re.MatchString(origin) {
+			return true, true
+		}
+	}
+	return cp.allowAny, false
+}
+
+// setOriginHeaders sets the headers common to preflight and actual responses.
+// The origin is always echoed rather than using '*', as '*' is not valid for
+// credentialed requests, and the response must then vary by origin.
+func (cp *corsPolicy) setOriginHeaders(header http.Header, origin string, listed bool, authType auth_j5pb.MethodAuthTypeKey) {
+	header.Set("Access-Control-Allow-Origin", origin)
+	if listed && cp.credentialedAuth[authType] {
+		header.Set("Access-Control-Allow-Credentials", "true")
+	}
+}
+
+func (cp *corsPolicy) requestHeaders(requested string) string {
+	if len(cp.allowedHeaders) == 0 {
+		return requested
+	}
+	allowed := make([]string, 0, len(cp.allowedHeaders))
+	for header := range strings.SplitSeq(requested, ",") {
+		header = http.CanonicalHeaderKey(strings.TrimSpace(header))
+		if header != "" && slices.Contains(cp.allowedHeaders, header) {
+			allowed = append(allowed, header)
+		}
+	}
+	return strings.Join(allowed, ", ")
+}
+
+// SetCORS enables CORS handling for all registered gRPC methods, including
+// those registered before the call.
+func (rr *Router) SetCORS(cfg CORSConfig) error {
+	policy, err := newCORSPolicy(cfg)
+	if err != nil {
+		return err
+	}
+	rr.cors = policy
+	return nil
+}
+
+// routeMethods collects the methods registered against a single HTTP path, to
+// answer preflight requests.
+type routeMethods struct {
+	router  *Router
+	path    string
+	methods map[string]*grpcMethod
+}
+
+func (rr *Router) corsHandler(handler *grpcMethod, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if rr.cors != nil {
+			w.Header().Add("Vary", "Origin")
+			origin := r.Header.Get("Origin")
+			if allowed, listed := rr.cors.matchOrigin(origin); allowed {
+				rr.cors.setOriginHeaders(w.Header(), origin, listed, handler.authType)
+				if rr.cors.exposedHeaders != "" {
+					w.Header().Set("Access-Control-Expose-Headers", rr.cors.exposedHeaders)
+				}
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (route *routeMethods) allowedMethods() string {
+	methods := make([]string, 0, len(route.methods))
+	for method := range route.methods {
+		methods = append(methods, method)
+	}
+	slices.Sort(methods)
+	return strings.Join(methods, ", ")
+}
+
+func (route *routeMethods) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	policy := route.router.cors
+	if policy == nil {
+		route.router.router.MethodNotAllowedHandler.ServeHTTP(w, r)
+		return
+	}
+
+	header := w.Header()
+	header.Add("Vary", "Origin")
+	header.Add("Vary", "Access-Control-Request-Method")
+	header.Add("Vary", "Access-Control-Request-Headers")
+
+	origin := r.Header.Get("Origin")
+	allowed, listed := policy.matchOrigin(origin)
+	if !allowed {
+		jsonError(w, fmt.Sprintf("origin %q not allowed", origin), http.StatusForbidden)
+		return
+	}
+
+	requestMethod := r.Header.Get("Access-Control-Request-Method")
+	method, ok := route.methods[requestMethod]
+	if !ok {
+		header.Set("Allow", route.allowedMethods())
+		jsonError(w, fmt.Sprintf("method %s not allowed for %s", requestMethod, route.path), http.StatusMethodNotAllowed)
+		return
+	}
+
+	policy.setOriginHeaders(header, origin, listed, method.authType)
+	header.Set("Access-Control-Allow-Methods", route.allowedMethods())
+	if requested := r.Header.Get("Access-Control-Request-Headers"); requested != "" {
+		if allowed := policy.requestHeaders(requested); allowed != "" {
+			header.Set("Access-Control-Allow-Headers", allowed)
+		}
+	}
+	if policy.maxAge != "" {
+		header.Set("Access-Control-Max-Age", policy.maxAge)
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,151 @@
+package proxy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pentops/j5/gen/j5/auth/v1/auth_j5pb"
+	"github.com/pentops/j5/internal/gen/test/foo/v1/foo_testspb"
+	codec "github.com/pentops/j5/lib/j5codec"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCORS(t *testing.T) {
+	services := foo_testspb.File_test_foo_v1_service_foo_p_j5s_proto.Services()
+
+	rr := NewRouter()
+	invoker := &MockInvoker{
+		Codec: codec.NewCodec(),
+	}
+
+	ctx := context.Background()
+	if err := rr.registerMethod(ctx, services.ByName("HandlerTestService").Methods().ByName("HandlerGet"), invoker, &auth_j5pb.MethodAuthType_None{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := rr.registerMethod(ctx, services.ByName("FooCommandService").Methods().ByName("PostFoo"), invoker, &auth_j5pb.MethodAuthType_Cookie{}); err != nil {
+		t.Fatal(err)
+	}
+
+	preflight := func(path, origin, method string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodOptions, path, nil)
+		req.Header.Set("Origin", origin)
+		req.Header.Set("Access-Control-Request-Method", method)
+		req.Header.Set("Access-Control-Request-Headers", "content-type, x-custom")
+		rec := httptest.NewRecorder()
+		rr.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("Disabled", func(t *testing.T) {
+		rec := preflight("/test/v1/foo/idVal", "https://app.example.com", "GET")
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+
+	if err := rr.SetCORS(CORSConfig{
+		AllowedOrigins: []string{"https://*.example.com", "http://localhost:*"},
+		AllowedHeaders: []string{"Content-Type"},
+		ExposedHeaders: []string{"X-Version"},
+		MaxAge:         time.Hour,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Preflight", func(t *testing.T) {
+		rec := preflight("/test/v1/foo/idVal", "https://app.example.com", "GET")
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, "https://app.example.com", rec.Header().Get("Access-Control-Allow-Origin"))
+		assert.Equal(t, "GET", rec.Header().Get("Access-Control-Allow-Methods"))
+		assert.Equal(t, "Content-Type", rec.Header().Get("Access-Control-Allow-Headers"))
+		assert.Equal(t, "3600", rec.Header().Get("Access-Control-Max-Age"))
+		assert.Empty(t, rec.Header().Get("Access-Control-Allow-Credentials"))
+	})
+
+	t.Run("Preflight Port Wildcard", func(t *testing.T) {
+		rec := preflight("/test/v1/foo/idVal", "http://localhost:8080", "GET")
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("Preflight Credentialed", func(t *testing.T) {
+		rec := preflight("/test/foo/v1/foo/c", "https://app.example.com", "POST")
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, "true", rec.Header().Get("Access-Control-Allow-Credentials"))
+	})
+
+	t.Run("Preflight Bad Origin", func(t *testing.T) {
+		rec := preflight("/test/v1/foo/idVal", "https://example.com.evil.com", "GET")
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+		assert.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+	})
+
+	t.Run("Preflight Wildcard Anchored", func(t *testing.T) {
+		for _, origin := range []string{
+			"http://localhost:1.evil.com",
+			"http://localhost:8080/",
+			"https://a.b.example.com",
+			"https://evil.com/.example.com",
+		} {
+			rec := preflight("/test/v1/foo/idVal", origin, "GET")
+			assert.Equal(t, http.StatusForbidden, rec.Code, origin)
+		}
+	})
+
+	t.Run("Preflight Bad Method", func(t *testing.T) {
+		rec := preflight("/test/v1/foo/idVal", "https://app.example.com", "DELETE")
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+		assert.Equal(t, "GET", rec.Header().Get("Allow"))
+	})
+
+	t.Run("Actual Request", func(t *testing.T) {
+		invoker.SetResponse(t, &foo_testspb.HandlerGetResponse{})
+		req := httptest.NewRequest(http.MethodGet, "/test/v1/foo/idVal", nil)
+		req.Header.Set("Origin", "https://app.example.com")
+		rec := httptest.NewRecorder()
+		rr.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "https://app.example.com", rec.Header().Get("Access-Control-Allow-Origin"))
+		assert.Equal(t, "X-Version", rec.Header().Get("Access-Control-Expose-Headers"))
+		assert.Equal(t, "Origin", rec.Header().Get("Vary"))
+	})
+}
+
+func TestCORSAllowAny(t *testing.T) {
+	services := foo_testspb.File_test_foo_v1_service_foo_p_j5s_proto.Services()
+
+	rr := NewRouter()
+	invoker := &MockInvoker{
+		Codec: codec.NewCodec(),
+	}
+	if err := rr.registerMethod(context.Background(), services.ByName("FooCommandService").Methods().ByName("PostFoo"), invoker, &auth_j5pb.MethodAuthType_Cookie{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := rr.SetCORS(CORSConfig{
+		AllowedOrigins: []string{"*", "https://app.example.com"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	preflight := func(origin string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodOptions, "/test/foo/v1/foo/c", nil)
+		req.Header.Set("Origin", origin)
+		req.Header.Set("Access-Control-Request-Method", "POST")
+		rec := httptest.NewRecorder()
+		rr.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("Any Origin", func(t *testing.T) {
+		rec := preflight("https://evil.com")
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, "https://evil.com", rec.Header().Get("Access-Control-Allow-Origin"))
+		assert.Empty(t, rec.Header().Get("Access-Control-Allow-Credentials"))
+	})
+
+	t.Run("Listed Origin", func(t *testing.T) {
+		rec := preflight("https://app.example.com")
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+		assert.Equal(t, "true", rec.Header().Get("Access-Control-Allow-Credentials"))
+	})
+}
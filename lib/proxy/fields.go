@@ -0,0 +1,105 @@
+package proxy
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/pentops/j5/lib/j5schema"
+	"github.com/pentops/log.go/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// fieldsParam is the query parameter on GET methods which selects the
+	// properties of the response, as comma separated client JSON paths.
+	fieldsParam = "fields"
+
+	// FieldMaskHeader passes the validated field mask to the method, so that
+	// it can skip loading unselected data, e.g. with j5query.Lister.ListMasked.
+	// The value is comma separated paths, see j5schema.FieldMask.String.
+	FieldMaskHeader = "x-field-mask"
+)
+
+// MaskedConn is implemented by AppConns which can encode partial responses,
+// e.g. *j5codec.Codec. Requests with a fields parameter to a connection which
+// doesn't implement it are rejected.
+type MaskedConn interface {
+	ProtoToJSONMasked(msg protoreflect.Message, mask *j5schema.FieldMask) ([]byte, error)
+}
+
+// acceptsFieldMask returns true when the fields parameter of the method
+// selects the properties of the response.
+func acceptsFieldMask(md protoreflect.MethodDescriptor) bool {
+	if md.Output().FullName() == httpBodyName {
+		return false
+	}
+	// the request's own field takes precedence
+	return md.Input().Fields().ByJSONName(fieldsParam) == nil
+}
+
+// outputSchemas builds the schemas which field masks are validated against,
+// shared by all methods of a Router. Schemas are built on the first request
+// with a fields parameter, so that methods whose output has no J5 schema can
+// still be served without masks. SchemaCache does not support concurrent
+// builds.
+type outputSchemas struct {
+	lock  sync.Mutex
+	cache *j5schema.SchemaCache
+}
+
+func (schemas *outputSchemas) forMethod(md protoreflect.MethodDescriptor) func() (*j5schema.ObjectSchema, error) {
+	return sync.OnceValues(func() (*j5schema.ObjectSchema, error) {
+		schemas.lock.Lock()
+		defer schemas.lock.Unlock()
+		schema, err := schemas.cache.ObjectSchema(md.Output())
+		if err != nil {
+			return nil, fmt.Errorf("output schema for %s: %w", md.FullName(), err)
+		}
+		return schema, nil
+	})
+}
+
+// fieldMask parses the fields parameter, which may be repeated, returning nil
+// when it is not set.
+func (mm *grpcMethod) fieldMask(r *http.Request) (*j5schema.FieldMask, error) {
+	if mm.outputSchema == nil {
+		return nil, nil
+	}
+	values, ok := r.URL.Query()[fieldsParam]
+	if !ok {
+		return nil, nil
+	}
+
+	if _, ok := mm.AppCon.(MaskedConn); !ok {
+		return nil, status.Errorf(codes.Unimplemented, "the %s parameter is not supported by %s", fieldsParam, mm.FullName)
+	}
+
+	schema, err := mm.outputSchema()
+	if err != nil {
+		log.WithError(r.Context(), err).Warn("Field masks are not supported")
+		return nil, status.Errorf(codes.Unimplemented, "the %s parameter is not supported by %s", fieldsParam, mm.FullName)
+	}
+
+	paths := []string{}
+	for _, value := range values {
+		for path := range strings.SplitSeq(value, ",") {
+			path = strings.TrimSpace(path)
+			if path != "" {
+				paths = append(paths, path)
+			}
+		}
+	}
+	if len(paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s must not be empty", fieldsParam)
+	}
+
+	mask, err := j5schema.ParseFieldMask(schema, paths...)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return mask, nil
+}
@@ -0,0 +1,143 @@
+package proxy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pentops/flowtest/prototest"
+	"github.com/pentops/j5/gen/j5/auth/v1/auth_j5pb"
+	"github.com/pentops/j5/gen/j5/state/v1/psm_j5pb"
+	"github.com/pentops/j5/internal/gen/test/foo/v1/foo_testpb"
+	"github.com/pentops/j5/internal/gen/test/foo/v1/foo_testspb"
+	codec "github.com/pentops/j5/lib/j5codec"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestFieldMask(t *testing.T) {
+	sd := foo_testspb.File_test_foo_v1_service_foo_p_j5s_proto.Services().ByName("FooQueryService")
+
+	invoker := &MockInvoker{
+		Codec: codec.NewCodec(),
+	}
+	invoker.SetResponse(t, &foo_testspb.FooGetResponse{
+		Foo: &foo_testpb.FooState{
+			Metadata: &psm_j5pb.StateMetadata{
+				LastSequence: 5,
+			},
+			Keys: &foo_testpb.FooKeys{
+				FooId: "foo-id",
+				BarId: "bar-id",
+			},
+			Data: &foo_testpb.FooData{
+				Name: "name",
+			},
+			Status: foo_testpb.FooStatus_FOO_STATUS_ACTIVE,
+		},
+	})
+
+	newRouter := func(conn AppConn) *Router {
+		rr := NewRouter()
+		rr.SetGlobalAuth(AuthHeadersFunc(func(ctx context.Context, req *http.Request) (map[string]string, error) {
+			return map[string]string{}, nil
+		}))
+		if err := rr.RegisterGRPCService(context.Background(), sd, conn); err != nil {
+			t.Fatal(err)
+		}
+		return rr
+	}
+
+	get := func(rr *Router, path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		rr.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	rr := newRouter(invoker)
+
+	t.Run("Masked", func(t *testing.T) {
+		rec := get(rr, "/test/foo/v1/foo/q/fooId?fields=foo.fooId,foo.status&fields=foo.data.name")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"foo":{"fooId":"foo-id","data":{"name":"name"},"status":"ACTIVE"}}`, rec.Body.String())
+	})
+
+	t.Run("Unmasked", func(t *testing.T) {
+		rec := get(rr, "/test/foo/v1/foo/q/fooId")
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"barId":"bar-id"`)
+	})
+
+	t.Run("Invalid Path", func(t *testing.T) {
+		rec := get(rr, "/test/foo/v1/foo/q/fooId?fields=foo.nope")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		rec := get(rr, "/test/foo/v1/foo/q/fooId?fields=")
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Unsupported Conn", func(t *testing.T) {
+		// hides ProtoToJSONMasked
+		rr := newRouter(struct{ AppConn }{invoker})
+		rec := get(rr, "/test/foo/v1/foo/q/fooId?fields=foo.status")
+		assert.Equal(t, http.StatusNotImplemented, rec.Code)
+	})
+}
+
+// protojsonConn encodes responses which have no J5 schema.
+type protojsonConn struct {
+	*uploadInvoker
+}
+
+func (pc protojsonConn) ProtoToJSON(msg protoreflect.Message) ([]byte, error) {
+	return protojson.Marshal(msg.Interface())
+}
+
+func TestFieldMaskNonJ5Output(t *testing.T) {
+	pdf := prototest.DescriptorsFromSource(t, map[string]string{
+		"test.proto": `
+			syntax = "proto3";
+
+			package test;
+
+			service LegacyService {
+				rpc GetLegacy(GetLegacyRequest) returns (GetLegacyResponse) {}
+			}
+
+			message GetLegacyRequest {}
+
+			message GetLegacyResponse {
+				map<int32, string> values = 1;
+			}
+		`,
+	})
+	sd := withHTTPRules(t, pdf.ServiceByName(t, "test.LegacyService"), map[string]*annotations.HttpRule{
+		"GetLegacy": {
+			Pattern: &annotations.HttpRule_Get{Get: "/legacy"},
+		},
+	})
+
+	rr := NewRouter()
+	invoker := protojsonConn{
+		uploadInvoker: &uploadInvoker{
+			Codec: codec.NewCodec(),
+		},
+	}
+	// the output has no J5 schema, which is only needed for field masks
+	if err := rr.registerMethod(context.Background(), sd.Methods().Get(0), invoker, &auth_j5pb.MethodAuthType_None{}); err != nil {
+		t.Fatal(err)
+	}
+
+	rec := httptest.NewRecorder()
+	rr.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/legacy", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	rec = httptest.NewRecorder()
+	rr.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/legacy?fields=values", nil))
+	assert.Equal(t, http.StatusNotImplemented, rec.Code)
+}
@@ -0,0 +1,324 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pentops/log.go/log"
+)
+
+const (
+	idempotencyKeyHeader      = "Idempotency-Key"
+	idempotentReplayedHeader  = "Idempotent-Replayed"
+	defaultIdempotencyTimeout = 24 * time.Hour
+	defaultIdempotencyLease   = time.Minute
+)
+
+// RecordedResponse is a response stored against an idempotency key.
+type RecordedResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// IdempotencyRecord is the stored state of an idempotency key.
+type IdempotencyRecord struct {
+	RequestHash string
+
+	// Response is nil while the original request is in progress.
+	Response *RecordedResponse
+}
+
+// IdempotencyStore persists responses for idempotency keys.
+type IdempotencyStore interface {
+	// Claim reserves the key for a new request, returning a claim token for
+	// Complete and Release. When the key is already held, the existing record
+	// is returned and the key is not claimed. A claim without a response
+	// expires after the store's lease, so a key is not held for the full
+	// timeout when the handler crashes.
+	Claim(ctx context.Context, key string, requestHash string) (token string, existing *IdempotencyRecord, err error)
+
+	// Complete stores the response for a claimed key. It has no effect when
+	// the claim has expired and the key was claimed again.
+	Complete(ctx context.Context, key string, token string, response *RecordedResponse) error
+
+	// Release removes a claimed key without storing a response, allowing the
+	// request to be retried. It has no effect when the claim has expired and
+	// the key was claimed again.
+	Release(ctx context.Context, key string, token string) error
+}
+
+type IdempotencyConfig struct {
+	Store IdempotencyStore
+
+	// Scope is prefixed to stored keys, and is required. Responses are
+	// replayed before the method's auth runs, so it must identify the
+	// authenticated caller, verified in the same way as AuthHeaders, so that
+	// one caller can't replay another's response. Errors are returned to the
+	// client in the same way as AuthHeaders.
+	Scope func(*http.Request) (string, error)
+}
+
+// SetIdempotency enables replay of responses for non-GET methods which are
+// called with an Idempotency-Key header.
+func (rr *Router) SetIdempotency(cfg IdempotencyConfig) error {
+	if cfg.Store == nil {
+		return errors.New("idempotency requires a Store")
+	}
+	if cfg.Scope == nil {
+		return errors.New("idempotency requires a Scope identifying the caller")
+	}
+	rr.idempotency = &cfg
+	return nil
+}
+
+// newClaimToken identifies a single claim of an idempotency key.
+func newClaimToken() string {
+	return rand.Text()
+}
+
+func (rr *Router) idempotencyHandler(method *grpcMethod, next http.Handler) http.Handler {
+	if method.HTTPMethod == http.MethodGet {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cfg := rr.idempotency
+		key := r.Header.Get(idempotencyKeyHeader)
+		if cfg == nil || key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := log.WithField(r.Context(), "idempotencyKey", key)
+
+		if method.clientStreaming {
+			// The request hash needs the whole body, which would defeat
+			// streaming the upload.
+			jsonError(w, fmt.Sprintf("%s is not supported for streamed uploads", idempotencyKeyHeader), http.StatusBadRequest)
+			return
+		}
+
+		scope, err := cfg.Scope(r)
+		if err != nil {
+			doUserError(ctx, w, err)
+			return
+		}
+		key = method.FullName + "/" + scope + "/" + key
+
+		// The body is buffered by the method anyway, it is read here with the
+		// same limit.
+		body, err := method.readBody(r)
+		if err != nil {
+			doUserError(ctx, w, err)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		requestHash := hashRequest(r, body)
+
+		token, existing, err := cfg.Store.Claim(ctx, key, requestHash)
+		if err != nil {
+			doError(ctx, w, err)
+			return
+		}
+
+		if existing != nil {
+			if existing.RequestHash != requestHash {
+				jsonError(w, "idempotency key was used for a different request", http.StatusUnprocessableEntity)
+				return
+			}
+			if existing.Response == nil {
+				jsonError(w, "a request with this idempotency key is in progress", http.StatusConflict)
+				return
+			}
+			log.Info(ctx, "Replaying idempotent response")
+			replayResponse(w, existing.Response)
+			return
+		}
+
+		rec := &responseRecorder{
+			header:     http.Header{},
+			statusCode: http.StatusOK,
+		}
+		next.ServeHTTP(rec, r)
+
+		if isReplayable(rec.statusCode) {
+			if err := cfg.Store.Complete(ctx, key, token, rec.response()); err != nil {
+				log.WithError(ctx, err).Error("Failed to store idempotent response")
+			}
+		} else {
+			if err := cfg.Store.Release(ctx, key, token); err != nil {
+				log.WithError(ctx, err).Error("Failed to release idempotency key")
+			}
+		}
+
+		rec.writeTo(w)
+	})
+}
+
+// isReplayable returns true for responses which would be the same if the
+// request were retried: success and client errors. Server errors, and client
+// errors which depend on the caller's credentials, timing or quota rather than
+// the request, are not recorded so that the request can be retried.
+func isReplayable(statusCode int) bool {
+	switch statusCode {
+	case http.StatusUnauthorized,
+		http.StatusForbidden,
+		http.StatusRequestTimeout,
+		http.StatusTooManyRequests:
+		return false
+	}
+	return (statusCode >= 200 && statusCode < 300) || (statusCode >= 400 && statusCode < 500)
+}
+
+func hashRequest(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(r.Method + " " + r.URL.RequestURI() + "\n"))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func replayResponse(w http.ResponseWriter, res *RecordedResponse) {
+	header := w.Header()
+	for key, vals := range res.Header {
+		header[key] = vals
+	}
+	header.Set(idempotentReplayedHeader, "true")
+	w.WriteHeader(res.StatusCode)
+	w.Write(res.Body) // nolint: errcheck
+}
+
+type responseRecorder struct {
+	header     http.Header
+	statusCode int
+	body       bytes.Buffer
+	wroteCode  bool
+}
+
+func (rec *responseRecorder) Header() http.Header {
+	return rec.header
+}
+
+func (rec *responseRecorder) WriteHeader(code int) {
+	if rec.wroteCode {
+		return
+	}
+	rec.wroteCode = true
+	rec.statusCode = code
+}
+
+func (rec *responseRecorder) Write(data []byte) (int, error) {
+	rec.WriteHeader(http.StatusOK)
+	return rec.body.Write(data)
+}
+
+func (rec *responseRecorder) response() *RecordedResponse {
+	return &RecordedResponse{
+		StatusCode: rec.statusCode,
+		Header:     rec.header.Clone(),
+		Body:       bytes.Clone(rec.body.Bytes()),
+	}
+}
+
+func (rec *responseRecorder) writeTo(w http.ResponseWriter) {
+	maps.Copy(w.Header(), rec.header)
+	w.WriteHeader(rec.statusCode)
+	w.Write(rec.body.Bytes()) // nolint: errcheck
+}
+
+// MemoryIdempotencyStore is an in-process IdempotencyStore, suitable for
+// tests and single instance deployments.
+type MemoryIdempotencyStore struct {
+	lock    sync.Mutex
+	records map[string]*memoryRecord
+	timeout time.Duration
+	lease   time.Duration
+	now     func() time.Time
+}
+
+type memoryRecord struct {
+	IdempotencyRecord
+	token     string
+	createdAt time.Time
+}
+
+var _ IdempotencyStore = &MemoryIdempotencyStore{}
+
+// NewMemoryIdempotencyStore creates a store which forgets keys after the
+// timeout, defaulting to 24 hours.
+func NewMemoryIdempotencyStore(timeout time.Duration) *MemoryIdempotencyStore {
+	if timeout <= 0 {
+		timeout = defaultIdempotencyTimeout
+	}
+	return &MemoryIdempotencyStore{
+		records: map[string]*memoryRecord{},
+		timeout: timeout,
+		lease:   defaultIdempotencyLease,
+		now:     time.Now,
+	}
+}
+
+// SetLease sets how long a claim without a response holds the key, defaulting
+// to one minute. It should be longer than the slowest request.
+func (ms *MemoryIdempotencyStore) SetLease(lease time.Duration) {
+	ms.lease = lease
+}
+
+func (ms *MemoryIdempotencyStore) Claim(ctx context.Context, key string, requestHash string) (string, *IdempotencyRecord, error) {
+	ms.lock.Lock()
+	defer ms.lock.Unlock()
+
+	now := ms.now()
+	for k, record := range ms.records {
+		age := now.Sub(record.createdAt)
+		if age > ms.timeout || (record.Response == nil && age > ms.lease) {
+			delete(ms.records, k)
+		}
+	}
+
+	if existing, ok := ms.records[key]; ok {
+		record := existing.IdempotencyRecord
+		return "", &record, nil
+	}
+
+	token := newClaimToken()
+	ms.records[key] = &memoryRecord{
+		IdempotencyRecord: IdempotencyRecord{
+			RequestHash: requestHash,
+		},
+		token:     token,
+		createdAt: now,
+	}
+	return token, nil, nil
+}
+
+func (ms *MemoryIdempotencyStore) Complete(ctx context.Context, key string, token string, response *RecordedResponse) error {
+	ms.lock.Lock()
+	defer ms.lock.Unlock()
+
+	if record, ok := ms.records[key]; ok && record.token == token && record.Response == nil {
+		record.Response = response
+	}
+	return nil
+}
+
+func (ms *MemoryIdempotencyStore) Release(ctx context.Context, key string, token string) error {
+	ms.lock.Lock()
+	defer ms.lock.Unlock()
+
+	if record, ok := ms.records[key]; ok && record.token == token && record.Response == nil {
+		delete(ms.records, key)
+	}
+	return nil
+}
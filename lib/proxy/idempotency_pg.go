@@ -0,0 +1,181 @@
+package proxy
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	sq "github.com/elgris/sqrl"
+	"github.com/pentops/j5/lib/j5query/pgmigrate"
+	"github.com/pentops/sqrlx.go/sqrlx"
+)
+
+// DefaultIdempotencyTable is the table name used by PostgresIdempotencyStore
+// when none is given.
+const DefaultIdempotencyTable = "proxy_idempotency"
+
+// IdempotencyTable builds the table migration for PostgresIdempotencyStore.
+func IdempotencyTable(tableName string) (*pgmigrate.Table, error) {
+	return pgmigrate.CreateTable(tableName).
+		Column("key", pgmigrate.Text, pgmigrate.PrimaryKey).
+		Column("request_hash", pgmigrate.Text, pgmigrate.NotNull).
+		Column("claim_token", pgmigrate.Text, pgmigrate.NotNull).
+		Column("created_at", pgmigrate.Timestamptz, pgmigrate.NotNull).
+		Column("status", pgmigrate.Int).
+		Column("header", pgmigrate.JSONB).
+		Column("body", pgmigrate.Bytea).
+		Build()
+}
+
+// PostgresIdempotencyStore stores idempotent responses in a table created by
+// IdempotencyTable.
+type PostgresIdempotencyStore struct {
+	db        sqrlx.Transactor
+	tableName string
+	timeout   time.Duration
+	lease     time.Duration
+}
+
+var _ IdempotencyStore = &PostgresIdempotencyStore{}
+
+// NewPostgresIdempotencyStore creates a store using the table, which defaults
+// to DefaultIdempotencyTable. Keys older than timeout, default 24 hours, are
+// treated as new.
+func NewPostgresIdempotencyStore(db sqrlx.Transactor, tableName string, timeout time.Duration) *PostgresIdempotencyStore {
+	if tableName == "" {
+		tableName = DefaultIdempotencyTable
+	}
+	if timeout <= 0 {
+		timeout = defaultIdempotencyTimeout
+	}
+	return &PostgresIdempotencyStore{
+		db:        db,
+		tableName: tableName,
+		timeout:   timeout,
+		lease:     defaultIdempotencyLease,
+	}
+}
+
+// SetLease sets how long a claim without a response holds the key, defaulting
+// to one minute. It should be longer than the slowest request.
+func (ps *PostgresIdempotencyStore) SetLease(lease time.Duration) {
+	ps.lease = lease
+}
+
+func (ps *PostgresIdempotencyStore) Claim(ctx context.Context, key string, requestHash string) (string, *IdempotencyRecord, error) {
+	var existing *IdempotencyRecord
+	token := newClaimToken()
+
+	err := ps.db.Transact(ctx, &sqrlx.TxOptions{
+		Isolation: sql.LevelReadCommitted,
+		Retryable: true,
+	}, func(ctx context.Context, tx sqrlx.Transaction) error {
+		existing = nil
+		now := time.Now()
+
+		claimed, err := tx.InsertRow(ctx, sq.Insert(ps.tableName).
+			Columns("key", "request_hash", "claim_token", "created_at").
+			Values(key, requestHash, token, now).
+			Suffix("ON CONFLICT (key) DO NOTHING"))
+		if err != nil {
+			return fmt.Errorf("claim idempotency key: %w", err)
+		}
+		if claimed {
+			return nil
+		}
+
+		var (
+			storedHash string
+			createdAt  time.Time
+			status     sql.NullInt64
+			header     []byte
+			body       []byte
+		)
+		err = tx.SelectRow(ctx, sq.Select("request_hash", "created_at", "status", "header", "body").
+			From(ps.tableName).
+			Where("key = ?", key).
+			Suffix("FOR UPDATE")).Scan(&storedHash, &createdAt, &status, &header, &body)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("idempotency key %q released during claim", key)
+		} else if err != nil {
+			return fmt.Errorf("select idempotency key: %w", err)
+		}
+
+		age := now.Sub(createdAt)
+		if age > ps.timeout || (!status.Valid && age > ps.lease) {
+			_, err := tx.Update(ctx, sq.Update(ps.tableName).
+				Set("request_hash", requestHash).
+				Set("claim_token", token).
+				Set("created_at", now).
+				Set("status", nil).
+				Set("header", nil).
+				Set("body", nil).
+				Where("key = ?", key))
+			if err != nil {
+				return fmt.Errorf("reclaim idempotency key: %w", err)
+			}
+			return nil
+		}
+
+		existing = &IdempotencyRecord{
+			RequestHash: storedHash,
+		}
+		if !status.Valid {
+			return nil
+		}
+
+		response := &RecordedResponse{
+			StatusCode: int(status.Int64),
+			Header:     http.Header{},
+			Body:       body,
+		}
+		if len(header) > 0 {
+			if err := json.Unmarshal(header, &response.Header); err != nil {
+				return fmt.Errorf("unmarshal stored header: %w", err)
+			}
+		}
+		existing.Response = response
+		return nil
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	if existing != nil {
+		return "", existing, nil
+	}
+	return token, nil, nil
+}
+
+func (ps *PostgresIdempotencyStore) Complete(ctx context.Context, key string, token string, response *RecordedResponse) error {
+	header, err := json.Marshal(response.Header)
+	if err != nil {
+		return err
+	}
+
+	return ps.db.Transact(ctx, &sqrlx.TxOptions{
+		Isolation: sql.LevelReadCommitted,
+		Retryable: true,
+	}, func(ctx context.Context, tx sqrlx.Transaction) error {
+		_, err := tx.Update(ctx, sq.Update(ps.tableName).
+			Set("status", response.StatusCode).
+			Set("header", header).
+			Set("body", response.Body).
+			Where("key = ? AND claim_token = ? AND status IS NULL", key, token))
+		return err
+	})
+}
+
+func (ps *PostgresIdempotencyStore) Release(ctx context.Context, key string, token string) error {
+	return ps.db.Transact(ctx, &sqrlx.TxOptions{
+		Isolation: sql.LevelReadCommitted,
+		Retryable: true,
+	}, func(ctx context.Context, tx sqrlx.Transaction) error {
+		_, err := tx.Delete(ctx, sq.Delete(ps.tableName).
+			Where("key = ? AND claim_token = ? AND status IS NULL", key, token))
+		return err
+	})
+}
@@ -0,0 +1,344 @@
+package proxy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pentops/j5/gen/j5/auth/v1/auth_j5pb"
+	"github.com/pentops/j5/gen/j5/schema/v1/schema_j5pb"
+	"github.com/pentops/j5/internal/gen/test/foo/v1/foo_testspb"
+	codec "github.com/pentops/j5/lib/j5codec"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type countingInvoker struct {
+	*MockInvoker
+	calls int
+	err   error
+}
+
+func (ci *countingInvoker) Invoke(ctx context.Context, method string, req, res any, opts ...grpc.CallOption) error {
+	ci.calls++
+	if ci.err != nil {
+		return ci.err
+	}
+	return ci.MockInvoker.Invoke(ctx, method, req, res, opts...)
+}
+
+// callerScope scopes idempotency keys to a test caller header, standing in
+// for a verified identity.
+func callerScope(r *http.Request) (string, error) {
+	return r.Header.Get("X-Caller"), nil
+}
+
+func TestIdempotency(t *testing.T) {
+	method := foo_testspb.File_test_foo_v1_service_foo_p_j5s_proto.
+		Services().ByName("FooCommandService").
+		Methods().ByName("PostFoo")
+
+	rr := NewRouter()
+	invoker := &countingInvoker{
+		MockInvoker: &MockInvoker{
+			Codec: codec.NewCodec(),
+		},
+	}
+	invoker.SetResponse(t, &foo_testspb.PostFooResponse{})
+
+	if err := rr.registerMethod(context.Background(), method, invoker, &auth_j5pb.MethodAuthType_None{}); err != nil {
+		t.Fatal(err)
+	}
+
+	store := NewMemoryIdempotencyStore(time.Hour)
+	if err := rr.SetIdempotency(IdempotencyConfig{
+		Store: store,
+		Scope: callerScope,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	postAs := func(caller string, key string, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/test/foo/v1/foo/c", strings.NewReader(body))
+		req.Header.Set("X-Caller", caller)
+		if key != "" {
+			req.Header.Set("Idempotency-Key", key)
+		}
+		rec := httptest.NewRecorder()
+		rr.ServeHTTP(rec, req)
+		return rec
+	}
+
+	post := func(key string, body string) *httptest.ResponseRecorder {
+		return postAs("caller1", key, body)
+	}
+
+	t.Run("No Key", func(t *testing.T) {
+		invoker.calls = 0
+		post("", `{"id":"a"}`)
+		post("", `{"id":"a"}`)
+		assert.Equal(t, 2, invoker.calls)
+	})
+
+	t.Run("Replay", func(t *testing.T) {
+		invoker.calls = 0
+		first := post("key1", `{"id":"a"}`)
+		assert.Equal(t, http.StatusOK, first.Code)
+
+		second := post("key1", `{"id":"a"}`)
+		assert.Equal(t, http.StatusOK, second.Code)
+		assert.Equal(t, first.Body.String(), second.Body.String())
+		assert.Equal(t, "true", second.Header().Get("Idempotent-Replayed"))
+		assert.Equal(t, "application/json", second.Header().Get("Content-Type"))
+		assert.Equal(t, 1, invoker.calls)
+	})
+
+	t.Run("Different Callers", func(t *testing.T) {
+		invoker.calls = 0
+		first := postAs("caller1", "shared", `{"id":"a"}`)
+		assert.Equal(t, http.StatusOK, first.Code)
+
+		second := postAs("caller2", "shared", `{"id":"a"}`)
+		assert.Equal(t, http.StatusOK, second.Code)
+		assert.Empty(t, second.Header().Get("Idempotent-Replayed"))
+		assert.Equal(t, 2, invoker.calls)
+	})
+
+	t.Run("Different Body", func(t *testing.T) {
+		rec := post("key1", `{"id":"b"}`)
+		assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	})
+
+	t.Run("In Progress", func(t *testing.T) {
+		body := `{"id":"a"}`
+		hash := hashRequest(httptest.NewRequest(http.MethodPost, "/test/foo/v1/foo/c", nil), []byte(body))
+		if _, _, err := store.Claim(context.Background(), "/test.foo.v1.service.FooCommandService/PostFoo/caller1/key2", hash); err != nil {
+			t.Fatal(err)
+		}
+		rec := post("key2", body)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	t.Run("Server Error Released", func(t *testing.T) {
+		invoker.calls = 0
+		invoker.err = status.Error(codes.Unavailable, "down")
+		rec := post("key3", `{"id":"a"}`)
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+
+		invoker.err = nil
+		rec = post("key3", `{"id":"a"}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, 2, invoker.calls)
+	})
+
+	t.Run("Rate Limited Released", func(t *testing.T) {
+		invoker.calls = 0
+		invoker.err = status.Error(codes.ResourceExhausted, "slow down")
+		rec := post("key5", `{"id":"a"}`)
+		assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+
+		invoker.err = nil
+		rec = post("key5", `{"id":"a"}`)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Empty(t, rec.Header().Get("Idempotent-Replayed"))
+		assert.Equal(t, 2, invoker.calls)
+	})
+
+	t.Run("Client Error Recorded", func(t *testing.T) {
+		invoker.calls = 0
+		invoker.err = status.Error(codes.InvalidArgument, "bad")
+		rec := post("key4", `{"id":"a"}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		invoker.err = nil
+		rec = post("key4", `{"id":"a"}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, 1, invoker.calls)
+	})
+}
+
+func TestIdempotencyRateLimited(t *testing.T) {
+	method := foo_testspb.File_test_foo_v1_service_foo_p_j5s_proto.
+		Services().ByName("FooCommandService").
+		Methods().ByName("PostFoo")
+
+	rr := NewRouter()
+	invoker := &countingInvoker{
+		MockInvoker: &MockInvoker{
+			Codec: codec.NewCodec(),
+		},
+	}
+	invoker.SetResponse(t, &foo_testspb.PostFooResponse{})
+
+	if err := rr.registerMethod(context.Background(), method, invoker, &auth_j5pb.MethodAuthType_None{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := rr.SetIdempotency(IdempotencyConfig{
+		Store: NewMemoryIdempotencyStore(time.Hour),
+		Scope: callerScope,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	rr.SetRateLimits(RateLimitConfig{
+		Default: &schema_j5pb.RateLimit{
+			RequestsPerSecond: 0.001,
+			Burst:             1,
+		},
+	})
+
+	post := func(key string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/test/foo/v1/foo/c", strings.NewReader(`{"id":"a"}`))
+		req.Header.Set("Idempotency-Key", key)
+		rec := httptest.NewRecorder()
+		rr.ServeHTTP(rec, req)
+		return rec
+	}
+
+	assert.Equal(t, http.StatusOK, post("key1").Code)
+
+	// The replay counts against the limit
+	assert.Equal(t, http.StatusTooManyRequests, post("key1").Code)
+	assert.Equal(t, http.StatusTooManyRequests, post("key2").Code)
+
+	// The rejected request did not claim its key
+	rr.rateLimits = nil
+	rec := post("key2")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get("Idempotent-Replayed"))
+	assert.Equal(t, 2, invoker.calls)
+}
+
+func TestIdempotencyBodyLimit(t *testing.T) {
+	method := foo_testspb.File_test_foo_v1_service_foo_p_j5s_proto.
+		Services().ByName("FooCommandService").
+		Methods().ByName("PostFoo")
+
+	rr := NewRouter()
+	rr.MaxUploadSize = 16
+	invoker := &countingInvoker{
+		MockInvoker: &MockInvoker{
+			Codec: codec.NewCodec(),
+		},
+	}
+	invoker.SetResponse(t, &foo_testspb.PostFooResponse{})
+
+	if err := rr.registerMethod(context.Background(), method, invoker, &auth_j5pb.MethodAuthType_None{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := rr.SetIdempotency(IdempotencyConfig{
+		Store: NewMemoryIdempotencyStore(time.Hour),
+		Scope: callerScope,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/test/foo/v1/foo/c", strings.NewReader(`{"id":"`+strings.Repeat("a", 16)+`"}`))
+	req.Header.Set("Idempotency-Key", "key1")
+	rec := httptest.NewRecorder()
+	rr.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	assert.Equal(t, 0, invoker.calls)
+}
+
+func TestIdempotencyConfig(t *testing.T) {
+	rr := NewRouter()
+	assert.Error(t, rr.SetIdempotency(IdempotencyConfig{
+		Scope: callerScope,
+	}))
+	assert.Error(t, rr.SetIdempotency(IdempotencyConfig{
+		Store: NewMemoryIdempotencyStore(time.Hour),
+	}))
+	assert.Nil(t, rr.idempotency)
+}
+
+func TestMemoryIdempotencyStoreExpiry(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryIdempotencyStore(time.Minute)
+	now := time.Now()
+	store.now = func() time.Time { return now }
+
+	token, existing, err := store.Claim(ctx, "k", "hash")
+	assert.NoError(t, err)
+	assert.Nil(t, existing)
+	assert.NotEmpty(t, token)
+
+	_, existing, err = store.Claim(ctx, "k", "hash")
+	assert.NoError(t, err)
+	if assert.NotNil(t, existing) {
+		assert.Nil(t, existing.Response)
+	}
+
+	now = now.Add(2 * time.Minute)
+	_, existing, err = store.Claim(ctx, "k", "other")
+	assert.NoError(t, err)
+	assert.Nil(t, existing)
+}
+
+func TestMemoryIdempotencyStoreLease(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryIdempotencyStore(time.Hour)
+	store.SetLease(time.Minute)
+	now := time.Now()
+	store.now = func() time.Time { return now }
+
+	_, existing, err := store.Claim(ctx, "claimed", "hash")
+	assert.NoError(t, err)
+	assert.Nil(t, existing)
+
+	token, existing, err := store.Claim(ctx, "complete", "hash")
+	assert.NoError(t, err)
+	assert.Nil(t, existing)
+	assert.NoError(t, store.Complete(ctx, "complete", token, &RecordedResponse{StatusCode: http.StatusOK}))
+
+	now = now.Add(2 * time.Minute)
+
+	// the handler never completed, the claim has expired
+	_, existing, err = store.Claim(ctx, "claimed", "hash")
+	assert.NoError(t, err)
+	assert.Nil(t, existing)
+
+	// completed responses are kept for the timeout
+	_, existing, err = store.Claim(ctx, "complete", "hash")
+	assert.NoError(t, err)
+	if assert.NotNil(t, existing) && assert.NotNil(t, existing.Response) {
+		assert.Equal(t, http.StatusOK, existing.Response.StatusCode)
+	}
+}
+
+func TestMemoryIdempotencyStoreStaleClaim(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryIdempotencyStore(time.Hour)
+	store.SetLease(time.Minute)
+	now := time.Now()
+	store.now = func() time.Time { return now }
+
+	staleToken, _, err := store.Claim(ctx, "k", "hash")
+	assert.NoError(t, err)
+
+	now = now.Add(2 * time.Minute)
+	token, existing, err := store.Claim(ctx, "k", "hash")
+	assert.NoError(t, err)
+	assert.Nil(t, existing)
+
+	// the slow original request can't release or complete the new claim
+	assert.NoError(t, store.Release(ctx, "k", staleToken))
+	assert.NoError(t, store.Complete(ctx, "k", staleToken, &RecordedResponse{StatusCode: http.StatusTeapot}))
+
+	_, existing, err = store.Claim(ctx, "k", "hash")
+	assert.NoError(t, err)
+	if assert.NotNil(t, existing) {
+		assert.Nil(t, existing.Response)
+	}
+
+	assert.NoError(t, store.Complete(ctx, "k", token, &RecordedResponse{StatusCode: http.StatusOK}))
+	_, existing, err = store.Claim(ctx, "k", "hash")
+	assert.NoError(t, err)
+	if assert.NotNil(t, existing) && assert.NotNil(t, existing.Response) {
+		assert.Equal(t, http.StatusOK, existing.Response.StatusCode)
+	}
+}
@@ -0,0 +1,188 @@
+package integration
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pentops/flowtest"
+	"github.com/pentops/j5/lib/proxy"
+	"github.com/pentops/pgtest.go/pgtest"
+	"github.com/pentops/sqrlx.go/sqrlx"
+)
+
+func setupStore(t flowtest.Asserter) (*sql.DB, *proxy.PostgresIdempotencyStore) {
+	t.Helper()
+	conn := pgtest.GetTestDB(t, pgtest.WithSchemaName("proxy_test"))
+
+	table, err := proxy.IdempotencyTable(proxy.DefaultIdempotencyTable)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	statement, err := table.ToSQL()
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if _, err := conn.Exec(statement); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	store := proxy.NewPostgresIdempotencyStore(sqrlx.NewPostgres(conn), "", time.Hour)
+	return conn, store
+}
+
+// age moves the claim of the key into the past.
+func age(t flowtest.Asserter, conn *sql.DB, key string, by time.Duration) {
+	t.Helper()
+	_, err := conn.Exec(`UPDATE proxy_idempotency SET created_at = created_at - make_interval(secs => $1) WHERE key = $2`, by.Seconds(), key)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+}
+
+func claim(ctx context.Context, t flowtest.Asserter, store *proxy.PostgresIdempotencyStore, key, hash string) *proxy.IdempotencyRecord {
+	t.Helper()
+	_, existing, err := store.Claim(ctx, key, hash)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	return existing
+}
+
+// claimNew claims a key which must not be held, returning the claim token.
+func claimNew(ctx context.Context, t flowtest.Asserter, store *proxy.PostgresIdempotencyStore, key, hash string) string {
+	t.Helper()
+	token, existing, err := store.Claim(ctx, key, hash)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	if existing != nil {
+		t.Fatalf("expected a new claim of %q, got %v", key, existing)
+	}
+	return token
+}
+
+func TestPostgresIdempotencyStore(t *testing.T) {
+	ss := flowtest.NewStepper[*testing.T](t.Name())
+	defer ss.RunSteps(t)
+
+	var conn *sql.DB
+	var store *proxy.PostgresIdempotencyStore
+	var k1Token string
+
+	ss.Setup(func(ctx context.Context, t flowtest.Asserter) error {
+		conn, store = setupStore(t)
+		return nil
+	})
+
+	ss.Step("Claim", func(ctx context.Context, t flowtest.Asserter) {
+		k1Token = claimNew(ctx, t, store, "k1", "hash1")
+
+		existing := claim(ctx, t, store, "k1", "hash1")
+		if existing == nil {
+			t.Fatal("expected the key to be held")
+		}
+		if existing.Response != nil {
+			t.Fatal("expected no response while in progress")
+		}
+	})
+
+	ss.Step("Replay", func(ctx context.Context, t flowtest.Asserter) {
+		err := store.Complete(ctx, "k1", k1Token, &proxy.RecordedResponse{
+			StatusCode: http.StatusCreated,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       []byte(`{"id":"1"}`),
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		existing := claim(ctx, t, store, "k1", "hash1")
+		if existing == nil || existing.Response == nil {
+			t.Fatal("expected a stored response")
+		}
+		t.Equal(http.StatusCreated, existing.Response.StatusCode)
+		t.Equal("application/json", existing.Response.Header.Get("Content-Type"))
+		t.Equal(`{"id":"1"}`, string(existing.Response.Body))
+	})
+
+	ss.Step("Conflict", func(ctx context.Context, t flowtest.Asserter) {
+		// The store returns the existing record, the handler compares the hash
+		existing := claim(ctx, t, store, "k1", "hash2")
+		if existing == nil {
+			t.Fatal("expected the key to be held")
+		}
+		t.Equal("hash1", existing.RequestHash)
+	})
+
+	ss.Step("Release", func(ctx context.Context, t flowtest.Asserter) {
+		k2Token := claimNew(ctx, t, store, "k2", "hash1")
+		if err := store.Release(ctx, "k2", k2Token); err != nil {
+			t.Fatal(err.Error())
+		}
+		if existing := claim(ctx, t, store, "k2", "hash1"); existing != nil {
+			t.Fatalf("expected the released key to be claimed, got %v", existing)
+		}
+
+		// completed keys are not released
+		if err := store.Release(ctx, "k1", k1Token); err != nil {
+			t.Fatal(err.Error())
+		}
+		if existing := claim(ctx, t, store, "k1", "hash1"); existing == nil || existing.Response == nil {
+			t.Fatal("expected the completed key to be kept")
+		}
+	})
+
+	ss.Step("Expiry", func(ctx context.Context, t flowtest.Asserter) {
+		age(t, conn, "k1", 2*time.Hour)
+		claimNew(ctx, t, store, "k1", "hash2")
+		existing := claim(ctx, t, store, "k1", "hash1")
+		if existing == nil {
+			t.Fatal("expected the key to be held")
+		}
+		t.Equal("hash2", existing.RequestHash)
+		if existing.Response != nil {
+			t.Fatal("expected the old response to be cleared")
+		}
+	})
+
+	ss.Step("Lease", func(ctx context.Context, t flowtest.Asserter) {
+		staleToken := claimNew(ctx, t, store, "k3", "hash1")
+		age(t, conn, "k3", 2*time.Minute)
+
+		// the handler never completed, the claim has expired
+		token := claimNew(ctx, t, store, "k3", "hash1")
+
+		// the slow original request can't release or complete the new claim
+		if err := store.Release(ctx, "k3", staleToken); err != nil {
+			t.Fatal(err.Error())
+		}
+		err := store.Complete(ctx, "k3", staleToken, &proxy.RecordedResponse{
+			StatusCode: http.StatusTeapot,
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		existing := claim(ctx, t, store, "k3", "hash1")
+		if existing == nil {
+			t.Fatal("expected the new claim to be held")
+		}
+		if existing.Response != nil {
+			t.Fatal("expected no response from the stale claim")
+		}
+
+		err = store.Complete(ctx, "k3", token, &proxy.RecordedResponse{
+			StatusCode: http.StatusOK,
+		})
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+		existing = claim(ctx, t, store, "k3", "hash1")
+		if existing == nil || existing.Response == nil {
+			t.Fatal("expected a stored response")
+		}
+		t.Equal(http.StatusOK, existing.Response.StatusCode)
+	})
+}
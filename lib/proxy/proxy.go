@@ -3,9 +3,10 @@ package proxy
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
 	"maps"
+	"mime"
 	"net/http"
 	"net/url"
 	"strings"
@@ -13,7 +14,9 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/pentops/j5/gen/j5/auth/v1/auth_j5pb"
 	"github.com/pentops/j5/gen/j5/ext/v1/ext_j5pb"
+	"github.com/pentops/j5/gen/j5/schema/v1/schema_j5pb"
 	"github.com/pentops/j5/internal/protosrc"
+	"github.com/pentops/j5/lib/j5schema"
 	"github.com/pentops/log.go/log"
 	"google.golang.org/genproto/googleapis/api/annotations"
 	"google.golang.org/genproto/googleapis/api/httpbody"
@@ -56,7 +59,23 @@ type Router struct {
 	ForwardRequestHeaders  map[string]bool
 	globalAuth             AuthHeaders
 
+	// MaxUploadSize limits the size of request bodies, including uploads
+	// streamed to client streaming methods. Larger requests are rejected with
+	// 413. Defaults to 32MB, zero does not limit the size.
+	MaxUploadSize int64
+
+	// UploadChunkSize is the size of each message sent to client streaming
+	// upload methods.
+	UploadChunkSize int64
+
 	middleware []func(http.Handler) http.Handler
+
+	outputSchemas *outputSchemas
+
+	cors        *corsPolicy
+	idempotency *IdempotencyConfig
+	rateLimits  *rateLimiter
+	routes      map[string]*routeMethods
 }
 
 func jsonError(w http.ResponseWriter, message string, code int) {
@@ -93,6 +112,11 @@ func NewRouter() *Router {
 			"cookie":          true,
 			"origin":          true,
 		},
+		MaxUploadSize:   defaultMaxUploadSize,
+		UploadChunkSize: defaultUploadChunkSize,
+		outputSchemas: &outputSchemas{
+			cache: j5schema.NewSchemaCache(),
+		},
 	}
 }
 
@@ -177,13 +201,15 @@ func (rr *Router) RegisterGRPCService(ctx context.Context, sd protoreflect.Servi
 func (rr *Router) buildMethod(md protoreflect.MethodDescriptor, conn AppConn, auth auth_j5pb.IsMethodAuthTypeWrappedType) (*grpcMethod, error) {
 	methodOptions := md.Options().(*descriptorpb.MethodOptions)
 
-	if j5Method := protosrc.GetExtension[*ext_j5pb.MethodOptions](methodOptions, ext_j5pb.E_Method); j5Method != nil {
+	j5Method := protosrc.GetExtension[*ext_j5pb.MethodOptions](methodOptions, ext_j5pb.E_Method)
+	if j5Method != nil {
 		if j5Method.Auth != nil {
 			auth = j5Method.Auth.Get()
 		}
 	}
 
-	serviceName := md.Parent().(protoreflect.ServiceDescriptor).FullName()
+	sd := md.Parent().(protoreflect.ServiceDescriptor)
+	serviceName := sd.FullName()
 	httpOpt := protosrc.GetExtension[*annotations.HttpRule](methodOptions, annotations.E_Http)
 
 	var httpMethod string
@@ -221,6 +247,35 @@ func (rr *Router) buildMethod(md protoreflect.MethodDescriptor, conn AppConn, au
 		ForwardResponseHeaders: maps.Clone(rr.ForwardResponseHeaders),
 		ForwardRequestHeaders:  rr.ForwardRequestHeaders,
 		authHeaders:            nil, // Set in a bit
+		authType:               auth.MethodAuthTypeKey(),
+		MaxUploadSize:          rr.MaxUploadSize,
+		UploadChunkSize:        rr.UploadChunkSize,
+		clientStreaming:        md.IsStreamingClient(),
+		serviceName:            string(serviceName),
+		rateLimit:              j5Method.GetRateLimit(),
+	}
+
+	serviceExt := protosrc.GetExtension[*ext_j5pb.ServiceOptions](sd.Options(), ext_j5pb.E_Service)
+	handler.serviceRateLimit = serviceExt.GetDefaultRateLimit()
+
+	upload, err := buildUploadTarget(md.Input(), httpOpt.Body)
+	if err != nil {
+		return nil, err
+	}
+	handler.upload = upload
+	if handler.clientStreaming && upload == nil {
+		return nil, fmt.Errorf("client streaming method %s must upload to an HttpBody or bytes body field", md.FullName())
+	}
+	if md.IsStreamingServer() {
+		return nil, fmt.Errorf("server streaming method %s is not supported", md.FullName())
+	}
+
+	if httpMethod == http.MethodGet {
+		handler.cacheControl = buildCacheControl(j5Method.GetCacheControl())
+		handler.stateMetadata = stateMetadataPath(md.Output())
+		if acceptsFieldMask(md) {
+			handler.outputSchema = rr.outputSchemas.forMethod(md)
+		}
 	}
 
 	switch authType := auth.(type) {
@@ -258,7 +313,7 @@ func (rr *Router) registerMethod(ctx context.Context, md protoreflect.MethodDesc
 		return err
 	}
 
-	rr.router.Methods(handler.HTTPMethod).Path(handler.HTTPPath).Handler(handler)
+	rr.addRoute(handler)
 	log.WithFields(ctx, map[string]any{
 		"method":     handler.HTTPMethod,
 		"path":       handler.HTTPPath,
@@ -269,6 +324,51 @@ func (rr *Router) registerMethod(ctx context.Context, md protoreflect.MethodDesc
 
 }
 
+func (rr *Router) addRoute(handler *grpcMethod) {
+	if rr.routes == nil {
+		rr.routes = map[string]*routeMethods{}
+	}
+	route, ok := rr.routes[handler.HTTPPath]
+	if !ok {
+		route = &routeMethods{
+			router:  rr,
+			path:    handler.HTTPPath,
+			methods: map[string]*grpcMethod{},
+		}
+		rr.routes[handler.HTTPPath] = route
+		rr.router.Methods(http.MethodOptions).Path(handler.HTTPPath).Handler(route)
+	}
+	route.methods[handler.HTTPMethod] = handler
+
+	rr.router.Methods(handler.HTTPMethod).Path(handler.HTTPPath).Handler(rr.routeHandler(handler))
+}
+
+// routeLayer wraps a method's handler. Layers read the Router's config on each
+// request, so that config set after the method is registered still applies.
+type routeLayer func(method *grpcMethod, next http.Handler) http.Handler
+
+// routeHandler wraps the method in the layers which apply to every route, the
+// first layer is the outermost.
+func (rr *Router) routeHandler(method *grpcMethod) http.Handler {
+	layers := []routeLayer{
+		// CORS headers are set on every response, including those written by
+		// the other layers.
+		rr.corsHandler,
+
+		// Replayed responses count against the limit, and a request rejected
+		// by the limit does not claim its idempotency key.
+		rr.rateLimitHandler,
+
+		rr.idempotencyHandler,
+	}
+
+	var handler http.Handler = method
+	for idx := len(layers) - 1; idx >= 0; idx-- {
+		handler = layers[idx](method, handler)
+	}
+	return handler
+}
+
 type grpcMethod struct {
 	FullName               string
 	Input                  protoreflect.MessageDescriptor
@@ -280,31 +380,89 @@ type grpcMethod struct {
 	ForwardRequestHeaders  map[string]bool
 	authHeaders            AuthHeaders
 	authMethodName         string
+	authType               auth_j5pb.MethodAuthTypeKey
+	MaxUploadSize          int64
+	UploadChunkSize        int64
+
+	upload          *uploadTarget
+	clientStreaming bool
+
+	// from annotations, see RateLimitConfig
+	serviceName      string
+	rateLimit        *schema_j5pb.RateLimit
+	serviceRateLimit *schema_j5pb.RateLimit
+
+	// GET methods only
+	cacheControl  string
+	stateMetadata []protoreflect.FieldDescriptor
+	outputSchema  func() (*j5schema.ObjectSchema, error) // nil when fields masks are not accepted
 }
 
-func (mm *grpcMethod) mapRequest(r *http.Request) (protoreflect.Message, error) {
+// mapRequest builds the request message from the path, query and body. For
+// client streaming methods the body is not read, the upload to stream is
+// returned.
+func (mm *grpcMethod) mapRequest(r *http.Request) (protoreflect.Message, *streamedUpload, error) {
 	inputMessage := dynamicpb.NewMessage(mm.Input)
-	reqBody, err := io.ReadAll(r.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	if len(reqBody) > 0 {
-		if err := mm.AppCon.JSONToProto(reqBody, inputMessage); err != nil {
-			return nil, status.Error(codes.InvalidArgument, err.Error())
-		}
-	}
 
 	query := r.URL.Query()
 	reqVars := mux.Vars(r)
 	for key, provided := range reqVars {
 		query.Set(key, provided)
 	}
+
+	contentType := r.Header.Get("Content-Type")
+	mediaType, params, _ := mime.ParseMediaType(contentType)
+
+	var upload *streamedUpload
+	switch {
+	case mm.upload != nil && mediaType == "multipart/form-data":
+		var err error
+		upload, err = mm.mapMultipart(r, params["boundary"], inputMessage, query)
+		if err != nil {
+			return nil, nil, err
+		}
+
+	case mm.clientStreaming:
+		upload = &streamedUpload{
+			contentType: contentType,
+			body:        mm.requestBody(r),
+		}
+
+	case mm.upload != nil && mm.upload.isRawUpload(mediaType):
+		reqBody, err := mm.readBody(r)
+		if err != nil {
+			return nil, nil, err
+		}
+		mm.upload.set(inputMessage, contentType, reqBody)
+
+	default:
+		reqBody, err := mm.readBody(r)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if len(reqBody) > 0 {
+			if err := mm.AppCon.JSONToProto(reqBody, inputMessage); err != nil {
+				return nil, nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+		}
+	}
+
+	if mm.Input.FullName() == httpBodyName {
+		// HttpBody has no fields to map from path or query parameters
+		return inputMessage, upload, nil
+	}
+
+	if mm.outputSchema != nil {
+		// mapped to the response, see fieldMask
+		query.Del(fieldsParam)
+	}
+
 	if err := mm.AppCon.QueryToProto(query, inputMessage); err != nil {
-		return nil, status.Error(codes.InvalidArgument, err.Error())
+		return nil, nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	return inputMessage, nil
+	return inputMessage, upload, nil
 }
 
 func (mm *grpcMethod) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -315,7 +473,13 @@ func (mm *grpcMethod) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		"gRPCMethod": mm.FullName,
 	})
 
-	inputMessage, err := mm.mapRequest(r)
+	inputMessage, upload, err := mm.mapRequest(r)
+	if err != nil {
+		doUserError(ctx, w, err)
+		return
+	}
+
+	fieldMask, err := mm.fieldMask(r)
 	if err != nil {
 		doUserError(ctx, w, err)
 		return
@@ -340,6 +504,10 @@ func (mm *grpcMethod) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 		md[key] = v[0] // only one value in gRPC
 	}
+	delete(md, FieldMaskHeader) // only from the fields parameter
+	if fieldMask != nil {
+		md[FieldMaskHeader] = fieldMask.String()
+	}
 	ctx = log.WithField(ctx, "passthroughHeaders", md)
 
 	if mm.authHeaders != nil {
@@ -358,7 +526,16 @@ func (mm *grpcMethod) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// Receive response header
 	var responseHeader metadata.MD
 
-	err = mm.AppCon.Invoke(ctx, mm.FullName, inputMessage, outputMessage, grpc.Header(&responseHeader))
+	if mm.clientStreaming {
+		streamConn, ok := mm.AppCon.(StreamConn)
+		if !ok {
+			doError(ctx, w, fmt.Errorf("connection for %s does not support client streaming", mm.FullName))
+			return
+		}
+		err = mm.invokeStreamingUpload(ctx, streamConn, upload, inputMessage, outputMessage, &responseHeader)
+	} else {
+		err = mm.AppCon.Invoke(ctx, mm.FullName, inputMessage, outputMessage, grpc.Header(&responseHeader))
+	}
 	if err != nil {
 		doUserError(ctx, w, err)
 		return
@@ -367,12 +544,17 @@ func (mm *grpcMethod) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	headerOut := w.Header()
 
 	var bytesOut []byte
+	var outputReflect protoreflect.Message
 
 	if httpBodyOutput != nil {
 		headerOut.Set("Content-Type", httpBodyOutput.ContentType)
 		bytesOut = httpBodyOutput.Data
 	} else {
-		bytesOut, err = mm.AppCon.ProtoToJSON(dynamicOutput)
+		if fieldMask != nil {
+			bytesOut, err = mm.AppCon.(MaskedConn).ProtoToJSONMasked(dynamicOutput, fieldMask)
+		} else {
+			bytesOut, err = mm.AppCon.ProtoToJSON(dynamicOutput)
+		}
 		if err != nil {
 			log.WithError(ctx, err).Error("Failed to marshal response")
 			doError(ctx, w, err)
@@ -380,6 +562,7 @@ func (mm *grpcMethod) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 
 		headerOut.Set("Content-Type", "application/json")
+		outputReflect = dynamicOutput
 	}
 
 	for key, vals := range responseHeader {
@@ -392,6 +575,18 @@ func (mm *grpcMethod) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	if mm.HTTPMethod == http.MethodGet {
+		etag := mm.responseETag(outputReflect, bytesOut)
+		headerOut.Set("ETag", etag)
+		if mm.cacheControl != "" {
+			headerOut.Set("Cache-Control", mm.cacheControl)
+		}
+		if writeNotModified(w, r, etag) {
+			log.Info(ctx, "Request completed, not modified")
+			return
+		}
+	}
+
 	w.WriteHeader(http.StatusOK)
 	if _, err := w.Write(bytesOut); err != nil {
 		log.WithError(ctx, err).Error("Failed to write response")
@@ -400,7 +595,24 @@ func (mm *grpcMethod) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	log.Info(ctx, "Request completed")
 }
 
+// httpError is a client error which has no gRPC status equivalent.
+type httpError struct {
+	code    int
+	message string
+}
+
+func (he *httpError) Error() string {
+	return he.message
+}
+
 func doUserError(ctx context.Context, w http.ResponseWriter, err error) {
+	var httpErr *httpError
+	if errors.As(err, &httpErr) {
+		log.WithField(ctx, "httpError", httpErr.message).Info("User error")
+		jsonError(w, httpErr.message, httpErr.code)
+		return
+	}
+
 	// TODO: Handle specific gRPC trailer type errors
 	if statusError, isStatusError := status.FromError(err); isStatusError {
 		log.WithField(ctx, "httpError", statusError).Info("User error")
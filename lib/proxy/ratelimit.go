@@ -0,0 +1,236 @@
+package proxy
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pentops/j5/gen/j5/schema/v1/schema_j5pb"
+	"github.com/pentops/log.go/log"
+)
+
+const rateLimitSweepInterval = time.Minute
+
+// RateLimitKeyFunc returns the key which requests are counted against, e.g. the
+// caller's IP address. Limits run before the method's auth, so a key taken
+// from a credential, such as a token's subject, must verify it first or
+// callers can pick their own limit. Errors are returned to the client in the
+// same way as AuthHeaders.
+type RateLimitKeyFunc func(*http.Request) (string, error)
+
+// RateLimitByIP counts requests by the remote address of the connection. When
+// running behind a load balancer, use RateLimitByHeader with the header it sets.
+func RateLimitByIP(r *http.Request) (string, error) {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr, nil
+	}
+	return host, nil
+}
+
+// RateLimitByHeader counts requests by the value of a header, e.g. a tenant ID.
+// Requests without the header are counted by IP address, as RateLimitByIP,
+// rather than sharing a single limit.
+func RateLimitByHeader(name string) RateLimitKeyFunc {
+	return func(r *http.Request) (string, error) {
+		if value := r.Header.Get(name); value != "" {
+			return "header:" + value, nil
+		}
+		return RateLimitByIP(r)
+	}
+}
+
+type RateLimitConfig struct {
+	// Key selects the caller each limit applies to, defaulting to
+	// RateLimitByIP.
+	Key RateLimitKeyFunc
+
+	// Default applies to methods without a limit in their annotations or in
+	// Overrides.
+	Default *schema_j5pb.RateLimit
+
+	// Overrides replace the annotated limits, keyed by the gRPC method
+	// ('/foo.v1.FooService/GetFoo') or service ('foo.v1.FooService') name.
+	// The most specific limit applies: a method's override, then its
+	// annotation, then the service's override, then its annotation. An empty
+	// limit removes the limit.
+	Overrides map[string]*schema_j5pb.RateLimit
+}
+
+type rateLimiter struct {
+	RateLimitConfig
+
+	lock    sync.Mutex
+	methods map[string]*methodLimiter
+}
+
+// SetRateLimits enables rate and concurrency limits for all registered gRPC
+// methods, including those registered before the call. Requests over the limit
+// are rejected with 429 Too Many Requests.
+func (rr *Router) SetRateLimits(cfg RateLimitConfig) {
+	if cfg.Key == nil {
+		cfg.Key = RateLimitByIP
+	}
+	rr.rateLimits = &rateLimiter{
+		RateLimitConfig: cfg,
+		methods:         map[string]*methodLimiter{},
+	}
+}
+
+func (rl *rateLimiter) limiterFor(method *grpcMethod) *methodLimiter {
+	rl.lock.Lock()
+	defer rl.lock.Unlock()
+
+	if limiter, ok := rl.methods[method.FullName]; ok {
+		return limiter
+	}
+
+	// least specific first
+	limit := rl.Default
+	for _, candidate := range []*schema_j5pb.RateLimit{
+		method.serviceRateLimit,
+		rl.Overrides[method.serviceName],
+		method.rateLimit,
+		rl.Overrides[method.FullName],
+	} {
+		if candidate != nil {
+			limit = candidate
+		}
+	}
+
+	var limiter *methodLimiter
+	if limit != nil && (limit.RequestsPerSecond > 0 || limit.MaxConcurrent > 0) {
+		limiter = newMethodLimiter(limit)
+	}
+	rl.methods[method.FullName] = limiter
+	return limiter
+}
+
+func (rr *Router) rateLimitHandler(method *grpcMethod, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rl := rr.rateLimits
+		if rl == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		limiter := rl.limiterFor(method)
+		if limiter == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		key, err := rl.Key(r)
+		if err != nil {
+			doUserError(r.Context(), w, err)
+			return
+		}
+
+		release, retryAfter, ok := limiter.acquire(key, time.Now())
+		if !ok {
+			log.WithField(r.Context(), "rateLimitKey", key).Info("Rate limit exceeded")
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+			jsonError(w, fmt.Sprintf("rate limit exceeded for %s", method.FullName), http.StatusTooManyRequests)
+			return
+		}
+		defer release()
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// methodLimiter holds a token bucket and a count of in-progress requests for
+// each key.
+type methodLimiter struct {
+	rate          float64
+	burst         float64
+	maxConcurrent int
+
+	lock      sync.Mutex
+	buckets   map[string]*rateBucket
+	lastSweep time.Time
+}
+
+type rateBucket struct {
+	tokens float64
+	last   time.Time
+	active int
+}
+
+func newMethodLimiter(limit *schema_j5pb.RateLimit) *methodLimiter {
+	burst := float64(limit.Burst)
+	if burst <= 0 {
+		burst = math.Max(1, math.Ceil(limit.RequestsPerSecond))
+	}
+	return &methodLimiter{
+		rate:          limit.RequestsPerSecond,
+		burst:         burst,
+		maxConcurrent: int(limit.MaxConcurrent),
+		buckets:       map[string]*rateBucket{},
+	}
+}
+
+// acquire takes a token for the key. When the limit is exceeded it returns
+// false, and how long the caller should wait before retrying.
+func (ml *methodLimiter) acquire(key string, now time.Time) (func(), time.Duration, bool) {
+	ml.lock.Lock()
+	defer ml.lock.Unlock()
+
+	if now.Sub(ml.lastSweep) > rateLimitSweepInterval {
+		ml.sweep(now)
+	}
+
+	bucket, ok := ml.buckets[key]
+	if !ok {
+		bucket = &rateBucket{
+			tokens: ml.burst,
+			last:   now,
+		}
+		ml.buckets[key] = bucket
+	}
+	ml.refill(bucket, now)
+
+	if ml.maxConcurrent > 0 && bucket.active >= ml.maxConcurrent {
+		return nil, time.Second, false
+	}
+
+	if ml.rate > 0 {
+		if bucket.tokens < 1 {
+			wait := time.Duration((1 - bucket.tokens) / ml.rate * float64(time.Second))
+			return nil, wait, false
+		}
+		bucket.tokens--
+	}
+
+	bucket.active++
+	return func() {
+		ml.lock.Lock()
+		defer ml.lock.Unlock()
+		bucket.active--
+	}, 0, true
+}
+
+func (ml *methodLimiter) refill(bucket *rateBucket, now time.Time) {
+	if elapsed := now.Sub(bucket.last); elapsed > 0 {
+		bucket.tokens = math.Min(ml.burst, bucket.tokens+elapsed.Seconds()*ml.rate)
+		bucket.last = now
+	}
+}
+
+// sweep removes buckets which are idle and full, as they are equivalent to a
+// new bucket.
+func (ml *methodLimiter) sweep(now time.Time) {
+	ml.lastSweep = now
+	for key, bucket := range ml.buckets {
+		if bucket.active > 0 {
+			continue
+		}
+		ml.refill(bucket, now)
+		if ml.rate <= 0 || bucket.tokens >= ml.burst {
+			delete(ml.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package proxy
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pentops/j5/gen/j5/auth/v1/auth_j5pb"
+	"github.com/pentops/j5/gen/j5/schema/v1/schema_j5pb"
+	"github.com/pentops/j5/internal/gen/test/foo/v1/foo_testspb"
+	codec "github.com/pentops/j5/lib/j5codec"
+	"github.com/stretchr/testify/assert"
+	"github.com/tidwall/gjson"
+)
+
+func TestRateLimit(t *testing.T) {
+	method := foo_testspb.File_test_foo_v1_service_foo_p_j5s_proto.
+		Services().ByName("FooCommandService").
+		Methods().ByName("PostFoo")
+
+	rr := NewRouter()
+	invoker := &MockInvoker{
+		Codec: codec.NewCodec(),
+	}
+	invoker.SetResponse(t, &foo_testspb.PostFooResponse{})
+
+	if err := rr.registerMethod(context.Background(), method, invoker, &auth_j5pb.MethodAuthType_None{}); err != nil {
+		t.Fatal(err)
+	}
+
+	rr.SetRateLimits(RateLimitConfig{
+		Key: RateLimitByHeader("X-Tenant"),
+		Overrides: map[string]*schema_j5pb.RateLimit{
+			"/test.foo.v1.service.FooCommandService/PostFoo": {
+				RequestsPerSecond: 0.5,
+				Burst:             2,
+			},
+		},
+	})
+
+	post := func(tenant string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/test/foo/v1/foo/c", strings.NewReader(`{"id":"a"}`))
+		req.Header.Set("X-Tenant", tenant)
+		rec := httptest.NewRecorder()
+		rr.ServeHTTP(rec, req)
+		return rec
+	}
+
+	assert.Equal(t, http.StatusOK, post("a").Code)
+	assert.Equal(t, http.StatusOK, post("a").Code)
+
+	rec := post("a")
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "2", rec.Header().Get("Retry-After"))
+	assert.Contains(t, gjson.Get(rec.Body.String(), "error").String(), "rate limit exceeded")
+
+	// Keys are limited independently
+	assert.Equal(t, http.StatusOK, post("b").Code)
+}
+
+func TestMethodLimiter(t *testing.T) {
+	now := time.Now()
+
+	t.Run("Refill", func(t *testing.T) {
+		ml := newMethodLimiter(&schema_j5pb.RateLimit{
+			RequestsPerSecond: 2,
+		})
+		for range 2 {
+			release, _, ok := ml.acquire("k", now)
+			assert.True(t, ok)
+			release()
+		}
+
+		_, retryAfter, ok := ml.acquire("k", now)
+		assert.False(t, ok)
+		assert.Equal(t, 500*time.Millisecond, retryAfter)
+
+		_, _, ok = ml.acquire("k", now.Add(500*time.Millisecond))
+		assert.True(t, ok)
+	})
+
+	t.Run("Concurrency", func(t *testing.T) {
+		ml := newMethodLimiter(&schema_j5pb.RateLimit{
+			MaxConcurrent: 1,
+		})
+		release, _, ok := ml.acquire("k", now)
+		assert.True(t, ok)
+
+		_, _, ok = ml.acquire("k", now)
+		assert.False(t, ok)
+
+		release()
+		_, _, ok = ml.acquire("k", now)
+		assert.True(t, ok)
+	})
+
+	t.Run("Sweep", func(t *testing.T) {
+		ml := newMethodLimiter(&schema_j5pb.RateLimit{
+			RequestsPerSecond: 1,
+		})
+		release, _, _ := ml.acquire("a", now)
+		release()
+		held, _, _ := ml.acquire("b", now)
+
+		_, _, _ = ml.acquire("c", now.Add(2*rateLimitSweepInterval))
+		assert.NotContains(t, ml.buckets, "a")
+		assert.Contains(t, ml.buckets, "b")
+		held()
+	})
+}
+
+func TestRateLimitPrecedence(t *testing.T) {
+	serviceAnnotation := &schema_j5pb.RateLimit{RequestsPerSecond: 1}
+	serviceOverride := &schema_j5pb.RateLimit{RequestsPerSecond: 2}
+	methodAnnotation := &schema_j5pb.RateLimit{RequestsPerSecond: 3}
+	methodOverride := &schema_j5pb.RateLimit{RequestsPerSecond: 4}
+
+	rr := NewRouter()
+	rr.SetRateLimits(RateLimitConfig{
+		Default: &schema_j5pb.RateLimit{RequestsPerSecond: 5},
+		Overrides: map[string]*schema_j5pb.RateLimit{
+			"foo.v1.FooService":        serviceOverride,
+			"/foo.v1.FooService/Get":   methodOverride,
+			"/foo.v1.BarService/Unset": {},
+		},
+	})
+
+	rate := func(method *grpcMethod) float64 {
+		limiter := rr.rateLimits.limiterFor(method)
+		if limiter == nil {
+			return 0
+		}
+		return limiter.rate
+	}
+
+	foo := func(name string, method *schema_j5pb.RateLimit) *grpcMethod {
+		return &grpcMethod{
+			FullName:         "/foo.v1.FooService/" + name,
+			serviceName:      "foo.v1.FooService",
+			rateLimit:        method,
+			serviceRateLimit: serviceAnnotation,
+		}
+	}
+	bar := func(name string, method *schema_j5pb.RateLimit) *grpcMethod {
+		return &grpcMethod{
+			FullName:    "/foo.v1.BarService/" + name,
+			serviceName: "foo.v1.BarService",
+			rateLimit:   method,
+		}
+	}
+
+	assert.Equal(t, 4.0, rate(foo("Get", methodAnnotation)))
+	assert.Equal(t, 3.0, rate(foo("List", methodAnnotation)))
+	assert.Equal(t, 2.0, rate(foo("Other", nil)))
+	assert.Equal(t, 1.0, rate(&grpcMethod{FullName: "/foo.v1.BazService/Get", serviceName: "foo.v1.BazService", serviceRateLimit: serviceAnnotation}))
+	assert.Equal(t, 3.0, rate(bar("Get", methodAnnotation)))
+	assert.Equal(t, 5.0, rate(bar("List", nil)))
+	assert.Equal(t, 0.0, rate(bar("Unset", methodAnnotation)))
+}
+
+func TestRateLimitByHeader(t *testing.T) {
+	keyFunc := RateLimitByHeader("X-Tenant")
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = "10.0.0.1:1234"
+	req.Header.Set("X-Tenant", "t1")
+	key, err := keyFunc(req)
+	assert.NoError(t, err)
+	assert.Equal(t, "header:t1", key)
+
+	req.Header.Del("X-Tenant")
+	key, err = keyFunc(req)
+	assert.NoError(t, err)
+	assert.Equal(t, "10.0.0.1", key)
+}
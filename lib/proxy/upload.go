@@ -0,0 +1,303 @@
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const (
+	httpBodyName protoreflect.FullName = "google.api.HttpBody"
+
+	defaultMaxUploadSize   = 32 << 20
+	defaultUploadChunkSize = 1 << 20
+)
+
+// StreamConn is implemented by AppConns which can call client streaming
+// methods, e.g. *grpc.ClientConn. Uploads to client streaming methods are sent
+// in chunks rather than buffered.
+//
+// Whether an upload is streamed depends on the method, not on the size of the
+// body: a unary method takes the request as a single message, so the body is
+// buffered, up to MaxUploadSize, and a client streaming method is always sent
+// chunks, however small. There is no size threshold above which a unary upload
+// is spilled to disk or streamed. Methods which accept large files should be
+// client streaming.
+type StreamConn interface {
+	NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error)
+}
+
+// uploadTarget is where a raw or multipart file body is placed in the request
+// message: either the request is itself an HttpBody, or the 'body' of the HTTP
+// rule names a bytes or HttpBody field.
+type uploadTarget struct {
+	field protoreflect.FieldDescriptor // nil when the input is an HttpBody
+}
+
+func buildUploadTarget(input protoreflect.MessageDescriptor, bodyField string) (*uploadTarget, error) {
+	if input.FullName() == httpBodyName {
+		return &uploadTarget{}, nil
+	}
+	if bodyField == "" || bodyField == "*" {
+		return nil, nil
+	}
+
+	field := input.Fields().ByName(protoreflect.Name(bodyField))
+	if field == nil {
+		return nil, fmt.Errorf("http body field %q not found in %s", bodyField, input.FullName())
+	}
+	if field.IsList() || field.IsMap() {
+		return nil, fmt.Errorf("http body field %q must not be repeated", bodyField)
+	}
+	switch field.Kind() {
+	case protoreflect.BytesKind:
+	case protoreflect.MessageKind:
+		if field.Message().FullName() != httpBodyName {
+			return nil, fmt.Errorf("http body field %q must be bytes or %s", bodyField, httpBodyName)
+		}
+	default:
+		return nil, fmt.Errorf("http body field %q must be bytes or %s", bodyField, httpBodyName)
+	}
+	return &uploadTarget{field: field}, nil
+}
+
+func (ut *uploadTarget) set(msg protoreflect.Message, contentType string, data []byte) {
+	if ut.field != nil && ut.field.Kind() == protoreflect.BytesKind {
+		msg.Set(ut.field, protoreflect.ValueOfBytes(data))
+		return
+	}
+
+	body := msg
+	if ut.field != nil {
+		body = msg.Mutable(ut.field).Message()
+	}
+	fields := body.Descriptor().Fields()
+	body.Set(fields.ByName("content_type"), protoreflect.ValueOfString(contentType))
+	body.Set(fields.ByName("data"), protoreflect.ValueOfBytes(data))
+}
+
+// isRawUpload returns true when the request body should be placed in the
+// upload target as-is rather than decoded as JSON.
+func (ut *uploadTarget) isRawUpload(mediaType string) bool {
+	if ut.field == nil {
+		return true
+	}
+	return mediaType != "" && mediaType != "application/json"
+}
+
+// isFilePart returns true when the multipart part is the file to upload,
+// rather than a field.
+func (ut *uploadTarget) isFilePart(part *multipart.Part) bool {
+	if part.FileName() != "" {
+		return true
+	}
+	return ut.field != nil && string(ut.field.Name()) == part.FormName()
+}
+
+// requestBody limits the request body to MaxUploadSize, when set.
+func (mm *grpcMethod) requestBody(r *http.Request) io.Reader {
+	if mm.MaxUploadSize <= 0 {
+		return r.Body
+	}
+	return http.MaxBytesReader(nil, r.Body, mm.MaxUploadSize)
+}
+
+func (mm *grpcMethod) readBody(r *http.Request) ([]byte, error) {
+	body, err := io.ReadAll(mm.requestBody(r))
+	if err != nil {
+		return nil, bodyReadError(err)
+	}
+	return body, nil
+}
+
+func bodyReadError(err error) error {
+	var maxErr *http.MaxBytesError
+	if errors.As(err, &maxErr) {
+		return &httpError{
+			code:    http.StatusRequestEntityTooLarge,
+			message: fmt.Sprintf("request body exceeds %d bytes", maxErr.Limit),
+		}
+	}
+	return err
+}
+
+// streamedUpload is the file sent in chunks to a client streaming method.
+type streamedUpload struct {
+	contentType string
+	body        io.Reader
+
+	// multipart is set when the file is a part of a multipart body, the
+	// remaining parts are checked after the file is sent.
+	multipart *multipart.Reader
+	target    *uploadTarget
+}
+
+func (su *streamedUpload) readError(err error) error {
+	if su.multipart != nil {
+		return multipartError(err)
+	}
+	return bodyReadError(err)
+}
+
+// finish checks that nothing follows the file in a multipart body. Fields
+// after the file can't be sent, as they belong in the first message.
+func (su *streamedUpload) finish() error {
+	if su.multipart == nil {
+		return nil
+	}
+	part, err := su.multipart.NextPart()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return multipartError(err)
+	}
+	if su.target.isFilePart(part) {
+		return errMultipleFiles
+	}
+	return status.Errorf(codes.InvalidArgument, "multipart field %q must precede the file", part.FormName())
+}
+
+var errMultipleFiles = status.Error(codes.InvalidArgument, "multipart body has more than one file")
+
+// mapMultipart decodes a multipart/form-data body. The file part is placed in
+// the upload target, other parts are placed in a bytes field of the same name
+// or decoded in the same way as query parameters.
+//
+// For client streaming methods the file is returned to be streamed, so the
+// other parts must precede it.
+func (mm *grpcMethod) mapMultipart(r *http.Request, boundary string, msg protoreflect.Message, query url.Values) (*streamedUpload, error) {
+	reader := multipart.NewReader(mm.requestBody(r), boundary)
+	fields := mm.Input.Fields()
+	hasFile := false
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, multipartError(err)
+		}
+
+		if mm.upload.isFilePart(part) {
+			if hasFile {
+				return nil, errMultipleFiles
+			}
+			hasFile = true
+
+			contentType := part.Header.Get("Content-Type")
+			if contentType == "" {
+				contentType = "application/octet-stream"
+			}
+			if mm.clientStreaming {
+				return &streamedUpload{
+					contentType: contentType,
+					body:        part,
+					multipart:   reader,
+					target:      mm.upload,
+				}, nil
+			}
+
+			data, err := io.ReadAll(part)
+			if err != nil {
+				return nil, multipartError(err)
+			}
+			mm.upload.set(msg, contentType, data)
+			continue
+		}
+
+		name := part.FormName()
+		data, err := io.ReadAll(part)
+		if err != nil {
+			return nil, multipartError(err)
+		}
+
+		field := fields.ByName(protoreflect.Name(name))
+		if field == nil {
+			field = fields.ByJSONName(name)
+		}
+		if field != nil && field.Kind() == protoreflect.BytesKind && !field.IsList() {
+			msg.Set(field, protoreflect.ValueOfBytes(data))
+			continue
+		}
+
+		query.Add(name, string(data))
+	}
+
+	if mm.clientStreaming {
+		// no file, the method is sent a single empty chunk
+		return &streamedUpload{body: http.NoBody}, nil
+	}
+	return nil, nil
+}
+
+func multipartError(err error) error {
+	var maxErr *http.MaxBytesError
+	if errors.As(err, &maxErr) {
+		return bodyReadError(err)
+	}
+	return status.Error(codes.InvalidArgument, err.Error())
+}
+
+// invokeStreamingUpload sends the upload to a client streaming method in
+// chunks of UploadChunkSize. The first message carries the fields mapped from
+// the path, query and multipart fields, each message carries the next chunk of
+// the file. When the upload fails part way the stream is cancelled, so the
+// method does not see a truncated file as complete.
+func (mm *grpcMethod) invokeStreamingUpload(ctx context.Context, conn StreamConn, upload *streamedUpload, input protoreflect.Message, output proto.Message, responseHeader *metadata.MD) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := conn.NewStream(ctx, &grpc.StreamDesc{
+		ClientStreams: true,
+	}, mm.FullName, grpc.Header(responseHeader))
+	if err != nil {
+		return err
+	}
+
+	buf := make([]byte, mm.UploadChunkSize)
+	msg := input
+	first := true
+	for {
+		n, readErr := io.ReadFull(upload.body, buf)
+		if readErr != nil && readErr != io.EOF && readErr != io.ErrUnexpectedEOF {
+			return upload.readError(readErr)
+		}
+		if n > 0 || first {
+			first = false
+			mm.upload.set(msg, upload.contentType, append([]byte(nil), buf[:n]...))
+			if err := stream.SendMsg(msg); err != nil {
+				if err == io.EOF {
+					// the server has ended the stream, the error is in RecvMsg
+					return stream.RecvMsg(output)
+				}
+				return err
+			}
+			msg = dynamicpb.NewMessage(mm.Input)
+		}
+		if readErr != nil {
+			break
+		}
+	}
+
+	if err := upload.finish(); err != nil {
+		return err
+	}
+
+	if err := stream.CloseSend(); err != nil {
+		return err
+	}
+	return stream.RecvMsg(output)
+}
@@ -0,0 +1,352 @@
+package proxy
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pentops/flowtest/prototest"
+	"github.com/pentops/j5/gen/j5/auth/v1/auth_j5pb"
+	codec "github.com/pentops/j5/lib/j5codec"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+type uploadInvoker struct {
+	*codec.Codec
+	got    []protoreflect.Message
+	stream bool
+}
+
+func (ui *uploadInvoker) Invoke(ctx context.Context, method string, req, res any, opts ...grpc.CallOption) error {
+	ui.got = append(ui.got, proto.Clone(req.(proto.Message)).ProtoReflect())
+	return nil
+}
+
+func (ui *uploadInvoker) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	ui.stream = true
+	return &uploadStream{invoker: ui, ctx: ctx}, nil
+}
+
+type uploadStream struct {
+	invoker *uploadInvoker
+	ctx     context.Context
+}
+
+func (us *uploadStream) Header() (metadata.MD, error) { return metadata.MD{}, nil }
+func (us *uploadStream) Trailer() metadata.MD         { return metadata.MD{} }
+func (us *uploadStream) CloseSend() error             { return nil }
+func (us *uploadStream) Context() context.Context     { return us.ctx }
+func (us *uploadStream) RecvMsg(m any) error          { return nil }
+func (us *uploadStream) SendMsg(m any) error {
+	us.invoker.got = append(us.invoker.got, proto.Clone(m.(proto.Message)).ProtoReflect())
+	return nil
+}
+
+func TestUploads(t *testing.T) {
+	pdf := prototest.DescriptorsFromSource(t, map[string]string{
+		"test.proto": `
+			syntax = "proto3";
+
+			package test;
+
+			import "google/api/annotations.proto";
+			import "google/api/httpbody.proto";
+
+			service UploadService {
+				rpc RawUpload(google.api.HttpBody) returns (UploadResponse) {}
+				rpc FieldUpload(FieldUploadRequest) returns (UploadResponse) {}
+				rpc StreamUpload(stream StreamUploadRequest) returns (UploadResponse) {}
+			}
+
+			message FieldUploadRequest {
+				string id = 1;
+				string name = 2;
+				google.api.HttpBody file = 3;
+				bytes thumbnail = 4;
+			}
+
+			message StreamUploadRequest {
+				string id = 1;
+				bytes data = 2;
+				string name = 3;
+			}
+
+			message UploadResponse {}
+		`,
+	})
+
+	// prototest does not interpret method options
+	sd := withHTTPRules(t, pdf.ServiceByName(t, "test.UploadService"), map[string]*annotations.HttpRule{
+		"RawUpload": {
+			Pattern: &annotations.HttpRule_Post{Post: "/upload/raw"},
+			Body:    "*",
+		},
+		"FieldUpload": {
+			Pattern: &annotations.HttpRule_Post{Post: "/upload/{id}/file"},
+			Body:    "file",
+		},
+		"StreamUpload": {
+			Pattern: &annotations.HttpRule_Post{Post: "/upload/{id}/stream"},
+			Body:    "data",
+		},
+	})
+
+	rr := NewRouter()
+	rr.MaxUploadSize = 512
+	rr.UploadChunkSize = 4
+	invoker := &uploadInvoker{
+		Codec: codec.NewCodec(),
+	}
+	for idx := range sd.Methods().Len() {
+		if err := rr.registerMethod(context.Background(), sd.Methods().Get(idx), invoker, &auth_j5pb.MethodAuthType_None{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	postStatus := func(path string, contentType string, body []byte) *httptest.ResponseRecorder {
+		invoker.got = nil
+		invoker.stream = false
+		req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+		req.Header.Set("Content-Type", contentType)
+		rec := httptest.NewRecorder()
+		rr.ServeHTTP(rec, req)
+		return rec
+	}
+
+	post := func(t *testing.T, path string, contentType string, body []byte) {
+		t.Helper()
+		rec := postStatus(path, contentType, body)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", rec.Code, rec.Body.String())
+		}
+	}
+
+	getBody := func(msg protoreflect.Message) (string, string) {
+		fields := msg.Descriptor().Fields()
+		return msg.Get(fields.ByName("content_type")).String(), string(msg.Get(fields.ByName("data")).Bytes())
+	}
+
+	t.Run("Raw HttpBody", func(t *testing.T) {
+		post(t, "/upload/raw", "image/png", []byte("png data"))
+		if !assert.Len(t, invoker.got, 1) {
+			return
+		}
+		contentType, data := getBody(invoker.got[0])
+		assert.Equal(t, "image/png", contentType)
+		assert.Equal(t, "png data", data)
+	})
+
+	t.Run("Raw Body Field", func(t *testing.T) {
+		post(t, "/upload/id1/file?name=foo.csv", "text/csv", []byte("a,b,c"))
+		if !assert.Len(t, invoker.got, 1) {
+			return
+		}
+		msg := invoker.got[0]
+		fields := msg.Descriptor().Fields()
+		assert.Equal(t, "id1", msg.Get(fields.ByName("id")).String())
+		assert.Equal(t, "foo.csv", msg.Get(fields.ByName("name")).String())
+		contentType, data := getBody(msg.Get(fields.ByName("file")).Message())
+		assert.Equal(t, "text/csv", contentType)
+		assert.Equal(t, "a,b,c", data)
+	})
+
+	t.Run("JSON Body Field", func(t *testing.T) {
+		post(t, "/upload/id1/file", "application/json", []byte(`{"name":"foo"}`))
+		if !assert.Len(t, invoker.got, 1) {
+			return
+		}
+		msg := invoker.got[0]
+		assert.Equal(t, "foo", msg.Get(msg.Descriptor().Fields().ByName("name")).String())
+	})
+
+	t.Run("Multipart", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		mw := multipart.NewWriter(buf)
+		if err := mw.WriteField("name", "report"); err != nil {
+			t.Fatal(err)
+		}
+		if err := mw.WriteField("thumbnail", "thumb"); err != nil {
+			t.Fatal(err)
+		}
+		part, err := mw.CreateFormFile("file", "report.txt")
+		if err != nil {
+			t.Fatal(err)
+		}
+		fmt.Fprint(part, "file contents")
+		if err := mw.Close(); err != nil {
+			t.Fatal(err)
+		}
+
+		post(t, "/upload/id2/file", mw.FormDataContentType(), buf.Bytes())
+		if !assert.Len(t, invoker.got, 1) {
+			return
+		}
+		msg := invoker.got[0]
+		fields := msg.Descriptor().Fields()
+		assert.Equal(t, "id2", msg.Get(fields.ByName("id")).String())
+		assert.Equal(t, "report", msg.Get(fields.ByName("name")).String())
+		assert.Equal(t, "thumb", string(msg.Get(fields.ByName("thumbnail")).Bytes()))
+		contentType, data := getBody(msg.Get(fields.ByName("file")).Message())
+		assert.Equal(t, "application/octet-stream", contentType)
+		assert.Equal(t, "file contents", data)
+	})
+
+	t.Run("Default Limit", func(t *testing.T) {
+		assert.Equal(t, int64(defaultMaxUploadSize), NewRouter().MaxUploadSize)
+	})
+
+	t.Run("Too Large", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/upload/raw", strings.NewReader(strings.Repeat("x", 513)))
+		req.Header.Set("Content-Type", "application/octet-stream")
+		rec := httptest.NewRecorder()
+		rr.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	})
+
+	t.Run("Streaming", func(t *testing.T) {
+		post(t, "/upload/id3/stream", "application/octet-stream", []byte(strings.Repeat("abcd", 20)+"ef"))
+		assert.True(t, invoker.stream)
+		if !assert.Len(t, invoker.got, 21) {
+			return
+		}
+		first := invoker.got[0]
+		fields := first.Descriptor().Fields()
+		assert.Equal(t, "id3", first.Get(fields.ByName("id")).String())
+		assert.Equal(t, "abcd", string(first.Get(fields.ByName("data")).Bytes()))
+
+		last := invoker.got[20]
+		assert.Equal(t, "", last.Get(fields.ByName("id")).String())
+		assert.Equal(t, "ef", string(last.Get(fields.ByName("data")).Bytes()))
+	})
+
+	t.Run("Streaming Too Large", func(t *testing.T) {
+		rec := postStatus("/upload/id3/stream", "application/octet-stream", []byte(strings.Repeat("x", 513)))
+		assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	})
+
+	t.Run("Streaming Multipart", func(t *testing.T) {
+		contentType, body := multipartBody(t, func(mw *multipart.Writer) {
+			if err := mw.WriteField("name", "data.bin"); err != nil {
+				t.Fatal(err)
+			}
+			part, err := mw.CreateFormFile("data", "data.bin")
+			if err != nil {
+				t.Fatal(err)
+			}
+			fmt.Fprint(part, "abcdef")
+		})
+
+		post(t, "/upload/id4/stream", contentType, body)
+		assert.True(t, invoker.stream)
+		if !assert.Len(t, invoker.got, 2) {
+			return
+		}
+		first := invoker.got[0]
+		fields := first.Descriptor().Fields()
+		assert.Equal(t, "id4", first.Get(fields.ByName("id")).String())
+		assert.Equal(t, "data.bin", first.Get(fields.ByName("name")).String())
+		assert.Equal(t, "abcd", string(first.Get(fields.ByName("data")).Bytes()))
+		assert.Equal(t, "ef", string(invoker.got[1].Get(fields.ByName("data")).Bytes()))
+	})
+
+	t.Run("Multiple Files", func(t *testing.T) {
+		contentType, body := multipartBody(t, func(mw *multipart.Writer) {
+			for _, name := range []string{"a.txt", "b.txt"} {
+				part, err := mw.CreateFormFile("file", name)
+				if err != nil {
+					t.Fatal(err)
+				}
+				fmt.Fprint(part, name)
+			}
+		})
+
+		rec := postStatus("/upload/id2/file", contentType, body)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+		rec = postStatus("/upload/id2/stream", contentType, body)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Streaming Field After File", func(t *testing.T) {
+		contentType, body := multipartBody(t, func(mw *multipart.Writer) {
+			part, err := mw.CreateFormFile("data", "data.bin")
+			if err != nil {
+				t.Fatal(err)
+			}
+			fmt.Fprint(part, "abcdef")
+			if err := mw.WriteField("name", "data.bin"); err != nil {
+				t.Fatal(err)
+			}
+		})
+
+		rec := postStatus("/upload/id4/stream", contentType, body)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Streaming Idempotency Key", func(t *testing.T) {
+		if err := rr.SetIdempotency(IdempotencyConfig{
+			Store: NewMemoryIdempotencyStore(time.Hour),
+			Scope: callerScope,
+		}); err != nil {
+			t.Fatal(err)
+		}
+		defer func() { rr.idempotency = nil }()
+		invoker.stream = false
+		req := httptest.NewRequest(http.MethodPost, "/upload/id3/stream", strings.NewReader("abcd"))
+		req.Header.Set("Content-Type", "application/octet-stream")
+		req.Header.Set("Idempotency-Key", "key1")
+		rec := httptest.NewRecorder()
+		rr.ServeHTTP(rec, req)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.False(t, invoker.stream)
+	})
+}
+
+func multipartBody(t testing.TB, write func(*multipart.Writer)) (string, []byte) {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	mw := multipart.NewWriter(buf)
+	write(mw)
+	if err := mw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return mw.FormDataContentType(), buf.Bytes()
+}
+
+func withHTTPRules(t testing.TB, sd protoreflect.ServiceDescriptor, rules map[string]*annotations.HttpRule) protoreflect.ServiceDescriptor {
+	t.Helper()
+	fileProto := protodesc.ToFileDescriptorProto(sd.ParentFile())
+	for _, service := range fileProto.Service {
+		if service.GetName() != string(sd.Name()) {
+			continue
+		}
+		for _, method := range service.Method {
+			rule, ok := rules[method.GetName()]
+			if !ok {
+				continue
+			}
+			method.Options = &descriptorpb.MethodOptions{}
+			proto.SetExtension(method.Options, annotations.E_Http, rule)
+		}
+	}
+
+	fd, err := protodesc.NewFile(fileProto, protoregistry.GlobalFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fd.Services().ByName(sd.Name())
+}